@@ -0,0 +1,126 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/cfgstruct"
+	"storj.io/common/errs2"
+	"storj.io/common/fpath"
+	"storj.io/common/identity"
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/process"
+	"storj.io/common/rpc"
+	"storj.io/storj/satellite/repair/repairer"
+)
+
+// Config defines the external repair worker configuration.
+type Config struct {
+	Identity identity.Config
+	TLS      tlsopts.Config
+	Worker   repairer.WorkerConfig
+}
+
+func main() {
+	logger, _, _ := process.NewLogger("repair-worker")
+	zap.ReplaceGlobals(logger)
+
+	rootCmd := &cobra.Command{
+		Use:   "repair-worker",
+		Short: "External repair worker for satellites",
+	}
+
+	var runCfg Config
+	var setupCfg Config
+	var confDir string
+	var identityDir string
+
+	defaultConfDir := fpath.ApplicationDir("storj", "repair-worker")
+	defaultIdentityDir := fpath.ApplicationDir("storj", "identity", "repair-worker")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &confDir, "config-dir", defaultConfDir, "main directory for repair worker configuration")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &identityDir, "identity-dir", defaultIdentityDir, "main directory for repair worker identity credentials")
+	defaults := cfgstruct.DefaultsFlag(rootCmd)
+
+	runCmd := RunCommand(&runCfg)
+	setupCmd := SetupCommand(confDir)
+
+	rootCmd.AddCommand(runCmd)
+	rootCmd.AddCommand(setupCmd)
+	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
+	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+
+	process.ExecCustomDebug(rootCmd)
+}
+
+// RunCommand creates command for running the repair worker.
+func RunCommand(runCfg *Config) *cobra.Command {
+	runCmd := &cobra.Command{
+		Use:   "run",
+		Short: "Run the repair worker",
+	}
+
+	runCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx, _ := process.Ctx(cmd)
+		log := zap.L()
+
+		identity, err := runCfg.Identity.Load()
+		if err != nil {
+			log.Error("failed to load identity.", zap.Error(err))
+			return errs.New("failed to load identity: %+v", err)
+		}
+
+		tlsOptions, err := tlsopts.NewOptions(identity, runCfg.TLS, nil)
+		if err != nil {
+			return err
+		}
+
+		worker, err := repairer.NewDelegatedWorker(log, rpc.NewDefaultDialer(tlsOptions), runCfg.Worker)
+		if err != nil {
+			return err
+		}
+
+		runError := errs2.IgnoreCanceled(worker.Run(ctx))
+		closeError := worker.Close()
+		return errs.Combine(runError, closeError)
+	}
+
+	return runCmd
+}
+
+// SetupCommand creates command for creating config file for the repair worker.
+func SetupCommand(confDir string) *cobra.Command {
+	setupCmd := &cobra.Command{
+		Use:         "setup",
+		Short:       "Create config files",
+		Annotations: map[string]string{"type": "setup"},
+	}
+
+	setupCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		setupDir, err := filepath.Abs(confDir)
+		if err != nil {
+			return err
+		}
+
+		valid, _ := fpath.IsValidSetupDir(setupDir)
+		if !valid {
+			return fmt.Errorf("repair worker configuration already exists (%v)", setupDir)
+		}
+
+		err = os.MkdirAll(setupDir, 0700)
+		if err != nil {
+			return err
+		}
+
+		return process.SaveConfig(cmd, filepath.Join(setupDir, "config.yaml"))
+	}
+
+	return setupCmd
+}
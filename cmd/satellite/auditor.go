@@ -69,6 +69,7 @@ func cmdAuditorRun(cmd *cobra.Command, args []string) (err error) {
 		db.NodeEvents(),
 		db.Reputation(),
 		db.Containment(),
+		db.AuditHistory(),
 		version.Build,
 		&runCfg.Config,
 		process.AtomicLevel(cmd),
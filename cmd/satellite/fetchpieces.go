@@ -82,9 +82,11 @@ func cmdFetchPieces(cmd *cobra.Command, args []string) (err error) {
 		db.RepairQueue(),
 		db.Buckets(),
 		db.OverlayCache(),
+		db.PeerIdentities(),
 		db.NodeEvents(),
 		db.Reputation(),
 		db.Containment(),
+		db.AuditHistory(),
 		version.Build,
 		&runCfg.Config,
 		process.AtomicLevel(cmd),
@@ -355,6 +355,57 @@ var (
 		Args:  cobra.RangeArgs(1, 2),
 		RunE:  cmdRepairSegment,
 	}
+	repairSimulationCmd = &cobra.Command{
+		Use:   "repair-simulation <node-filter>",
+		Short: "Report segment health as if the nodes matching the filter were offline",
+		Long:  "Run the repair checker classification against all segments, treating the nodes matching the filter (e.g. country(\"DE\") || select(\"last_net\", \"=\", \"1.2.3.0\")) as offline. The repair queue is not modified.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdRepairSimulation,
+	}
+	migrateRepairQueueCmd = &cobra.Command{
+		Use:   "migrate-repair-queue <source> <destination>",
+		Short: "Move the segments of a repair queue to another repair queue backend",
+		Long:  "Move all segments from the source repair queue to the destination repair queue. A queue is either \"database\" for the satellite database or a backend URL, e.g. redis://127.0.0.1:6379?db=2.",
+		Args:  cobra.ExactArgs(2),
+		RunE:  cmdMigrateRepairQueue,
+	}
+	nodeTagsCmd = &cobra.Command{
+		Use:   "node-tags",
+		Short: "Manage the node tags signed by the satellite",
+		Long:  "Manage the node tags which are signed by the satellite identity, instead of the node operators. The tags can be used by the placement rules the same way as the tags signed by the nodes, and every change is recorded in an audit log.",
+	}
+	nodeTagsSetCmd = &cobra.Command{
+		Use:   "set <node-id> <name> <value>",
+		Short: "Create or update a node tag",
+		Args:  cobra.ExactArgs(3),
+		RunE:  cmdNodeTagsSet,
+	}
+	nodeTagsDeleteCmd = &cobra.Command{
+		Use:   "delete <node-id> <name>",
+		Short: "Delete a node tag",
+		Args:  cobra.ExactArgs(2),
+		RunE:  cmdNodeTagsDelete,
+	}
+	nodeTagsImportCmd = &cobra.Command{
+		Use:   "import <csv-file>",
+		Short: "Create or update the node tags of a CSV file",
+		Long:  "Create or update the node tags of a CSV file with node_id,name,value columns (the header is optional). Use - to read from the standard input. Either all the tags are imported, or none of them when the file is invalid.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdNodeTagsImport,
+	}
+	nodeTagsExportCmd = &cobra.Command{
+		Use:   "export [csv-file]",
+		Short: "Export the node tags as CSV",
+		Long:  "Export the node tags signed by the satellite as CSV with node_id,name,value columns. The standard output is used without file argument.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  cmdNodeTagsExport,
+	}
+	nodeTagsChangesCmd = &cobra.Command{
+		Use:   "changes [node-id]",
+		Short: "List the most recent changes of the node tags",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  cmdNodeTagsChanges,
+	}
 	fixLastNetsCmd = &cobra.Command{
 		Use:   "fix-last-nets",
 		Short: "Fix last_net entries in the database for satellites with DistinctIP=false",
@@ -434,6 +485,9 @@ var (
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 		Before   string `help:"select only exited nodes before this UTC date formatted like YYYY-MM. Date cannot be newer than the current time (required)"`
 	}
+	nodeTagsCfg struct {
+		ChangedBy string `help:"who is recorded in the audit log as the author of the changes (default: current user)" default:""`
+	}
 	setInvoiceStatusCfg struct {
 		DryRun bool `help:"do not update stripe" default:"false"`
 	}
@@ -469,7 +523,10 @@ func init() {
 	rootCmd.AddCommand(registerLostSegments)
 	rootCmd.AddCommand(fetchPiecesCmd)
 	rootCmd.AddCommand(repairSegmentCmd)
+	rootCmd.AddCommand(repairSimulationCmd)
+	rootCmd.AddCommand(migrateRepairQueueCmd)
 	rootCmd.AddCommand(fixLastNetsCmd)
+	rootCmd.AddCommand(nodeTagsCmd)
 	rootCmd.AddCommand(deleteDataCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
 	reportsCmd.AddCommand(partnerAttributionCmd)
@@ -501,6 +558,11 @@ func init() {
 	consistencyCmd.AddCommand(consistencyGECleanupCmd)
 	deleteDataCmd.AddCommand(deleteObjectsCmd)
 	deleteDataCmd.AddCommand(deleteAccountsCmd)
+	nodeTagsCmd.AddCommand(nodeTagsSetCmd)
+	nodeTagsCmd.AddCommand(nodeTagsDeleteCmd)
+	nodeTagsCmd.AddCommand(nodeTagsImportCmd)
+	nodeTagsCmd.AddCommand(nodeTagsExportCmd)
+	nodeTagsCmd.AddCommand(nodeTagsChangesCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runMigrationCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runAPICmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -516,6 +578,16 @@ func init() {
 	process.Bind(registerLostSegments, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(fetchPiecesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(repairSegmentCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(repairSimulationCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(migrateRepairQueueCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(nodeTagsSetCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(nodeTagsDeleteCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(nodeTagsImportCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(nodeTagsExportCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(nodeTagsChangesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(nodeTagsSetCmd, &nodeTagsCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(nodeTagsDeleteCmd, &nodeTagsCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(nodeTagsImportCmd, &nodeTagsCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(qdiagCmd, &qdiagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(nodeUsageCmd, &nodeUsageCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
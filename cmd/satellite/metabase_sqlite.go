@@ -0,0 +1,8 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+// The embedded metabase is intended only for local development, e.g. with storj-sim.
+// It requires building with cgo.
+import _ "storj.io/storj/satellite/metabase/sqlitedriver"
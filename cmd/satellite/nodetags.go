@@ -0,0 +1,160 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/process"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodetags"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// nodeTagsChangesLimit is the number of the listed node tag changes.
+const nodeTagsChangesLimit = 100
+
+// withNodeTags opens the satellite database, and calls fn with the node tag
+// service which uses the satellite identity as signer.
+func withNodeTags(cmd *cobra.Command, fn func(ctx context.Context, service *nodetags.Service) error) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	identity, err := runCfg.Identity.Load()
+	if err != nil {
+		log.Error("Failed to load identity.", zap.Error(err))
+		return errs.New("Failed to load identity: %+v", err)
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-node-tags"})
+	if err != nil {
+		return errs.New("Error starting master database: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	return fn(ctx, nodetags.NewService(log.Named("nodetags"), db.NodeTags(), identity.ID))
+}
+
+// nodeTagsChangedBy returns who is recorded in the audit log as the author of
+// the changes.
+func nodeTagsChangedBy() (string, error) {
+	if nodeTagsCfg.ChangedBy != "" {
+		return nodeTagsCfg.ChangedBy, nil
+	}
+	current, err := user.Current()
+	if err != nil {
+		return "", errs.New("unable to find the current user, use --changed-by: %+v", err)
+	}
+	return current.Username, nil
+}
+
+func cmdNodeTagsSet(cmd *cobra.Command, args []string) error {
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid node ID %q: %+v", args[0], err)
+	}
+	changedBy, err := nodeTagsChangedBy()
+	if err != nil {
+		return err
+	}
+
+	return withNodeTags(cmd, func(ctx context.Context, service *nodetags.Service) error {
+		return service.SetTag(ctx, nodeID, args[1], []byte(args[2]), changedBy)
+	})
+}
+
+func cmdNodeTagsDelete(cmd *cobra.Command, args []string) error {
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid node ID %q: %+v", args[0], err)
+	}
+	changedBy, err := nodeTagsChangedBy()
+	if err != nil {
+		return err
+	}
+
+	return withNodeTags(cmd, func(ctx context.Context, service *nodetags.Service) error {
+		return service.DeleteTag(ctx, nodeID, args[1], changedBy)
+	})
+}
+
+func cmdNodeTagsImport(cmd *cobra.Command, args []string) (err error) {
+	changedBy, err := nodeTagsChangedBy()
+	if err != nil {
+		return err
+	}
+
+	var input io.Reader = os.Stdin
+	if args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		defer func() { err = errs.Combine(err, file.Close()) }()
+		input = file
+	}
+
+	return withNodeTags(cmd, func(ctx context.Context, service *nodetags.Service) error {
+		count, err := service.ImportCSV(ctx, input, changedBy)
+		if err != nil {
+			return err
+		}
+		zap.L().Info("node tags are imported", zap.Int("count", count))
+		return nil
+	})
+}
+
+func cmdNodeTagsExport(cmd *cobra.Command, args []string) (err error) {
+	var output io.Writer = os.Stdout
+	if len(args) > 0 && args[0] != "-" {
+		file, err := os.Create(args[0])
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		defer func() { err = errs.Combine(err, file.Close()) }()
+		output = file
+	}
+
+	return withNodeTags(cmd, func(ctx context.Context, service *nodetags.Service) error {
+		return service.ExportCSV(ctx, output)
+	})
+}
+
+func cmdNodeTagsChanges(cmd *cobra.Command, args []string) error {
+	var nodeID storj.NodeID
+	if len(args) > 0 {
+		var err error
+		nodeID, err = storj.NodeIDFromString(args[0])
+		if err != nil {
+			return errs.New("invalid node ID %q: %+v", args[0], err)
+		}
+	}
+
+	return withNodeTags(cmd, func(ctx context.Context, service *nodetags.Service) error {
+		changes, err := service.Changes(ctx, nodeID, nodeTagsChangesLimit)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "CHANGED AT\tCHANGED BY\tACTION\tNODE ID\tNAME\tVALUE")
+		for _, change := range changes {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				change.ChangedAt.UTC().Format("2006-01-02T15:04:05Z"), change.ChangedBy, change.Action,
+				change.NodeID, change.Name, change.Value)
+		}
+		return w.Flush()
+	})
+}
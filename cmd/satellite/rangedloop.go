@@ -9,8 +9,11 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/identity"
+	"storj.io/common/peertls/extensions"
 	"storj.io/common/process"
 	"storj.io/common/process/eventkitbq"
+	"storj.io/storj/private/revocation"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/satellitedb"
@@ -36,7 +39,26 @@ func cmdRangedLoopRun(cmd *cobra.Command, args []string) (err error) {
 		err = errs.Combine(err, metabaseDB.Close())
 	}()
 
-	peer, err := satellite.NewRangedLoop(log, db, metabaseDB, &runCfg.Config, process.AtomicLevel(cmd))
+	var identity *identity.FullIdentity
+	var revocationDB extensions.RevocationDB
+	if runCfg.AuditInventory.Enabled {
+		identity, err = runCfg.Identity.Load()
+		if err != nil {
+			log.Error("Failed to load identity.", zap.Error(err))
+			return errs.New("Failed to load identity: %+v", err)
+		}
+
+		revDB, err := revocation.OpenDBFromCfg(ctx, runCfg.Server.Config)
+		if err != nil {
+			return errs.New("Error creating revocation database: %+v", err)
+		}
+		defer func() {
+			err = errs.Combine(err, revDB.Close())
+		}()
+		revocationDB = revDB
+	}
+
+	peer, err := satellite.NewRangedLoop(log, identity, db, metabaseDB, revocationDB, &runCfg.Config, process.AtomicLevel(cmd))
 	if err != nil {
 		return err
 	}
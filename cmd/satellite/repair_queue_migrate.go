@@ -0,0 +1,91 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/process"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// migrateRepairQueueBatchSize is how many segments are moved at once.
+const migrateRepairQueueBatchSize = 1000
+
+func cmdMigrateRepairQueue(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-migrate-repair-queue"})
+	if err != nil {
+		return errs.New("Error starting master database: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	openQueue := func(backend string) (queue.RepairQueue, error) {
+		if backend == "database" {
+			backend = ""
+		}
+		return queue.Open(queue.Config{Backend: backend}, db.RepairQueue())
+	}
+	closeQueue := func(repairQueue queue.RepairQueue) error {
+		if closer, ok := repairQueue.(io.Closer); ok {
+			return closer.Close()
+		}
+		return nil
+	}
+
+	source, err := openQueue(args[0])
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, closeQueue(source)) }()
+
+	destination, err := openQueue(args[1])
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, closeQueue(destination)) }()
+
+	moved, err := migrateRepairQueue(ctx, source, destination)
+	log.Info("repair queue migration finished", zap.Int("Moved Segments", moved), zap.Error(err))
+	return err
+}
+
+// migrateRepairQueue moves all segments from the source queue to the
+// destination queue. The segment health and placement are preserved.
+func migrateRepairQueue(ctx context.Context, source, destination queue.RepairQueue) (moved int, err error) {
+	for {
+		segments, err := source.SelectN(ctx, migrateRepairQueueBatchSize)
+		if err != nil {
+			return moved, err
+		}
+		if len(segments) == 0 {
+			return moved, nil
+		}
+
+		batch := make([]*queue.InjuredSegment, len(segments))
+		for i := range segments {
+			batch[i] = &segments[i]
+		}
+		if _, err := destination.InsertBatch(ctx, batch); err != nil {
+			return moved, err
+		}
+
+		for _, segment := range segments {
+			if err := source.Delete(ctx, segment); err != nil {
+				return moved, err
+			}
+			moved++
+		}
+	}
+}
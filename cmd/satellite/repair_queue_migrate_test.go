@@ -0,0 +1,60 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testredis"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func TestMigrateRepairQueue(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server, err := testredis.Mini(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(server.Close)
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer ctx.Check(client.Close)
+
+	source := queue.NewRedisRepairQueue(client, "source")
+	destination := queue.NewRedisRepairQueue(client, "destination")
+
+	const count = 2*migrateRepairQueueBatchSize + 10
+	segments := make([]*queue.InjuredSegment, count)
+	for i := range segments {
+		segments[i] = &queue.InjuredSegment{
+			StreamID:      testrand.UUID(),
+			SegmentHealth: float64(i),
+			Placement:     storj.PlacementConstraint(i % 3),
+		}
+	}
+	_, err = source.InsertBatch(ctx, segments)
+	require.NoError(t, err)
+
+	moved, err := migrateRepairQueue(ctx, source, destination)
+	require.NoError(t, err)
+	require.Equal(t, count, moved)
+
+	sourceCount, err := source.Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, sourceCount)
+
+	destinationCount, err := destination.Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, count, destinationCount)
+
+	selected, err := destination.Select(ctx, 1, []storj.PlacementConstraint{2}, nil)
+	require.NoError(t, err)
+	require.Equal(t, segments[2].StreamID, selected[0].StreamID)
+	require.Equal(t, segments[2].SegmentHealth, selected[0].SegmentHealth)
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/process"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+func cmdRepairSimulation(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	offline, err := nodeselection.FilterFromString(args[0], nil)
+	if err != nil {
+		return err
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-repair-simulation"})
+	if err != nil {
+		return errs.New("Error starting master database: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), runCfg.Metainfo.DatabaseURL,
+		runCfg.Config.Metainfo.Metabase("satellite-repair-simulation"))
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	placement, err := runCfg.Placement.Parse(runCfg.Overlay.Node.CreateDefaultPlacement, nil)
+	if err != nil {
+		return err
+	}
+
+	overlayService, err := overlay.NewService(log.Named("overlay"), db.OverlayCache(), db.NodeEvents(), placement, runCfg.Console.ExternalAddress, runCfg.Console.SatelliteName, runCfg.Overlay)
+	if err != nil {
+		return err
+	}
+
+	checkerConfig := runCfg.Checker
+	if len(checkerConfig.RepairExcludedCountryCodes) == 0 {
+		checkerConfig.RepairExcludedCountryCodes = runCfg.Overlay.RepairExcludedCountryCodes
+	}
+
+	observer := checker.NewSimulationObserver(log.Named("repair:simulation"), overlayService, placement, offline, checkerConfig)
+
+	provider := rangedloop.NewMetabaseRangeSplitter(log.Named("rangedloop-metabase-range-splitter"), metabaseDB, runCfg.RangedLoop)
+	service := rangedloop.NewService(log.Named("rangedloop"), runCfg.RangedLoop, provider, []rangedloop.Observer{observer})
+	service.DisableCheckpoints()
+	if _, err := service.RunOnce(ctx); err != nil {
+		return err
+	}
+
+	report := observer.Report()
+	placements := make([]storj.PlacementConstraint, 0, len(report))
+	for p := range report {
+		placements = append(placements, p)
+	}
+	sort.Slice(placements, func(i, j int) bool { return placements[i] < placements[j] })
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "placement\tsegments\taffected segments\taffected pieces\tneeding repair\tnew needing repair\tirreparable\tnew irreparable\tobjects lost\tmin health")
+	for _, p := range placements {
+		stats := report[p]
+		_, _ = fmt.Fprintf(w, "%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%.4f\n", p,
+			stats.SegmentsChecked, stats.SegmentsAffected, stats.PiecesAffected,
+			stats.SegmentsNeedingRepair, stats.NewSegmentsNeedingRepair,
+			stats.SegmentsIrreparable, stats.NewSegmentsIrreparable,
+			stats.ObjectsLost, stats.MinSegmentHealth)
+	}
+	return w.Flush()
+}
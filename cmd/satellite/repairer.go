@@ -4,6 +4,8 @@
 package main
 
 import (
+	"io"
+
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
@@ -16,6 +18,7 @@ import (
 	"storj.io/storj/private/revocation"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/satellitedb"
 )
 
@@ -54,17 +57,29 @@ func cmdRepairerRun(cmd *cobra.Command, args []string) (err error) {
 		err = errs.Combine(err, revocationDB.Close())
 	}()
 
+	repairQueue, err := queue.Open(runCfg.RepairQueue, db.RepairQueue())
+	if err != nil {
+		return errs.New("Error opening repair queue: %+v", err)
+	}
+	if closer, ok := repairQueue.(io.Closer); ok {
+		defer func() {
+			err = errs.Combine(err, closer.Close())
+		}()
+	}
+
 	peer, err := satellite.NewRepairer(
 		log,
 		identity,
 		metabaseDB,
 		revocationDB,
-		db.RepairQueue(),
+		repairQueue,
 		db.Buckets(),
 		db.OverlayCache(),
+		db.PeerIdentities(),
 		db.NodeEvents(),
 		db.Reputation(),
 		db.Containment(),
+		db.AuditHistory(),
 		version.Build,
 		&runCfg.Config,
 		process.AtomicLevel(cmd),
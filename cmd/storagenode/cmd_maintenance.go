@@ -0,0 +1,248 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/cfgstruct"
+	"storj.io/common/identity"
+	"storj.io/common/process"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/storj/private/server"
+	"storj.io/storj/storagenode/internalpb"
+)
+
+// maintenanceDeclareCfg defines configuration for maintenance-declare command.
+type maintenanceDeclareCfg struct {
+	Identity identity.Config
+	Server   server.Config
+
+	MaintenanceDeclareOptions
+}
+
+// MaintenanceDeclareOptions defines options for maintenance-declare command.
+type MaintenanceDeclareOptions struct {
+	SatelliteIDs []string `internal:"true"`
+
+	Start    string        `help:"start of the maintenance in RFC3339 format (e.g. 2025-01-02T15:04:05Z), empty means now" default:""`
+	Duration time.Duration `help:"expected duration of the maintenance, the satellites reject too long windows" default:"1h"`
+
+	Stdout io.Writer `internal:"true"`
+}
+
+// maintenanceCancelCfg defines configuration for maintenance-cancel command.
+type maintenanceCancelCfg struct {
+	Identity identity.Config
+	Server   server.Config
+
+	SatelliteIDs []string  `internal:"true"`
+	Stdout       io.Writer `internal:"true"`
+}
+
+func newMaintenanceDeclareCmd(f *Factory) *cobra.Command {
+	var cfg maintenanceDeclareCfg
+	cmd := &cobra.Command{
+		Use:   "maintenance-declare [satellite_IDs...]",
+		Short: "Declare a maintenance window",
+		Long: "The command declares a maintenance window to the satellites. During the window the node is not " +
+			"selected for uploads and it's not counted as offline. The satellites limit the duration and the frequency " +
+			"of the windows.\n",
+		Example: `
+# Declare one hour maintenance starting now to all trusted satellites
+$ storagenode maintenance-declare --identity-dir /path/to/identityDir --config-dir /path/to/configDir
+
+# Schedule maintenance to a specific satellite
+$ storagenode maintenance-declare satellite_ID --start 2025-01-02T15:00:00Z --duration 2h --identity-dir /path/to/identityDir --config-dir /path/to/configDir
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg.SatelliteIDs = args
+
+			ctx, _ := process.Ctx(cmd)
+			return cmdMaintenanceDeclare(ctx, zap.L(), &cfg)
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func newMaintenanceCancelCmd(f *Factory) *cobra.Command {
+	var cfg maintenanceCancelCfg
+	cmd := &cobra.Command{
+		Use:   "maintenance-cancel [satellite_IDs...]",
+		Short: "Cancel the maintenance window",
+		Long:  "The command cancels the scheduled maintenance window, or finishes the active one.\n",
+		Example: `
+# Cancel the maintenance on all trusted satellites
+$ storagenode maintenance-cancel --identity-dir /path/to/identityDir --config-dir /path/to/configDir
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg.SatelliteIDs = args
+
+			ctx, _ := process.Ctx(cmd)
+			return cmdMaintenanceCancel(ctx, zap.L(), &cfg)
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func cmdMaintenanceDeclare(ctx context.Context, log *zap.Logger, cfg *maintenanceDeclareCfg) (err error) {
+	// we don't really need the identity, but we load it as a sanity check
+	ident, err := cfg.Identity.Load()
+	if err != nil {
+		log.Fatal("Failed to load identity.", zap.Error(err))
+	} else {
+		log.Info("Identity loaded.", zap.Stringer("Node ID", ident.ID))
+	}
+
+	client, err := dialMaintenanceClient(ctx, cfg.Server.PrivateAddress)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.close()) }()
+
+	return declareMaintenance(ctx, client, cfg.MaintenanceDeclareOptions)
+}
+
+func declareMaintenance(ctx context.Context, client *maintenanceClient, opts MaintenanceDeclareOptions) error {
+	if opts.Stdout == nil {
+		opts.Stdout = os.Stdout
+	}
+
+	ids, err := parseSatelliteIDs(opts.SatelliteIDs)
+	if err != nil {
+		return err
+	}
+
+	var start time.Time
+	if opts.Start != "" {
+		start, err = time.Parse(time.RFC3339, opts.Start)
+		if err != nil {
+			return errs.New("invalid start time: %w", err)
+		}
+	}
+
+	resp, err := client.declare(ctx, ids, start, opts.Duration)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	return displayMaintenanceStatuses(tabwriter.NewWriter(opts.Stdout, 0, 0, 2, ' ', 0), resp.Statuses)
+}
+
+func cmdMaintenanceCancel(ctx context.Context, log *zap.Logger, cfg *maintenanceCancelCfg) (err error) {
+	if cfg.Stdout == nil {
+		cfg.Stdout = os.Stdout
+	}
+	// we don't really need the identity, but we load it as a sanity check
+	ident, err := cfg.Identity.Load()
+	if err != nil {
+		log.Fatal("Failed to load identity.", zap.Error(err))
+	} else {
+		log.Info("Identity loaded.", zap.Stringer("Node ID", ident.ID))
+	}
+
+	ids, err := parseSatelliteIDs(cfg.SatelliteIDs)
+	if err != nil {
+		return err
+	}
+
+	client, err := dialMaintenanceClient(ctx, cfg.Server.PrivateAddress)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.close()) }()
+
+	resp, err := client.cancel(ctx, ids)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	return displayMaintenanceStatuses(tabwriter.NewWriter(cfg.Stdout, 0, 0, 2, ' ', 0), resp.Statuses)
+}
+
+func parseSatelliteIDs(args []string) (ids []storj.NodeID, err error) {
+	for _, arg := range args {
+		id, err := storj.NodeIDFromString(arg)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func displayMaintenanceStatuses(w *tabwriter.Writer, statuses []*internalpb.MaintenanceStatus) (err error) {
+	defer func() { err = errs.Combine(err, w.Flush()) }()
+
+	_, err = fmt.Fprintln(w, "Satellite ID\tStart\tEnd\tStatus")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	for _, status := range statuses {
+		start, end, result := "-", "-", "OK"
+		if !status.StartTime.IsZero() {
+			start = status.StartTime.UTC().Format(time.RFC3339)
+			end = status.EndTime.UTC().Format(time.RFC3339)
+		}
+		if status.Error != "" {
+			result = "Failed: " + status.Error
+		}
+
+		_, err = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", status.SatelliteId, start, end, result)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+type maintenanceClient struct {
+	conn *rpc.Conn
+}
+
+func dialMaintenanceClient(ctx context.Context, address string) (*maintenanceClient, error) {
+	conn, err := rpc.NewDefaultDialer(nil).DialAddressUnencrypted(ctx, address)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &maintenanceClient{conn: conn}, nil
+}
+
+func (client *maintenanceClient) declare(ctx context.Context, ids []storj.NodeID, start time.Time, duration time.Duration) (*internalpb.DeclareMaintenanceResponse, error) {
+	return internalpb.NewDRPCNodeMaintenanceClient(client.conn).DeclareMaintenance(ctx, &internalpb.DeclareMaintenanceRequest{
+		SatelliteIds:    ids,
+		StartTime:       start,
+		DurationSeconds: int64(duration / time.Second),
+	})
+}
+
+func (client *maintenanceClient) cancel(ctx context.Context, ids []storj.NodeID) (*internalpb.CancelMaintenanceResponse, error) {
+	return internalpb.NewDRPCNodeMaintenanceClient(client.conn).CancelMaintenance(ctx, &internalpb.CancelMaintenanceRequest{
+		SatelliteIds: ids,
+	})
+}
+
+func (client *maintenanceClient) close() error {
+	return client.conn.Close()
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/cfgstruct"
+	"storj.io/common/identity"
+	"storj.io/common/memory"
+	"storj.io/common/process"
+	"storj.io/common/rpc"
+	"storj.io/storj/private/server"
+	"storj.io/storj/storagenode/internalpb"
+)
+
+// probeCfg defines configuration for probe command.
+type probeCfg struct {
+	Identity identity.Config
+	Server   server.Config
+
+	SatelliteIDs []string  `internal:"true"`
+	Stdout       io.Writer `internal:"true"`
+}
+
+func newProbeCmd(f *Factory) *cobra.Command {
+	var cfg probeCfg
+	cmd := &cobra.Command{
+		Use:   "probe [satellite_IDs...]",
+		Short: "Test uploads and downloads from the satellites",
+		Long: "The command asks the satellites to upload a small test piece to the node and to download it back, " +
+			"over both TCP and QUIC. It helps to diagnose nodes which are reachable, but fail real uploads " +
+			"(e.g. because of MTU problems or blocked QUIC). The satellites limit how often the node can be probed.\n",
+		Example: `
+# Ask all trusted satellites to probe the node
+$ storagenode probe --identity-dir /path/to/identityDir --config-dir /path/to/configDir
+
+# Ask a specific satellite to probe the node
+$ storagenode probe satellite_ID --identity-dir /path/to/identityDir --config-dir /path/to/configDir
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg.SatelliteIDs = args
+
+			ctx, _ := process.Ctx(cmd)
+			return cmdProbe(ctx, zap.L(), &cfg)
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func cmdProbe(ctx context.Context, log *zap.Logger, cfg *probeCfg) (err error) {
+	if cfg.Stdout == nil {
+		cfg.Stdout = os.Stdout
+	}
+	// we don't really need the identity, but we load it as a sanity check
+	ident, err := cfg.Identity.Load()
+	if err != nil {
+		log.Fatal("Failed to load identity.", zap.Error(err))
+	} else {
+		log.Info("Identity loaded.", zap.Stringer("Node ID", ident.ID))
+	}
+
+	ids, err := parseSatelliteIDs(cfg.SatelliteIDs)
+	if err != nil {
+		return err
+	}
+
+	conn, err := rpc.NewDefaultDialer(nil).DialAddressUnencrypted(ctx, cfg.Server.PrivateAddress)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	resp, err := internalpb.NewDRPCNodeProbeClient(conn).Probe(ctx, &internalpb.ProbeRequest{
+		SatelliteIds: ids,
+	})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	return displayProbeResults(tabwriter.NewWriter(cfg.Stdout, 0, 0, 2, ' ', 0), resp.Results)
+}
+
+func displayProbeResults(w *tabwriter.Writer, results []*internalpb.SatelliteProbeResult) (err error) {
+	defer func() { err = errs.Combine(err, w.Flush()) }()
+
+	_, err = fmt.Fprintln(w, "Satellite ID\tTransport\tAddress\tDial\tUpload\tDownload\tStatus")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	for _, result := range results {
+		if result.Error != "" {
+			_, err = fmt.Fprintf(w, "%s\t-\t-\t-\t-\t-\tFailed: %s\n", result.SatelliteId, result.Error)
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			continue
+		}
+
+		for _, transport := range result.Transports {
+			status := "OK"
+			if transport.FailedStep != "" {
+				status = fmt.Sprintf("Failed to %s: %s", transport.FailedStep, transport.Error)
+			}
+
+			_, err = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				result.SatelliteId, transport.Transport, transport.Address,
+				formatProbeLatency(transport.DialMicros),
+				formatProbeThroughput(transport.PieceSize, transport.UploadMicros),
+				formatProbeThroughput(transport.PieceSize, transport.DownloadMicros),
+				status)
+			if err != nil {
+				return errs.Wrap(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func formatProbeLatency(micros int64) string {
+	if micros <= 0 {
+		return "-"
+	}
+	return (time.Duration(micros) * time.Microsecond).Round(time.Millisecond / 10).String()
+}
+
+func formatProbeThroughput(size, micros int64) string {
+	if micros <= 0 {
+		return "-"
+	}
+	duration := time.Duration(micros) * time.Microsecond
+	perSecond := memory.Size(float64(size) / duration.Seconds())
+	return fmt.Sprintf("%s (%s/s)", duration.Round(time.Millisecond/10), perSecond)
+}
@@ -62,6 +62,9 @@ func newRootCmd(setDefaults bool) (*cobra.Command, *Factory) {
 		newGracefulExitStatusCmd(factory),
 		newForgetSatelliteCmd(factory),
 		newForgetSatelliteStatusCmd(factory),
+		newMaintenanceDeclareCmd(factory),
+		newMaintenanceCancelCmd(factory),
+		newProbeCmd(factory),
 		// internal hidden commands
 		internalcmd.NewUsedSpaceFilewalkerCmd().Command,
 		internalcmd.NewGCFilewalkerCmd().Command,
@@ -36,6 +36,10 @@ type Flags struct {
 	Postgres string
 	Redis    string
 
+	// Connection string for the metabase, e.g. sqlite3:// uses an embedded
+	// database in each satellite directory instead of postgres.
+	Metabase string
+
 	// Value of first redis db
 	RedisStartDB int
 }
@@ -73,6 +77,7 @@ func main() {
 	rootCmd.PersistentFlags().BoolVarP(&flags.FailFast, "failfast", "", true, "stop all processes when one of the processes fails")
 
 	rootCmd.PersistentFlags().StringVarP(&flags.Postgres, "postgres", "", os.Getenv("STORJ_SIM_POSTGRES"), "connection string for postgres (defaults to STORJ_SIM_POSTGRES)")
+	rootCmd.PersistentFlags().StringVarP(&flags.Metabase, "metabase", "", os.Getenv("STORJ_SIM_METABASE"), "connection string for metabase, sqlite3:// uses an embedded database (defaults to STORJ_SIM_METABASE or postgres)")
 	rootCmd.PersistentFlags().StringVarP(&flags.Redis, "redis", "", os.Getenv("STORJ_SIM_REDIS"), "connection string for redis e.g. 127.0.0.1:6379 (defaults to STORJ_SIM_REDIS)")
 	rootCmd.PersistentFlags().IntVarP(&flags.RedisStartDB, "redis-startdb", "", 0, "value of first redis db (defaults to 0)")
 
@@ -376,7 +376,7 @@ func newNetwork(flags *Flags) (*Processes, error) {
 			if err != nil {
 				return nil, err
 			}
-			metainfoDBURL, err := namespacedDatabaseURL(flags.Postgres, fmt.Sprintf("satellite/%d/meta", i))
+			metainfoDBURL, err := metabaseDatabaseURL(flags, apiProcess.Directory, fmt.Sprintf("satellite/%d/meta", i))
 			if err != nil {
 				return nil, err
 			}
@@ -770,6 +770,24 @@ func readConfigString(into *string, dir, flagName string) error {
 	return nil
 }
 
+// metabaseDatabaseURL returns the metabase url for a satellite, which is
+// either the namespaced postgres database or an embedded database in the
+// satellite directory.
+func metabaseDatabaseURL(flags *Flags, directory, namespace string) (string, error) {
+	if flags.Metabase == "" {
+		return namespacedDatabaseURL(flags.Postgres, namespace)
+	}
+
+	_, _, impl, err := dbutil.SplitConnStr(flags.Metabase)
+	if err != nil {
+		return "", err
+	}
+	if impl == dbutil.SQLite3 {
+		return "sqlite3://" + filepath.Join(directory, "metabase.db"), nil
+	}
+	return namespacedDatabaseURL(flags.Metabase, namespace)
+}
+
 // namespacedDatabaseURL returns an equivalent database url with the given namespace
 // so that a database opened with the url does not conflict with other databases
 // opened with a different namespace.
@@ -63,6 +63,7 @@ func (chore *Chore) RunOnce(ctx context.Context) error {
 			plainOffset,
 			progress,
 		})
+	loop.DisableCheckpoints()
 
 	_, err := loop.RunOnce(ctx)
 	return Error.Wrap(err)
@@ -0,0 +1,140 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/process"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "placement-simulator",
+		Short: "Simulate node selection of placements against a snapshot of real nodes",
+	}
+
+	exportCmd = &cobra.Command{
+		Use:   "export <snapshot.json>",
+		Short: "Export the participating nodes of a satellite into a snapshot file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return exportNodes(ctx, args[0])
+		},
+	}
+
+	simulateCmd = &cobra.Command{
+		Use:   "simulate <snapshot.json>",
+		Short: "Simulate node selection of the placements against a snapshot",
+		Long: `This command helps to evaluate a placement configuration before using it in production.
+
+The selector of each placement is invoked many times against the nodes of a snapshot
+(created with the export command), and the distribution of the selected pieces by country,
+subnet, operator (email) and tag is reported, together with the number of failed selections
+for the EC parameters of the placement and the number of selections violating the
+invariant of the placement.
+
+EXAMPLES:
+
+placement-simulator export --database postgres://... nodes.json
+
+placement-simulator simulate --placement /tmp/placement.yaml --iterations 10000 nodes.json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return simulate(ctx, args[0])
+		},
+	}
+
+	exportConfig   ExportConfig
+	simulateConfig SimulateConfig
+)
+
+// ExportConfig contains configuration of the node export.
+type ExportConfig struct {
+	Database           string        `help:"satellite database connection string" default:""`
+	OnlineWindow       time.Duration `help:"the amount of time without seeing a node before its considered offline" default:"4h"`
+	AsOfSystemInterval time.Duration `help:"as of system interval of the node query" default:"-10s"`
+}
+
+// SimulateConfig contains configuration of the simulation.
+type SimulateConfig struct {
+	Placement  nodeselection.ConfigurablePlacementRule `help:"placement configuration, either a YAML file or the legacy 'id:definition;id:definition;...' rules" default:""`
+	RS         metainfo.RSConfig                       `help:"default redundancy scheme configuration in the format k/m/o/n-sharesize, which is overridden by the EC parameters of the placements" default:"29/35/80/110-256B"`
+	Iterations int                                     `help:"number of simulated selections per placement" default:"10000"`
+	Top        int                                     `help:"number of the largest groups to print for each distribution" default:"10"`
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(simulateCmd)
+
+	process.Bind(exportCmd, &exportConfig)
+	process.Bind(simulateCmd, &simulateConfig)
+}
+
+func exportNodes(ctx context.Context, snapshot string) (err error) {
+	if exportConfig.Database == "" {
+		return errs.New("database is not set")
+	}
+
+	db, err := satellitedb.Open(ctx, zap.L().Named("db"), exportConfig.Database, satellitedb.Options{ApplicationName: "placement-simulator"})
+	if err != nil {
+		return errs.New("error connecting to satellite database: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	nodes, err := db.OverlayCache().GetParticipatingNodes(ctx, exportConfig.OnlineWindow, exportConfig.AsOfSystemInterval)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(nodes)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(os.WriteFile(snapshot, data, 0644))
+}
+
+func simulate(ctx context.Context, snapshot string) error {
+	data, err := os.ReadFile(snapshot)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	var nodes []*nodeselection.SelectedNode
+	if err := json.Unmarshal(data, &nodes); err != nil {
+		return errs.New("invalid snapshot %s: %v", snapshot, err)
+	}
+
+	placements, err := simulateConfig.Placement.Parse(func() (nodeselection.Placement, error) {
+		return nodeselection.Placement{}, errs.New("placement is not set")
+	}, nil)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	for _, report := range Simulate(placements, nodes, simulateConfig.RS, simulateConfig.Iterations) {
+		report.Print(os.Stdout, simulateConfig.Top)
+	}
+	return nil
+}
+
+func main() {
+	logger, _, _ := process.NewLogger("placement-simulator")
+	zap.ReplaceGlobals(logger)
+
+	process.Exec(rootCmd)
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
+)
+
+func TestSimulate(t *testing.T) {
+	var nodes []*nodeselection.SelectedNode
+	for i := 0; i < 20; i++ {
+		country := location.Germany
+		if i%2 == 1 {
+			country = location.UnitedStates
+		}
+		nodes = append(nodes, &nodeselection.SelectedNode{
+			ID:          testrand.NodeID(),
+			LastNet:     fmt.Sprintf("10.0.%d.0", i/2),
+			CountryCode: country,
+			Email:       fmt.Sprintf("operator%d@example.com", i%4),
+			Online:      true,
+		})
+	}
+	// nodes which are not online don't get pieces.
+	nodes = append(nodes, &nodeselection.SelectedNode{ID: testrand.NodeID(), LastNet: "10.0.100.0"})
+
+	placements, err := nodeselection.LoadConfigFromString(`
+placements:
+  - id: 0
+    name: random
+    invariant: maxcontrol("last_net",1)
+  - id: 1
+    name: subnet
+    invariant: maxcontrol("last_net",1)
+    selector: attribute("last_net")
+  - id: 2
+    name: de
+    filter: country("DE")
+    ec:
+      total: 12
+      success: 11
+`, nodeselection.NewPlacementConfigEnvironment(nil, nil))
+	require.NoError(t, err)
+
+	rs := metainfo.RSConfig{Min: 2, Repair: 4, Success: 6, Total: 8}
+	reports := Simulate(placements, nodes, rs, 100)
+	require.Len(t, reports, 3)
+
+	random, subnet, de := reports[0], reports[1], reports[2]
+
+	require.Equal(t, 20, random.EligibleNodes)
+	require.Zero(t, random.Failures)
+	require.Zero(t, random.FilterViolations)
+	require.NotZero(t, random.InvariantViolations)
+	require.Len(t, random.Subnets.Pieces, 10)
+	require.Len(t, random.Countries.Pieces, 2)
+	require.Len(t, random.Operators.Pieces, 4)
+	require.NotContains(t, random.Subnets.Pieces, "10.0.100.0")
+
+	require.Zero(t, subnet.Failures)
+	require.Zero(t, subnet.InvariantViolations)
+	require.Equal(t, 1, subnet.Subnets.MaxPerSelection)
+
+	require.Equal(t, 10, de.EligibleNodes)
+	require.Equal(t, 12, de.RS.Total)
+	require.Equal(t, 100, de.Failures)
+	require.Equal(t, 100, de.BelowSuccess)
+	require.Zero(t, de.FilterViolations)
+	require.Equal(t, map[string]int{"DE": 1000}, de.Countries.Pieces)
+
+	var out bytes.Buffer
+	for _, report := range reports {
+		report.Print(&out, 3)
+	}
+	require.Contains(t, out.String(), "Placement 2 de")
+	require.Contains(t, out.String(), "... 7 more")
+}
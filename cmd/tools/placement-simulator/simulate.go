@@ -0,0 +1,223 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+// Report contains the result of the simulated selections of a placement.
+type Report struct {
+	Placement storj.PlacementConstraint
+	Name      string
+	RS        metainfo.RSConfig
+
+	// EligibleNodes is the number of nodes matching the placement filter.
+	EligibleNodes int
+	Selections    int
+	// Failures is the number of selections which returned an error or less than
+	// Total nodes.
+	Failures int
+	// BelowSuccess is the number of selections with less than Success nodes,
+	// which would fail the upload.
+	BelowSuccess int
+	// FilterViolations is the number of selected nodes which don't match the
+	// placement filter.
+	FilterViolations int
+	// InvariantViolations is the number of selections with pieces which the
+	// repair checker would move.
+	InvariantViolations int
+	// InvariantPieces is the number of pieces which the repair checker would move.
+	InvariantPieces int
+
+	Countries Distribution
+	Subnets   Distribution
+	Operators Distribution
+	Tags      Distribution
+}
+
+// Distribution counts how many pieces were placed to each group of nodes.
+type Distribution struct {
+	// Pieces contains the number of selected pieces per group.
+	Pieces map[string]int
+	// MaxPerSelection is the highest number of pieces of a single selection
+	// placed to the same group.
+	MaxPerSelection int
+}
+
+// add adds the groups of a single selection.
+func (d *Distribution) add(groups []string) {
+	if d.Pieces == nil {
+		d.Pieces = map[string]int{}
+	}
+	perSelection := map[string]int{}
+	for _, group := range groups {
+		d.Pieces[group]++
+		perSelection[group]++
+		d.MaxPerSelection = max(d.MaxPerSelection, perSelection[group])
+	}
+}
+
+// Simulate runs the selector of every placement against the nodes for the
+// given number of iterations.
+func Simulate(placements nodeselection.PlacementDefinitions, nodes []*nodeselection.SelectedNode, rs metainfo.RSConfig, iterations int) []Report {
+	var eligible []*nodeselection.SelectedNode
+	for _, node := range nodes {
+		if node.Online && !node.Suspended && !node.Exiting {
+			eligible = append(eligible, node)
+		}
+	}
+
+	// the placements are reported in the order of their IDs.
+	ids := placements.SupportedPlacements()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var reports []Report
+	for _, id := range ids {
+		reports = append(reports, simulatePlacement(placements[id], eligible, rs, iterations))
+	}
+	return reports
+}
+
+func simulatePlacement(placement nodeselection.Placement, nodes []*nodeselection.SelectedNode, defaultRS metainfo.RSConfig, iterations int) Report {
+	report := Report{
+		Placement:  placement.ID,
+		Name:       placement.Name,
+		RS:         *defaultRS.Override(placement.EC),
+		Selections: iterations,
+	}
+
+	filter := placement.NodeFilter
+	if filter == nil {
+		filter = nodeselection.AnyFilter{}
+	}
+	for _, node := range nodes {
+		if filter.Match(node) {
+			report.EligibleNodes++
+		}
+	}
+
+	init := placement.Selector
+	if init == nil {
+		init = nodeselection.RandomSelector()
+	}
+	selector := init(nodes, filter)
+
+	for i := 0; i < iterations; i++ {
+		// some selectors depend on the uplink, which requests the upload.
+		var requester storj.NodeID
+		_, _ = rand.Read(requester[:])
+
+		selected, err := selector(requester, report.RS.Total, nil, nil)
+		if err != nil || len(selected) < report.RS.Total {
+			report.Failures++
+		}
+		if len(selected) < report.RS.Success {
+			report.BelowSuccess++
+		}
+
+		pieces := make(metabase.Pieces, 0, len(selected))
+		selectedNodes := make([]nodeselection.SelectedNode, 0, len(selected))
+		var countries, subnets, operators, tags []string
+		for number, node := range selected {
+			if !filter.Match(node) {
+				report.FilterViolations++
+			}
+			pieces = append(pieces, metabase.Piece{Number: uint16(number), StorageNode: node.ID})
+			selectedNodes = append(selectedNodes, *node)
+
+			countries = append(countries, node.CountryCode.String())
+			subnets = append(subnets, node.LastNet)
+			operators = append(operators, node.Email)
+			for _, tag := range node.Tags {
+				tags = append(tags, tag.Name+"="+string(tag.Value))
+			}
+		}
+		report.Countries.add(countries)
+		report.Subnets.add(subnets)
+		report.Operators.add(operators)
+		report.Tags.add(tags)
+
+		if placement.Invariant != nil && len(pieces) > 0 {
+			if moved := placement.Invariant(pieces, selectedNodes).Count(); moved > 0 {
+				report.InvariantViolations++
+				report.InvariantPieces += moved
+			}
+		}
+	}
+
+	return report
+}
+
+// Print writes the human readable form of the report, including the top
+// groups of each distribution.
+func (report *Report) Print(w io.Writer, top int) {
+	_, _ = fmt.Fprintf(w, "--------- Placement %d %s ---------\n", report.Placement, report.Name)
+	_, _ = fmt.Fprintf(w, "EC:                   %d/%d/%d/%d\n", report.RS.Min, report.RS.Repair, report.RS.Success, report.RS.Total)
+	_, _ = fmt.Fprintf(w, "Eligible nodes:       %d\n", report.EligibleNodes)
+	_, _ = fmt.Fprintf(w, "Failed selections:    %s\n", ratio(report.Failures, report.Selections))
+	_, _ = fmt.Fprintf(w, "Below success:        %s\n", ratio(report.BelowSuccess, report.Selections))
+	_, _ = fmt.Fprintf(w, "Filter violations:    %d\n", report.FilterViolations)
+	_, _ = fmt.Fprintf(w, "Invariant violations: %s (%d pieces)\n", ratio(report.InvariantViolations, report.Selections), report.InvariantPieces)
+
+	for _, d := range []struct {
+		name         string
+		distribution Distribution
+	}{
+		{"Countries", report.Countries},
+		{"Subnets", report.Subnets},
+		{"Operators", report.Operators},
+		{"Tags", report.Tags},
+	} {
+		_, _ = fmt.Fprintf(w, "%s (%d, at most %d pieces of a selection in the same group):\n", d.name, len(d.distribution.Pieces), d.distribution.MaxPerSelection)
+
+		total := 0
+		for _, count := range d.distribution.Pieces {
+			total += count
+		}
+		for i, group := range d.distribution.top() {
+			if i == top {
+				_, _ = fmt.Fprintf(w, "   ... %d more\n", len(d.distribution.Pieces)-top)
+				break
+			}
+			label := group
+			if strings.TrimSpace(label) == "" {
+				label = "<none>"
+			}
+			_, _ = fmt.Fprintf(w, "   %-40s %s\n", label, ratio(d.distribution.Pieces[group], total))
+		}
+	}
+	_, _ = fmt.Fprintln(w)
+}
+
+// top returns the groups ordered by the number of pieces.
+func (d *Distribution) top() []string {
+	groups := make([]string, 0, len(d.Pieces))
+	for group := range d.Pieces {
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if d.Pieces[groups[i]] != d.Pieces[groups[j]] {
+			return d.Pieces[groups[i]] > d.Pieces[groups[j]]
+		}
+		return groups[i] < groups[j]
+	})
+	return groups
+}
+
+func ratio(count, total int) string {
+	if total == 0 {
+		return fmt.Sprintf("%d", count)
+	}
+	return fmt.Sprintf("%d (%.2f%%)", count, float64(count)*100/float64(total))
+}
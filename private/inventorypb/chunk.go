@@ -0,0 +1,55 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventorypb
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/signing"
+)
+
+// InRange returns whether the piece ID is in the range [start, end). A zero
+// end means the end of the piece ID space.
+func InRange(id, start, end PieceID) bool {
+	if bytes.Compare(id[:], start[:]) < 0 {
+		return false
+	}
+	return end.IsZero() || bytes.Compare(id[:], end[:]) < 0
+}
+
+// SignChunk serializes the chunk and signs it with the signer.
+func SignChunk(ctx context.Context, signer signing.Signer, chunk *Chunk) (_ *ListResponse, err error) {
+	data, err := proto.Marshal(chunk)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	signature, err := signer.HashAndSign(ctx, data)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ListResponse{
+		Chunk:     data,
+		Signature: signature,
+	}, nil
+}
+
+// VerifyChunk verifies the signature of the response with the signee and
+// returns the deserialized chunk.
+func VerifyChunk(ctx context.Context, signee signing.Signee, response *ListResponse) (_ *Chunk, err error) {
+	if err := signee.HashAndVerifySignature(ctx, response.Chunk, response.Signature); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	chunk := &Chunk{}
+	if err := proto.Unmarshal(response.Chunk, chunk); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if chunk.NodeId != signee.ID() {
+		return nil, errs.New("chunk node ID %s does not match signee %s", chunk.NodeId, signee.ID())
+	}
+	return chunk, nil
+}
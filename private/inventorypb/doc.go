@@ -0,0 +1,7 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package inventorypb contains protobuf definitions for piece inventory audits.
+package inventorypb
+
+//go:generate go run gen.go
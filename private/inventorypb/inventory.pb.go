@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inventory.proto
+
+package inventorypb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListRequest struct {
+	// range_start is the first piece ID of the range (inclusive).
+	RangeStart PieceID `protobuf:"bytes,1,opt,name=range_start,json=rangeStart,proto3,customtype=PieceID" json:"range_start"`
+	// range_end is the end of the range (exclusive). Zero means the end of the piece ID space.
+	RangeEnd             PieceID  `protobuf:"bytes,2,opt,name=range_end,json=rangeEnd,proto3,customtype=PieceID" json:"range_end"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRequest) Reset()         { *m = ListRequest{} }
+func (m *ListRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()    {}
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7173caedb7c6ae96, []int{0}
+}
+func (m *ListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
+}
+func (m *ListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRequest.Merge(m, src)
+}
+func (m *ListRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRequest.Size(m)
+}
+func (m *ListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRequest proto.InternalMessageInfo
+
+type ListResponse struct {
+	// chunk is a serialized Chunk.
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// signature is the storage node signature of chunk.
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListResponse) Reset()         { *m = ListResponse{} }
+func (m *ListResponse) String() string { return proto.CompactTextString(m) }
+func (*ListResponse) ProtoMessage()    {}
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7173caedb7c6ae96, []int{1}
+}
+func (m *ListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResponse.Unmarshal(m, b)
+}
+func (m *ListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResponse.Marshal(b, m, deterministic)
+}
+func (m *ListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResponse.Merge(m, src)
+}
+func (m *ListResponse) XXX_Size() int {
+	return xxx_messageInfo_ListResponse.Size(m)
+}
+func (m *ListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListResponse proto.InternalMessageInfo
+
+func (m *ListResponse) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (m *ListResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// Chunk is a part of the piece listing of a range. Chunks are numbered
+// sequentially and the last chunk of the listing has last set. Pieces in the
+// trash are not listed.
+type Chunk struct {
+	SatelliteId          NodeID    `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	NodeId               NodeID    `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	RangeStart           PieceID   `protobuf:"bytes,3,opt,name=range_start,json=rangeStart,proto3,customtype=PieceID" json:"range_start"`
+	RangeEnd             PieceID   `protobuf:"bytes,4,opt,name=range_end,json=rangeEnd,proto3,customtype=PieceID" json:"range_end"`
+	ListedAt             time.Time `protobuf:"bytes,5,opt,name=listed_at,json=listedAt,proto3,stdtime" json:"listed_at"`
+	Sequence             int32     `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Last                 bool      `protobuf:"varint,7,opt,name=last,proto3" json:"last,omitempty"`
+	PieceIds             []PieceID `protobuf:"bytes,8,rep,name=piece_ids,json=pieceIds,proto3,customtype=PieceID" json:"piece_ids"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Chunk) Reset()         { *m = Chunk{} }
+func (m *Chunk) String() string { return proto.CompactTextString(m) }
+func (*Chunk) ProtoMessage()    {}
+func (*Chunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7173caedb7c6ae96, []int{2}
+}
+func (m *Chunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Chunk.Unmarshal(m, b)
+}
+func (m *Chunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Chunk.Marshal(b, m, deterministic)
+}
+func (m *Chunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Chunk.Merge(m, src)
+}
+func (m *Chunk) XXX_Size() int {
+	return xxx_messageInfo_Chunk.Size(m)
+}
+func (m *Chunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_Chunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Chunk proto.InternalMessageInfo
+
+func (m *Chunk) GetListedAt() time.Time {
+	if m != nil {
+		return m.ListedAt
+	}
+	return time.Time{}
+}
+
+func (m *Chunk) GetSequence() int32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Chunk) GetLast() bool {
+	if m != nil {
+		return m.Last
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ListRequest)(nil), "inventory.ListRequest")
+	proto.RegisterType((*ListResponse)(nil), "inventory.ListResponse")
+	proto.RegisterType((*Chunk)(nil), "inventory.Chunk")
+}
+
+func init() { proto.RegisterFile("inventory.proto", fileDescriptor_7173caedb7c6ae96) }
+
+var fileDescriptor_7173caedb7c6ae96 = []byte{
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcd, 0x8a, 0xd4, 0x40,
+	0x10, 0xc7, 0x37, 0xb3, 0xf3, 0x91, 0xa9, 0x19, 0x76, 0xa1, 0x11, 0x0d, 0x41, 0x98, 0x10, 0x04,
+	0xe7, 0x20, 0xc9, 0xba, 0x1e, 0x3d, 0xed, 0xa8, 0x87, 0x01, 0x15, 0x89, 0x9e, 0xbc, 0x84, 0x9e,
+	0xe9, 0x32, 0xb6, 0x66, 0xba, 0x63, 0xba, 0xb2, 0xe0, 0x5b, 0xf8, 0x58, 0x3e, 0x83, 0x87, 0xf5,
+	0xe2, 0x83, 0x48, 0x77, 0xef, 0x64, 0x45, 0x07, 0x64, 0x6f, 0x5d, 0xff, 0xfc, 0xea, 0x23, 0xf5,
+	0x2f, 0x38, 0x95, 0xea, 0x12, 0x15, 0xe9, 0xf6, 0x6b, 0xd6, 0xb4, 0x9a, 0x34, 0x9b, 0xf6, 0x42,
+	0x0c, 0x95, 0xae, 0xb4, 0x97, 0xe3, 0x45, 0xa5, 0x75, 0x55, 0x63, 0xee, 0xa2, 0x4d, 0xf7, 0x21,
+	0x27, 0xb9, 0x43, 0x43, 0x7c, 0xd7, 0x78, 0x20, 0xdd, 0xc1, 0xec, 0xa5, 0x34, 0x54, 0xe0, 0x97,
+	0x0e, 0x0d, 0xb1, 0x33, 0x98, 0xb5, 0x5c, 0x55, 0x58, 0x1a, 0xe2, 0x2d, 0x45, 0x41, 0x12, 0x2c,
+	0xe7, 0xab, 0xd3, 0xef, 0x57, 0x8b, 0xa3, 0x1f, 0x57, 0x8b, 0xc9, 0x1b, 0x89, 0x5b, 0x5c, 0x3f,
+	0x2f, 0xc0, 0x31, 0x6f, 0x2d, 0xc2, 0x1e, 0xc1, 0xd4, 0x67, 0xa0, 0x12, 0xd1, 0xe0, 0x30, 0x1f,
+	0x3a, 0xe2, 0x85, 0x12, 0xe9, 0x0a, 0xe6, 0xbe, 0x9d, 0x69, 0xb4, 0x32, 0xc8, 0xee, 0xc0, 0x68,
+	0xfb, 0xb1, 0x53, 0x9f, 0x7d, 0xa7, 0xc2, 0x07, 0xec, 0x3e, 0x4c, 0x8d, 0xac, 0x14, 0xa7, 0xae,
+	0x45, 0x5f, 0xb3, 0xb8, 0x11, 0xd2, 0x5f, 0x03, 0x18, 0x3d, 0x73, 0xdc, 0x63, 0x98, 0x1b, 0x4e,
+	0x58, 0xd7, 0x92, 0xb0, 0x94, 0xe2, 0x7a, 0xdc, 0x93, 0xeb, 0xf6, 0xe3, 0xd7, 0x5a, 0xd8, 0xee,
+	0xb3, 0x9e, 0x59, 0x0b, 0xf6, 0x10, 0x26, 0x4a, 0x0b, 0x47, 0x0f, 0x0e, 0xd2, 0x63, 0xfb, 0x79,
+	0x2d, 0xfe, 0xde, 0xc4, 0xf1, 0x2d, 0x37, 0x31, 0xfc, 0xcf, 0x26, 0xd8, 0x05, 0x4c, 0x6b, 0x69,
+	0x08, 0x45, 0xc9, 0x29, 0x1a, 0x25, 0xc1, 0x72, 0x76, 0x1e, 0x67, 0xde, 0xad, 0x6c, 0xef, 0x56,
+	0xf6, 0x6e, 0xef, 0xd6, 0x2a, 0xb4, 0x95, 0xbe, 0xfd, 0x5c, 0x04, 0x45, 0xe8, 0xd3, 0x2e, 0x88,
+	0xc5, 0x10, 0x1a, 0xeb, 0x9b, 0xda, 0x62, 0x34, 0x4e, 0x82, 0xe5, 0xa8, 0xe8, 0x63, 0xc6, 0x60,
+	0x58, 0x73, 0x43, 0xd1, 0x24, 0x09, 0x96, 0x61, 0xe1, 0xde, 0x76, 0xc0, 0xc6, 0xce, 0x51, 0x4a,
+	0x61, 0xa2, 0x30, 0x39, 0x3e, 0x38, 0xa0, 0x23, 0xd6, 0xc2, 0x9c, 0xbf, 0x82, 0x13, 0x2f, 0xee,
+	0x0f, 0x8b, 0x3d, 0x85, 0xa1, 0x35, 0x8f, 0xdd, 0xcd, 0x6e, 0xae, 0xef, 0x8f, 0xe3, 0x89, 0xef,
+	0xfd, 0xa3, 0x7b, 0x97, 0xd3, 0xa3, 0xb3, 0x60, 0xf5, 0xe0, 0x7d, 0x6a, 0x48, 0xb7, 0x9f, 0x32,
+	0xa9, 0x73, 0xf7, 0xc8, 0x9b, 0x56, 0x5e, 0x72, 0xc2, 0xbc, 0x4f, 0x6a, 0x36, 0x9b, 0xb1, 0xfb,
+	0xf5, 0x27, 0xbf, 0x07, 0x00, 0x52, 0x7c, 0x7d, 0x6f, 0xe0, 0x02, 0x00, 0x00,
+}
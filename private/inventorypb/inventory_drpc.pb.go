@@ -0,0 +1,147 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.35-0.20240709171858-0075ac871661
+// source: inventory.proto
+
+package inventorypb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_inventory_proto struct{}
+
+func (drpcEncoding_File_inventory_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_inventory_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_inventory_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_inventory_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCPieceInventoryClient interface {
+	DRPCConn() drpc.Conn
+
+	List(ctx context.Context, in *ListRequest) (DRPCPieceInventory_ListClient, error)
+}
+
+type drpcPieceInventoryClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCPieceInventoryClient(cc drpc.Conn) DRPCPieceInventoryClient {
+	return &drpcPieceInventoryClient{cc}
+}
+
+func (c *drpcPieceInventoryClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcPieceInventoryClient) List(ctx context.Context, in *ListRequest) (DRPCPieceInventory_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, "/inventory.PieceInventory/List", drpcEncoding_File_inventory_proto{})
+	if err != nil {
+		return nil, err
+	}
+	x := &drpcPieceInventory_ListClient{stream}
+	if err := x.MsgSend(in, drpcEncoding_File_inventory_proto{}); err != nil {
+		return nil, err
+	}
+	if err := x.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DRPCPieceInventory_ListClient interface {
+	drpc.Stream
+	Recv() (*ListResponse, error)
+}
+
+type drpcPieceInventory_ListClient struct {
+	drpc.Stream
+}
+
+func (x *drpcPieceInventory_ListClient) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcPieceInventory_ListClient) Recv() (*ListResponse, error) {
+	m := new(ListResponse)
+	if err := x.MsgRecv(m, drpcEncoding_File_inventory_proto{}); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (x *drpcPieceInventory_ListClient) RecvMsg(m *ListResponse) error {
+	return x.MsgRecv(m, drpcEncoding_File_inventory_proto{})
+}
+
+type DRPCPieceInventoryServer interface {
+	List(*ListRequest, DRPCPieceInventory_ListStream) error
+}
+
+type DRPCPieceInventoryUnimplementedServer struct{}
+
+func (s *DRPCPieceInventoryUnimplementedServer) List(*ListRequest, DRPCPieceInventory_ListStream) error {
+	return drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCPieceInventoryDescription struct{}
+
+func (DRPCPieceInventoryDescription) NumMethods() int { return 1 }
+
+func (DRPCPieceInventoryDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/inventory.PieceInventory/List", drpcEncoding_File_inventory_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return nil, srv.(DRPCPieceInventoryServer).
+					List(
+						in1.(*ListRequest),
+						&drpcPieceInventory_ListStream{in2.(drpc.Stream)},
+					)
+			}, DRPCPieceInventoryServer.List, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterPieceInventory(mux drpc.Mux, impl DRPCPieceInventoryServer) error {
+	return mux.Register(impl, DRPCPieceInventoryDescription{})
+}
+
+type DRPCPieceInventory_ListStream interface {
+	drpc.Stream
+	Send(*ListResponse) error
+}
+
+type drpcPieceInventory_ListStream struct {
+	drpc.Stream
+}
+
+func (x *drpcPieceInventory_ListStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcPieceInventory_ListStream) Send(m *ListResponse) error {
+	return x.MsgSend(m, drpcEncoding_File_inventory_proto{})
+}
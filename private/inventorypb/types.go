@@ -0,0 +1,12 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventorypb
+
+import "storj.io/common/storj"
+
+// NodeID is an alias to storj.NodeID for use in generated protobuf code.
+type NodeID = storj.NodeID
+
+// PieceID is an alias to storj.PieceID for use in generated protobuf code.
+type PieceID = storj.PieceID
@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: audithistory.proto
+
+package nodestatspb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AuditOutcome_Outcome int32
+
+const (
+	AuditOutcome_SUCCESS   AuditOutcome_Outcome = 0
+	AuditOutcome_FAILURE   AuditOutcome_Outcome = 1
+	AuditOutcome_OFFLINE   AuditOutcome_Outcome = 2
+	AuditOutcome_UNKNOWN   AuditOutcome_Outcome = 3
+	AuditOutcome_CONTAINED AuditOutcome_Outcome = 4
+)
+
+var AuditOutcome_Outcome_name = map[int32]string{
+	0: "SUCCESS",
+	1: "FAILURE",
+	2: "OFFLINE",
+	3: "UNKNOWN",
+	4: "CONTAINED",
+}
+
+var AuditOutcome_Outcome_value = map[string]int32{
+	"SUCCESS":   0,
+	"FAILURE":   1,
+	"OFFLINE":   2,
+	"UNKNOWN":   3,
+	"CONTAINED": 4,
+}
+
+func (x AuditOutcome_Outcome) String() string {
+	return proto.EnumName(AuditOutcome_Outcome_name, int32(x))
+}
+
+func (AuditOutcome_Outcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2ab8e94de62e54ec, []int{2, 0}
+}
+
+type GetAuditHistoryRequest struct {
+	// limit is the maximum number of outcomes returned. The satellite may
+	// return fewer outcomes than requested.
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAuditHistoryRequest) Reset()         { *m = GetAuditHistoryRequest{} }
+func (m *GetAuditHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAuditHistoryRequest) ProtoMessage()    {}
+func (*GetAuditHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ab8e94de62e54ec, []int{0}
+}
+func (m *GetAuditHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetAuditHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAuditHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditHistoryRequest.Merge(m, src)
+}
+func (m *GetAuditHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAuditHistoryRequest.Size(m)
+}
+func (m *GetAuditHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditHistoryRequest proto.InternalMessageInfo
+
+func (m *GetAuditHistoryRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetAuditHistoryResponse struct {
+	// outcomes are the recent audit outcomes of the node, newest first.
+	Outcomes             []*AuditOutcome `protobuf:"bytes,1,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetAuditHistoryResponse) Reset()         { *m = GetAuditHistoryResponse{} }
+func (m *GetAuditHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuditHistoryResponse) ProtoMessage()    {}
+func (*GetAuditHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ab8e94de62e54ec, []int{1}
+}
+func (m *GetAuditHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetAuditHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAuditHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditHistoryResponse.Merge(m, src)
+}
+func (m *GetAuditHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAuditHistoryResponse.Size(m)
+}
+func (m *GetAuditHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditHistoryResponse proto.InternalMessageInfo
+
+func (m *GetAuditHistoryResponse) GetOutcomes() []*AuditOutcome {
+	if m != nil {
+		return m.Outcomes
+	}
+	return nil
+}
+
+type AuditOutcome struct {
+	StreamId []byte               `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Position uint64               `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Outcome  AuditOutcome_Outcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=audithistory.AuditOutcome_Outcome" json:"outcome,omitempty"`
+	// reverify_count is the number of reverification attempts of the piece
+	// at the time of the outcome.
+	ReverifyCount        int32     `protobuf:"varint,4,opt,name=reverify_count,json=reverifyCount,proto3" json:"reverify_count,omitempty"`
+	CreatedAt            time.Time `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *AuditOutcome) Reset()         { *m = AuditOutcome{} }
+func (m *AuditOutcome) String() string { return proto.CompactTextString(m) }
+func (*AuditOutcome) ProtoMessage()    {}
+func (*AuditOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ab8e94de62e54ec, []int{2}
+}
+func (m *AuditOutcome) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditOutcome.Unmarshal(m, b)
+}
+func (m *AuditOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditOutcome.Marshal(b, m, deterministic)
+}
+func (m *AuditOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditOutcome.Merge(m, src)
+}
+func (m *AuditOutcome) XXX_Size() int {
+	return xxx_messageInfo_AuditOutcome.Size(m)
+}
+func (m *AuditOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditOutcome proto.InternalMessageInfo
+
+func (m *AuditOutcome) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *AuditOutcome) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *AuditOutcome) GetOutcome() AuditOutcome_Outcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return AuditOutcome_SUCCESS
+}
+
+func (m *AuditOutcome) GetReverifyCount() int32 {
+	if m != nil {
+		return m.ReverifyCount
+	}
+	return 0
+}
+
+func (m *AuditOutcome) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("audithistory.AuditOutcome_Outcome", AuditOutcome_Outcome_name, AuditOutcome_Outcome_value)
+	proto.RegisterType((*GetAuditHistoryRequest)(nil), "audithistory.GetAuditHistoryRequest")
+	proto.RegisterType((*GetAuditHistoryResponse)(nil), "audithistory.GetAuditHistoryResponse")
+	proto.RegisterType((*AuditOutcome)(nil), "audithistory.AuditOutcome")
+}
+
+func init() { proto.RegisterFile("audithistory.proto", fileDescriptor_2ab8e94de62e54ec) }
+
+var fileDescriptor_2ab8e94de62e54ec = []byte{
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0x4d, 0x6f, 0xd3, 0x40,
+	0x14, 0xcc, 0xe6, 0x83, 0x24, 0xaf, 0x69, 0xb1, 0x56, 0x08, 0x2c, 0x73, 0x88, 0x65, 0xb5, 0x52,
+	0x4e, 0xb6, 0x14, 0x24, 0x4e, 0x5c, 0x52, 0xe3, 0x80, 0x45, 0x64, 0x0b, 0xa7, 0x11, 0x12, 0x97,
+	0xe0, 0xc4, 0x5b, 0xb3, 0xa8, 0xf6, 0x1a, 0xef, 0x73, 0xa5, 0xfe, 0x0b, 0xfe, 0x11, 0x57, 0x7e,
+	0x05, 0xfc, 0x15, 0x64, 0x6f, 0x5d, 0xa5, 0x7c, 0xa8, 0x37, 0xcf, 0xbc, 0x19, 0xef, 0x7b, 0x33,
+	0x40, 0xe3, 0x2a, 0xe1, 0xf8, 0x99, 0x4b, 0x14, 0xe5, 0x8d, 0x5d, 0x94, 0x02, 0x05, 0x9d, 0x1c,
+	0x72, 0x06, 0xa4, 0x22, 0x15, 0x6a, 0x62, 0x4c, 0x53, 0x21, 0xd2, 0x2b, 0xe6, 0x34, 0x68, 0x57,
+	0x5d, 0x3a, 0xc8, 0x33, 0x26, 0x31, 0xce, 0x0a, 0x25, 0xb0, 0x6c, 0x78, 0xfa, 0x86, 0xe1, 0xa2,
+	0xf6, 0xbf, 0x55, 0xfe, 0x88, 0x7d, 0xad, 0x98, 0x44, 0xfa, 0x04, 0x06, 0x57, 0x3c, 0xe3, 0xa8,
+	0x13, 0x93, 0xcc, 0x06, 0x91, 0x02, 0xd6, 0x7b, 0x78, 0xf6, 0x97, 0x5e, 0x16, 0x22, 0x97, 0x8c,
+	0xbe, 0x84, 0x91, 0xa8, 0x70, 0x2f, 0x32, 0x26, 0x75, 0x62, 0xf6, 0x66, 0x47, 0x73, 0xc3, 0xbe,
+	0xb7, 0x6c, 0xe3, 0x0a, 0x95, 0x24, 0xba, 0xd3, 0x5a, 0xdf, 0xbb, 0x30, 0x39, 0x1c, 0xd1, 0xe7,
+	0x30, 0x96, 0x58, 0xb2, 0x38, 0xdb, 0xf2, 0xa4, 0x79, 0x7d, 0x12, 0x8d, 0x14, 0xe1, 0x27, 0xd4,
+	0x80, 0x51, 0x21, 0x24, 0x47, 0x2e, 0x72, 0xbd, 0x6b, 0x92, 0x59, 0x3f, 0xba, 0xc3, 0xf4, 0x15,
+	0x0c, 0x6f, 0xff, 0xaa, 0xf7, 0x4c, 0x32, 0x3b, 0x99, 0x5b, 0xff, 0x5f, 0xc0, 0x6e, 0x17, 0x69,
+	0x2d, 0xf4, 0x0c, 0x4e, 0x4a, 0x76, 0xcd, 0x4a, 0x7e, 0x79, 0xb3, 0xdd, 0x8b, 0x2a, 0x47, 0xbd,
+	0xdf, 0x5c, 0x7e, 0xdc, 0xb2, 0x6e, 0x4d, 0x52, 0x17, 0x60, 0x5f, 0xb2, 0x18, 0x59, 0xb2, 0x8d,
+	0x51, 0x1f, 0x98, 0xa4, 0x39, 0x54, 0xe5, 0x6c, 0xb7, 0x39, 0xdb, 0x17, 0x6d, 0xce, 0xe7, 0xa3,
+	0x1f, 0x3f, 0xa7, 0x9d, 0x6f, 0xbf, 0xa6, 0x24, 0x1a, 0xdf, 0xfa, 0x16, 0x68, 0xad, 0x60, 0xd8,
+	0x5e, 0x7b, 0x04, 0xc3, 0xf5, 0xc6, 0x75, 0xbd, 0xf5, 0x5a, 0xeb, 0xd4, 0x60, 0xb9, 0xf0, 0x57,
+	0x9b, 0xc8, 0xd3, 0x48, 0x0d, 0xc2, 0xe5, 0x72, 0xe5, 0x07, 0x9e, 0xd6, 0xad, 0xc1, 0x26, 0x78,
+	0x17, 0x84, 0x1f, 0x02, 0xad, 0x47, 0x8f, 0x61, 0xec, 0x86, 0xc1, 0xc5, 0xc2, 0x0f, 0xbc, 0xd7,
+	0x5a, 0x7f, 0x5e, 0xc0, 0xe4, 0xb0, 0x11, 0xfa, 0x09, 0x1e, 0xff, 0x51, 0x12, 0x3d, 0xbd, 0x9f,
+	0xc4, 0xbf, 0x3b, 0x37, 0xce, 0x1e, 0x50, 0xa9, 0xa6, 0xad, 0xce, 0xf9, 0xe9, 0x47, 0xab, 0xa6,
+	0xbe, 0xd8, 0x5c, 0x38, 0xcd, 0x87, 0x53, 0x94, 0xfc, 0x3a, 0x46, 0xe6, 0xe4, 0x22, 0xa9, 0x0f,
+	0x47, 0x59, 0xec, 0x76, 0x8f, 0x9a, 0x38, 0x5e, 0xfc, 0x1e, 0x00, 0xd6, 0xf3, 0xeb, 0xc9, 0xb4,
+	0x02, 0x00, 0x00,
+}
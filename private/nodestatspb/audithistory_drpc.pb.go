@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.35-0.20240709171858-0075ac871661
+// source: audithistory.proto
+
+package nodestatspb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_audithistory_proto struct{}
+
+func (drpcEncoding_File_audithistory_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_audithistory_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_audithistory_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_audithistory_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCAuditHistoryClient interface {
+	DRPCConn() drpc.Conn
+
+	GetAuditHistory(ctx context.Context, in *GetAuditHistoryRequest) (*GetAuditHistoryResponse, error)
+}
+
+type drpcAuditHistoryClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCAuditHistoryClient(cc drpc.Conn) DRPCAuditHistoryClient {
+	return &drpcAuditHistoryClient{cc}
+}
+
+func (c *drpcAuditHistoryClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcAuditHistoryClient) GetAuditHistory(ctx context.Context, in *GetAuditHistoryRequest) (*GetAuditHistoryResponse, error) {
+	out := new(GetAuditHistoryResponse)
+	err := c.cc.Invoke(ctx, "/audithistory.AuditHistory/GetAuditHistory", drpcEncoding_File_audithistory_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCAuditHistoryServer interface {
+	GetAuditHistory(context.Context, *GetAuditHistoryRequest) (*GetAuditHistoryResponse, error)
+}
+
+type DRPCAuditHistoryUnimplementedServer struct{}
+
+func (s *DRPCAuditHistoryUnimplementedServer) GetAuditHistory(context.Context, *GetAuditHistoryRequest) (*GetAuditHistoryResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCAuditHistoryDescription struct{}
+
+func (DRPCAuditHistoryDescription) NumMethods() int { return 1 }
+
+func (DRPCAuditHistoryDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/audithistory.AuditHistory/GetAuditHistory", drpcEncoding_File_audithistory_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCAuditHistoryServer).
+					GetAuditHistory(
+						ctx,
+						in1.(*GetAuditHistoryRequest),
+					)
+			}, DRPCAuditHistoryServer.GetAuditHistory, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterAuditHistory(mux drpc.Mux, impl DRPCAuditHistoryServer) error {
+	return mux.Register(impl, DRPCAuditHistoryDescription{})
+}
+
+type DRPCAuditHistory_GetAuditHistoryStream interface {
+	drpc.Stream
+	SendAndClose(*GetAuditHistoryResponse) error
+}
+
+type drpcAuditHistory_GetAuditHistoryStream struct {
+	drpc.Stream
+}
+
+func (x *drpcAuditHistory_GetAuditHistoryStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcAuditHistory_GetAuditHistoryStream) SendAndClose(m *GetAuditHistoryResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_audithistory_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
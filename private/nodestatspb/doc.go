@@ -0,0 +1,8 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodestatspb contains protobuf definitions for node stats, which are
+// served by satellites in addition to storj.io/common/pb.
+package nodestatspb
+
+//go:generate go run gen.go
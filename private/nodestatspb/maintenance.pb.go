@@ -0,0 +1,264 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maintenance.proto
+
+package nodestatspb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DeclareMaintenanceRequest struct {
+	// start_time is the start of the maintenance. Zero (or past) time means
+	// the maintenance starts immediately.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// duration_seconds is the expected duration of the maintenance. The
+	// satellite rejects windows longer than its configured limit.
+	DurationSeconds      int64    `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeclareMaintenanceRequest) Reset()         { *m = DeclareMaintenanceRequest{} }
+func (m *DeclareMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeclareMaintenanceRequest) ProtoMessage()    {}
+func (*DeclareMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{0}
+}
+func (m *DeclareMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclareMaintenanceRequest.Unmarshal(m, b)
+}
+func (m *DeclareMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclareMaintenanceRequest.Marshal(b, m, deterministic)
+}
+func (m *DeclareMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclareMaintenanceRequest.Merge(m, src)
+}
+func (m *DeclareMaintenanceRequest) XXX_Size() int {
+	return xxx_messageInfo_DeclareMaintenanceRequest.Size(m)
+}
+func (m *DeclareMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclareMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeclareMaintenanceRequest proto.InternalMessageInfo
+
+func (m *DeclareMaintenanceRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *DeclareMaintenanceRequest) GetDurationSeconds() int64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+type DeclareMaintenanceResponse struct {
+	Window               *MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DeclareMaintenanceResponse) Reset()         { *m = DeclareMaintenanceResponse{} }
+func (m *DeclareMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*DeclareMaintenanceResponse) ProtoMessage()    {}
+func (*DeclareMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{1}
+}
+func (m *DeclareMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclareMaintenanceResponse.Unmarshal(m, b)
+}
+func (m *DeclareMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclareMaintenanceResponse.Marshal(b, m, deterministic)
+}
+func (m *DeclareMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclareMaintenanceResponse.Merge(m, src)
+}
+func (m *DeclareMaintenanceResponse) XXX_Size() int {
+	return xxx_messageInfo_DeclareMaintenanceResponse.Size(m)
+}
+func (m *DeclareMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclareMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeclareMaintenanceResponse proto.InternalMessageInfo
+
+func (m *DeclareMaintenanceResponse) GetWindow() *MaintenanceWindow {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+type CancelMaintenanceRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelMaintenanceRequest) Reset()         { *m = CancelMaintenanceRequest{} }
+func (m *CancelMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelMaintenanceRequest) ProtoMessage()    {}
+func (*CancelMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{2}
+}
+func (m *CancelMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMaintenanceRequest.Unmarshal(m, b)
+}
+func (m *CancelMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMaintenanceRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMaintenanceRequest.Merge(m, src)
+}
+func (m *CancelMaintenanceRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelMaintenanceRequest.Size(m)
+}
+func (m *CancelMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMaintenanceRequest proto.InternalMessageInfo
+
+type CancelMaintenanceResponse struct {
+	// window is the remaining window. It is empty when a scheduled window is
+	// canceled, and it ends now when an active window is finished.
+	Window               *MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CancelMaintenanceResponse) Reset()         { *m = CancelMaintenanceResponse{} }
+func (m *CancelMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelMaintenanceResponse) ProtoMessage()    {}
+func (*CancelMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{3}
+}
+func (m *CancelMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMaintenanceResponse.Unmarshal(m, b)
+}
+func (m *CancelMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMaintenanceResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMaintenanceResponse.Merge(m, src)
+}
+func (m *CancelMaintenanceResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelMaintenanceResponse.Size(m)
+}
+func (m *CancelMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMaintenanceResponse proto.InternalMessageInfo
+
+func (m *CancelMaintenanceResponse) GetWindow() *MaintenanceWindow {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+type MaintenanceWindow struct {
+	StartTime            time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime              time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{4}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenanceWindow.Unmarshal(m, b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return xxx_messageInfo_MaintenanceWindow.Size(m)
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func (m *MaintenanceWindow) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MaintenanceWindow) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*DeclareMaintenanceRequest)(nil), "maintenance.DeclareMaintenanceRequest")
+	proto.RegisterType((*DeclareMaintenanceResponse)(nil), "maintenance.DeclareMaintenanceResponse")
+	proto.RegisterType((*CancelMaintenanceRequest)(nil), "maintenance.CancelMaintenanceRequest")
+	proto.RegisterType((*CancelMaintenanceResponse)(nil), "maintenance.CancelMaintenanceResponse")
+	proto.RegisterType((*MaintenanceWindow)(nil), "maintenance.MaintenanceWindow")
+}
+
+func init() { proto.RegisterFile("maintenance.proto", fileDescriptor_6053ae89a3b3f561) }
+
+var fileDescriptor_6053ae89a3b3f561 = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x4e, 0xf2, 0x40,
+	0x14, 0x85, 0x29, 0x7f, 0xc2, 0x8f, 0x97, 0x05, 0x32, 0x2b, 0x98, 0x85, 0x90, 0x46, 0x11, 0x37,
+	0x6d, 0x82, 0x89, 0x5b, 0x13, 0x70, 0xab, 0x8b, 0x42, 0x62, 0xe2, 0x86, 0x0c, 0xcc, 0xb5, 0xa9,
+	0x81, 0xb9, 0xb5, 0x33, 0xc8, 0x43, 0xb8, 0x71, 0xeb, 0x1b, 0xf9, 0x14, 0xea, 0xa3, 0x98, 0xb6,
+	0x34, 0x96, 0x14, 0x42, 0x8c, 0xee, 0xda, 0x73, 0xcf, 0x39, 0x33, 0xf7, 0x6b, 0xa1, 0xb1, 0x10,
+	0x81, 0x32, 0xa8, 0x84, 0x9a, 0xa1, 0x13, 0x46, 0x64, 0x88, 0xd5, 0x72, 0x12, 0x07, 0x9f, 0x7c,
+	0x4a, 0x07, 0xbc, 0xed, 0x13, 0xf9, 0x73, 0x74, 0x93, 0xb7, 0xe9, 0xf2, 0xde, 0x35, 0xc1, 0x02,
+	0xb5, 0x11, 0x8b, 0x30, 0x35, 0xd8, 0xcf, 0x16, 0xb4, 0xae, 0x70, 0x36, 0x17, 0x11, 0x5e, 0x7f,
+	0x77, 0x78, 0xf8, 0xb8, 0x44, 0x6d, 0xd8, 0x10, 0x40, 0x1b, 0x11, 0x99, 0x49, 0x1c, 0x6b, 0x5a,
+	0x1d, 0xab, 0x57, 0xeb, 0x73, 0x27, 0xed, 0x74, 0xb2, 0x4e, 0x67, 0x9c, 0x75, 0x0e, 0xaa, 0x6f,
+	0xef, 0xed, 0xd2, 0xcb, 0x47, 0xdb, 0xf2, 0x0e, 0x92, 0x5c, 0x3c, 0x61, 0x67, 0x70, 0x28, 0x97,
+	0x91, 0x30, 0x01, 0xa9, 0x89, 0xc6, 0x19, 0x29, 0xa9, 0x9b, 0xe5, 0x8e, 0xd5, 0xfb, 0xe7, 0xd5,
+	0x33, 0x7d, 0x94, 0xca, 0xf6, 0x18, 0xf8, 0xb6, 0xcb, 0xe8, 0x90, 0x94, 0x46, 0x76, 0x01, 0x95,
+	0x55, 0xa0, 0x24, 0xad, 0xd6, 0x37, 0x39, 0x72, 0xf2, 0x24, 0x72, 0x89, 0xdb, 0xc4, 0xe5, 0xad,
+	0xdd, 0x36, 0x87, 0xe6, 0x30, 0x96, 0xe7, 0xc5, 0x0d, 0xed, 0x11, 0xb4, 0xb6, 0xcc, 0x7e, 0x79,
+	0xe0, 0xab, 0x05, 0x8d, 0xc2, 0xf4, 0x6f, 0x60, 0x5e, 0x42, 0x15, 0x95, 0x4c, 0x2b, 0xca, 0x3f,
+	0xa8, 0xf8, 0x8f, 0x4a, 0xc6, 0x7a, 0xff, 0xd3, 0x82, 0xfa, 0x0d, 0xc9, 0x3c, 0x60, 0xe6, 0x03,
+	0x2b, 0x62, 0x67, 0xdd, 0x8d, 0x6d, 0x77, 0xfe, 0x24, 0xfc, 0x74, 0xaf, 0x2f, 0xc5, 0x69, 0x97,
+	0x98, 0x84, 0x46, 0x81, 0x36, 0x3b, 0xd9, 0xc8, 0xef, 0xfa, 0x52, 0xbc, 0xbb, 0xcf, 0x96, 0x9d,
+	0x32, 0x38, 0xbe, 0xb3, 0xb5, 0xa1, 0xe8, 0xc1, 0x09, 0xc8, 0x4d, 0x1e, 0xdc, 0x30, 0x0a, 0x9e,
+	0x84, 0x41, 0x57, 0x91, 0x8c, 0xc9, 0x18, 0x1d, 0x4e, 0xa7, 0x95, 0x84, 0xd7, 0xf9, 0xd7, 0x00,
+	0xc6, 0x2a, 0xd4, 0x16, 0x4f, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: probe.proto
+
+package nodestatspb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ProbeRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbeRequest) Reset()         { *m = ProbeRequest{} }
+func (m *ProbeRequest) String() string { return proto.CompactTextString(m) }
+func (*ProbeRequest) ProtoMessage()    {}
+func (*ProbeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cc8551cf1a5c4f, []int{0}
+}
+func (m *ProbeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbeRequest.Unmarshal(m, b)
+}
+func (m *ProbeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbeRequest.Marshal(b, m, deterministic)
+}
+func (m *ProbeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeRequest.Merge(m, src)
+}
+func (m *ProbeRequest) XXX_Size() int {
+	return xxx_messageInfo_ProbeRequest.Size(m)
+}
+func (m *ProbeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeRequest proto.InternalMessageInfo
+
+type ProbeResponse struct {
+	// results contains one entry for each of the tested transports.
+	Results              []*ProbeResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ProbeResponse) Reset()         { *m = ProbeResponse{} }
+func (m *ProbeResponse) String() string { return proto.CompactTextString(m) }
+func (*ProbeResponse) ProtoMessage()    {}
+func (*ProbeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cc8551cf1a5c4f, []int{1}
+}
+func (m *ProbeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbeResponse.Unmarshal(m, b)
+}
+func (m *ProbeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbeResponse.Marshal(b, m, deterministic)
+}
+func (m *ProbeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeResponse.Merge(m, src)
+}
+func (m *ProbeResponse) XXX_Size() int {
+	return xxx_messageInfo_ProbeResponse.Size(m)
+}
+func (m *ProbeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeResponse proto.InternalMessageInfo
+
+func (m *ProbeResponse) GetResults() []*ProbeResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type ProbeResult struct {
+	// transport is the tested transport, either "tcp" or "quic".
+	Transport string `protobuf:"bytes,1,opt,name=transport,proto3" json:"transport,omitempty"`
+	// address is the node address used by the satellite, the same which is
+	// handed to the uplinks.
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	PieceSize      int64  `protobuf:"varint,3,opt,name=piece_size,json=pieceSize,proto3" json:"piece_size,omitempty"`
+	DialMicros     int64  `protobuf:"varint,4,opt,name=dial_micros,json=dialMicros,proto3" json:"dial_micros,omitempty"`
+	UploadMicros   int64  `protobuf:"varint,5,opt,name=upload_micros,json=uploadMicros,proto3" json:"upload_micros,omitempty"`
+	DownloadMicros int64  `protobuf:"varint,6,opt,name=download_micros,json=downloadMicros,proto3" json:"download_micros,omitempty"`
+	// failed_step is the step where the probe failed: "dial", "upload",
+	// "download" or "verify". It's empty when the probe succeeded.
+	FailedStep           string   `protobuf:"bytes,7,opt,name=failed_step,json=failedStep,proto3" json:"failed_step,omitempty"`
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbeResult) Reset()         { *m = ProbeResult{} }
+func (m *ProbeResult) String() string { return proto.CompactTextString(m) }
+func (*ProbeResult) ProtoMessage()    {}
+func (*ProbeResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cc8551cf1a5c4f, []int{2}
+}
+func (m *ProbeResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbeResult.Unmarshal(m, b)
+}
+func (m *ProbeResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbeResult.Marshal(b, m, deterministic)
+}
+func (m *ProbeResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeResult.Merge(m, src)
+}
+func (m *ProbeResult) XXX_Size() int {
+	return xxx_messageInfo_ProbeResult.Size(m)
+}
+func (m *ProbeResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeResult proto.InternalMessageInfo
+
+func (m *ProbeResult) GetTransport() string {
+	if m != nil {
+		return m.Transport
+	}
+	return ""
+}
+
+func (m *ProbeResult) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ProbeResult) GetPieceSize() int64 {
+	if m != nil {
+		return m.PieceSize
+	}
+	return 0
+}
+
+func (m *ProbeResult) GetDialMicros() int64 {
+	if m != nil {
+		return m.DialMicros
+	}
+	return 0
+}
+
+func (m *ProbeResult) GetUploadMicros() int64 {
+	if m != nil {
+		return m.UploadMicros
+	}
+	return 0
+}
+
+func (m *ProbeResult) GetDownloadMicros() int64 {
+	if m != nil {
+		return m.DownloadMicros
+	}
+	return 0
+}
+
+func (m *ProbeResult) GetFailedStep() string {
+	if m != nil {
+		return m.FailedStep
+	}
+	return ""
+}
+
+func (m *ProbeResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ProbeRequest)(nil), "probe.ProbeRequest")
+	proto.RegisterType((*ProbeResponse)(nil), "probe.ProbeResponse")
+	proto.RegisterType((*ProbeResult)(nil), "probe.ProbeResult")
+}
+
+func init() { proto.RegisterFile("probe.proto", fileDescriptor_f8cc8551cf1a5c4f) }
+
+var fileDescriptor_f8cc8551cf1a5c4f = []byte{
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x41, 0x4b, 0xc3, 0x40,
+	0x10, 0x85, 0x4d, 0x6b, 0x5a, 0x33, 0x69, 0x2b, 0xac, 0x3d, 0x2c, 0xa2, 0xb4, 0x44, 0xc1, 0x1e,
+	0xa4, 0x85, 0xea, 0xd5, 0x83, 0xde, 0x15, 0x49, 0x6f, 0x5e, 0xca, 0xb6, 0x3b, 0xc2, 0x4a, 0xcc,
+	0xae, 0x3b, 0x1b, 0x85, 0xfe, 0x04, 0x7f, 0xb5, 0x74, 0xd2, 0x60, 0x7b, 0x9b, 0xf7, 0xbd, 0x8f,
+	0x10, 0xde, 0x42, 0xea, 0xbc, 0x5d, 0xe1, 0xd4, 0x79, 0x1b, 0xac, 0x88, 0x39, 0x64, 0x03, 0xe8,
+	0xbd, 0x6e, 0x8f, 0x1c, 0xbf, 0x2a, 0xa4, 0x90, 0x3d, 0x40, 0x7f, 0x97, 0xc9, 0xd9, 0x92, 0x50,
+	0xdc, 0x42, 0xd7, 0x23, 0x55, 0x45, 0x20, 0x19, 0x8d, 0xdb, 0x93, 0x74, 0x2e, 0xa6, 0xf5, 0x67,
+	0x1a, 0xad, 0x2a, 0x42, 0xde, 0x28, 0xd9, 0x6f, 0x0b, 0xd2, 0xbd, 0x42, 0x5c, 0x40, 0x12, 0xbc,
+	0x2a, 0xc9, 0x59, 0x1f, 0x64, 0x34, 0x8e, 0x26, 0x49, 0xfe, 0x0f, 0x84, 0x84, 0xae, 0xd2, 0xda,
+	0x23, 0x91, 0x6c, 0x71, 0xd7, 0x44, 0x71, 0x09, 0xe0, 0x0c, 0xae, 0x71, 0x49, 0x66, 0x83, 0xb2,
+	0x3d, 0x8e, 0x26, 0xed, 0x3c, 0x61, 0xb2, 0x30, 0x1b, 0x14, 0x23, 0x48, 0xb5, 0x51, 0xc5, 0xf2,
+	0xd3, 0xac, 0xbd, 0x25, 0x79, 0xcc, 0x3d, 0x6c, 0xd1, 0x33, 0x13, 0x71, 0x05, 0xfd, 0xca, 0x15,
+	0x56, 0xe9, 0x46, 0x89, 0x59, 0xe9, 0xd5, 0x70, 0x27, 0xdd, 0xc0, 0xa9, 0xb6, 0x3f, 0xe5, 0xbe,
+	0xd6, 0x61, 0x6d, 0xd0, 0xe0, 0x9d, 0x38, 0x82, 0xf4, 0x5d, 0x99, 0x02, 0xf5, 0x92, 0x02, 0x3a,
+	0xd9, 0xe5, 0x7f, 0x85, 0x1a, 0x2d, 0x02, 0x3a, 0x31, 0x84, 0x18, 0xbd, 0xb7, 0x5e, 0x9e, 0x70,
+	0x55, 0x87, 0xf9, 0x23, 0x24, 0x2f, 0x56, 0x23, 0xef, 0x21, 0xee, 0x21, 0xae, 0x8f, 0xb3, 0xc3,
+	0xfd, 0x78, 0xf6, 0xf3, 0xe1, 0x21, 0xac, 0xb7, 0xcf, 0x8e, 0x9e, 0xae, 0xdf, 0x32, 0x0a, 0xd6,
+	0x7f, 0x4c, 0x8d, 0x9d, 0xf1, 0x31, 0x73, 0xde, 0x7c, 0xab, 0x80, 0xb3, 0xd2, 0x6a, 0xa4, 0xa0,
+	0x02, 0xb9, 0xd5, 0xaa, 0xc3, 0x4f, 0x7a, 0xf7, 0x37, 0x00, 0x9e, 0x8c, 0x35, 0xe0, 0xe1, 0x01,
+	0x00, 0x00,
+}
@@ -0,0 +1,181 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: reachability.proto
+
+package nodestatspb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GetReachabilityRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReachabilityRequest) Reset()         { *m = GetReachabilityRequest{} }
+func (m *GetReachabilityRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReachabilityRequest) ProtoMessage()    {}
+func (*GetReachabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eca490143db368ac, []int{0}
+}
+func (m *GetReachabilityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReachabilityRequest.Unmarshal(m, b)
+}
+func (m *GetReachabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReachabilityRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReachabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReachabilityRequest.Merge(m, src)
+}
+func (m *GetReachabilityRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReachabilityRequest.Size(m)
+}
+func (m *GetReachabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReachabilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReachabilityRequest proto.InternalMessageInfo
+
+type GetReachabilityResponse struct {
+	Transports           []*TransportReachability `protobuf:"bytes,1,rep,name=transports,proto3" json:"transports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetReachabilityResponse) Reset()         { *m = GetReachabilityResponse{} }
+func (m *GetReachabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReachabilityResponse) ProtoMessage()    {}
+func (*GetReachabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eca490143db368ac, []int{1}
+}
+func (m *GetReachabilityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReachabilityResponse.Unmarshal(m, b)
+}
+func (m *GetReachabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReachabilityResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReachabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReachabilityResponse.Merge(m, src)
+}
+func (m *GetReachabilityResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReachabilityResponse.Size(m)
+}
+func (m *GetReachabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReachabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReachabilityResponse proto.InternalMessageInfo
+
+func (m *GetReachabilityResponse) GetTransports() []*TransportReachability {
+	if m != nil {
+		return m.Transports
+	}
+	return nil
+}
+
+type TransportReachability struct {
+	// transport is the name of the transport, e.g. "tcp", "quic" or "noise".
+	Transport string `protobuf:"bytes,1,opt,name=transport,proto3" json:"transport,omitempty"`
+	// reachable is true when the transport succeeded during the last check-in.
+	Reachable bool `protobuf:"varint,2,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	// last_success is the last time the node was reached with the transport.
+	// It's not set when the node was never reached with it.
+	LastSuccess          *time.Time `protobuf:"bytes,3,opt,name=last_success,json=lastSuccess,proto3,stdtime" json:"last_success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *TransportReachability) Reset()         { *m = TransportReachability{} }
+func (m *TransportReachability) String() string { return proto.CompactTextString(m) }
+func (*TransportReachability) ProtoMessage()    {}
+func (*TransportReachability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eca490143db368ac, []int{2}
+}
+func (m *TransportReachability) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransportReachability.Unmarshal(m, b)
+}
+func (m *TransportReachability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransportReachability.Marshal(b, m, deterministic)
+}
+func (m *TransportReachability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransportReachability.Merge(m, src)
+}
+func (m *TransportReachability) XXX_Size() int {
+	return xxx_messageInfo_TransportReachability.Size(m)
+}
+func (m *TransportReachability) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransportReachability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransportReachability proto.InternalMessageInfo
+
+func (m *TransportReachability) GetTransport() string {
+	if m != nil {
+		return m.Transport
+	}
+	return ""
+}
+
+func (m *TransportReachability) GetReachable() bool {
+	if m != nil {
+		return m.Reachable
+	}
+	return false
+}
+
+func (m *TransportReachability) GetLastSuccess() *time.Time {
+	if m != nil {
+		return m.LastSuccess
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetReachabilityRequest)(nil), "reachability.GetReachabilityRequest")
+	proto.RegisterType((*GetReachabilityResponse)(nil), "reachability.GetReachabilityResponse")
+	proto.RegisterType((*TransportReachability)(nil), "reachability.TransportReachability")
+}
+
+func init() { proto.RegisterFile("reachability.proto", fileDescriptor_eca490143db368ac) }
+
+var fileDescriptor_eca490143db368ac = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x41, 0x4a, 0xc3, 0x40,
+	0x14, 0x86, 0x1d, 0x0b, 0xd2, 0x4e, 0x0b, 0xca, 0x80, 0x1a, 0x82, 0xd0, 0x10, 0x2b, 0x64, 0x35,
+	0x81, 0x7a, 0x83, 0xba, 0xe8, 0xce, 0x45, 0xec, 0xca, 0x85, 0x3a, 0x69, 0xc7, 0x18, 0x49, 0xf3,
+	0xc6, 0x79, 0x2f, 0x82, 0xb7, 0xf0, 0x00, 0x1e, 0xc8, 0x53, 0xe8, 0x55, 0x24, 0x89, 0xb1, 0x89,
+	0x16, 0xdc, 0xcd, 0xbc, 0xff, 0xff, 0x67, 0xfe, 0xf7, 0x71, 0x61, 0xb5, 0x5a, 0x3e, 0xa8, 0x38,
+	0xcd, 0x52, 0x7a, 0x91, 0xc6, 0x02, 0x81, 0x18, 0xb5, 0x67, 0x2e, 0x4f, 0x20, 0x81, 0x5a, 0x71,
+	0xc7, 0x09, 0x40, 0x92, 0xe9, 0xb0, 0xba, 0xc5, 0xc5, 0x7d, 0x48, 0xe9, 0x5a, 0x23, 0xa9, 0xb5,
+	0xa9, 0x0d, 0xbe, 0xc3, 0x8f, 0xe6, 0x9a, 0xa2, 0x56, 0x3e, 0xd2, 0x4f, 0x85, 0x46, 0xf2, 0x6f,
+	0xf8, 0xf1, 0x1f, 0x05, 0x0d, 0xe4, 0xa8, 0xc5, 0x05, 0xe7, 0x64, 0x55, 0x8e, 0x06, 0x2c, 0xa1,
+	0xc3, 0xbc, 0x5e, 0x30, 0x9c, 0x9e, 0xca, 0x4e, 0xb1, 0x45, 0xa3, 0x77, 0x1e, 0x68, 0xc5, 0xfc,
+	0x37, 0xc6, 0x0f, 0xb7, 0xba, 0xc4, 0x09, 0x1f, 0xfc, 0xf8, 0x1c, 0xe6, 0xb1, 0x60, 0x10, 0x6d,
+	0x06, 0xa5, 0xfa, 0xfd, 0x53, 0xa6, 0x9d, 0x5d, 0x8f, 0x05, 0xfd, 0x68, 0x33, 0x10, 0x73, 0x3e,
+	0xca, 0x14, 0xd2, 0x2d, 0x16, 0xcb, 0xa5, 0x46, 0x74, 0x7a, 0x1e, 0x0b, 0x86, 0x53, 0x57, 0xd6,
+	0x1c, 0x64, 0xc3, 0x41, 0x2e, 0x1a, 0x0e, 0xb3, 0xfe, 0xfb, 0xc7, 0x98, 0xbd, 0x7e, 0x8e, 0x59,
+	0x34, 0x2c, 0x93, 0x57, 0x75, 0x70, 0x4a, 0xfc, 0xe0, 0x12, 0x56, 0xba, 0x53, 0xec, 0x8e, 0xef,
+	0xff, 0x42, 0x22, 0x26, 0xdd, 0xb5, 0xb7, 0xb3, 0x74, 0xcf, 0xfe, 0x71, 0xd5, 0x5c, 0xfd, 0x9d,
+	0xd9, 0xe4, 0xda, 0x47, 0x02, 0xfb, 0x28, 0x53, 0x08, 0xab, 0x43, 0x68, 0x6c, 0xfa, 0xac, 0x48,
+	0x87, 0x39, 0xac, 0xca, 0xc2, 0x84, 0x26, 0x8e, 0xf7, 0xaa, 0x35, 0xce, 0xbf, 0x06, 0x00, 0x75,
+	0x12, 0xf5, 0x11, 0x0c, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.35-0.20240709171858-0075ac871661
+// source: reachability.proto
+
+package nodestatspb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_reachability_proto struct{}
+
+func (drpcEncoding_File_reachability_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_reachability_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_reachability_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_reachability_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCNodeReachabilityClient interface {
+	DRPCConn() drpc.Conn
+
+	GetReachability(ctx context.Context, in *GetReachabilityRequest) (*GetReachabilityResponse, error)
+}
+
+type drpcNodeReachabilityClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCNodeReachabilityClient(cc drpc.Conn) DRPCNodeReachabilityClient {
+	return &drpcNodeReachabilityClient{cc}
+}
+
+func (c *drpcNodeReachabilityClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcNodeReachabilityClient) GetReachability(ctx context.Context, in *GetReachabilityRequest) (*GetReachabilityResponse, error) {
+	out := new(GetReachabilityResponse)
+	err := c.cc.Invoke(ctx, "/reachability.NodeReachability/GetReachability", drpcEncoding_File_reachability_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCNodeReachabilityServer interface {
+	GetReachability(context.Context, *GetReachabilityRequest) (*GetReachabilityResponse, error)
+}
+
+type DRPCNodeReachabilityUnimplementedServer struct{}
+
+func (s *DRPCNodeReachabilityUnimplementedServer) GetReachability(context.Context, *GetReachabilityRequest) (*GetReachabilityResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCNodeReachabilityDescription struct{}
+
+func (DRPCNodeReachabilityDescription) NumMethods() int { return 1 }
+
+func (DRPCNodeReachabilityDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/reachability.NodeReachability/GetReachability", drpcEncoding_File_reachability_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeReachabilityServer).
+					GetReachability(
+						ctx,
+						in1.(*GetReachabilityRequest),
+					)
+			}, DRPCNodeReachabilityServer.GetReachability, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterNodeReachability(mux drpc.Mux, impl DRPCNodeReachabilityServer) error {
+	return mux.Register(impl, DRPCNodeReachabilityDescription{})
+}
+
+type DRPCNodeReachability_GetReachabilityStream interface {
+	drpc.Stream
+	SendAndClose(*GetReachabilityResponse) error
+}
+
+type drpcNodeReachability_GetReachabilityStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeReachability_GetReachabilityStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcNodeReachability_GetReachabilityStream) SendAndClose(m *GetReachabilityResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_reachability_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
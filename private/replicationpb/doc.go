@@ -0,0 +1,9 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package replicationpb contains protobuf definitions for the bucket
+// replication status of objects, which is served by satellites in addition to
+// storj.io/common/pb.
+package replicationpb
+
+//go:generate go run gen.go
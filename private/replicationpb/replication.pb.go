@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: replication.proto
+
+package replicationpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ReplicationStatus_Status int32
+
+const (
+	ReplicationStatus_INVALID     ReplicationStatus_Status = 0
+	ReplicationStatus_PENDING     ReplicationStatus_Status = 1
+	ReplicationStatus_REPLICATING ReplicationStatus_Status = 2
+	ReplicationStatus_COMPLETED   ReplicationStatus_Status = 3
+	ReplicationStatus_FAILED      ReplicationStatus_Status = 4
+)
+
+var ReplicationStatus_Status_name = map[int32]string{
+	0: "INVALID",
+	1: "PENDING",
+	2: "REPLICATING",
+	3: "COMPLETED",
+	4: "FAILED",
+}
+
+var ReplicationStatus_Status_value = map[string]int32{
+	"INVALID":     0,
+	"PENDING":     1,
+	"REPLICATING": 2,
+	"COMPLETED":   3,
+	"FAILED":      4,
+}
+
+func (x ReplicationStatus_Status) String() string {
+	return proto.EnumName(ReplicationStatus_Status_name, int32(x))
+}
+
+func (ReplicationStatus_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ed0454e9e09fb71a, []int{2, 0}
+}
+
+type GetObjectReplicationStatusRequest struct {
+	ApiKey             []byte `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	UserAgent          []byte `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Bucket             []byte `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey []byte `protobuf:"bytes,4,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	// object_version selects the version of the object, the latest committed
+	// version is used when it's empty.
+	ObjectVersion        []byte   `protobuf:"bytes,5,opt,name=object_version,json=objectVersion,proto3" json:"object_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetObjectReplicationStatusRequest) Reset()         { *m = GetObjectReplicationStatusRequest{} }
+func (m *GetObjectReplicationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetObjectReplicationStatusRequest) ProtoMessage()    {}
+func (*GetObjectReplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed0454e9e09fb71a, []int{0}
+}
+func (m *GetObjectReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetObjectReplicationStatusRequest.Unmarshal(m, b)
+}
+func (m *GetObjectReplicationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetObjectReplicationStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetObjectReplicationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetObjectReplicationStatusRequest.Merge(m, src)
+}
+func (m *GetObjectReplicationStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetObjectReplicationStatusRequest.Size(m)
+}
+func (m *GetObjectReplicationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetObjectReplicationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetObjectReplicationStatusRequest proto.InternalMessageInfo
+
+func (m *GetObjectReplicationStatusRequest) GetApiKey() []byte {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+func (m *GetObjectReplicationStatusRequest) GetUserAgent() []byte {
+	if m != nil {
+		return m.UserAgent
+	}
+	return nil
+}
+
+func (m *GetObjectReplicationStatusRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *GetObjectReplicationStatusRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *GetObjectReplicationStatusRequest) GetObjectVersion() []byte {
+	if m != nil {
+		return m.ObjectVersion
+	}
+	return nil
+}
+
+type GetObjectReplicationStatusResponse struct {
+	ReplicationStatus    []*ReplicationStatus `protobuf:"bytes,1,rep,name=replication_status,json=replicationStatus,proto3" json:"replication_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetObjectReplicationStatusResponse) Reset()         { *m = GetObjectReplicationStatusResponse{} }
+func (m *GetObjectReplicationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetObjectReplicationStatusResponse) ProtoMessage()    {}
+func (*GetObjectReplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed0454e9e09fb71a, []int{1}
+}
+func (m *GetObjectReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetObjectReplicationStatusResponse.Unmarshal(m, b)
+}
+func (m *GetObjectReplicationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetObjectReplicationStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetObjectReplicationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetObjectReplicationStatusResponse.Merge(m, src)
+}
+func (m *GetObjectReplicationStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetObjectReplicationStatusResponse.Size(m)
+}
+func (m *GetObjectReplicationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetObjectReplicationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetObjectReplicationStatusResponse proto.InternalMessageInfo
+
+func (m *GetObjectReplicationStatusResponse) GetReplicationStatus() []*ReplicationStatus {
+	if m != nil {
+		return m.ReplicationStatus
+	}
+	return nil
+}
+
+// ReplicationStatus is the replication status of an object version into a
+// single destination bucket.
+type ReplicationStatus struct {
+	DestinationBucket    []byte                   `protobuf:"bytes,1,opt,name=destination_bucket,json=destinationBucket,proto3" json:"destination_bucket,omitempty"`
+	Status               ReplicationStatus_Status `protobuf:"varint,2,opt,name=status,proto3,enum=replication.ReplicationStatus_Status" json:"status,omitempty"`
+	UpdatedAt            time.Time                `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ReplicationStatus) Reset()         { *m = ReplicationStatus{} }
+func (m *ReplicationStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationStatus) ProtoMessage()    {}
+func (*ReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed0454e9e09fb71a, []int{2}
+}
+func (m *ReplicationStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicationStatus.Unmarshal(m, b)
+}
+func (m *ReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicationStatus.Marshal(b, m, deterministic)
+}
+func (m *ReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationStatus.Merge(m, src)
+}
+func (m *ReplicationStatus) XXX_Size() int {
+	return xxx_messageInfo_ReplicationStatus.Size(m)
+}
+func (m *ReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationStatus proto.InternalMessageInfo
+
+func (m *ReplicationStatus) GetDestinationBucket() []byte {
+	if m != nil {
+		return m.DestinationBucket
+	}
+	return nil
+}
+
+func (m *ReplicationStatus) GetStatus() ReplicationStatus_Status {
+	if m != nil {
+		return m.Status
+	}
+	return ReplicationStatus_INVALID
+}
+
+func (m *ReplicationStatus) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("replication.ReplicationStatus_Status", ReplicationStatus_Status_name, ReplicationStatus_Status_value)
+	proto.RegisterType((*GetObjectReplicationStatusRequest)(nil), "replication.GetObjectReplicationStatusRequest")
+	proto.RegisterType((*GetObjectReplicationStatusResponse)(nil), "replication.GetObjectReplicationStatusResponse")
+	proto.RegisterType((*ReplicationStatus)(nil), "replication.ReplicationStatus")
+}
+
+func init() { proto.RegisterFile("replication.proto", fileDescriptor_ed0454e9e09fb71a) }
+
+var fileDescriptor_ed0454e9e09fb71a = []byte{
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x93, 0xe2, 0x92, 0x6b, 0x5a, 0xe2, 0x11, 0x02, 0xcb, 0x12, 0xa4, 0x58, 0x14, 0x75,
+	0x83, 0x8d, 0xc2, 0x9a, 0x85, 0x93, 0x98, 0xca, 0x22, 0x4d, 0x23, 0x13, 0x75, 0xc1, 0x26, 0xb2,
+	0x93, 0x8b, 0xe5, 0x3e, 0x3c, 0x83, 0x67, 0x5c, 0x29, 0x2b, 0x7e, 0x81, 0x05, 0xe2, 0x9b, 0x58,
+	0xf0, 0x0d, 0xf0, 0x2b, 0xc8, 0x33, 0x2e, 0x18, 0x22, 0x02, 0xab, 0xe4, 0x9e, 0x73, 0xee, 0x63,
+	0xce, 0x31, 0x98, 0x05, 0xb2, 0xcb, 0x6c, 0x19, 0x8b, 0x8c, 0xe6, 0x2e, 0x2b, 0xa8, 0xa0, 0xc4,
+	0x68, 0x40, 0x36, 0xa4, 0x34, 0xa5, 0x8a, 0xb0, 0xfb, 0x29, 0xa5, 0xe9, 0x25, 0x7a, 0xb2, 0x4a,
+	0xca, 0x77, 0x9e, 0xc8, 0xae, 0x90, 0x8b, 0xf8, 0x8a, 0x29, 0x81, 0xf3, 0x55, 0x83, 0xc7, 0xc7,
+	0x28, 0x4e, 0x93, 0x73, 0x5c, 0x8a, 0xe8, 0xd7, 0x94, 0x37, 0x22, 0x16, 0x25, 0x8f, 0xf0, 0x7d,
+	0x89, 0x5c, 0x90, 0x07, 0xb0, 0x1b, 0xb3, 0x6c, 0x71, 0x81, 0x6b, 0x4b, 0x3b, 0xd0, 0x8e, 0xee,
+	0x44, 0x7a, 0xcc, 0xb2, 0xd7, 0xb8, 0x26, 0x0f, 0x01, 0x4a, 0x8e, 0xc5, 0x22, 0x4e, 0x31, 0x17,
+	0x56, 0x5b, 0x72, 0xdd, 0x0a, 0xf1, 0x2b, 0x80, 0xdc, 0x07, 0x3d, 0x29, 0x97, 0x17, 0x28, 0xac,
+	0x8e, 0x6a, 0x53, 0x15, 0x79, 0x0e, 0xf7, 0x30, 0x5f, 0x16, 0x6b, 0x26, 0x70, 0xb5, 0xa0, 0x72,
+	0xb7, 0x1c, 0xbe, 0x23, 0x55, 0xe4, 0x27, 0xa7, 0xce, 0xaa, 0x16, 0x1d, 0xc2, 0x7e, 0xad, 0xbb,
+	0xc6, 0x82, 0x67, 0x34, 0xb7, 0x6e, 0x49, 0xed, 0x9e, 0x42, 0xcf, 0x14, 0xe8, 0x70, 0x70, 0xb6,
+	0xbd, 0x86, 0x33, 0x9a, 0x73, 0x24, 0x27, 0x40, 0x1a, 0x86, 0x2d, 0xb8, 0x64, 0x2d, 0xed, 0xa0,
+	0x73, 0x64, 0x0c, 0x1e, 0xb9, 0x4d, 0x7b, 0x37, 0x67, 0x98, 0xc5, 0x9f, 0x90, 0xf3, 0xb9, 0x0d,
+	0xe6, 0x86, 0x90, 0x3c, 0x03, 0xb2, 0x42, 0x2e, 0xb2, 0x5c, 0x2d, 0xa9, 0x7d, 0x50, 0xf6, 0x99,
+	0x0d, 0x66, 0xa8, 0x2c, 0x79, 0x09, 0x7a, 0x7d, 0x47, 0xe5, 0xe2, 0xfe, 0xe0, 0x70, 0xfb, 0x1d,
+	0xae, 0xfa, 0x89, 0xea, 0x26, 0x32, 0x02, 0x28, 0xd9, 0x2a, 0xae, 0xfc, 0x8c, 0x95, 0xdb, 0xc6,
+	0xc0, 0x76, 0x55, 0xfa, 0xee, 0x4d, 0xfa, 0xee, 0xfc, 0x26, 0xfd, 0xe1, 0xed, 0x2f, 0xdf, 0xfa,
+	0xad, 0x8f, 0xdf, 0xfb, 0x5a, 0xd4, 0xad, 0xfb, 0x7c, 0xe1, 0x4c, 0x41, 0xaf, 0x8f, 0x37, 0x60,
+	0x37, 0x9c, 0x9e, 0xf9, 0x93, 0x70, 0xdc, 0x6b, 0x55, 0xc5, 0x2c, 0x98, 0x8e, 0xc3, 0xe9, 0x71,
+	0x4f, 0x23, 0x77, 0xc1, 0x88, 0x82, 0xd9, 0x24, 0x1c, 0xf9, 0xf3, 0x0a, 0x68, 0x93, 0x3d, 0xe8,
+	0x8e, 0x4e, 0x4f, 0x66, 0x93, 0x60, 0x1e, 0x8c, 0x7b, 0x1d, 0x02, 0xa0, 0xbf, 0xf2, 0xc3, 0x49,
+	0x30, 0xee, 0xed, 0x0c, 0x3e, 0x69, 0x60, 0x6e, 0x64, 0x41, 0x3e, 0x80, 0xfd, 0xf7, 0x8c, 0x88,
+	0xfb, 0xdb, 0xbb, 0xff, 0xf9, 0x69, 0xda, 0xde, 0x7f, 0xeb, 0x55, 0xf8, 0x4e, 0x6b, 0xf8, 0xf4,
+	0xed, 0x13, 0x2e, 0x68, 0x71, 0xee, 0x66, 0xd4, 0x93, 0x7f, 0x3c, 0x56, 0x64, 0xd7, 0xb1, 0x40,
+	0xaf, 0x31, 0x8a, 0x25, 0x89, 0x2e, 0x7d, 0x7b, 0xf1, 0x63, 0x00, 0x4c, 0xe7, 0xdc, 0xe4, 0x71,
+	0x03, 0x00, 0x00,
+}
@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.35-0.20240709171858-0075ac871661
+// source: replication.proto
+
+package replicationpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_replication_proto struct{}
+
+func (drpcEncoding_File_replication_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_replication_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_replication_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_replication_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCObjectReplicationClient interface {
+	DRPCConn() drpc.Conn
+
+	GetObjectReplicationStatus(ctx context.Context, in *GetObjectReplicationStatusRequest) (*GetObjectReplicationStatusResponse, error)
+}
+
+type drpcObjectReplicationClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCObjectReplicationClient(cc drpc.Conn) DRPCObjectReplicationClient {
+	return &drpcObjectReplicationClient{cc}
+}
+
+func (c *drpcObjectReplicationClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcObjectReplicationClient) GetObjectReplicationStatus(ctx context.Context, in *GetObjectReplicationStatusRequest) (*GetObjectReplicationStatusResponse, error) {
+	out := new(GetObjectReplicationStatusResponse)
+	err := c.cc.Invoke(ctx, "/replication.ObjectReplication/GetObjectReplicationStatus", drpcEncoding_File_replication_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCObjectReplicationServer interface {
+	GetObjectReplicationStatus(context.Context, *GetObjectReplicationStatusRequest) (*GetObjectReplicationStatusResponse, error)
+}
+
+type DRPCObjectReplicationUnimplementedServer struct{}
+
+func (s *DRPCObjectReplicationUnimplementedServer) GetObjectReplicationStatus(context.Context, *GetObjectReplicationStatusRequest) (*GetObjectReplicationStatusResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCObjectReplicationDescription struct{}
+
+func (DRPCObjectReplicationDescription) NumMethods() int { return 1 }
+
+func (DRPCObjectReplicationDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/replication.ObjectReplication/GetObjectReplicationStatus", drpcEncoding_File_replication_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCObjectReplicationServer).
+					GetObjectReplicationStatus(
+						ctx,
+						in1.(*GetObjectReplicationStatusRequest),
+					)
+			}, DRPCObjectReplicationServer.GetObjectReplicationStatus, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterObjectReplication(mux drpc.Mux, impl DRPCObjectReplicationServer) error {
+	return mux.Register(impl, DRPCObjectReplicationDescription{})
+}
+
+type DRPCObjectReplication_GetObjectReplicationStatusStream interface {
+	drpc.Stream
+	SendAndClose(*GetObjectReplicationStatusResponse) error
+}
+
+type drpcObjectReplication_GetObjectReplicationStatusStream struct {
+	drpc.Stream
+}
+
+func (x *drpcObjectReplication_GetObjectReplicationStatusStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcObjectReplication_GetObjectReplicationStatusStream) SendAndClose(m *GetObjectReplicationStatusResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_replication_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -0,0 +1,8 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package restorepb contains protobuf definitions for point-in-time restore
+// of buckets, which is served by satellites in addition to storj.io/common/pb.
+package restorepb
+
+//go:generate go run gen.go
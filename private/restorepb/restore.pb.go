@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: restore.proto
+
+package restorepb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RestoreBucketRequest struct {
+	ApiKey    []byte `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	UserAgent []byte `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Bucket    []byte `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// encrypted_prefix limits the restore to objects with the prefix.
+	EncryptedPrefix []byte    `protobuf:"bytes,4,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	Time            time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// cursor is the last encrypted object key processed by a previous request.
+	Cursor               []byte   `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreBucketRequest) Reset()         { *m = RestoreBucketRequest{} }
+func (m *RestoreBucketRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreBucketRequest) ProtoMessage()    {}
+func (*RestoreBucketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f367b124aedd064, []int{0}
+}
+func (m *RestoreBucketRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreBucketRequest.Unmarshal(m, b)
+}
+func (m *RestoreBucketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreBucketRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreBucketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreBucketRequest.Merge(m, src)
+}
+func (m *RestoreBucketRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreBucketRequest.Size(m)
+}
+func (m *RestoreBucketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreBucketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreBucketRequest proto.InternalMessageInfo
+
+func (m *RestoreBucketRequest) GetApiKey() []byte {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+func (m *RestoreBucketRequest) GetUserAgent() []byte {
+	if m != nil {
+		return m.UserAgent
+	}
+	return nil
+}
+
+func (m *RestoreBucketRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *RestoreBucketRequest) GetEncryptedPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return nil
+}
+
+func (m *RestoreBucketRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *RestoreBucketRequest) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+type RestoreBucketResponse struct {
+	Restored      int64 `protobuf:"varint,1,opt,name=restored,proto3" json:"restored,omitempty"`
+	DeleteMarkers int64 `protobuf:"varint,2,opt,name=delete_markers,json=deleteMarkers,proto3" json:"delete_markers,omitempty"`
+	Unchanged     int64 `protobuf:"varint,3,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	// cursor is the last encrypted object key processed by the request.
+	Cursor []byte `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// more is set when there are keys left to process.
+	More                 bool     `protobuf:"varint,5,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreBucketResponse) Reset()         { *m = RestoreBucketResponse{} }
+func (m *RestoreBucketResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreBucketResponse) ProtoMessage()    {}
+func (*RestoreBucketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f367b124aedd064, []int{1}
+}
+func (m *RestoreBucketResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreBucketResponse.Unmarshal(m, b)
+}
+func (m *RestoreBucketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreBucketResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreBucketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreBucketResponse.Merge(m, src)
+}
+func (m *RestoreBucketResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreBucketResponse.Size(m)
+}
+func (m *RestoreBucketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreBucketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreBucketResponse proto.InternalMessageInfo
+
+func (m *RestoreBucketResponse) GetRestored() int64 {
+	if m != nil {
+		return m.Restored
+	}
+	return 0
+}
+
+func (m *RestoreBucketResponse) GetDeleteMarkers() int64 {
+	if m != nil {
+		return m.DeleteMarkers
+	}
+	return 0
+}
+
+func (m *RestoreBucketResponse) GetUnchanged() int64 {
+	if m != nil {
+		return m.Unchanged
+	}
+	return 0
+}
+
+func (m *RestoreBucketResponse) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *RestoreBucketResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*RestoreBucketRequest)(nil), "restore.RestoreBucketRequest")
+	proto.RegisterType((*RestoreBucketResponse)(nil), "restore.RestoreBucketResponse")
+}
+
+func init() { proto.RegisterFile("restore.proto", fileDescriptor_2f367b124aedd064) }
+
+var fileDescriptor_2f367b124aedd064 = []byte{
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xdf, 0x6e, 0xd3, 0x30,
+	0x18, 0xc5, 0x1b, 0x12, 0xd2, 0xf6, 0x83, 0x00, 0xb2, 0xf8, 0x13, 0x45, 0x94, 0x56, 0x91, 0x90,
+	0xca, 0x4d, 0x22, 0x95, 0x1b, 0x6e, 0xe9, 0x2d, 0x42, 0xaa, 0x2c, 0xae, 0xb8, 0x89, 0x9c, 0xe4,
+	0x6b, 0x08, 0x6d, 0x62, 0x63, 0x3b, 0x68, 0x7d, 0x8b, 0x3d, 0xc4, 0x1e, 0x66, 0x4f, 0xb1, 0x5d,
+	0xec, 0x45, 0xa6, 0xd8, 0x6d, 0xd7, 0x4d, 0xdb, 0x9d, 0xcf, 0xf9, 0x7c, 0xec, 0xf3, 0xb3, 0x21,
+	0x90, 0xa8, 0x34, 0x97, 0x98, 0x08, 0xc9, 0x35, 0x27, 0xc3, 0xbd, 0x8c, 0xa0, 0xe2, 0x15, 0xb7,
+	0x66, 0x34, 0xad, 0x38, 0xaf, 0xb6, 0x98, 0x1a, 0x95, 0x77, 0xeb, 0x54, 0xd7, 0x0d, 0x2a, 0xcd,
+	0x1a, 0x61, 0x37, 0xc4, 0x37, 0x0e, 0xbc, 0xa5, 0x36, 0xb8, 0xec, 0x8a, 0x0d, 0x6a, 0x8a, 0xff,
+	0x3a, 0x54, 0x9a, 0x7c, 0x80, 0x21, 0x13, 0x75, 0xb6, 0xc1, 0x5d, 0xe8, 0xcc, 0x9c, 0xf9, 0x4b,
+	0xea, 0x33, 0x51, 0xff, 0xc0, 0x1d, 0x99, 0x00, 0x74, 0x0a, 0x65, 0xc6, 0x2a, 0x6c, 0x75, 0xf8,
+	0xcc, 0xcc, 0xc6, 0xbd, 0xf3, 0xbd, 0x37, 0xc8, 0x7b, 0xf0, 0x73, 0x73, 0x50, 0xe8, 0xda, 0x98,
+	0x55, 0xe4, 0x0b, 0xbc, 0xc1, 0xb6, 0x90, 0x3b, 0xa1, 0xb1, 0xcc, 0x84, 0xc4, 0x75, 0x7d, 0x16,
+	0x7a, 0x66, 0xc7, 0xeb, 0xa3, 0xbf, 0x32, 0x36, 0xf9, 0x06, 0x5e, 0x5f, 0x33, 0x7c, 0x3e, 0x73,
+	0xe6, 0x2f, 0x16, 0x51, 0x62, 0x19, 0x92, 0x03, 0x43, 0xf2, 0xeb, 0xc0, 0xb0, 0x1c, 0x5d, 0x5e,
+	0x4d, 0x07, 0xe7, 0xd7, 0x53, 0x87, 0x9a, 0x44, 0x7f, 0x79, 0xd1, 0x49, 0xc5, 0x65, 0xe8, 0xdb,
+	0xcb, 0xad, 0x8a, 0x2f, 0x1c, 0x78, 0xf7, 0x80, 0x52, 0x09, 0xde, 0x2a, 0x24, 0x11, 0x8c, 0xf6,
+	0xef, 0x56, 0x1a, 0x4e, 0x97, 0x1e, 0x35, 0xf9, 0x0c, 0xaf, 0x4a, 0xdc, 0xa2, 0xc6, 0xac, 0x61,
+	0x72, 0x83, 0x52, 0x19, 0x5a, 0x97, 0x06, 0xd6, 0xfd, 0x69, 0x4d, 0xf2, 0x11, 0xc6, 0x5d, 0x5b,
+	0xfc, 0x61, 0x6d, 0x85, 0xa5, 0x81, 0x76, 0xe9, 0x9d, 0x71, 0x52, 0xc9, 0x3b, 0xad, 0x44, 0x08,
+	0x78, 0x0d, 0x97, 0x16, 0x72, 0x44, 0xcd, 0x7a, 0xc1, 0x20, 0x38, 0xd6, 0xeb, 0x2b, 0x90, 0x15,
+	0x04, 0xf7, 0x6a, 0x93, 0x49, 0x72, 0xf8, 0xf4, 0xc7, 0x3e, 0x2d, 0xfa, 0xf4, 0xd4, 0xd8, 0xd2,
+	0xc6, 0x83, 0x65, 0xfc, 0x7b, 0xd6, 0x0f, 0xfe, 0x26, 0x35, 0x4f, 0xcd, 0x22, 0x15, 0xb2, 0xfe,
+	0xcf, 0x34, 0xa6, 0xfb, 0xa4, 0xc8, 0x73, 0xdf, 0xbc, 0xf4, 0xd7, 0xdb, 0x01, 0x00, 0x39, 0x28,
+	0xe0, 0x1e, 0x61, 0x02, 0x00, 0x00,
+}
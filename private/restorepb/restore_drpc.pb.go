@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.35-0.20240709171858-0075ac871661
+// source: restore.proto
+
+package restorepb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_restore_proto struct{}
+
+func (drpcEncoding_File_restore_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_restore_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_restore_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_restore_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCBucketRestoreClient interface {
+	DRPCConn() drpc.Conn
+
+	RestoreBucket(ctx context.Context, in *RestoreBucketRequest) (*RestoreBucketResponse, error)
+}
+
+type drpcBucketRestoreClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCBucketRestoreClient(cc drpc.Conn) DRPCBucketRestoreClient {
+	return &drpcBucketRestoreClient{cc}
+}
+
+func (c *drpcBucketRestoreClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcBucketRestoreClient) RestoreBucket(ctx context.Context, in *RestoreBucketRequest) (*RestoreBucketResponse, error) {
+	out := new(RestoreBucketResponse)
+	err := c.cc.Invoke(ctx, "/restore.BucketRestore/RestoreBucket", drpcEncoding_File_restore_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCBucketRestoreServer interface {
+	RestoreBucket(context.Context, *RestoreBucketRequest) (*RestoreBucketResponse, error)
+}
+
+type DRPCBucketRestoreUnimplementedServer struct{}
+
+func (s *DRPCBucketRestoreUnimplementedServer) RestoreBucket(context.Context, *RestoreBucketRequest) (*RestoreBucketResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCBucketRestoreDescription struct{}
+
+func (DRPCBucketRestoreDescription) NumMethods() int { return 1 }
+
+func (DRPCBucketRestoreDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/restore.BucketRestore/RestoreBucket", drpcEncoding_File_restore_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCBucketRestoreServer).
+					RestoreBucket(
+						ctx,
+						in1.(*RestoreBucketRequest),
+					)
+			}, DRPCBucketRestoreServer.RestoreBucket, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterBucketRestore(mux drpc.Mux, impl DRPCBucketRestoreServer) error {
+	return mux.Register(impl, DRPCBucketRestoreDescription{})
+}
+
+type DRPCBucketRestore_RestoreBucketStream interface {
+	drpc.Stream
+	SendAndClose(*RestoreBucketResponse) error
+}
+
+type drpcBucketRestore_RestoreBucketStream struct {
+	drpc.Stream
+}
+
+func (x *drpcBucketRestore_RestoreBucketStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcBucketRestore_RestoreBucketStream) SendAndClose(m *RestoreBucketResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_restore_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -56,6 +56,7 @@ import (
 	"storj.io/storj/satellite/overlay/straynodes"
 	"storj.io/storj/satellite/payments/stripe"
 	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/satellite/replication"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/satellitedb"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
@@ -149,6 +150,11 @@ type Satellite struct {
 		Sender *sender.Service
 	}
 
+	Replication struct {
+		Service *replication.Service
+		Worker  *replication.Worker
+	}
+
 	ExpiredDeletion struct {
 		Chore *expireddeletion.Chore
 	}
@@ -618,7 +624,7 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 		return nil, errs.Wrap(err)
 	}
 
-	rangedLoopPeer, err := planet.newRangedLoop(ctx, index, db, metabaseDB, config)
+	rangedLoopPeer, err := planet.newRangedLoop(ctx, index, identity, db, metabaseDB, config)
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
@@ -694,6 +700,9 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 
 	system.GarbageCollection.Sender = peer.GarbageCollection.Sender
 
+	system.Replication.Service = api.Replication.Service
+	system.Replication.Worker = peer.Replication.Worker
+
 	system.ExpiredDeletion.Chore = peer.ExpiredDeletion.Chore
 	system.ZombieDeletion.Chore = peer.ZombieDeletion.Chore
 
@@ -808,7 +817,7 @@ func (planet *Planet) newRepairer(ctx context.Context, index int, identity *iden
 	}
 	planet.databases = append(planet.databases, revocationDB)
 
-	return satellite.NewRepairer(log, identity, metabaseDB, revocationDB, db.RepairQueue(), db.Buckets(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), versionInfo, &config, nil)
+	return satellite.NewRepairer(log, identity, metabaseDB, revocationDB, db.RepairQueue(), db.Buckets(), db.OverlayCache(), db.PeerIdentities(), db.NodeEvents(), db.Reputation(), db.Containment(), db.AuditHistory(), versionInfo, &config, nil)
 }
 
 func (planet *Planet) newAuditor(ctx context.Context, index int, identity *identity.FullIdentity, db satellite.DB, metabaseDB *metabase.DB, config satellite.Config, versionInfo version.Info) (_ *satellite.Auditor, err error) {
@@ -823,7 +832,7 @@ func (planet *Planet) newAuditor(ctx context.Context, index int, identity *ident
 	}
 	planet.databases = append(planet.databases, revocationDB)
 
-	return satellite.NewAuditor(log, identity, metabaseDB, revocationDB, db.VerifyQueue(), db.ReverifyQueue(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), versionInfo, &config, nil)
+	return satellite.NewAuditor(log, identity, metabaseDB, revocationDB, db.VerifyQueue(), db.ReverifyQueue(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), db.AuditHistory(), versionInfo, &config, nil)
 }
 
 type rollupsWriteCacheCloser struct {
@@ -848,12 +857,18 @@ func (planet *Planet) newGarbageCollectionBF(ctx context.Context, index int, db
 	return satellite.NewGarbageCollectionBF(log, db, metabaseDB, revocationDB, versionInfo, &config, nil)
 }
 
-func (planet *Planet) newRangedLoop(ctx context.Context, index int, db satellite.DB, metabaseDB *metabase.DB, config satellite.Config) (_ *satellite.RangedLoop, err error) {
+func (planet *Planet) newRangedLoop(ctx context.Context, index int, identity *identity.FullIdentity, db satellite.DB, metabaseDB *metabase.DB, config satellite.Config) (_ *satellite.RangedLoop, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	prefix := "satellite-ranged-loop" + strconv.Itoa(index)
 	log := planet.log.Named(prefix)
-	return satellite.NewRangedLoop(log, db, metabaseDB, &config, nil)
+
+	revocationDB, err := revocation.OpenDBFromCfg(ctx, config.Server.Config)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	planet.databases = append(planet.databases, revocationDB)
+	return satellite.NewRangedLoop(log, identity, db, metabaseDB, revocationDB, &config, nil)
 }
 
 // atLeastOne returns 1 if value < 1, or value otherwise.
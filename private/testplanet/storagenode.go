@@ -162,8 +162,9 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 			Cache:    true,
 		},
 		Nodestats: nodestats.Config{
-			MaxSleep:    0,
-			StorageSync: defaultInterval,
+			MaxSleep:         0,
+			StorageSync:      defaultInterval,
+			ReachabilitySync: defaultInterval,
 		},
 		Console: consoleserver.Config{
 			Address:   planet.NewListenAddress(),
@@ -29,6 +29,7 @@ import (
 	"storj.io/storj/satellite/console/restkeys"
 	"storj.io/storj/satellite/emission"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodetags"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripe"
 )
@@ -265,6 +266,8 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			peer.DB.Console(),
 			peer.DB.ProjectAccounting(),
 			peer.Accounting.Service,
+			peer.DB.Durability(),
+			nodetags.NewService(log.Named("back-office:nodetags"), peer.DB.NodeTags(), peer.Identity.ID),
 			placement,
 			config.Metainfo.ProjectLimits.MaxBuckets,
 			config.Metainfo.RateLimiter.Rate,
@@ -0,0 +1,159 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+)
+
+const (
+	// defaultNodeAuditsLimit is the number of audit outcomes returned when
+	// the limit isn't specified.
+	defaultNodeAuditsLimit = 100
+	// maxNodeAuditsLimit is the maximum number of audit outcomes returned by
+	// a single request.
+	maxNodeAuditsLimit = 1000
+)
+
+// nodeAudits is the admin API representation of the reputation and the recent
+// audit outcomes of a node.
+type nodeAudits struct {
+	NodeID     string             `json:"nodeId"`
+	Reputation *nodeReputation    `json:"reputation"`
+	Summary    map[string]int     `json:"summary"`
+	Audits     []nodeAuditOutcome `json:"audits"`
+}
+
+// nodeReputation is the admin API representation of reputation.Info.
+type nodeReputation struct {
+	AuditSuccessCount      int64      `json:"auditSuccessCount"`
+	TotalAuditCount        int64      `json:"totalAuditCount"`
+	AuditScore             float64    `json:"auditScore"`
+	UnknownAuditScore      float64    `json:"unknownAuditScore"`
+	OnlineScore            float64    `json:"onlineScore"`
+	VettedAt               *time.Time `json:"vettedAt"`
+	UnknownAuditSuspended  *time.Time `json:"unknownAuditSuspended"`
+	OfflineSuspended       *time.Time `json:"offlineSuspended"`
+	UnderReview            *time.Time `json:"underReview"`
+	Disqualified           *time.Time `json:"disqualified"`
+	DisqualificationReason string     `json:"disqualificationReason,omitempty"`
+}
+
+// nodeAuditOutcome is the admin API representation of audit.HistoryEntry.
+type nodeAuditOutcome struct {
+	StreamID      string    `json:"streamId"`
+	Part          uint32    `json:"part"`
+	Index         uint32    `json:"index"`
+	Outcome       string    `json:"outcome"`
+	ReverifyCount int       `json:"reverifyCount"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+func (server *Server) getNodeAudits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, err := storj.NodeIDFromString(mux.Vars(r)["nodeid"])
+	if err != nil {
+		sendJSONError(w, "invalid node id", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	limit := defaultNodeAuditsLimit
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		limit, err = strconv.Atoi(limitParam)
+		if err != nil || limit <= 0 || limit > maxNodeAuditsLimit {
+			sendJSONError(w, "invalid limit", "limit must be between 1 and "+strconv.Itoa(maxNodeAuditsLimit), http.StatusBadRequest)
+			return
+		}
+	}
+
+	info, err := server.db.Reputation().Get(ctx, nodeID)
+	if err != nil {
+		if reputation.ErrNodeNotFound.Has(err) {
+			sendJSONError(w, "node not found", "", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "unable to get node reputation", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	entries, err := server.db.AuditHistory().List(ctx, nodeID, limit)
+	if err != nil {
+		sendJSONError(w, "unable to list audit history", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := nodeAudits{
+		NodeID: nodeID.String(),
+		Reputation: &nodeReputation{
+			AuditSuccessCount:     info.AuditSuccessCount,
+			TotalAuditCount:       info.TotalAuditCount,
+			AuditScore:            score(info.AuditReputationAlpha, info.AuditReputationBeta),
+			UnknownAuditScore:     score(info.UnknownAuditReputationAlpha, info.UnknownAuditReputationBeta),
+			OnlineScore:           info.OnlineScore,
+			VettedAt:              info.VettedAt,
+			UnknownAuditSuspended: info.UnknownAuditSuspended,
+			OfflineSuspended:      info.OfflineSuspended,
+			UnderReview:           info.UnderReview,
+			Disqualified:          info.Disqualified,
+		},
+		Summary: make(map[string]int),
+		Audits:  make([]nodeAuditOutcome, 0, len(entries)),
+	}
+	if info.Disqualified != nil {
+		output.Reputation.DisqualificationReason = disqualificationReason(info.DisqualificationReason)
+	}
+	for _, entry := range entries {
+		outcome := entry.Outcome.String()
+		output.Summary[outcome]++
+		output.Audits = append(output.Audits, nodeAuditOutcome{
+			StreamID:      entry.StreamID.String(),
+			Part:          entry.Position.Part,
+			Index:         entry.Position.Index,
+			Outcome:       outcome,
+			ReverifyCount: entry.ReverifyCount,
+			CreatedAt:     entry.CreatedAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+// score calculates the reputation score from its alpha and beta values.
+func score(alpha, beta float64) float64 {
+	if alpha+beta == 0 {
+		return 0
+	}
+	return alpha / (alpha + beta)
+}
+
+// disqualificationReason returns the name of the disqualification reason used
+// in API responses.
+func disqualificationReason(reason overlay.DisqualificationReason) string {
+	switch reason {
+	case overlay.DisqualificationReasonAuditFailure:
+		return "audit failure"
+	case overlay.DisqualificationReasonSuspension:
+		return "suspension"
+	case overlay.DisqualificationReasonNodeOffline:
+		return "node offline"
+	default:
+		return "unknown"
+	}
+}
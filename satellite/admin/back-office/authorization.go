@@ -4,6 +4,7 @@
 package admin
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -39,6 +40,9 @@ const (
 	PermBucketSetDataPlacement
 	PermBucketRemoveDataPlacement
 	PermBucketSetUserAgent
+	PermDurabilityView
+	PermNodeTagsView
+	PermNodeTagsEdit
 )
 
 // These constants are the list of roles that users can have and the service uses to match
@@ -52,9 +56,11 @@ const (
 			PermProjectView | PermProjectSetLimits | PermProjectSetDataPlacement |
 			PermProjectRemoveDataPlacement | PermProjectSetUserAgent | PermProjectSendInvitation |
 			PermBucketView | PermBucketSetDataPlacement | PermBucketRemoveDataPlacement |
-			PermBucketSetUserAgent,
+			PermBucketSetUserAgent | PermDurabilityView | PermNodeTagsView | PermNodeTagsEdit,
+	)
+	RoleViewer = Authorization(
+		PermAccountView | PermProjectView | PermBucketView | PermDurabilityView | PermNodeTagsView,
 	)
-	RoleViewer          = Authorization(PermAccountView | PermProjectView | PermBucketView)
 	RoleCustomerSupport = Authorization(
 		PermAccountView | PermAccountChangeEmail | PermAccountDisableMFA | PermAccountChangeLimits |
 			PermAccountSetDataPlacement | PermAccountRemoveDataPlacement | PermAccountSetUserAgent |
@@ -62,7 +68,7 @@ const (
 			PermProjectView | PermProjectSetLimits | PermProjectSetDataPlacement |
 			PermProjectRemoveDataPlacement | PermProjectSetUserAgent | PermProjectSendInvitation |
 			PermBucketView | PermBucketSetDataPlacement | PermBucketRemoveDataPlacement |
-			PermBucketSetUserAgent,
+			PermBucketSetUserAgent | PermDurabilityView | PermNodeTagsView,
 	)
 	RoleFinanceManager = Authorization(
 		PermAccountView | PermAccountSuspendTemporary | PermAccountReActivateTemporary |
@@ -169,3 +175,21 @@ func (auth *Authorizer) IsRejected(w http.ResponseWriter, r *http.Request, perms
 	api.ServeError(auth.log, w, http.StatusUnauthorized, err)
 	return true
 }
+
+type operatorKey struct{}
+
+// WithOperator returns a copy of ctx which carries the email address of the user who sent r, as
+// it's set by the authentication proxy.
+//
+// This function is convenient to inject it to the handlers generated by the API generator through
+// a customized handler, for the operations which are audited.
+func WithOperator(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, operatorKey{}, r.Header.Get("X-Forwarded-Email"))
+}
+
+// OperatorFromContext returns the email address of the user set by WithOperator. It returns an
+// empty string if it isn't set.
+func OperatorFromContext(ctx context.Context) string {
+	email, _ := ctx.Value(operatorKey{}).(string)
+	return email
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/api"
+)
+
+// DurabilityReport contains the results of the most recent correlated durability report.
+type DurabilityReport struct {
+	// CreatedAt is zero when there isn't any stored report.
+	CreatedAt time.Time          `json:"createdAt"`
+	Results   []DurabilityResult `json:"results"`
+}
+
+// DurabilityResult contains the worst-case loss of a placement when all the nodes of a class are
+// lost.
+type DurabilityResult struct {
+	Placement        storj.PlacementConstraint `json:"placement"`
+	Class            string                    `json:"class"`
+	HealthyMargin    int                       `json:"healthyMargin"`
+	SegmentCount     int64                     `json:"segmentCount"`
+	LostSegments     int64                     `json:"lostSegments"`
+	TotalSegments    int64                     `json:"totalSegments"`
+	SegmentExemplars []string                  `json:"segmentExemplars"`
+	ClassExemplars   []string                  `json:"classExemplars"`
+}
+
+// GetDurabilityReport returns the results of the most recent correlated durability report.
+func (s *Service) GetDurabilityReport(ctx context.Context) (*DurabilityReport, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	results, err := s.durability.Latest(ctx)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	report := &DurabilityReport{
+		Results: make([]DurabilityResult, 0, len(results)),
+	}
+	for _, result := range results {
+		report.CreatedAt = result.CreatedAt
+		report.Results = append(report.Results, DurabilityResult{
+			Placement:        result.Placement,
+			Class:            result.Class,
+			HealthyMargin:    result.HealthyMargin,
+			SegmentCount:     result.SegmentCount,
+			LostSegments:     result.LostSegments,
+			TotalSegments:    result.TotalSegments,
+			SegmentExemplars: result.SegmentExemplars,
+			ClassExemplars:   result.ClassExemplars,
+		})
+	}
+
+	return report, api.HTTPError{}
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/durability"
+)
+
+func TestGetDurabilityReport(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Admin.Admin.Service
+
+		report, apiErr := service.GetDurabilityReport(ctx)
+		require.NoError(t, apiErr.Err)
+		require.Empty(t, report.Results)
+		require.True(t, report.CreatedAt.IsZero())
+
+		createdAt := time.Now().UTC().Truncate(time.Microsecond)
+		err := sat.DB.Durability().Insert(ctx, []durability.Result{
+			{
+				CreatedAt:        createdAt,
+				Placement:        storj.DefaultPlacement,
+				Class:            "email",
+				HealthyMargin:    2,
+				SegmentCount:     10,
+				TotalSegments:    100,
+				SegmentExemplars: []string{"stream/0"},
+				ClassExemplars:   []string{"operator@example.test"},
+			},
+		})
+		require.NoError(t, err)
+
+		report, apiErr = service.GetDurabilityReport(ctx)
+		require.NoError(t, apiErr.Err)
+		require.WithinDuration(t, createdAt, report.CreatedAt, time.Second)
+		require.Len(t, report.Results, 1)
+		require.Equal(t, "email", report.Results[0].Class)
+		require.Equal(t, 2, report.Results[0].HealthyMargin)
+		require.Equal(t, int64(10), report.Results[0].SegmentCount)
+		require.Equal(t, []string{"operator@example.test"}, report.Results[0].ClassExemplars)
+	})
+}
@@ -95,6 +95,100 @@ func main() {
 		},
 	})
 
+	group = api.Group("DurabilityManagement", "durability")
+	group.Middleware = append(group.Middleware, authMiddleware{})
+
+	group.Get("/", &apigen.Endpoint{
+		Name:           "Get durability report",
+		Description:    "Gets the results of the most recent correlated durability report",
+		GoName:         "GetDurabilityReport",
+		TypeScriptName: "getDurabilityReport",
+		Response:       backoffice.DurabilityReport{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermDurabilityView},
+		},
+	})
+
+	group = api.Group("NodeManagement", "nodes")
+	group.Middleware = append(group.Middleware, authMiddleware{}, operatorMiddleware{})
+
+	group.Get("/tags", &apigen.Endpoint{
+		Name:           "Get node tags",
+		Description:    "Gets the node tags signed by the satellite",
+		GoName:         "GetNodeTags",
+		TypeScriptName: "getNodeTags",
+		Response:       []backoffice.NodeTag{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermNodeTagsView},
+		},
+	})
+
+	group.Get("/tags/changes", &apigen.Endpoint{
+		Name:           "Get node tag changes",
+		Description:    "Gets the most recent changes of the node tags signed by the satellite",
+		GoName:         "GetNodeTagChanges",
+		TypeScriptName: "getNodeTagChanges",
+		Response:       []backoffice.NodeTagChange{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermNodeTagsView},
+		},
+	})
+
+	group.Get("/tags/csv", &apigen.Endpoint{
+		Name:           "Export node tags",
+		Description:    "Exports the node tags signed by the satellite as CSV",
+		GoName:         "ExportNodeTags",
+		TypeScriptName: "exportNodeTags",
+		Response:       backoffice.NodeTagsCSV{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermNodeTagsView},
+		},
+	})
+
+	group.Put("/tags/csv", &apigen.Endpoint{
+		Name:           "Import node tags",
+		Description:    "Creates or updates the node tags of the CSV with the satellite as signer",
+		GoName:         "ImportNodeTags",
+		TypeScriptName: "importNodeTags",
+		Request:        backoffice.NodeTagsCSV{},
+		Response:       backoffice.NodeTagsImported{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermNodeTagsEdit},
+			operatorKey:  true,
+		},
+	})
+
+	group.Put("/{nodeID}/tags/{name}", &apigen.Endpoint{
+		Name:           "Set node tag",
+		Description:    "Creates or updates a node tag with the satellite as signer",
+		GoName:         "SetNodeTag",
+		TypeScriptName: "setNodeTag",
+		PathParams: []apigen.Param{
+			apigen.NewParam("nodeID", ""),
+			apigen.NewParam("name", ""),
+		},
+		Request: backoffice.NodeTagValue{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermNodeTagsEdit},
+			operatorKey:  true,
+		},
+	})
+
+	group.Delete("/{nodeID}/tags/{name}", &apigen.Endpoint{
+		Name:           "Delete node tag",
+		Description:    "Deletes a node tag signed by the satellite",
+		GoName:         "DeleteNodeTag",
+		TypeScriptName: "deleteNodeTag",
+		PathParams: []apigen.Param{
+			apigen.NewParam("nodeID", ""),
+			apigen.NewParam("name", ""),
+		},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermNodeTagsEdit},
+			operatorKey:  true,
+		},
+	})
+
 	api.OutputRootDir = findModuleRootDir()
 	api.MustWriteGo(filepath.Join("satellite", "admin", "back-office", "handlers.gen.go"))
 	api.MustWriteTS(filepath.Join("satellite", "admin", "back-office", "ui", "src", "api", "client.gen.ts"))
@@ -128,10 +222,27 @@ func (a authMiddleware) Generate(api *apigen.API, group *apigen.EndpointGroup, e
 
 var _ apigen.Middleware = authMiddleware{}
 
+// operatorMiddleware adds the email address of the user to the context of the audited operations.
+type operatorMiddleware struct{}
+
+func (operatorMiddleware) Generate(api *apigen.API, group *apigen.EndpointGroup, ep *apigen.FullEndpoint) string {
+	if !apigen.LoadSetting(operatorKey, ep, false) {
+		return ""
+	}
+
+	return "ctx = WithOperator(ctx, r)"
+}
+
+var _ apigen.Middleware = operatorMiddleware{}
+
 type tagAuthPerms struct{}
 
 var authPermsKey = tagAuthPerms{}
 
+type tagOperator struct{}
+
+var operatorKey = tagOperator{}
+
 func findModuleRootDir() string {
 	dir, err := os.Getwd()
 	if err != nil {
@@ -21,6 +21,8 @@ var ErrSettingsAPI = errs.Class("admin settings api")
 var ErrPlacementsAPI = errs.Class("admin placements api")
 var ErrUsersAPI = errs.Class("admin users api")
 var ErrProjectsAPI = errs.Class("admin projects api")
+var ErrDurabilityAPI = errs.Class("admin durability api")
+var ErrNodesAPI = errs.Class("admin nodes api")
 
 type SettingsService interface {
 	GetSettings(ctx context.Context) (*Settings, api.HTTPError)
@@ -39,6 +41,19 @@ type ProjectManagementService interface {
 	UpdateProjectLimits(ctx context.Context, publicID uuid.UUID, request ProjectLimitsUpdate) api.HTTPError
 }
 
+type DurabilityManagementService interface {
+	GetDurabilityReport(ctx context.Context) (*DurabilityReport, api.HTTPError)
+}
+
+type NodeManagementService interface {
+	GetNodeTags(ctx context.Context) ([]NodeTag, api.HTTPError)
+	GetNodeTagChanges(ctx context.Context) ([]NodeTagChange, api.HTTPError)
+	ExportNodeTags(ctx context.Context) (*NodeTagsCSV, api.HTTPError)
+	ImportNodeTags(ctx context.Context, request NodeTagsCSV) (*NodeTagsImported, api.HTTPError)
+	SetNodeTag(ctx context.Context, nodeID, name string, request NodeTagValue) api.HTTPError
+	DeleteNodeTag(ctx context.Context, nodeID, name string) api.HTTPError
+}
+
 // SettingsHandler is an api handler that implements all Settings API endpoints functionality.
 type SettingsHandler struct {
 	log     *zap.Logger
@@ -69,6 +84,22 @@ type ProjectManagementHandler struct {
 	auth    *Authorizer
 }
 
+// DurabilityManagementHandler is an api handler that implements all DurabilityManagement API endpoints functionality.
+type DurabilityManagementHandler struct {
+	log     *zap.Logger
+	mon     *monkit.Scope
+	service DurabilityManagementService
+	auth    *Authorizer
+}
+
+// NodeManagementHandler is an api handler that implements all NodeManagement API endpoints functionality.
+type NodeManagementHandler struct {
+	log     *zap.Logger
+	mon     *monkit.Scope
+	service NodeManagementService
+	auth    *Authorizer
+}
+
 func NewSettings(log *zap.Logger, mon *monkit.Scope, service SettingsService, router *mux.Router) *SettingsHandler {
 	handler := &SettingsHandler{
 		log:     log,
@@ -124,6 +155,39 @@ func NewProjectManagement(log *zap.Logger, mon *monkit.Scope, service ProjectMan
 	return handler
 }
 
+func NewDurabilityManagement(log *zap.Logger, mon *monkit.Scope, service DurabilityManagementService, router *mux.Router, auth *Authorizer) *DurabilityManagementHandler {
+	handler := &DurabilityManagementHandler{
+		log:     log,
+		mon:     mon,
+		service: service,
+		auth:    auth,
+	}
+
+	durabilityRouter := router.PathPrefix("/back-office/api/v1/durability").Subrouter()
+	durabilityRouter.HandleFunc("/", handler.handleGetDurabilityReport).Methods("GET")
+
+	return handler
+}
+
+func NewNodeManagement(log *zap.Logger, mon *monkit.Scope, service NodeManagementService, router *mux.Router, auth *Authorizer) *NodeManagementHandler {
+	handler := &NodeManagementHandler{
+		log:     log,
+		mon:     mon,
+		service: service,
+		auth:    auth,
+	}
+
+	nodesRouter := router.PathPrefix("/back-office/api/v1/nodes").Subrouter()
+	nodesRouter.HandleFunc("/tags", handler.handleGetNodeTags).Methods("GET")
+	nodesRouter.HandleFunc("/tags/changes", handler.handleGetNodeTagChanges).Methods("GET")
+	nodesRouter.HandleFunc("/tags/csv", handler.handleExportNodeTags).Methods("GET")
+	nodesRouter.HandleFunc("/tags/csv", handler.handleImportNodeTags).Methods("PUT")
+	nodesRouter.HandleFunc("/{nodeID}/tags/{name}", handler.handleSetNodeTag).Methods("PUT")
+	nodesRouter.HandleFunc("/{nodeID}/tags/{name}", handler.handleDeleteNodeTag).Methods("DELETE")
+
+	return handler
+}
+
 func (h *SettingsHandler) handleGetSettings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
@@ -260,3 +324,191 @@ func (h *ProjectManagementHandler) handleUpdateProjectLimits(w http.ResponseWrit
 		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
 	}
 }
+
+func (h *DurabilityManagementHandler) handleGetDurabilityReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if h.auth.IsRejected(w, r, 8388608) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetDurabilityReport(ctx)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetDurabilityReport response", zap.Error(ErrDurabilityAPI.Wrap(err)))
+	}
+}
+
+func (h *NodeManagementHandler) handleGetNodeTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if h.auth.IsRejected(w, r, 16777216) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetNodeTags(ctx)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetNodeTags response", zap.Error(ErrNodesAPI.Wrap(err)))
+	}
+}
+
+func (h *NodeManagementHandler) handleGetNodeTagChanges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if h.auth.IsRejected(w, r, 16777216) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetNodeTagChanges(ctx)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetNodeTagChanges response", zap.Error(ErrNodesAPI.Wrap(err)))
+	}
+}
+
+func (h *NodeManagementHandler) handleExportNodeTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if h.auth.IsRejected(w, r, 16777216) {
+		return
+	}
+
+	retVal, httpErr := h.service.ExportNodeTags(ctx)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json ExportNodeTags response", zap.Error(ErrNodesAPI.Wrap(err)))
+	}
+}
+
+func (h *NodeManagementHandler) handleImportNodeTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	payload := NodeTagsCSV{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 33554432) {
+		return
+	}
+	ctx = WithOperator(ctx, r)
+
+	retVal, httpErr := h.service.ImportNodeTags(ctx, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json ImportNodeTags response", zap.Error(ErrNodesAPI.Wrap(err)))
+	}
+}
+
+func (h *NodeManagementHandler) handleSetNodeTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	nodeID, ok := mux.Vars(r)["nodeID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing nodeID route param"))
+		return
+	}
+
+	name, ok := mux.Vars(r)["name"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing name route param"))
+		return
+	}
+
+	payload := NodeTagValue{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 33554432) {
+		return
+	}
+	ctx = WithOperator(ctx, r)
+
+	httpErr := h.service.SetNodeTag(ctx, nodeID, name, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+	}
+}
+
+func (h *NodeManagementHandler) handleDeleteNodeTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	nodeID, ok := mux.Vars(r)["nodeID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing nodeID route param"))
+		return
+	}
+
+	name, ok := mux.Vars(r)["name"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing name route param"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 33554432) {
+		return
+	}
+	ctx = WithOperator(ctx, r)
+
+	httpErr := h.service.DeleteNodeTag(ctx, nodeID, name)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+	}
+}
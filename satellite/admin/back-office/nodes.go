@@ -0,0 +1,183 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/api"
+	"storj.io/storj/satellite/nodetags"
+)
+
+// nodeTagChangesLimit is the maximum number of returned node tag changes.
+const nodeTagChangesLimit = 1000
+
+// NodeTag is a tag of a node, which is signed by the satellite.
+type NodeTag struct {
+	NodeID   string    `json:"nodeID"`
+	Name     string    `json:"name"`
+	Value    string    `json:"value"`
+	SignedAt time.Time `json:"signedAt"`
+}
+
+// NodeTagValue is the new value of a node tag.
+type NodeTagValue struct {
+	Value string `json:"value"`
+}
+
+// NodeTagChange is a change of a node tag signed by the satellite.
+type NodeTagChange struct {
+	NodeID    string    `json:"nodeID"`
+	Name      string    `json:"name"`
+	Value     string    `json:"value"`
+	Action    string    `json:"action"`
+	ChangedBy string    `json:"changedBy"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+// NodeTagsCSV contains node tags as CSV with node_id,name,value columns.
+type NodeTagsCSV struct {
+	CSV string `json:"csv"`
+}
+
+// NodeTagsImported contains the number of imported node tags.
+type NodeTagsImported struct {
+	Count int `json:"count"`
+}
+
+// GetNodeTags returns all the node tags signed by the satellite.
+func (s *Service) GetNodeTags(ctx context.Context) ([]NodeTag, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	tags, err := s.nodeTags.Tags(ctx)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	result := make([]NodeTag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, NodeTag{
+			NodeID:   tag.NodeID.String(),
+			Name:     tag.Name,
+			Value:    string(tag.Value),
+			SignedAt: tag.SignedAt,
+		})
+	}
+	return result, api.HTTPError{}
+}
+
+// GetNodeTagChanges returns the most recent changes of the node tags signed by the satellite.
+func (s *Service) GetNodeTagChanges(ctx context.Context) ([]NodeTagChange, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	changes, err := s.nodeTags.Changes(ctx, storj.NodeID{}, nodeTagChangesLimit)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	result := make([]NodeTagChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, NodeTagChange{
+			NodeID:    change.NodeID.String(),
+			Name:      change.Name,
+			Value:     string(change.Value),
+			Action:    change.Action,
+			ChangedBy: change.ChangedBy,
+			ChangedAt: change.ChangedAt,
+		})
+	}
+	return result, api.HTTPError{}
+}
+
+// SetNodeTag creates or changes a node tag signed by the satellite.
+func (s *Service) SetNodeTag(ctx context.Context, nodeID, name string, request NodeTagValue) api.HTTPError {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := storj.NodeIDFromString(nodeID)
+	if err != nil {
+		return api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    Error.New("invalid node ID %q: %v", nodeID, err),
+		}
+	}
+
+	err = s.nodeTags.SetTag(ctx, id, name, []byte(request.Value), OperatorFromContext(ctx))
+	return nodeTagsHTTPError(err)
+}
+
+// DeleteNodeTag removes a node tag signed by the satellite.
+func (s *Service) DeleteNodeTag(ctx context.Context, nodeID, name string) api.HTTPError {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := storj.NodeIDFromString(nodeID)
+	if err != nil {
+		return api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    Error.New("invalid node ID %q: %v", nodeID, err),
+		}
+	}
+
+	err = s.nodeTags.DeleteTag(ctx, id, name, OperatorFromContext(ctx))
+	return nodeTagsHTTPError(err)
+}
+
+// ExportNodeTags returns all the node tags signed by the satellite as CSV.
+func (s *Service) ExportNodeTags(ctx context.Context) (*NodeTagsCSV, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var buf bytes.Buffer
+	if err := s.nodeTags.ExportCSV(ctx, &buf); err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+	return &NodeTagsCSV{CSV: buf.String()}, api.HTTPError{}
+}
+
+// ImportNodeTags creates or changes the node tags of the CSV. Either all the tags are imported, or
+// none of them.
+func (s *Service) ImportNodeTags(ctx context.Context, request NodeTagsCSV) (*NodeTagsImported, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	count, err := s.nodeTags.ImportCSV(ctx, strings.NewReader(request.CSV), OperatorFromContext(ctx))
+	if httpErr := nodeTagsHTTPError(err); httpErr.Err != nil {
+		return nil, httpErr
+	}
+	return &NodeTagsImported{Count: count}, api.HTTPError{}
+}
+
+func nodeTagsHTTPError(err error) api.HTTPError {
+	switch {
+	case err == nil:
+		return api.HTTPError{}
+	case nodetags.ErrInvalid.Has(err):
+		return api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    Error.Wrap(err),
+		}
+	default:
+		return api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+}
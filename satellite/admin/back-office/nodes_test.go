@@ -0,0 +1,79 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	admin "storj.io/storj/satellite/admin/back-office"
+	"storj.io/storj/satellite/nodetags"
+)
+
+func TestNodeTags(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Admin.Admin.Service
+
+		req := httptest.NewRequest(http.MethodPut, "/", nil)
+		req.Header.Set("X-Forwarded-Email", "operator@mail.test")
+		opCtx := admin.WithOperator(ctx, req)
+
+		nodeID := testrand.NodeID()
+
+		apiErr := service.SetNodeTag(ctx, nodeID.String(), "soc2", admin.NodeTagValue{Value: "true"})
+		require.Error(t, apiErr.Err, "the operator is required")
+		require.Equal(t, http.StatusBadRequest, apiErr.Status)
+
+		apiErr = service.SetNodeTag(opCtx, "invalid", "soc2", admin.NodeTagValue{Value: "true"})
+		require.Equal(t, http.StatusBadRequest, apiErr.Status)
+
+		apiErr = service.SetNodeTag(opCtx, nodeID.String(), "soc2", admin.NodeTagValue{Value: "true"})
+		require.NoError(t, apiErr.Err)
+
+		tags, apiErr := service.GetNodeTags(ctx)
+		require.NoError(t, apiErr.Err)
+		require.Len(t, tags, 1)
+		require.Equal(t, nodeID.String(), tags[0].NodeID)
+		require.Equal(t, "true", tags[0].Value)
+
+		stored, err := sat.DB.OverlayCache().GetNodeTags(ctx, nodeID)
+		require.NoError(t, err)
+		require.Len(t, stored, 1)
+		require.Equal(t, sat.ID(), stored[0].Signer)
+
+		exported, apiErr := service.ExportNodeTags(ctx)
+		require.NoError(t, apiErr.Err)
+		require.Equal(t, "node_id,name,value\n"+nodeID.String()+",soc2,true\n", exported.CSV)
+
+		other := testrand.NodeID()
+		imported, apiErr := service.ImportNodeTags(opCtx, admin.NodeTagsCSV{CSV: other.String() + ",soc2,false\n"})
+		require.NoError(t, apiErr.Err)
+		require.Equal(t, 1, imported.Count)
+
+		apiErr = service.DeleteNodeTag(opCtx, nodeID.String(), "soc2")
+		require.NoError(t, apiErr.Err)
+
+		tags, apiErr = service.GetNodeTags(ctx)
+		require.NoError(t, apiErr.Err)
+		require.Len(t, tags, 1)
+		require.Equal(t, other.String(), tags[0].NodeID)
+
+		changes, apiErr := service.GetNodeTagChanges(ctx)
+		require.NoError(t, apiErr.Err)
+		require.Len(t, changes, 3)
+		for _, change := range changes {
+			require.Equal(t, "operator@mail.test", change.ChangedBy)
+		}
+		require.Equal(t, nodetags.ActionDelete, changes[0].Action)
+	})
+}
@@ -89,6 +89,8 @@ func NewServer(
 	NewPlacementManagement(log, mon, service, root)
 	NewUserManagement(log, mon, service, root, auth)
 	NewProjectManagement(log, mon, service, root, auth)
+	NewDurabilityManagement(log, mon, service, root, auth)
+	NewNodeManagement(log, mon, service, root, auth)
 	NewSettings(log, mon, service, root)
 
 	root = root.PathPrefix(PathPrefix).Subrouter()
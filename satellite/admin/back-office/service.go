@@ -8,7 +8,9 @@ import (
 
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/durability"
 	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/nodetags"
 )
 
 // Defaults contains default values for limits which are not stored in the DB.
@@ -23,6 +25,8 @@ type Service struct {
 	consoleDB    console.DB
 	accountingDB accounting.ProjectAccounting
 	accounting   *accounting.Service
+	durability   durability.DB
+	nodeTags     *nodetags.Service
 	placement    nodeselection.PlacementDefinitions
 	defaults     Defaults
 }
@@ -33,6 +37,8 @@ func NewService(
 	consoleDB console.DB,
 	accountingDB accounting.ProjectAccounting,
 	accounting *accounting.Service,
+	durability durability.DB,
+	nodeTags *nodetags.Service,
 	placement nodeselection.PlacementDefinitions,
 	defaultMaxBuckets int,
 	defaultRateLimit float64,
@@ -42,6 +48,8 @@ func NewService(
 		consoleDB:    consoleDB,
 		accountingDB: accountingDB,
 		accounting:   accounting,
+		durability:   durability,
+		nodeTags:     nodeTags,
 		placement:    placement,
 		defaults: Defaults{
 			MaxBuckets: defaultMaxBuckets,
@@ -33,6 +33,7 @@ type FeatureFlags struct {
 	Project         ProjectFlags `json:"project"`
 	Bucket          BucketFlags  `json:"bucket"`
 	Dashboard       bool         `json:"dashboard"`
+	Durability      bool         `json:"durability"`
 	Operator        bool         `json:"operator"` // This is the information about the logged operator
 	SignOut         bool         `json:"signOut"`
 	SwitchSatellite bool         `json:"switchSatellite"`
@@ -132,6 +133,7 @@ func (s *Service) GetSettings(ctx context.Context) (*Settings, api.HTTPError) {
 					View:                   false,
 				},
 				Dashboard:       false,
+				Durability:      true,
 				Operator:        false,
 				SignOut:         false,
 				SwitchSatellite: false,
@@ -33,16 +33,61 @@ export class BucketFlags {
     view: boolean;
 }
 
+export class DurabilityReport {
+    createdAt: Time;
+    results: DurabilityResult[] | null;
+}
+
+export class DurabilityResult {
+    placement: number;
+    class: string;
+    healthyMargin: number;
+    segmentCount: number;
+    lostSegments: number;
+    totalSegments: number;
+    segmentExemplars: string[] | null;
+    classExemplars: string[] | null;
+}
+
 export class FeatureFlags {
     account: AccountFlags;
     project: ProjectFlags;
     bucket: BucketFlags;
     dashboard: boolean;
+    durability: boolean;
     operator: boolean;
     signOut: boolean;
     switchSatellite: boolean;
 }
 
+export class NodeTag {
+    nodeID: string;
+    name: string;
+    value: string;
+    signedAt: Time;
+}
+
+export class NodeTagChange {
+    nodeID: string;
+    name: string;
+    value: string;
+    action: string;
+    changedBy: string;
+    changedAt: Time;
+}
+
+export class NodeTagValue {
+    value: string;
+}
+
+export class NodeTagsCSV {
+    csv: string;
+}
+
+export class NodeTagsImported {
+    count: number;
+}
+
 export class PlacementInfo {
     id: number;
     location: string;
@@ -206,3 +251,83 @@ export class ProjectManagementHttpApiV1 {
         throw new APIError(err.error, response.status);
     }
 }
+
+export class DurabilityManagementHttpApiV1 {
+    private readonly http: HttpClient = new HttpClient();
+    private readonly ROOT_PATH: string = '/back-office/api/v1/durability';
+
+    public async getDurabilityReport(): Promise<DurabilityReport> {
+        const fullPath = `${this.ROOT_PATH}/`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as DurabilityReport);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+}
+
+export class NodeManagementHttpApiV1 {
+    private readonly http: HttpClient = new HttpClient();
+    private readonly ROOT_PATH: string = '/back-office/api/v1/nodes';
+
+    public async getNodeTags(): Promise<NodeTag[]> {
+        const fullPath = `${this.ROOT_PATH}/tags`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as NodeTag[]);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async getNodeTagChanges(): Promise<NodeTagChange[]> {
+        const fullPath = `${this.ROOT_PATH}/tags/changes`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as NodeTagChange[]);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async exportNodeTags(): Promise<NodeTagsCSV> {
+        const fullPath = `${this.ROOT_PATH}/tags/csv`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as NodeTagsCSV);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async importNodeTags(request: NodeTagsCSV): Promise<NodeTagsImported> {
+        const fullPath = `${this.ROOT_PATH}/tags/csv`;
+        const response = await this.http.put(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return response.json().then((body) => body as NodeTagsImported);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async setNodeTag(request: NodeTagValue, nodeID: string, name: string): Promise<void> {
+        const fullPath = `${this.ROOT_PATH}/${nodeID}/${name}`;
+        const response = await this.http.put(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return;
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async deleteNodeTag(nodeID: string, name: string): Promise<void> {
+        const fullPath = `${this.ROOT_PATH}/${nodeID}/${name}`;
+        const response = await this.http.delete(fullPath);
+        if (response.ok) {
+            return;
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+}
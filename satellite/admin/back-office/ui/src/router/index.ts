@@ -59,6 +59,11 @@ const routes = [
                 name: 'Bucket Details',
                 component: () => import(/* webpackChunkName: "BucketDetails" */ '@/views/BucketDetails.vue'),
             },
+            {
+                path: '/durability',
+                name: 'Durability',
+                component: () => import(/* webpackChunkName: "Durability" */ '@/views/Durability.vue'),
+            },
             {
                 path: '/admin-settings',
                 name: 'Admin Settings',
@@ -4,7 +4,7 @@
 import { reactive } from 'vue';
 import { defineStore } from 'pinia';
 
-import { PlacementInfo, PlacementManagementHttpApiV1, Project, ProjectLimitsUpdate, ProjectManagementHttpApiV1, UserAccount, UserManagementHttpApiV1, Settings, SettingsHttpApiV1 } from '@/api/client.gen';
+import { DurabilityManagementHttpApiV1, DurabilityReport, PlacementInfo, PlacementManagementHttpApiV1, Project, ProjectLimitsUpdate, ProjectManagementHttpApiV1, UserAccount, UserManagementHttpApiV1, Settings, SettingsHttpApiV1 } from '@/api/client.gen';
 
 class AppState {
     public placements: PlacementInfo[];
@@ -20,6 +20,7 @@ export const useAppStore = defineStore('app', () => {
     const placementApi = new PlacementManagementHttpApiV1();
     const projectApi = new ProjectManagementHttpApiV1();
     const settingsApi = new SettingsHttpApiV1();
+    const durabilityApi = new DurabilityManagementHttpApiV1();
 
     async function getUserByEmail(email: string): Promise<void> {
         state.userAccount = await userApi.getUserByEmail(email);
@@ -69,6 +70,10 @@ export const useAppStore = defineStore('app', () => {
         }
     }
 
+    async function getDurabilityReport(): Promise<DurabilityReport> {
+        return await durabilityApi.getDurabilityReport();
+    }
+
     async function getSettings(): Promise<void> {
         state.settings = await settingsApi.get();
     }
@@ -81,6 +86,7 @@ export const useAppStore = defineStore('app', () => {
         getPlacementText,
         selectProject,
         updateProjectLimits,
+        getDurabilityReport,
         getSettings,
     };
 });
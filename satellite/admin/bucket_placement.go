@@ -0,0 +1,98 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/buckets"
+)
+
+// parseBucketPlacement parses the placement either from the region or from the
+// numeric placement query parameter.
+func parseBucketPlacement(r *http.Request) (storj.PlacementConstraint, error) {
+	query := r.URL.Query()
+
+	value := query.Get("placement")
+	if value == "" {
+		return parsePlacementConstraint(query.Get("region"))
+	}
+
+	placement, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return storj.DefaultPlacement, fmt.Errorf("invalid placement parameter: %s", value)
+	}
+	return storj.PlacementConstraint(placement), nil
+}
+
+func (server *Server) changeBucketPlacement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	placement, err := parseBucketPlacement(r)
+	if err != nil {
+		sendJSONError(w, err.Error(), "available regions: EU, EEA, US, DE, NR", http.StatusBadRequest)
+		return
+	}
+
+	b, err := server.buckets.ChangePlacement(ctx, bucket, project.UUID, placement)
+	if err != nil {
+		switch {
+		case buckets.ErrBucketNotFound.Has(err):
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		case buckets.ErrPlacementInvalid.Has(err):
+			sendJSONError(w, "unable to change bucket placement", err.Error(), http.StatusBadRequest)
+		default:
+			sendJSONError(w, "unable to change bucket placement", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getBucketPlacementMigration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	migration, err := server.buckets.GetPlacementMigration(ctx, bucket, project.UUID)
+	if err != nil {
+		if buckets.ErrPlacementMigrationNotFound.Has(err) {
+			sendJSONError(w, "bucket has no placement migration", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get placement migration", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(migration)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+func (server *Server) moveBucket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		ProjectID string `json:"projectId"`
+	}
+	if err := json.Unmarshal(body, &input); err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+	if input.ProjectID == "" {
+		sendJSONError(w, "destination project id is missing", "", http.StatusBadRequest)
+		return
+	}
+
+	target, err := server.getProjectByAnyID(ctx, input.ProjectID)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "destination project does not exist", "", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "error getting destination project", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	moved, err := server.buckets.MoveBucket(ctx, metabase.BucketLocation{
+		ProjectID:  project.UUID,
+		BucketName: metabase.BucketName(bucket),
+	}, target.ID)
+	if err != nil {
+		switch {
+		case buckets.ErrBucketNotFound.Has(err):
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		case buckets.ErrMoveInvalid.Has(err):
+			sendJSONError(w, "unable to move bucket", err.Error(), http.StatusBadRequest)
+		case buckets.ErrBucketAlreadyExists.Has(err), buckets.ErrConflict.Has(err), metabase.ErrObjectAlreadyExists.Has(err):
+			sendJSONError(w, "unable to move bucket", err.Error(), http.StatusConflict)
+		default:
+			sendJSONError(w, "unable to move bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(moved)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
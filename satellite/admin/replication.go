@@ -0,0 +1,208 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/replication"
+)
+
+// replicationRule is the admin API representation of replication.Rule.
+type replicationRule struct {
+	Destination string `json:"destination"`
+	// Prefix is the encrypted object key prefix, base64 encoded in JSON.
+	Prefix           []byte    `json:"prefix"`
+	ReplicateDeletes bool      `json:"replicateDeletes"`
+	CreatedAt        time.Time `json:"createdAt"`
+}
+
+// replicationStatus is the admin API representation of replication.Job.
+type replicationStatus struct {
+	Destination        string    `json:"destination"`
+	Action             string    `json:"action"`
+	Status             string    `json:"status"`
+	DestinationVersion int64     `json:"destinationVersion,omitempty"`
+	Attempts           int       `json:"attempts"`
+	LastError          string    `json:"lastError,omitempty"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+}
+
+func (server *Server) getBucketReplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	rules, err := server.db.Replication().ListRules(ctx, project.UUID, metabase.BucketName(bucket))
+	if err != nil {
+		sendJSONError(w, "unable to list replication rules", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]replicationRule, 0, len(rules))
+	for _, rule := range rules {
+		output = append(output, replicationRule{
+			Destination:      rule.DestinationBucket.String(),
+			Prefix:           []byte(rule.Prefix),
+			ReplicateDeletes: rule.ReplicateDeletes,
+			CreatedAt:        rule.CreatedAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) setBucketReplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input replicationRule
+	if err := json.Unmarshal(body, &input); err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rule := replication.Rule{
+		ProjectID:         project.UUID,
+		BucketName:        metabase.BucketName(bucket),
+		DestinationBucket: metabase.BucketName(input.Destination),
+		Prefix:            metabase.ObjectKey(input.Prefix),
+		ReplicateDeletes:  input.ReplicateDeletes,
+		CreatedAt:         server.nowFn(),
+	}
+	if err := rule.Verify(); err != nil {
+		sendJSONError(w, "invalid replication rule", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, name := range []metabase.BucketName{rule.BucketName, rule.DestinationBucket} {
+		if _, err := server.buckets.GetBucket(ctx, []byte(name), project.UUID); err != nil {
+			if buckets.ErrBucketNotFound.Has(err) {
+				sendJSONError(w, "bucket does not exist", name.String(), http.StatusNotFound)
+			} else {
+				sendJSONError(w, "unable to check bucket", err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+	}
+
+	if err := server.db.Replication().SetRule(ctx, rule); err != nil {
+		sendJSONError(w, "unable to set replication rule", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *Server) deleteBucketReplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	project, bucket, err := validateBucketPathParameters(vars)
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	destination := vars["destination"]
+	if destination == "" {
+		sendJSONError(w, "destination bucket name is missing", "", http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.Replication().DeleteRule(ctx, project.UUID, metabase.BucketName(bucket), metabase.BucketName(destination))
+	if err != nil {
+		if replication.ErrRuleNotFound.Has(err) {
+			sendJSONError(w, "replication rule does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to delete replication rule", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *Server) getObjectReplicationStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+	key, err := base64.URLEncoding.DecodeString(query.Get("key"))
+	if err != nil || len(key) == 0 {
+		sendJSONError(w, "invalid or missing key parameter", "expected base64 URL encoded encrypted object key", http.StatusBadRequest)
+		return
+	}
+	version, err := strconv.ParseInt(query.Get("version"), 10, 64)
+	if err != nil || version <= 0 {
+		sendJSONError(w, "invalid or missing version parameter", "", http.StatusBadRequest)
+		return
+	}
+
+	jobs, err := server.db.Replication().ObjectStatus(ctx, metabase.ObjectLocation{
+		ProjectID:  project.UUID,
+		BucketName: metabase.BucketName(bucket),
+		ObjectKey:  metabase.ObjectKey(key),
+	}, metabase.Version(version))
+	if err != nil {
+		sendJSONError(w, "unable to get replication status", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]replicationStatus, 0, len(jobs))
+	for _, job := range jobs {
+		output = append(output, replicationStatus{
+			Destination:        job.DestinationBucket.String(),
+			Action:             job.Action.String(),
+			Status:             job.Status.String(),
+			DestinationVersion: int64(job.DestinationVersion),
+			Attempts:           job.Attempts,
+			LastError:          job.LastError,
+			UpdatedAt:          job.UpdatedAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
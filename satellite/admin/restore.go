@@ -0,0 +1,91 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+func (server *Server) restoreBucket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+	restoreTime, err := time.Parse(time.RFC3339, query.Get("time"))
+	if err != nil {
+		sendJSONError(w, "invalid or missing time parameter", "expected RFC3339 timestamp", http.StatusBadRequest)
+		return
+	}
+
+	prefix, err := base64.URLEncoding.DecodeString(query.Get("prefix"))
+	if err != nil {
+		sendJSONError(w, "invalid prefix parameter", "expected base64 URL encoded encrypted object key prefix", http.StatusBadRequest)
+		return
+	}
+
+	cursor, err := base64.URLEncoding.DecodeString(query.Get("cursor"))
+	if err != nil {
+		sendJSONError(w, "invalid cursor parameter", "expected base64 URL encoded encrypted object key", http.StatusBadRequest)
+		return
+	}
+
+	var dryRun bool
+	if value := query.Get("dryRun"); value != "" {
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			sendJSONError(w, "invalid dryRun parameter", err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	result, err := server.buckets.RestoreToTime(ctx, buckets.RestoreToTime{
+		ProjectID:  project.UUID,
+		BucketName: metabase.BucketName(bucket),
+		Prefix:     metabase.ObjectKey(prefix),
+		Time:       restoreTime,
+		DryRun:     dryRun,
+		Cursor:     metabase.ObjectKey(cursor),
+	})
+	resultCursor := base64.URLEncoding.EncodeToString([]byte(result.Cursor))
+	if err != nil {
+		switch {
+		case buckets.ErrBucketNotFound.Has(err):
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		case buckets.ErrRestoreInvalid.Has(err):
+			sendJSONError(w, "unable to restore bucket", err.Error(), http.StatusBadRequest)
+		default:
+			sendJSONError(w, "unable to restore bucket", fmt.Sprintf("%v; resume with cursor=%s", err, resultCursor), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		buckets.RestoreResult
+		Cursor string
+	}{
+		RestoreResult: result,
+		Cursor:        resultCursor,
+	})
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
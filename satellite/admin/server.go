@@ -25,6 +25,7 @@ import (
 	backoffice "storj.io/storj/satellite/admin/back-office"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/attribution"
+	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
@@ -33,6 +34,8 @@ import (
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/replication"
+	"storj.io/storj/satellite/reputation"
 )
 
 // Assets contains either the built admin/back-office/ui or it is nil.
@@ -78,6 +81,12 @@ type DB interface {
 	Buckets() buckets.DB
 	// Attribution returns database for value attribution.
 	Attribution() attribution.DB
+	// Replication returns database for bucket replication.
+	Replication() replication.DB
+	// Reputation returns database for the reputation of nodes.
+	Reputation() reputation.DB
+	// AuditHistory returns database for the recent audit outcomes of nodes.
+	AuditHistory() audit.HistoryDB
 }
 
 // Server provides endpoints for administrative tasks.
@@ -167,6 +176,14 @@ func NewServer(
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}", server.getBucketInfo).Methods("GET")
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.createGeofenceForBucket).Methods("POST")
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.deleteGeofenceForBucket).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/restore", server.restoreBucket).Methods("POST")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/move", server.moveBucket).Methods("POST")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/placement", server.changeBucketPlacement).Methods("PUT")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/placement/migration", server.getBucketPlacementMigration).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/replication", server.getBucketReplication).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/replication", server.setBucketReplication).Methods("PUT")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/replication/status", server.getObjectReplicationStatus).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/replication/{destination}", server.deleteBucketReplication).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/projects/{project}/usage", server.checkProjectUsage).Methods("GET")
 	fullAccessAPI.HandleFunc("/projects/{project}/useragent", server.updateProjectsUserAgent).Methods("PATCH")
 	fullAccessAPI.HandleFunc("/projects/{project}/geofence", server.createGeofenceForProject).Methods("PUT")
@@ -175,6 +192,7 @@ func NewServer(
 	fullAccessAPI.HandleFunc("/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/restkeys/{useremail}", server.addRESTKey).Methods("POST")
 	fullAccessAPI.HandleFunc("/restkeys/{apikey}/revoke", server.revokeRESTKey).Methods("PUT")
+	fullAccessAPI.HandleFunc("/nodes/{nodeid}/audits", server.getNodeAudits).Methods("GET")
 
 	// limit update access required
 	limitUpdateAPI := api.NewRoute().Subrouter()
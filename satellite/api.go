@@ -29,11 +29,15 @@ import (
 	"storj.io/common/version"
 	"storj.io/storj/private/healthcheck"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/nodestatspb"
+	"storj.io/storj/private/replicationpb"
+	"storj.io/storj/private/restorepb"
 	"storj.io/storj/private/server"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/abtesting"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
@@ -59,6 +63,7 @@ import (
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/replication"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/snopayouts"
 	"storj.io/storj/satellite/trust"
@@ -93,11 +98,14 @@ type API struct {
 	Contact struct {
 		Service  *contact.Service
 		Endpoint *contact.Endpoint
+		Probe    *contact.ProbeEndpoint
 	}
 
 	Overlay struct {
-		DB      overlay.DB
-		Service *overlay.Service
+		DB               overlay.DB
+		Service          *overlay.Service
+		PlacementWatcher *nodeselection.Watcher
+		SelectionTracer  *overlay.SelectionTracer
 	}
 
 	Reputation struct {
@@ -160,7 +168,8 @@ type API struct {
 	}
 
 	NodeStats struct {
-		Endpoint *nodestats.Endpoint
+		Endpoint    *nodestats.Endpoint
+		Maintenance *nodestats.MaintenanceEndpoint
 	}
 
 	OIDC struct {
@@ -189,6 +198,10 @@ type API struct {
 		Service *buckets.Service
 	}
 
+	Replication struct {
+		Service *replication.Service
+	}
+
 	KeyManagement struct {
 		Service *kms.Service
 	}
@@ -205,9 +218,10 @@ type API struct {
 		Server *healthcheck.Server
 	}
 
-	SuccessTrackers *metainfo.SuccessTrackers
-	FailureTracker  metainfo.SuccessTracker
-	TrustedUplinks  *trust.TrustedPeersList
+	SuccessTrackers         *metainfo.SuccessTrackers
+	FailureTracker          metainfo.SuccessTracker
+	RegionalSuccessTrackers *metainfo.RegionalSuccessTrackers
+	TrustedUplinks          *trust.TrustedPeersList
 }
 
 // NewAPI creates a new satellite API process.
@@ -229,7 +243,13 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.Buckets.Service = buckets.NewService(db.Buckets(), metabaseDB)
 	}
 
+	{ // setup replication service
+		peer.Replication.Service = replication.NewService(peer.Log.Named("replication"), db.Replication(), config.Replication)
+	}
+
 	migrationModeFlag := metainfo.NewMigrationModeFlagExtension(config.Metainfo)
+	peer.Overlay.PlacementWatcher = nodeselection.NewWatcher(peer.Log.Named("placement:watcher"), config.Placement, config.PlacementWatcher)
+	peer.Overlay.SelectionTracer = overlay.NewSelectionTracer(peer.Log.Named("overlay:selection-tracer"), config.Overlay.SelectionTrace)
 
 	{ // setup debug
 		var err error
@@ -243,7 +263,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		debugConfig := config.Debug
 		debugConfig.ControlTitle = "API"
 		peer.Debug.Server = debug.NewServerWithAtomicLevel(log.Named("debug"), peer.Debug.Listener, monkit.Default,
-			debugConfig, atomicLogLevel, migrationModeFlag)
+			debugConfig, atomicLogLevel, migrationModeFlag, peer.Overlay.PlacementWatcher, peer.Overlay.SelectionTracer)
 		peer.Servers.Add(lifecycle.Item{
 			Name:  "debug",
 			Run:   peer.Debug.Server.Run,
@@ -335,10 +355,19 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.FailureTracker = metainfo.NewStochasticPercentSuccessTracker(float32(config.Metainfo.FailureTrackerChanceToSkip))
 		monkit.ScopeNamed(mon.Name() + ".failure_tracker").Chain(peer.FailureTracker)
 
+		if config.Metainfo.RegionalSuccessTracker {
+			peer.RegionalSuccessTrackers = metainfo.NewRegionalSuccessTrackers(newTracker)
+			monkit.ScopeNamed(mon.Name() + ".regional_success_trackers").Chain(peer.RegionalSuccessTrackers)
+		}
+
 		peer.TrustedUplinks = trust.NewTrustedPeerList(trustedUplinkSlice)
 	}
 
-	placements, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nodeselection.NewPlacementConfigEnvironment(peer.SuccessTrackers, peer.FailureTracker))
+	placementEnvironment := nodeselection.NewPlacementConfigEnvironment(peer.SuccessTrackers, peer.FailureTracker)
+	if peer.RegionalSuccessTrackers != nil {
+		placementEnvironment = placementEnvironment.WithProximityTracker(peer.RegionalSuccessTrackers)
+	}
+	placements, err := peer.Overlay.PlacementWatcher.Load(config.Overlay.Node.CreateDefaultPlacement, placementEnvironment)
 	if err != nil {
 		return nil, err
 	}
@@ -350,11 +379,19 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peer.Overlay.Service.SelectionTracer = peer.Overlay.SelectionTracer
+		peer.Overlay.Service.FillRateTracker = placementEnvironment.FillRateTracker()
 		peer.Services.Add(lifecycle.Item{
 			Name:  "overlay",
 			Run:   peer.Overlay.Service.Run,
 			Close: peer.Overlay.Service.Close,
 		})
+
+		peer.Overlay.PlacementWatcher.OnChange(peer.Overlay.Service.SetPlacements)
+		peer.Services.Add(lifecycle.Item{
+			Name: "placement:watcher",
+			Run:  peer.Overlay.PlacementWatcher.Run,
+		})
 	}
 
 	{ // setup reputation
@@ -426,7 +463,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.Overlay.Service,
 			peer.Orders.DB,
-			placements.CreateFilters,
+			peer.Overlay.PlacementWatcher.CreateFilters,
 			config.Orders,
 		)
 		if err != nil {
@@ -449,6 +486,18 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		}
 	}
 
+	{ // setup node probe
+		peer.Contact.Probe = contact.NewProbeEndpoint(
+			peer.Log.Named("contact:probe"),
+			peer.Orders.Service,
+			peer.Dialer,
+			config.Contact,
+		)
+		if err := nodestatspb.DRPCRegisterNodeProbe(peer.Server.DRPC(), peer.Contact.Probe); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
 	{ // setup metainfo
 		peer.Metainfo.Metabase = metabaseDB
 		config.Metainfo.SelfServePlacementSelectEnabled = config.Console.Placement.SelfServeEnabled
@@ -470,20 +519,29 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.Revocation(),
 			peer.SuccessTrackers,
 			peer.FailureTracker,
+			peer.RegionalSuccessTrackers,
 			peer.TrustedUplinks,
 			config.Metainfo,
 			migrationModeFlag,
 			placements,
 			config.Console.PlacementEdgeURLOverrides,
+			peer.Replication.Service,
 			config.Orders.TrustedOrders,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peer.Overlay.PlacementWatcher.OnChange(peer.Metainfo.Endpoint.SetPlacements)
 
 		if err := pb.DRPCRegisterMetainfo(peer.Server.DRPC(), peer.Metainfo.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := restorepb.DRPCRegisterBucketRestore(peer.Server.DRPC(), peer.Metainfo.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		if err := replicationpb.DRPCRegisterObjectReplication(peer.Server.DRPC(), peer.Metainfo.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:endpoint",
@@ -519,17 +577,37 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 	}
 
 	{ // setup node stats endpoint
+		var auditHistory audit.HistoryDB
+		if config.Audit.HistoryRetention > 0 {
+			auditHistory = peer.DB.AuditHistory()
+		}
+
 		peer.NodeStats.Endpoint = nodestats.NewEndpoint(
 			peer.Log.Named("nodestats:endpoint"),
 			peer.Overlay.DB,
 			peer.Reputation.Service,
 			peer.DB.StoragenodeAccounting(),
+			auditHistory,
 			config.Payments,
 			config.Compensation,
 		)
 		if err := pb.DRPCRegisterNodeStats(peer.Server.DRPC(), peer.NodeStats.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := nodestatspb.DRPCRegisterAuditHistory(peer.Server.DRPC(), peer.NodeStats.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		if err := nodestatspb.DRPCRegisterNodeReachability(peer.Server.DRPC(), peer.NodeStats.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.NodeStats.Maintenance = nodestats.NewMaintenanceEndpoint(
+			peer.Log.Named("nodestats:maintenance"),
+			peer.Overlay.Service,
+		)
+		if err := nodestatspb.DRPCRegisterNodeMaintenance(peer.Server.DRPC(), peer.NodeStats.Maintenance); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	{ // setup SnoPayout endpoint
@@ -779,6 +857,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			if err != nil {
 				return nil, errs.Combine(err, peer.Close())
 			}
+			peer.Overlay.PlacementWatcher.OnChange(peer.Console.Service.SetPlacements)
 
 			peer.CSRF.Service = csrf.NewService(signer)
 
@@ -0,0 +1,184 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// HistoryOutcome is the outcome of a single audit as kept in the audit
+// history of a node.
+type HistoryOutcome int
+
+const (
+	// HistorySuccess is a successful audit.
+	HistorySuccess HistoryOutcome = iota
+	// HistoryFailure is a failed audit, including reverifications which
+	// reached the maximum reverify count.
+	HistoryFailure
+	// HistoryOffline is an audit where the node was offline.
+	HistoryOffline
+	// HistoryUnknown is an audit which failed with an unknown error.
+	HistoryUnknown
+	// HistoryContained is an audit which timed out and placed the node in
+	// containment until the piece is reverified.
+	HistoryContained
+)
+
+// String returns the name of the outcome used in API responses.
+func (outcome HistoryOutcome) String() string {
+	switch outcome {
+	case HistorySuccess:
+		return "success"
+	case HistoryFailure:
+		return "failure"
+	case HistoryOffline:
+		return "offline"
+	case HistoryUnknown:
+		return "unknown"
+	case HistoryContained:
+		return "contained"
+	}
+	return fmt.Sprintf("<unregistered history outcome %d>", int(outcome))
+}
+
+// HistoryEntry is a single audit outcome of a node.
+type HistoryEntry struct {
+	NodeID   storj.NodeID
+	StreamID uuid.UUID
+	Position metabase.SegmentPosition
+	Outcome  HistoryOutcome
+	// ReverifyCount is the number of reverification attempts of the piece
+	// at the time of the outcome. It is zero for regular audits.
+	ReverifyCount int
+	CreatedAt     time.Time
+}
+
+// HistoryDB keeps the recent audit outcomes of nodes, so that operators and
+// support can find out which audits caused a change of reputation.
+type HistoryDB interface {
+	// Insert adds audit outcomes to the history.
+	Insert(ctx context.Context, entries []HistoryEntry) error
+	// List returns up to limit of the most recent audit outcomes of the node,
+	// newest first.
+	List(ctx context.Context, nodeID storj.NodeID, limit int) ([]HistoryEntry, error)
+	// DeleteBefore removes audit outcomes recorded before the given time.
+	DeleteBefore(ctx context.Context, before time.Time) (deleted int64, err error)
+}
+
+// historyEntries converts the results of an audit report into audit history entries.
+func historyEntries(req Report, maxReverifyCount int, now time.Time) []HistoryEntry {
+	var streamID uuid.UUID
+	var position metabase.SegmentPosition
+	var reverifyCount int
+	switch {
+	case req.Reverification != nil:
+		streamID = req.Reverification.Locator.StreamID
+		position = req.Reverification.Locator.Position
+		reverifyCount = req.Reverification.ReverifyCount
+	case req.Segment != nil:
+		streamID = req.Segment.StreamID
+		position = req.Segment.Position
+	}
+
+	var entries []HistoryEntry
+	add := func(nodeID storj.NodeID, outcome HistoryOutcome) {
+		entries = append(entries, HistoryEntry{
+			NodeID:        nodeID,
+			StreamID:      streamID,
+			Position:      position,
+			Outcome:       outcome,
+			ReverifyCount: reverifyCount,
+			CreatedAt:     now,
+		})
+	}
+	for _, nodeID := range req.Successes {
+		add(nodeID, HistorySuccess)
+	}
+	for _, piece := range req.Fails {
+		add(piece.StorageNode, HistoryFailure)
+	}
+	for _, nodeID := range req.Offlines {
+		add(nodeID, HistoryOffline)
+	}
+	for _, nodeID := range req.Unknown {
+		add(nodeID, HistoryUnknown)
+	}
+	for _, pending := range req.PendingAudits {
+		outcome := HistoryContained
+		if pending.ReverifyCount >= maxReverifyCount {
+			outcome = HistoryFailure
+		}
+		entries = append(entries, HistoryEntry{
+			NodeID:        pending.Locator.NodeID,
+			StreamID:      pending.Locator.StreamID,
+			Position:      pending.Locator.Position,
+			Outcome:       outcome,
+			ReverifyCount: pending.ReverifyCount,
+			CreatedAt:     now,
+		})
+	}
+	return entries
+}
+
+// HistoryCleanupChore deletes audit outcomes older than the retention from
+// the audit history.
+type HistoryCleanupChore struct {
+	log       *zap.Logger
+	db        HistoryDB
+	retention time.Duration
+	nowFn     func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewHistoryCleanupChore creates a new HistoryCleanupChore.
+func NewHistoryCleanupChore(log *zap.Logger, db HistoryDB, config Config) *HistoryCleanupChore {
+	return &HistoryCleanupChore{
+		log:       log,
+		db:        db,
+		retention: config.HistoryRetention,
+		nowFn:     time.Now,
+		Loop:      sync2.NewCycle(config.HistoryCleanupInterval),
+	}
+}
+
+// Run runs the audit history cleanup chore.
+func (chore *HistoryCleanupChore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		deleted, err := chore.db.DeleteBefore(ctx, chore.nowFn().Add(-chore.retention))
+		if err != nil {
+			chore.log.Error("failed to delete expired audit history", zap.Error(Error.Wrap(err)))
+			return nil
+		}
+		mon.IntVal("audit_history_deleted").Observe(deleted)
+		chore.log.Debug("deleted expired audit history", zap.Int64("count", deleted))
+		return nil
+	})
+}
+
+// Close halts the audit history cleanup chore.
+func (chore *HistoryCleanupChore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestingSetNow allows tests to have the chore act as if the current time is
+// different than it is.
+func (chore *HistoryCleanupChore) TestingSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestHistoryEntries(t *testing.T) {
+	now := time.Now()
+	segment := &metabase.Segment{
+		StreamID: testrand.UUID(),
+		Position: metabase.SegmentPosition{Part: 1, Index: 2},
+	}
+	success, failure, offline, unknown := testrand.NodeID(), testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+	contained, exhausted := testrand.NodeID(), testrand.NodeID()
+	pendingLocator := func(nodeID storj.NodeID) PieceLocator {
+		return PieceLocator{StreamID: testrand.UUID(), Position: metabase.SegmentPosition{Index: 7}, NodeID: nodeID}
+	}
+
+	entries := historyEntries(Report{
+		Segment:   segment,
+		Successes: storj.NodeIDList{success},
+		Fails:     metabase.Pieces{{Number: 1, StorageNode: failure}},
+		Offlines:  storj.NodeIDList{offline},
+		Unknown:   storj.NodeIDList{unknown},
+		PendingAudits: []*ReverificationJob{
+			{Locator: pendingLocator(contained), ReverifyCount: 1},
+			{Locator: pendingLocator(exhausted), ReverifyCount: 3},
+		},
+	}, 3, now)
+	require.Len(t, entries, 6)
+
+	outcomes := make(map[storj.NodeID]HistoryEntry)
+	for _, entry := range entries {
+		require.Equal(t, now, entry.CreatedAt)
+		outcomes[entry.NodeID] = entry
+	}
+	for nodeID, outcome := range map[storj.NodeID]HistoryOutcome{
+		success: HistorySuccess,
+		failure: HistoryFailure,
+		offline: HistoryOffline,
+		unknown: HistoryUnknown,
+	} {
+		require.Equal(t, outcome, outcomes[nodeID].Outcome)
+		require.Equal(t, segment.StreamID, outcomes[nodeID].StreamID)
+		require.Equal(t, segment.Position, outcomes[nodeID].Position)
+		require.Zero(t, outcomes[nodeID].ReverifyCount)
+	}
+	require.Equal(t, HistoryContained, outcomes[contained].Outcome)
+	require.Equal(t, 1, outcomes[contained].ReverifyCount)
+	require.Equal(t, metabase.SegmentPosition{Index: 7}, outcomes[contained].Position)
+	require.Equal(t, HistoryFailure, outcomes[exhausted].Outcome)
+
+	// reverification results are recorded for the reverified piece.
+	job := &ReverificationJob{Locator: pendingLocator(failure), ReverifyCount: 2}
+	entries = historyEntries(Report{
+		Segment:        segment,
+		Reverification: job,
+		Fails:          metabase.Pieces{{Number: 1, StorageNode: failure}},
+	}, 3, now)
+	require.Len(t, entries, 1)
+	require.Equal(t, job.Locator.StreamID, entries[0].StreamID)
+	require.Equal(t, job.Locator.Position, entries[0].Position)
+	require.Equal(t, 2, entries[0].ReverifyCount)
+}
@@ -0,0 +1,423 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/inventorypb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/overlay"
+)
+
+// InventoryConfig contains configurable values for piece inventory audits.
+type InventoryConfig struct {
+	Enabled     bool          `help:"whether to audit the piece inventory of nodes with the ranged loop" default:"false"`
+	Nodes       int           `help:"number of nodes whose piece inventory is audited in a single ranged loop run" default:"10"`
+	Ranges      int           `help:"number of ranges the piece ID space is split into. A single range of each audited node is checked in a ranged loop run" default:"64"`
+	Concurrency int           `help:"number of nodes whose piece inventory is listed concurrently" default:"5"`
+	Timeout     time.Duration `help:"timeout for listing the piece inventory of a single node" default:"10m"`
+}
+
+// Inventory is a verified listing of the pieces a node holds in a piece ID range.
+type Inventory struct {
+	NodeID   storj.NodeID
+	Start    storj.PieceID
+	End      storj.PieceID
+	ListedAt time.Time
+	PieceIDs []storj.PieceID // sorted
+}
+
+// Contains returns whether the piece is in the inventory.
+func (inventory *Inventory) Contains(pieceID storj.PieceID) bool {
+	i := sort.Search(len(inventory.PieceIDs), func(i int) bool {
+		return bytes.Compare(inventory.PieceIDs[i][:], pieceID[:]) >= 0
+	})
+	return i < len(inventory.PieceIDs) && inventory.PieceIDs[i] == pieceID
+}
+
+// InventoryRange returns the bounds of the index-th of n equally sized ranges
+// of the piece ID space. The end of the last range is zero.
+func InventoryRange(index, n int) (start, end storj.PieceID) {
+	step := ^uint64(0)/uint64(n) + 1
+	if n == 1 {
+		step = 0
+	}
+	binary.BigEndian.PutUint64(start[:8], uint64(index)*step)
+	if index+1 < n {
+		binary.BigEndian.PutUint64(end[:8], uint64(index+1)*step)
+	}
+	return start, end
+}
+
+// ListInventory requests the signed piece listing of the range from the node
+// and verifies it.
+func ListInventory(ctx context.Context, dialer rpc.Dialer, satellite storj.NodeID, node storj.NodeURL, start, end storj.PieceID) (_ *Inventory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := dialer.DialNodeURL(ctx, node)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	peer, err := conn.PeerIdentity()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	signee := signing.SigneeFromPeerIdentity(peer)
+
+	stream, err := inventorypb.NewDRPCPieceInventoryClient(conn).List(ctx, &inventorypb.ListRequest{
+		RangeStart: start,
+		RangeEnd:   end,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, stream.Close()) }()
+
+	inventory := &Inventory{
+		NodeID: node.ID,
+		Start:  start,
+		End:    end,
+	}
+	for sequence := int32(0); ; sequence++ {
+		response, err := stream.Recv()
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		chunk, err := inventorypb.VerifyChunk(ctx, signee, response)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		switch {
+		case chunk.SatelliteId != satellite:
+			return nil, Error.New("chunk was created for satellite %s", chunk.SatelliteId)
+		case chunk.RangeStart != start || chunk.RangeEnd != end:
+			return nil, Error.New("chunk range does not match the requested range")
+		case chunk.Sequence != sequence:
+			return nil, Error.New("expected chunk %d but got %d", sequence, chunk.Sequence)
+		case sequence > 0 && !chunk.ListedAt.Equal(inventory.ListedAt):
+			return nil, Error.New("chunks were listed at different times")
+		}
+		inventory.ListedAt = chunk.ListedAt
+
+		for _, pieceID := range chunk.PieceIds {
+			if !inventorypb.InRange(pieceID, start, end) {
+				return nil, Error.New("piece %s is not in the requested range", pieceID)
+			}
+			if n := len(inventory.PieceIDs); n > 0 && bytes.Compare(inventory.PieceIDs[n-1][:], pieceID[:]) >= 0 {
+				return nil, Error.New("piece IDs are not sorted")
+			}
+			inventory.PieceIDs = append(inventory.PieceIDs, pieceID)
+		}
+
+		if chunk.Last {
+			return inventory, nil
+		}
+	}
+}
+
+// InventoryObserver audits nodes by comparing a signed listing of the pieces
+// they hold in a piece ID range with the pieces the segments expect them to
+// hold. Missing pieces are reported as failed audits, which removes them from
+// their segments so that the segments are repaired when needed.
+//
+// architecture: Observer
+type InventoryObserver struct {
+	log       *zap.Logger
+	satellite storj.NodeID
+	dialer    rpc.Dialer
+	overlay   *overlay.Service
+	metabase  *metabase.DB
+	reporter  Reporter
+	config    InventoryConfig
+	rand      *rand.Rand
+
+	// The following fields are reset on each segment loop cycle.
+	startTime   time.Time
+	ranges      map[storj.NodeID]pieceRange
+	reputations map[storj.NodeID]overlay.ReputationStatus
+	expected    map[storj.NodeID][]expectedPiece
+	listing     sync.WaitGroup
+	mu          sync.Mutex
+	inventories map[storj.NodeID]*Inventory
+}
+
+var _ rangedloop.Observer = (*InventoryObserver)(nil)
+var _ rangedloop.Partial = (*inventoryFork)(nil)
+
+type pieceRange struct {
+	start, end storj.PieceID
+}
+
+type expectedPiece struct {
+	pieceID  storj.PieceID
+	streamID uuid.UUID
+	position metabase.SegmentPosition
+	piece    metabase.Piece
+}
+
+// NewInventoryObserver instantiates InventoryObserver.
+func NewInventoryObserver(log *zap.Logger, satellite storj.NodeID, dialer rpc.Dialer, overlay *overlay.Service, metabase *metabase.DB, reporter Reporter, config InventoryConfig) *InventoryObserver {
+	if config.Ranges < 1 {
+		config.Ranges = 1
+	}
+	if config.Concurrency < 1 {
+		config.Concurrency = 1
+	}
+	return &InventoryObserver{
+		log:       log,
+		satellite: satellite,
+		dialer:    dialer,
+		overlay:   overlay,
+		metabase:  metabase,
+		reporter:  reporter,
+		config:    config,
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Start selects the nodes to audit and starts listing their inventories in
+// the background.
+func (obs *InventoryObserver) Start(ctx context.Context, startTime time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// wait for listings of a previous run which did not finish.
+	obs.listing.Wait()
+
+	obs.startTime = startTime
+	obs.ranges = make(map[storj.NodeID]pieceRange)
+	obs.reputations = make(map[storj.NodeID]overlay.ReputationStatus)
+	obs.expected = make(map[storj.NodeID][]expectedPiece)
+	obs.inventories = make(map[storj.NodeID]*Inventory)
+
+	participating, err := obs.overlay.GetParticipatingNodes(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	var candidates []storj.NodeID
+	for _, node := range participating {
+		if node.Online && !node.Suspended {
+			candidates = append(candidates, node.ID)
+		}
+	}
+	obs.rand.Shuffle(len(candidates), func(i, k int) {
+		candidates[i], candidates[k] = candidates[k], candidates[i]
+	})
+	if len(candidates) > obs.config.Nodes {
+		candidates = candidates[:obs.config.Nodes]
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	nodes, err := obs.overlay.GetOnlineNodesForAuditRepair(ctx, candidates)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	targets := make(map[storj.NodeID]storj.NodeURL)
+	for id, node := range nodes {
+		if node.Address == nil {
+			continue
+		}
+		start, end := InventoryRange(obs.rand.Intn(obs.config.Ranges), obs.config.Ranges)
+		obs.ranges[id] = pieceRange{start: start, end: end}
+		obs.reputations[id] = node.Reputation
+		targets[id] = storj.NodeURL{ID: id, Address: node.Address.Address}
+	}
+
+	// the inventories are listed while the segments are processed.
+	obs.listing.Add(1)
+	go func() {
+		defer obs.listing.Done()
+
+		limiter := sync2.NewLimiter(obs.config.Concurrency)
+		defer limiter.Wait()
+
+		for id, nodeURL := range targets {
+			pieceRange := obs.ranges[id]
+			limiter.Go(ctx, func() {
+				obs.list(ctx, nodeURL, pieceRange.start, pieceRange.end)
+			})
+		}
+	}()
+	return nil
+}
+
+// list lists the inventory of the node and stores it.
+func (obs *InventoryObserver) list(ctx context.Context, node storj.NodeURL, start, end storj.PieceID) {
+	ctx, cancel := context.WithTimeout(ctx, obs.config.Timeout)
+	defer cancel()
+
+	inventory, err := ListInventory(ctx, obs.dialer, obs.satellite, node, start, end)
+	if err != nil {
+		obs.log.Warn("failed to list piece inventory", zap.Stringer("Node ID", node.ID), zap.Error(err))
+		return
+	}
+
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	obs.inventories[node.ID] = inventory
+}
+
+// Fork creates a partial which collects the pieces expected in the listed ranges.
+func (obs *InventoryObserver) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return &inventoryFork{
+		startTime: obs.startTime,
+		ranges:    obs.ranges,
+		expected:  make(map[storj.NodeID][]expectedPiece),
+	}, nil
+}
+
+// Join merges the expected pieces of the partial.
+func (obs *InventoryObserver) Join(ctx context.Context, partial rangedloop.Partial) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork, ok := partial.(*inventoryFork)
+	if !ok {
+		return Error.New("expected partial type %T but got %T", fork, partial)
+	}
+	for id, pieces := range fork.expected {
+		obs.expected[id] = append(obs.expected[id], pieces...)
+	}
+	return nil
+}
+
+// Finish waits for the inventories and reports the missing pieces.
+func (obs *InventoryObserver) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	obs.listing.Wait()
+
+	type segmentKey struct {
+		streamID uuid.UUID
+		position metabase.SegmentPosition
+	}
+	missing := make(map[segmentKey]metabase.Pieces)
+
+	for id, inventory := range obs.inventories {
+		var missingCount int
+		for _, expected := range obs.expected[id] {
+			if inventory.Contains(expected.pieceID) {
+				continue
+			}
+			key := segmentKey{streamID: expected.streamID, position: expected.position}
+			missing[key] = append(missing[key], expected.piece)
+			missingCount++
+		}
+
+		mon.IntVal("inventory_audit_expected_pieces").Observe(int64(len(obs.expected[id])))
+		mon.IntVal("inventory_audit_missing_pieces").Observe(int64(missingCount))
+		obs.log.Info("piece inventory audited",
+			zap.Stringer("Node ID", id),
+			zap.Int("Expected Pieces", len(obs.expected[id])),
+			zap.Int("Listed Pieces", len(inventory.PieceIDs)),
+			zap.Int("Missing Pieces", missingCount))
+	}
+
+	for key, pieces := range missing {
+		segment, err := obs.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+			StreamID: key.streamID,
+			Position: key.position,
+		})
+		if err != nil {
+			if !metabase.ErrSegmentNotFound.Has(err) {
+				obs.log.Error("failed to get segment with missing pieces",
+					zap.Stringer("Stream ID", key.streamID),
+					zap.Uint64("Position", key.position.Encode()),
+					zap.Error(err))
+			}
+			continue
+		}
+		if segment.RepairedAt != nil && !segment.RepairedAt.Before(obs.startTime) {
+			continue
+		}
+
+		// the segment may have changed since it was processed.
+		var fails metabase.Pieces
+		for _, piece := range pieces {
+			current, ok := segment.Pieces.FindByNum(int(piece.Number))
+			if ok && current.StorageNode == piece.StorageNode {
+				fails = append(fails, piece)
+			}
+		}
+		if len(fails) == 0 {
+			continue
+		}
+
+		obs.reporter.RecordAudits(ctx, Report{
+			Segment:         &segment,
+			Fails:           fails,
+			NodesReputation: obs.reputations,
+		})
+	}
+	return nil
+}
+
+type inventoryFork struct {
+	startTime time.Time
+	ranges    map[storj.NodeID]pieceRange
+	expected  map[storj.NodeID][]expectedPiece
+}
+
+// Process collects the pieces which are expected on the audited nodes.
+func (fork *inventoryFork) Process(ctx context.Context, segments []rangedloop.Segment) error {
+	if len(fork.ranges) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	for i := range segments {
+		segment := &segments[i]
+		if segment.Inline() || segment.Expired(now) {
+			continue
+		}
+		// only segments created before the inventories were listed are expected
+		// to be complete on the nodes.
+		if !segment.CreatedAt.Before(fork.startTime) {
+			continue
+		}
+		if segment.RepairedAt != nil && !segment.RepairedAt.Before(fork.startTime) {
+			continue
+		}
+
+		for _, piece := range segment.Pieces {
+			pieceRange, ok := fork.ranges[piece.StorageNode]
+			if !ok {
+				continue
+			}
+			pieceID := segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+			if !inventorypb.InRange(pieceID, pieceRange.start, pieceRange.end) {
+				continue
+			}
+			fork.expected[piece.StorageNode] = append(fork.expected[piece.StorageNode], expectedPiece{
+				pieceID:  pieceID,
+				streamID: segment.StreamID,
+				position: segment.Position,
+				piece:    piece,
+			})
+		}
+	}
+	return nil
+}
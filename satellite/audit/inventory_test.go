@@ -0,0 +1,114 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestListInventory(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		for i := 0; i < 5; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "testbucket", "object"+string(rune('a'+i)), testrand.Bytes(10*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+
+		node := planet.StorageNodes[0]
+		var expected []storj.PieceID
+		for _, segment := range segments {
+			for _, piece := range segment.Pieces {
+				if piece.StorageNode == node.ID() {
+					expected = append(expected, segment.RootPieceID.Derive(node.ID(), int32(piece.Number)))
+				}
+			}
+		}
+		require.NotEmpty(t, expected)
+
+		inventory, err := audit.ListInventory(ctx, sat.Dialer, sat.ID(), node.NodeURL(), storj.PieceID{}, storj.PieceID{})
+		require.NoError(t, err)
+		require.Equal(t, node.ID(), inventory.NodeID)
+		require.ElementsMatch(t, expected, inventory.PieceIDs)
+		for _, pieceID := range expected {
+			require.True(t, inventory.Contains(pieceID))
+		}
+
+		// only pieces of the requested range are listed.
+		start, end := audit.InventoryRange(1, 2)
+		inventory, err = audit.ListInventory(ctx, sat.Dialer, sat.ID(), node.NodeURL(), start, end)
+		require.NoError(t, err)
+		for _, pieceID := range inventory.PieceIDs {
+			require.GreaterOrEqual(t, pieceID[0], byte(0x80))
+		}
+	})
+}
+
+func TestInventoryAudit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.AuditInventory = audit.InventoryConfig{
+					Enabled:     true,
+					Nodes:       4,
+					Ranges:      1,
+					Concurrency: 4,
+					Timeout:     time.Minute,
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		err := planet.Uplinks[0].Upload(ctx, sat, "testbucket", "object", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		segment := segments[0]
+
+		missing := segment.Pieces[0]
+		node := planet.FindNode(missing.StorageNode)
+		node.Storage2.PieceBackend.TestingDeletePiece(sat.ID(), segment.RootPieceID.Derive(node.ID(), int32(missing.Number)))
+
+		_, err = sat.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+
+		// the missing piece is removed from the segment, so it can be repaired.
+		updated, err := sat.Metabase.DB.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+			StreamID: segment.StreamID,
+			Position: segment.Position,
+		})
+		require.NoError(t, err)
+		require.Len(t, updated.Pieces, len(segment.Pieces)-1)
+		_, found := updated.Pieces.FindByNum(int(missing.Number))
+		require.False(t, found)
+
+		// and the node is penalized for it.
+		info, err := sat.DB.Reputation().Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.EqualValues(t, 1, info.TotalAuditCount)
+		require.Zero(t, info.AuditSuccessCount)
+	})
+}
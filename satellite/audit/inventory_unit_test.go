@@ -0,0 +1,90 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/inventorypb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+)
+
+func TestInventoryRange(t *testing.T) {
+	start, end := InventoryRange(0, 1)
+	require.True(t, start.IsZero())
+	require.True(t, end.IsZero())
+
+	const n = 3
+	var previousEnd storj.PieceID
+	for i := 0; i < n; i++ {
+		start, end := InventoryRange(i, n)
+		require.Equal(t, previousEnd, start)
+		require.Equal(t, i == n-1, end.IsZero())
+		previousEnd = end
+	}
+
+	// every piece ID is in exactly one range.
+	for k := 0; k < 100; k++ {
+		pieceID := testrand.PieceID()
+		count := 0
+		for i := 0; i < n; i++ {
+			start, end := InventoryRange(i, n)
+			if inventorypb.InRange(pieceID, start, end) {
+				count++
+			}
+		}
+		require.Equal(t, 1, count)
+	}
+}
+
+func TestInventoryFork(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	startTime := time.Now()
+	audited, other := testrand.NodeID(), testrand.NodeID()
+
+	fork := &inventoryFork{
+		startTime: startTime,
+		ranges: map[storj.NodeID]pieceRange{
+			audited: {},
+		},
+		expected: make(map[storj.NodeID][]expectedPiece),
+	}
+
+	newSegment := func(createdAt time.Time) rangedloop.Segment {
+		return rangedloop.Segment{
+			StreamID:    testrand.UUID(),
+			CreatedAt:   createdAt,
+			RootPieceID: testrand.PieceID(),
+			Redundancy:  storj.RedundancyScheme{RequiredShares: 1, TotalShares: 2},
+			Pieces: metabase.Pieces{
+				{Number: 0, StorageNode: audited},
+				{Number: 1, StorageNode: other},
+			},
+		}
+	}
+
+	old := newSegment(startTime.Add(-time.Hour))
+	recent := newSegment(startTime.Add(time.Minute))
+	require.NoError(t, fork.Process(ctx, []rangedloop.Segment{old, recent}))
+
+	// only the piece of the audited node in the segment created before the
+	// start is expected.
+	require.Len(t, fork.expected, 1)
+	require.Len(t, fork.expected[audited], 1)
+	expected := fork.expected[audited][0]
+	require.Equal(t, old.StreamID, expected.streamID)
+	require.Equal(t, old.RootPieceID.Derive(audited, 0), expected.pieceID)
+
+	inventory := &Inventory{PieceIDs: []storj.PieceID{expected.pieceID}}
+	require.True(t, inventory.Contains(expected.pieceID))
+	require.False(t, inventory.Contains(recent.RootPieceID.Derive(audited, 0)))
+}
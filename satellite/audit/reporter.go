@@ -6,6 +6,7 @@ package audit
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -25,6 +26,7 @@ type reporter struct {
 	overlay          *overlay.Service
 	metabase         *metabase.DB
 	containment      Containment
+	history          HistoryDB
 	maxRetries       int
 	maxReverifyCount int32
 }
@@ -50,16 +52,22 @@ type Report struct {
 	PendingAudits   []*ReverificationJob
 	Unknown         storj.NodeIDList
 	NodesReputation map[storj.NodeID]overlay.ReputationStatus
+
+	// Reverification is set when the report is the result of reverifying a
+	// single piece.
+	Reverification *ReverificationJob
 }
 
-// NewReporter instantiates a reporter.
-func NewReporter(log *zap.Logger, reputations *reputation.Service, overlay *overlay.Service, metabase *metabase.DB, containment Containment, maxRetries int, maxReverifyCount int32) Reporter {
+// NewReporter instantiates a reporter. The audit outcomes are kept in history,
+// unless it is nil.
+func NewReporter(log *zap.Logger, reputations *reputation.Service, overlay *overlay.Service, metabase *metabase.DB, containment Containment, history HistoryDB, maxRetries int, maxReverifyCount int32) Reporter {
 	return &reporter{
 		log:              log,
 		reputations:      reputations,
 		overlay:          overlay,
 		metabase:         metabase,
 		containment:      containment,
+		history:          history,
 		maxRetries:       maxRetries,
 		maxReverifyCount: maxReverifyCount,
 	}
@@ -91,6 +99,8 @@ func (reporter *reporter) RecordAudits(ctx context.Context, req Report) {
 
 	nodesReputation := req.NodesReputation
 
+	reporter.recordHistory(ctx, req)
+
 	reportFailures := func(tries int, resultType string, err error, nodes storj.NodeIDList, pending []*ReverificationJob) {
 		if err == nil || tries < reporter.maxRetries {
 			// don't need to report anything until the last time through
@@ -122,6 +132,21 @@ func (reporter *reporter) RecordAudits(ctx context.Context, req Report) {
 	}
 }
 
+// recordHistory adds the audit outcomes of the report to the audit history.
+// Failing to do so does not affect the reputation changes.
+func (reporter *reporter) recordHistory(ctx context.Context, req Report) {
+	if reporter.history == nil {
+		return
+	}
+	entries := historyEntries(req, int(reporter.maxReverifyCount), time.Now())
+	if len(entries) == 0 {
+		return
+	}
+	if err := reporter.history.Insert(ctx, entries); err != nil {
+		reporter.log.Warn("failed to record audit history", zap.Int("entries", len(entries)), zap.Error(err))
+	}
+}
+
 func (reporter *reporter) recordAuditStatus(ctx context.Context, nodeIDs storj.NodeIDList, nodesReputation map[storj.NodeID]overlay.ReputationStatus, auditOutcome reputation.AuditType) (failed storj.NodeIDList, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -258,6 +283,7 @@ func (reporter *reporter) RecordReverificationResult(ctx context.Context, pendin
 		NodesReputation: map[storj.NodeID]overlay.ReputationStatus{
 			pendingJob.Locator.NodeID: reputation,
 		},
+		Reverification: pendingJob,
 	}
 	switch outcome {
 	case OutcomeNotPerformed:
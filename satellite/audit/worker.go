@@ -35,6 +35,9 @@ type Config struct {
 	ReverificationRetryInterval time.Duration `help:"how long a single reverification job can take before it may be taken over by another worker" releaseDefault:"6h" devDefault:"10m"`
 
 	ContainmentSyncChoreInterval time.Duration `help:"how often to run the containment-sync chore" releaseDefault:"2h" devDefault:"2m" testDefault:"$TESTINTERVAL"`
+
+	HistoryRetention       time.Duration `help:"how long audit outcomes are kept in the audit history of nodes, 0 disables the audit history" default:"720h"`
+	HistoryCleanupInterval time.Duration `help:"how often to delete audit outcomes past the retention from the audit history" releaseDefault:"24h" devDefault:"1h" testDefault:"$TESTINTERVAL"`
 }
 
 // Worker contains information for populating audit queue and processing audits.
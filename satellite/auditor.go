@@ -83,6 +83,7 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 	nodeEvents nodeevents.DB,
 	reputationdb reputation.DB,
 	containmentDB audit.Containment,
+	auditHistoryDB audit.HistoryDB,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel,
 ) (*Auditor, error) {
 	peer := &Auditor{
@@ -226,12 +227,18 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 			reverifyQueue,
 			config.Audit)
 
+		var auditHistory audit.HistoryDB
+		if config.Audit.HistoryRetention > 0 {
+			auditHistory = auditHistoryDB
+		}
+
 		peer.Audit.Reporter = audit.NewReporter(
 			log.Named("reporter"),
 			peer.Reputation,
 			peer.Overlay,
 			metabaseDB,
 			containmentDB,
+			auditHistory,
 			config.Audit.MaxRetriesStatDB,
 			int32(config.Audit.MaxReverifyCount))
 
@@ -181,4 +181,18 @@ type DB interface {
 	IterateBucketLocations(ctx context.Context, pageSize int, fn func([]metabase.BucketLocation) error) (err error)
 	// GetBucketObjectLockEnabled returns whether a bucket has Object Lock enabled.
 	GetBucketObjectLockEnabled(ctx context.Context, bucketName []byte, projectID uuid.UUID) (enabled bool, err error)
+	// StartBucketMove records a move of the bucket into a different project and creates the bucket in that project.
+	StartBucketMove(ctx context.Context, bucket metabase.BucketLocation, destination uuid.UUID) (err error)
+	// FinishBucketMove moves the attribution and usage rollups of a bucket being moved and removes it from the source project.
+	FinishBucketMove(ctx context.Context, bucket metabase.BucketLocation, destination uuid.UUID) (_ Bucket, err error)
+	// IsBucketMoving returns whether a bucket is being moved out of or into the project.
+	IsBucketMoving(ctx context.Context, bucketName []byte, projectID uuid.UUID) (moving bool, err error)
+	// ChangeBucketPlacement changes the placement of a bucket and starts migrating its data into the new placement.
+	ChangeBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID, placement storj.PlacementConstraint) (_ Bucket, err error)
+	// GetPlacementMigration returns the placement migration of a bucket.
+	GetPlacementMigration(ctx context.Context, bucketName []byte, projectID uuid.UUID) (migration PlacementMigration, err error)
+	// ListPlacementMigrations returns all placement migrations which are not completed.
+	ListPlacementMigrations(ctx context.Context) (migrations []PlacementMigration, err error)
+	// UpdatePlacementMigrations updates the progress of placement migrations.
+	UpdatePlacementMigrations(ctx context.Context, migrations []PlacementMigration) (err error)
 }
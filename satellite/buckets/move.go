@@ -0,0 +1,68 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrMoveInvalid is returned when a bucket move request is invalid.
+var ErrMoveInvalid = errs.Class("invalid bucket move")
+
+// MoveBucket moves a bucket into a different project, without copying any
+// data. The bucket keeps its name, because the object keys are encrypted with
+// a key derived from it.
+//
+// The move is started by creating the bucket in the destination project and
+// recording the move in the satellite database. Afterwards objects are moved
+// in metabase batch by batch. Finally the bucket metadata, attribution and
+// usage rollups are moved and the bucket is removed from the source project.
+//
+// Every step is idempotent, so when the move fails part way through, calling
+// MoveBucket again with the same arguments resumes it.
+//
+// Uploads, copies and moves of objects into or out of the bucket are rejected
+// while it's being moved.
+func (buckets *Service) MoveBucket(ctx context.Context, bucket metabase.BucketLocation, destination uuid.UUID) (_ Bucket, err error) {
+	if bucket.ProjectID.IsZero() || destination.IsZero() {
+		return Bucket{}, ErrMoveInvalid.New("project id is missing")
+	}
+	if bucket.ProjectID == destination {
+		return Bucket{}, ErrMoveInvalid.New("source and destination project are the same")
+	}
+
+	err = buckets.DB.StartBucketMove(ctx, bucket, destination)
+	if err != nil {
+		return Bucket{}, err
+	}
+
+	// Uploads, which checked the bucket just before the move was recorded, may
+	// still commit objects into the source bucket while objects are moved, so
+	// objects are moved until a final pass finds none.
+	for {
+		moved, err := buckets.metabase.MoveBucket(ctx, metabase.MoveBucket{
+			From: bucket,
+			To: metabase.BucketLocation{
+				ProjectID:  destination,
+				BucketName: bucket.BucketName,
+			},
+		})
+		if err != nil {
+			if metabase.ErrObjectAlreadyExists.Has(err) {
+				return Bucket{}, err
+			}
+			return Bucket{}, ErrBucket.Wrap(err)
+		}
+		if moved == 0 {
+			break
+		}
+	}
+
+	return buckets.DB.FinishBucketMove(ctx, bucket, destination)
+}
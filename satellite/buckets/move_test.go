@@ -0,0 +1,99 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestMoveBucket(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.API.Buckets.Service
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+
+		require.NoError(t, uplink.CreateBucket(ctx, satellite, TestBucket))
+		require.NoError(t, uplink.Upload(ctx, satellite, TestBucket, "object", testrand.Bytes(memory.KiB)))
+
+		bucket := metabase.BucketLocation{ProjectID: projectID, BucketName: TestBucket}
+
+		_, err := service.MoveBucket(ctx, bucket, projectID)
+		require.True(t, buckets.ErrMoveInvalid.Has(err))
+
+		project, err := satellite.AddProject(ctx, uplink.Projects[0].Owner.ID, "destination")
+		require.NoError(t, err)
+
+		_, err = service.MoveBucket(ctx, metabase.BucketLocation{ProjectID: projectID, BucketName: "missing"}, project.ID)
+		require.True(t, buckets.ErrBucketNotFound.Has(err))
+
+		other, err := satellite.AddProject(ctx, uplink.Projects[0].Owner.ID, "other")
+		require.NoError(t, err)
+		_, err = service.CreateBucket(ctx, buckets.Bucket{ID: testrand.UUID(), Name: TestBucket, ProjectID: other.ID})
+		require.NoError(t, err)
+		_, err = service.MoveBucket(ctx, bucket, other.ID)
+		require.True(t, buckets.ErrBucketAlreadyExists.Has(err))
+
+		// simulate a move which was interrupted after it was started.
+		require.NoError(t, service.StartBucketMove(ctx, bucket, project.ID))
+		require.NoError(t, service.StartBucketMove(ctx, bucket, project.ID))
+
+		moving, err := service.IsBucketMoving(ctx, []byte(TestBucket), projectID)
+		require.NoError(t, err)
+		require.True(t, moving)
+		moving, err = service.IsBucketMoving(ctx, []byte(TestBucket), project.ID)
+		require.NoError(t, err)
+		require.True(t, moving)
+		moving, err = service.IsBucketMoving(ctx, []byte(TestBucket), other.ID)
+		require.NoError(t, err)
+		require.False(t, moving)
+
+		// objects can't be written while the bucket is moved.
+		err = uplink.Upload(ctx, satellite, TestBucket, "new-object", testrand.Bytes(memory.KiB))
+		require.ErrorContains(t, err, "bucket is being moved")
+
+		_, err = service.MoveBucket(ctx, bucket, other.ID)
+		require.True(t, buckets.ErrConflict.Has(err))
+
+		moved, err := service.MoveBucket(ctx, bucket, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, project.ID, moved.ProjectID)
+		require.Equal(t, TestBucket, moved.Name)
+
+		_, err = service.GetBucket(ctx, []byte(TestBucket), projectID)
+		require.True(t, buckets.ErrBucketNotFound.Has(err))
+
+		_, err = service.GetBucket(ctx, []byte(TestBucket), project.ID)
+		require.NoError(t, err)
+
+		moving, err = service.IsBucketMoving(ctx, []byte(TestBucket), project.ID)
+		require.NoError(t, err)
+		require.False(t, moving)
+
+		// finishing a finished move is a no-op.
+		_, err = service.FinishBucketMove(ctx, bucket, project.ID)
+		require.NoError(t, err)
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, metabase.BucketLocation{ProjectID: project.ID, BucketName: TestBucket}, objects[0].Location().Bucket())
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Equal(t, objects[0].StreamID, segments[0].StreamID)
+	})
+}
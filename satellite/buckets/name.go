@@ -0,0 +1,70 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/zeebo/errs"
+)
+
+var ipRegexp = regexp.MustCompile(`^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$`)
+
+// ValidateName checks whether the bucket name is valid.
+func ValidateName(bucket []byte) error {
+	if len(bucket) == 0 {
+		return ErrNoBucket.New("")
+	}
+
+	if len(bucket) < 3 || len(bucket) > 63 {
+		return errs.New("bucket name must be at least 3 and no more than 63 characters long")
+	}
+
+	// Regexp not used because benchmark shows it will be slower for valid bucket names
+	// https://gist.github.com/mniewrzal/49de3af95f36e63e88fac24f565e444c
+	labels := bytes.Split(bucket, []byte("."))
+	for _, label := range labels {
+		err := validateLabel(label)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ipRegexp.MatchString(string(bucket)) {
+		return errs.New("bucket name cannot be formatted as an IP address")
+	}
+
+	return nil
+}
+
+func validateLabel(label []byte) error {
+	if len(label) == 0 {
+		return errs.New("bucket label cannot be empty")
+	}
+
+	if !isLowerLetter(label[0]) && !isDigit(label[0]) {
+		return errs.New("bucket label must start with a lowercase letter or number")
+	}
+
+	if !isLowerLetter(label[len(label)-1]) && !isDigit(label[len(label)-1]) {
+		return errs.New("bucket label must end with a lowercase letter or number")
+	}
+
+	for i := 1; i < len(label)-1; i++ {
+		if !isLowerLetter(label[i]) && !isDigit(label[i]) && (label[i] != '-') && (label[i] != '.') {
+			return errs.New("bucket name must contain only lowercase letters, numbers or hyphens")
+		}
+	}
+
+	return nil
+}
+
+func isLowerLetter(r byte) bool {
+	return r >= 'a' && r <= 'z'
+}
+
+func isDigit(r byte) bool {
+	return r >= '0' && r <= '9'
+}
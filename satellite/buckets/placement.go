@@ -0,0 +1,72 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// ErrPlacementInvalid is returned when a placement change request is invalid.
+	ErrPlacementInvalid = errs.Class("invalid placement change")
+
+	// ErrPlacementMigrationNotFound is returned when a bucket has no placement migration.
+	ErrPlacementMigrationNotFound = errs.Class("placement migration not found")
+)
+
+// PlacementMigration tracks moving the pieces of a bucket into the placement
+// which was set after the bucket was created.
+type PlacementMigration struct {
+	ProjectID  uuid.UUID                 `json:"projectId"`
+	BucketName metabase.BucketName       `json:"bucketName"`
+	Placement  storj.PlacementConstraint `json:"placement"`
+	CreatedAt  time.Time                 `json:"createdAt"`
+
+	// SegmentsTotal is the number of segments seen by the last check.
+	SegmentsTotal int64 `json:"segmentsTotal"`
+	// SegmentsMigrated is the number of segments which had all pieces in the
+	// placement during the last check.
+	SegmentsMigrated int64 `json:"segmentsMigrated"`
+
+	CheckedAt   *time.Time `json:"checkedAt"`
+	CompletedAt *time.Time `json:"completedAt"`
+}
+
+// Location returns the location of the migrated bucket.
+func (migration PlacementMigration) Location() metabase.BucketLocation {
+	return metabase.BucketLocation{
+		ProjectID:  migration.ProjectID,
+		BucketName: migration.BucketName,
+	}
+}
+
+// Completed returns whether all segments of the bucket are in the placement.
+func (migration PlacementMigration) Completed() bool {
+	return migration.CompletedAt != nil
+}
+
+// ChangePlacement changes the placement of a bucket, which may contain data.
+//
+// New uploads use the new placement immediately. The existing segments are
+// moved into the new placement in the background: the placement migration
+// ranged loop observer updates their placement and queues them for repair,
+// which moves the pieces onto nodes of the new placement.
+func (buckets *Service) ChangePlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID, placement storj.PlacementConstraint) (_ Bucket, err error) {
+	current, err := buckets.GetBucket(ctx, bucketName, projectID)
+	if err != nil {
+		return Bucket{}, err
+	}
+	if current.Placement == placement {
+		return Bucket{}, ErrPlacementInvalid.New("bucket already has placement %d", placement)
+	}
+
+	return buckets.DB.ChangeBucketPlacement(ctx, bucketName, projectID, placement)
+}
@@ -0,0 +1,277 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrRestoreInvalid is returned when a point-in-time restore request is invalid.
+var ErrRestoreInvalid = errs.Class("invalid restore")
+
+// restoreBatchSize is the number of object keys which changes are planned
+// and applied at once.
+const restoreBatchSize = 100
+
+// RestoreToTime contains arguments for restoring a bucket to a point in time.
+type RestoreToTime struct {
+	ProjectID  uuid.UUID
+	BucketName metabase.BucketName
+	// Prefix limits the restore to objects with the encrypted object key prefix.
+	Prefix metabase.ObjectKey
+	// Time is the point in time the bucket is restored to.
+	Time time.Time
+	// DryRun only computes the changes without applying them.
+	DryRun bool
+
+	// Cursor is the last processed encrypted object key, as returned in
+	// RestoreResult. The restore continues after it.
+	Cursor metabase.ObjectKey
+	// Limit is the maximum number of object keys processed. Zero means
+	// processing all keys.
+	Limit int
+}
+
+// RestoreResult contains the outcome of a point-in-time restore.
+type RestoreResult struct {
+	// Restored is the number of keys for which the version current at the
+	// restore time was copied as the new latest version.
+	Restored int
+	// DeleteMarkers is the number of keys for which a delete marker was
+	// inserted, because the key did not exist at the restore time.
+	DeleteMarkers int
+	// Unchanged is the number of keys which were already in the state they
+	// had at the restore time.
+	Unchanged int
+
+	// Cursor is the last processed encrypted object key. It's returned also
+	// with an error, so the restore can be resumed by passing it in
+	// RestoreToTime.
+	Cursor metabase.ObjectKey
+	// More is set when the limit was reached before processing all keys.
+	More bool
+}
+
+// restoreAction is a change needed to restore a single object key.
+type restoreAction struct {
+	key metabase.ObjectKey
+	// unchanged is set when the key is already in the restored state.
+	unchanged bool
+	// version is the version to copy; zero means inserting a delete marker.
+	version metabase.Version
+}
+
+// RestoreToTime restores objects of a versioned bucket to their state at the
+// specified time.
+//
+// All versions are kept: for every key which changed after the restore time
+// a new latest version is created, either by copying the version which was
+// current at the restore time or, when the key did not exist at that time, by
+// inserting a delete marker.
+//
+// Keys are processed in batches ordered by the encrypted object key. A restore
+// which failed or reached the limit can be resumed with the returned cursor;
+// restoring the keys before the cursor again would create another copy of the
+// restored versions.
+func (buckets *Service) RestoreToTime(ctx context.Context, opts RestoreToTime) (result RestoreResult, err error) {
+	if opts.Time.IsZero() {
+		return RestoreResult{}, ErrRestoreInvalid.New("restore time is missing")
+	}
+	if opts.Time.After(time.Now()) {
+		return RestoreResult{}, ErrRestoreInvalid.New("restore time is in the future")
+	}
+	if opts.Limit < 0 {
+		return RestoreResult{}, ErrRestoreInvalid.New("limit is negative")
+	}
+
+	bucket, err := buckets.GetBucket(ctx, []byte(opts.BucketName), opts.ProjectID)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+	if bucket.Versioning != VersioningEnabled {
+		return RestoreResult{}, ErrRestoreInvalid.New("bucket versioning must be enabled")
+	}
+
+	result.Cursor = opts.Cursor
+	processed := 0
+	for {
+		batchSize := restoreBatchSize
+		if opts.Limit > 0 && opts.Limit-processed < batchSize {
+			batchSize = opts.Limit - processed
+		}
+
+		actions, more, err := buckets.planRestore(ctx, opts, result.Cursor, batchSize)
+		if err != nil {
+			return result, ErrBucket.Wrap(err)
+		}
+
+		for _, action := range actions {
+			if !opts.DryRun {
+				if err := buckets.applyRestore(ctx, opts, action); err != nil {
+					return result, ErrBucket.Wrap(err)
+				}
+			}
+
+			switch {
+			case action.unchanged:
+				result.Unchanged++
+			case action.version == 0:
+				result.DeleteMarkers++
+			default:
+				result.Restored++
+			}
+			result.Cursor = action.key
+		}
+		processed += len(actions)
+
+		if !more {
+			return result, nil
+		}
+		if opts.Limit > 0 && processed >= opts.Limit {
+			result.More = true
+			return result, nil
+		}
+	}
+}
+
+// planRestore iterates versions of up to limit keys after the cursor and
+// computes the changes needed to restore them. more is set when there are
+// keys left after the returned ones.
+func (buckets *Service) planRestore(ctx context.Context, opts RestoreToTime, cursor metabase.ObjectKey, limit int) (actions []restoreAction, more bool, err error) {
+	var (
+		currentKey metabase.ObjectKey
+		latest     metabase.ObjectEntry
+		atTime     *metabase.ObjectEntry
+		started    bool
+	)
+
+	flush := func() {
+		if !started {
+			return
+		}
+		action := restoreAction{key: currentKey}
+		switch {
+		case atTime == nil || atTime.Status.IsDeleteMarker():
+			// the key did not exist at the restore time.
+			action.unchanged = latest.Status.IsDeleteMarker()
+		case atTime.Version == latest.Version:
+			action.unchanged = true
+		default:
+			action.version = atTime.Version
+		}
+		actions = append(actions, action)
+	}
+
+	err = buckets.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+		ProjectID:  opts.ProjectID,
+		BucketName: opts.BucketName,
+		Prefix:     opts.Prefix,
+		// versions are ordered from the newest, so version zero skips all
+		// versions of the cursor key.
+		Cursor:    metabase.IterateCursor{Key: cursor},
+		Recursive: true,
+		Pending:   false,
+	}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+		var entry metabase.ObjectEntry
+		for it.Next(ctx, &entry) {
+			key := opts.Prefix + entry.ObjectKey
+
+			if !started || key != currentKey {
+				flush()
+				if len(actions) >= limit {
+					more = true
+					return nil
+				}
+				started = true
+				currentKey = key
+				latest = entry
+				atTime = nil
+			}
+
+			if atTime == nil && !entry.CreatedAt.After(opts.Time) {
+				entry := entry
+				atTime = &entry
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if !more {
+		flush()
+	}
+
+	return actions, more, nil
+}
+
+// applyRestore applies the change needed to restore a single object key.
+func (buckets *Service) applyRestore(ctx context.Context, opts RestoreToTime, action restoreAction) (err error) {
+	if action.unchanged {
+		return nil
+	}
+
+	location := metabase.ObjectLocation{
+		ProjectID:  opts.ProjectID,
+		BucketName: opts.BucketName,
+		ObjectKey:  action.key,
+	}
+	if action.version == 0 {
+		_, err = buckets.metabase.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+			ObjectLocation: location,
+			Versioned:      true,
+		})
+		return err
+	}
+	return buckets.restoreVersion(ctx, location, action.version)
+}
+
+// restoreVersion copies the specified version as the new latest version of the same key.
+func (buckets *Service) restoreVersion(ctx context.Context, location metabase.ObjectLocation, version metabase.Version) (err error) {
+	source, err := buckets.metabase.BeginCopyObject(ctx, metabase.BeginCopyObject{
+		ObjectLocation: location,
+		Version:        version,
+	})
+	if err != nil {
+		return err
+	}
+
+	var metadataKeyNonce storj.Nonce
+	if len(source.EncryptedMetadataKeyNonce) > 0 {
+		metadataKeyNonce, err = storj.NonceFromBytes(source.EncryptedMetadataKeyNonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	newStreamID, err := uuid.New()
+	if err != nil {
+		return err
+	}
+
+	_, err = buckets.metabase.FinishCopyObject(ctx, metabase.FinishCopyObject{
+		ObjectStream: metabase.ObjectStream{
+			ProjectID:  location.ProjectID,
+			BucketName: location.BucketName,
+			ObjectKey:  location.ObjectKey,
+			Version:    version,
+			StreamID:   source.StreamID,
+		},
+		NewBucket:                    location.BucketName,
+		NewEncryptedObjectKey:        location.ObjectKey,
+		NewStreamID:                  newStreamID,
+		NewSegmentKeys:               source.EncryptedKeysNonces,
+		NewEncryptedMetadataKeyNonce: metadataKeyNonce,
+		NewEncryptedMetadataKey:      source.EncryptedMetadataKey,
+		NewVersioned:                 true,
+	})
+	return err
+}
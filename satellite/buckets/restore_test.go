@@ -0,0 +1,120 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestRestoreToTime(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.API.Buckets.Service
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+
+		require.NoError(t, uplink.CreateBucket(ctx, satellite, TestBucket))
+
+		restore := buckets.RestoreToTime{
+			ProjectID:  projectID,
+			BucketName: TestBucket,
+			Time:       time.Now(),
+		}
+
+		// bucket without versioning cannot be restored.
+		_, err := service.RestoreToTime(ctx, restore)
+		require.True(t, buckets.ErrRestoreInvalid.Has(err))
+
+		require.NoError(t, service.EnableBucketVersioning(ctx, []byte(TestBucket), projectID))
+
+		original := testrand.Bytes(100)
+		require.NoError(t, uplink.Upload(ctx, satellite, TestBucket, "overwritten", original))
+		require.NoError(t, uplink.Upload(ctx, satellite, TestBucket, "unchanged", testrand.Bytes(100)))
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		restore.Time = objects[0].CreatedAt
+		if objects[1].CreatedAt.After(restore.Time) {
+			restore.Time = objects[1].CreatedAt
+		}
+
+		time.Sleep(time.Millisecond)
+
+		require.NoError(t, uplink.Upload(ctx, satellite, TestBucket, "overwritten", testrand.Bytes(100)))
+		require.NoError(t, uplink.Upload(ctx, satellite, TestBucket, "created", testrand.Bytes(100)))
+
+		restore.DryRun = true
+		result, err := service.RestoreToTime(ctx, restore)
+		require.NoError(t, err)
+		require.Equal(t, 1, result.Restored)
+		require.Equal(t, 1, result.DeleteMarkers)
+		require.Equal(t, 1, result.Unchanged)
+		require.False(t, result.More)
+		require.NotEmpty(t, result.Cursor)
+
+		// a limited restore is resumed with the returned cursor.
+		limited := restore
+		limited.Limit = 1
+		var total buckets.RestoreResult
+		for requests := 1; ; requests++ {
+			require.LessOrEqual(t, requests, 4)
+
+			partial, err := service.RestoreToTime(ctx, limited)
+			require.NoError(t, err)
+			total.Restored += partial.Restored
+			total.DeleteMarkers += partial.DeleteMarkers
+			total.Unchanged += partial.Unchanged
+			if !partial.More {
+				require.Equal(t, result.Cursor, partial.Cursor)
+				break
+			}
+			require.Greater(t, string(partial.Cursor), string(limited.Cursor))
+			limited.Cursor = partial.Cursor
+		}
+		require.Equal(t, buckets.RestoreResult{Restored: 1, DeleteMarkers: 1, Unchanged: 1}, total)
+
+		objects, err = satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 4)
+
+		restore.DryRun = false
+		result, err = service.RestoreToTime(ctx, restore)
+		require.NoError(t, err)
+		require.Equal(t, 1, result.Restored)
+		require.Equal(t, 1, result.DeleteMarkers)
+		require.Equal(t, 1, result.Unchanged)
+
+		data, err := uplink.Download(ctx, satellite, TestBucket, "overwritten")
+		require.NoError(t, err)
+		require.Equal(t, original, data)
+
+		_, err = uplink.Download(ctx, satellite, TestBucket, "created")
+		require.Error(t, err)
+
+		// all versions are kept.
+		objects, err = satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 6)
+
+		var deleteMarkers int
+		for _, object := range objects {
+			if object.Status == metabase.DeleteMarkerVersioned {
+				deleteMarkers++
+			}
+		}
+		require.Equal(t, 1, deleteMarkers)
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 )
 
@@ -300,6 +301,61 @@ func (b *Buckets) GetSingleBucketTotals(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// MoveBucket moves a bucket into another project.
+func (b *Buckets) MoveBucket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var request struct {
+		ProjectID       uuid.UUID `json:"projectID"`
+		BucketName      string    `json:"bucketName"`
+		TargetProjectID uuid.UUID `json:"targetProjectID"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+	if request.ProjectID.IsZero() {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("Project ID was not provided."))
+		return
+	}
+	if request.BucketName == "" {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("Bucket name was not provided."))
+		return
+	}
+	if request.TargetProjectID.IsZero() {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("Target project ID was not provided."))
+		return
+	}
+
+	bucket, err := b.service.MoveBucket(ctx, request.ProjectID, request.BucketName, request.TargetProjectID)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			b.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+		case console.ErrForbidden.Has(err):
+			b.serveJSONError(ctx, w, http.StatusForbidden, err)
+		case buckets.ErrBucketNotFound.Has(err):
+			b.serveJSONError(ctx, w, http.StatusNotFound, err)
+		case console.ErrValidation.Has(err):
+			b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		case console.ErrConflict.Has(err):
+			b.serveJSONError(ctx, w, http.StatusConflict, err)
+		default:
+			b.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(bucket)
+	if err != nil {
+		b.log.Error("failed to write json move bucket response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (b *Buckets) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(ctx, b.log, w, status, err)
@@ -20,6 +20,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -50,6 +51,7 @@ import (
 	"storj.io/storj/satellite/emission"
 	"storj.io/storj/satellite/kms"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
@@ -216,7 +218,8 @@ type Service struct {
 	restKeys                   RESTKeys
 	projectAccounting          accounting.ProjectAccounting
 	projectUsage               *accounting.Service
-	buckets                    buckets.DB
+	buckets                    *buckets.Service
+	placementMu                sync.RWMutex
 	placements                 nodeselection.PlacementDefinitions
 	placementNameLookup        map[string]storj.PlacementConstraint
 	accounts                   payments.Accounts
@@ -268,7 +271,7 @@ type Payments struct {
 
 // NewService returns new instance of Service.
 func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting accounting.ProjectAccounting,
-	projectUsage *accounting.Service, buckets buckets.DB, accounts payments.Accounts, depositWallets payments.DepositWallets,
+	projectUsage *accounting.Service, buckets *buckets.Service, accounts payments.Accounts, depositWallets payments.DepositWallets,
 	billingDb billing.TransactionsDB, analytics *analytics.Service, tokens *consoleauth.Service, mailService *mailservice.Service,
 	accountFreezeService *AccountFreezeService, emission *emission.Service, kmsService *kms.Service, ssoService *sso.Service, satelliteAddress string,
 	satelliteName string, maxProjectBuckets int, ssoEnabled bool, placements nodeselection.PlacementDefinitions,
@@ -312,11 +315,6 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		}
 	}
 
-	placementNameLookup := make(map[string]storj.PlacementConstraint, len(placements))
-	for _, placement := range placements {
-		placementNameLookup[placement.Name] = placement.ID
-	}
-
 	return &Service{
 		log:                           log,
 		auditLogger:                   log.Named("auditlog"),
@@ -326,7 +324,7 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		projectUsage:                  projectUsage,
 		buckets:                       buckets,
 		placements:                    placements,
-		placementNameLookup:           placementNameLookup,
+		placementNameLookup:           placementNameLookupOf(placements),
 		accounts:                      accounts,
 		depositWallets:                depositWallets,
 		billing:                       billingDb,
@@ -4623,7 +4621,7 @@ func (s *Service) GetBucketTotals(ctx context.Context, projectID uuid.UUID, curs
 
 	for i := range usage.BucketUsages {
 		placementID := usage.BucketUsages[i].DefaultPlacement
-		usage.BucketUsages[i].Location = s.placements[placementID].Name
+		usage.BucketUsages[i].Location = s.placementName(placementID)
 	}
 
 	return usage, nil
@@ -4648,7 +4646,7 @@ func (s *Service) GetSingleBucketTotals(ctx context.Context, projectID uuid.UUID
 		return nil, Error.Wrap(err)
 	}
 
-	usage.Location = s.placements[usage.DefaultPlacement].Name
+	usage.Location = s.placementName(usage.DefaultPlacement)
 
 	return usage, nil
 }
@@ -4723,7 +4721,7 @@ func (s *Service) GetBucketMetadata(ctx context.Context, projectID uuid.UUID) (l
 			Versioning: bucket.Versioning,
 			Placement: Placement{
 				DefaultPlacement: bucket.Placement,
-				Location:         s.placements[bucket.Placement].Name,
+				Location:         s.placementName(bucket.Placement),
 			},
 			ObjectLockEnabled: bucket.ObjectLock.Enabled,
 		})
@@ -4732,6 +4730,60 @@ func (s *Service) GetBucketMetadata(ctx context.Context, projectID uuid.UUID) (l
 	return list, nil
 }
 
+// MoveBucket moves a bucket into another project without copying any data.
+// projectID and targetProjectID here may be Project.ID or Project.PublicID.
+// The user must be an Owner or Admin of both projects.
+func (s *Service) MoveBucket(ctx context.Context, projectID uuid.UUID, bucketName string, targetProjectID uuid.UUID) (_ *BucketMetadata, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "move bucket",
+		zap.String("projectID", projectID.String()), zap.String("bucket", bucketName),
+		zap.String("targetProjectID", targetProjectID.String()))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	source, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+	if source.membership.Role != RoleAdmin {
+		return nil, ErrForbidden.New("only project Owner or Admin can move buckets")
+	}
+
+	target, err := s.isProjectMember(ctx, user.ID, targetProjectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+	if target.membership.Role != RoleAdmin {
+		return nil, ErrForbidden.New("only project Owner or Admin can move buckets")
+	}
+
+	bucket, err := s.buckets.MoveBucket(ctx,
+		metabase.BucketLocation{ProjectID: source.project.ID, BucketName: metabase.BucketName(bucketName)},
+		target.project.ID,
+	)
+	if err != nil {
+		switch {
+		case buckets.ErrMoveInvalid.Has(err):
+			return nil, ErrValidation.Wrap(err)
+		case buckets.ErrBucketAlreadyExists.Has(err), buckets.ErrConflict.Has(err), metabase.ErrObjectAlreadyExists.Has(err):
+			return nil, ErrConflict.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return &BucketMetadata{
+		Name:       bucket.Name,
+		Versioning: bucket.Versioning,
+		Placement: Placement{
+			DefaultPlacement: bucket.Placement,
+			Location:         s.placementName(bucket.Placement),
+		},
+		ObjectLockEnabled: bucket.ObjectLock.Enabled,
+	}, nil
+}
+
 // GetPlacementDetails retrieves all placement with human-readable details available to a project's user agent.
 func (s *Service) GetPlacementDetails(ctx context.Context, projectID uuid.UUID) (_ []PlacementDetail, err error) {
 	user, err := GetUser(ctx)
@@ -5292,12 +5344,42 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 
 // GetPlacementByName returns the placement constraint by name.
 func (s *Service) GetPlacementByName(name string) (storj.PlacementConstraint, error) {
+	s.placementMu.RLock()
+	defer s.placementMu.RUnlock()
+
 	if placement, ok := s.placementNameLookup[name]; ok {
 		return placement, nil
 	}
 	return storj.DefaultPlacement, ErrPlacementNotFound.New("")
 }
 
+// SetPlacements replaces the placement definitions used by the service.
+func (s *Service) SetPlacements(ctx context.Context, placements nodeselection.PlacementDefinitions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	s.placementMu.Lock()
+	s.placements = placements
+	s.placementNameLookup = placementNameLookupOf(placements)
+	s.placementMu.Unlock()
+	return nil
+}
+
+// placementName returns the name of the placement.
+func (s *Service) placementName(id storj.PlacementConstraint) string {
+	s.placementMu.RLock()
+	defer s.placementMu.RUnlock()
+
+	return s.placements[id].Name
+}
+
+func placementNameLookupOf(placements nodeselection.PlacementDefinitions) map[string]storj.PlacementConstraint {
+	lookup := make(map[string]storj.PlacementConstraint, len(placements))
+	for _, placement := range placements {
+		lookup[placement.Name] = placement.ID
+	}
+	return lookup
+}
+
 // WalletInfo contains all the information about a destination wallet assigned to a user.
 type WalletInfo struct {
 	Address blockchain.Address `json:"address"`
@@ -20,6 +20,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/shared/nodetag"
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/contact"
@@ -41,14 +42,18 @@ func TestSatelliteContactEndpoint(t *testing.T) {
 				PeerCertificates: []*x509.Certificate{ident.Leaf, ident.CA},
 			},
 		}
+		noiseKeyAttestation, err := planet.StorageNodes[0].Server.NoiseKeyAttestation(ctx)
+		require.NoError(t, err)
+
 		peerCtx := rpcpeer.NewContext(ctx, &peer)
 		resp, err := planet.Satellites[0].Contact.Endpoint.CheckIn(peerCtx, &pb.CheckInRequest{
-			Address:       nodeInfo.Address,
-			Version:       &nodeInfo.Version,
-			Capacity:      &nodeInfo.Capacity,
-			Operator:      &nodeInfo.Operator,
-			DebounceLimit: 3,
-			Features:      0xf,
+			Address:             nodeInfo.Address,
+			Version:             &nodeInfo.Version,
+			Capacity:            &nodeInfo.Capacity,
+			Operator:            &nodeInfo.Operator,
+			NoiseKeyAttestation: noiseKeyAttestation,
+			DebounceLimit:       3,
+			Features:            0xf,
 		})
 		require.NoError(t, err)
 		require.NotNil(t, resp)
@@ -64,6 +69,10 @@ func TestSatelliteContactEndpoint(t *testing.T) {
 		require.Equal(t, node.Address.Address, nodeInfo.Address)
 		require.Equal(t, node.Address.DebounceLimit, int32(3))
 		require.Equal(t, node.Address.Features, uint64(0xf))
+		require.Equal(t, nodeselection.Transports{TCP: true, QUIC: true, Noise: true}, node.Reachable)
+		require.NotNil(t, node.LastTransportSuccess.TCP)
+		require.NotNil(t, node.LastTransportSuccess.QUIC)
+		require.NotNil(t, node.LastTransportSuccess.Noise)
 	})
 }
 
@@ -103,6 +112,11 @@ func TestSatelliteContactEndpoint_QUIC_Unreachable(t *testing.T) {
 		peerID, err := planet.Satellites[0].DB.PeerIdentities().Get(ctx, nodeInfo.ID)
 		require.NoError(t, err)
 		require.Equal(t, ident.PeerIdentity(), peerID)
+
+		node, err := planet.Satellites[0].DB.OverlayCache().Get(ctx, nodeInfo.ID)
+		require.NoError(t, err)
+		require.True(t, node.Reachable.TCP)
+		require.False(t, node.Reachable.QUIC)
 	})
 }
 
@@ -20,6 +20,7 @@ import (
 	"storj.io/drpc/drpcctx"
 	"storj.io/eventkit"
 	"storj.io/storj/private/nodeoperator"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/overlay"
 )
 
@@ -104,6 +105,16 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 		return nil, endpoint.checkPingRPCErr(err, nodeurl)
 	}
 
+	// Noise is optional, and it's served on the same port as TCP.
+	var pingNodeSuccessNoise bool
+	if pingNodeSuccess && noiseInfo != nil {
+		err = endpoint.service.pingNodeNoise(ctx, nodeurl)
+		if err != nil {
+			endpoint.log.Debug("pingBack over Noise failed", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		}
+		pingNodeSuccessNoise = err == nil
+	}
+
 	// check wallet features
 	if req.Operator != nil {
 		if err := nodeoperator.DefaultWalletFeaturesValidation.Validate(req.Operator.WalletFeatures); err != nil {
@@ -134,6 +145,11 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 		Capacity:   req.Capacity,
 		Operator:   req.Operator,
 		Version:    req.Version,
+		Reachable: nodeselection.Transports{
+			TCP:   pingNodeSuccess,
+			QUIC:  pingNodeSuccessQUIC,
+			Noise: pingNodeSuccessNoise,
+		},
 	}
 
 	emitEventkitEvent(ctx, req, pingNodeSuccess, pingNodeSuccessQUIC, nodeInfo)
@@ -173,6 +189,7 @@ func emitEventkitEvent(ctx context.Context, req *pb.CheckInRequest, pingNodeTCPS
 		eventkit.String("country", nodeInfo.CountryCode.String()),
 		eventkit.Bool("ping-tpc-success", pingNodeTCPSuccess),
 		eventkit.Bool("ping-quic-success", pingNodeQUICSuccess),
+		eventkit.Bool("ping-noise-success", nodeInfo.Reachable.Noise),
 	}
 
 	if nodeInfo.Capacity != nil {
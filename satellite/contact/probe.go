@@ -0,0 +1,210 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/quic"
+	"storj.io/common/rpc/rpcpool"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/private/nodestatspb"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/uplink/private/piecestore"
+)
+
+// ProbeConfig contains configurable values for probing the storage nodes.
+type ProbeConfig struct {
+	PieceSize         memory.Size   `help:"size of the test piece uploaded to the node by the probe" default:"256KiB"`
+	PieceExpiration   time.Duration `help:"expiration of the test piece, the node deletes it afterwards" default:"1h"`
+	Timeout           time.Duration `help:"timeout for probing a node over a single transport" default:"1m"`
+	RateLimitInterval time.Duration `help:"the minimum amount of time between two probes requested by the same node" releaseDefault:"1h" devDefault:"1ns"`
+}
+
+// Probe transports.
+const (
+	ProbeTransportTCP  = "tcp"
+	ProbeTransportQUIC = "quic"
+)
+
+// Probe steps, where the probe can fail.
+const (
+	ProbeStepDial     = "dial"
+	ProbeStepUpload   = "upload"
+	ProbeStepDownload = "download"
+	ProbeStepVerify   = "verify"
+)
+
+// ProbeEndpoint lets the storage nodes request an end-to-end test of their
+// reachability. Unlike PingMe, the satellite uploads a test piece to the node
+// and downloads it back using the piecestore protocol, the same way uplinks do.
+//
+// architecture: Endpoint
+type ProbeEndpoint struct {
+	nodestatspb.DRPCNodeProbeUnimplementedServer
+
+	log     *zap.Logger
+	orders  *orders.Service
+	dialer  rpc.Dialer
+	limiter *RateLimiter
+	config  ProbeConfig
+}
+
+// NewProbeEndpoint returns a new probe endpoint.
+func NewProbeEndpoint(log *zap.Logger, orders *orders.Service, dialer rpc.Dialer, config Config) *ProbeEndpoint {
+	return &ProbeEndpoint{
+		log:     log,
+		orders:  orders,
+		dialer:  dialer,
+		limiter: NewRateLimiter(config.Probe.RateLimitInterval, 1, config.RateLimitCacheSize),
+		config:  config.Probe,
+	}
+}
+
+// Probe uploads a test piece to the calling node and downloads it back, over
+// both TCP and QUIC. Failures of the node are reported in the results.
+func (endpoint *ProbeEndpoint) Probe(ctx context.Context, req *nodestatspb.ProbeRequest) (_ *nodestatspb.ProbeResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peerID, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+	nodeID := peerID.ID
+
+	if !endpoint.limiter.IsAllowed(ctx, nodeID.String()) {
+		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "too many probe requests, try again later")
+	}
+
+	resp := &nodestatspb.ProbeResponse{}
+	for _, transport := range []string{ProbeTransportTCP, ProbeTransportQUIC} {
+		result, err := endpoint.probe(ctx, nodeID, transport)
+		if err != nil {
+			return nil, endpoint.toRPCError(err)
+		}
+		if result.FailedStep != "" {
+			mon.Event("probe_failed_" + transport + "_" + result.FailedStep)
+			endpoint.log.Debug("node probe failed",
+				zap.Stringer("Node ID", nodeID),
+				zap.String("transport", transport),
+				zap.String("step", result.FailedStep),
+				zap.String("error", result.Error))
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp, nil
+}
+
+// probe tests the node over a single transport. The returned error is set
+// only when the probe couldn't be started, failures of the node are reported
+// in the result.
+func (endpoint *ProbeEndpoint) probe(ctx context.Context, nodeID storj.NodeID, transport string) (_ *nodestatspb.ProbeResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if endpoint.config.Timeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, endpoint.config.Timeout)
+		defer cancel()
+	}
+
+	pieceSize := endpoint.config.PieceSize.Int64()
+	put, get, putKey, getKey, err := endpoint.orders.CreateProbeOrderLimits(ctx, nodeID, pieceSize, time.Now().Add(endpoint.config.PieceExpiration))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &nodestatspb.ProbeResult{
+		Transport: transport,
+		Address:   put.GetStorageNodeAddress().GetAddress(),
+		PieceSize: pieceSize,
+	}
+	fail := func(step string, err error) (*nodestatspb.ProbeResult, error) {
+		result.FailedStep = step
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	dialer := endpoint.dialer
+	switch transport {
+	case ProbeTransportTCP:
+		dialer.Connector = rpc.NewDefaultTCPConnector(nil)
+	case ProbeTransportQUIC:
+		dialer.Connector = quic.NewDefaultConnector(nil)
+	default:
+		return nil, Error.New("unknown transport: %q", transport)
+	}
+
+	start := time.Now()
+	client, err := piecestore.Dial(rpcpool.WithForceDial(ctx), dialer, storj.NodeURL{
+		ID:      nodeID,
+		Address: result.Address,
+	}, piecestore.DefaultConfig)
+	if err != nil {
+		return fail(ProbeStepDial, err)
+	}
+	// the result of the probe is already decided when closing
+	defer func() { _ = client.Close() }()
+	result.DialMicros = time.Since(start).Microseconds()
+
+	data := make([]byte, pieceSize)
+	if _, err := rand.Read(data); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	start = time.Now()
+	_, err = client.UploadReader(ctx, put.Limit, putKey, bytes.NewReader(data))
+	if err != nil {
+		return fail(ProbeStepUpload, err)
+	}
+	result.UploadMicros = time.Since(start).Microseconds()
+
+	start = time.Now()
+	downloaded, err := download(ctx, client, get, getKey, pieceSize)
+	if err != nil {
+		return fail(ProbeStepDownload, err)
+	}
+	result.DownloadMicros = time.Since(start).Microseconds()
+
+	if !bytes.Equal(data, downloaded) {
+		return fail(ProbeStepVerify, Error.New("downloaded piece doesn't match the uploaded one"))
+	}
+
+	return result, nil
+}
+
+func download(ctx context.Context, client *piecestore.Client, limit *pb.AddressedOrderLimit, key storj.PiecePrivateKey, size int64) (_ []byte, err error) {
+	downloader, err := client.Download(ctx, limit.Limit, key, 0, size)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, downloader.Close()) }()
+
+	return io.ReadAll(downloader)
+}
+
+func (endpoint *ProbeEndpoint) toRPCError(err error) error {
+	switch {
+	case overlay.ErrNodeNotFound.Has(err):
+		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	case overlay.ErrNodeDisqualified.Has(err), overlay.ErrNodeFinishedGE.Has(err):
+		return rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+	default:
+		endpoint.log.Error("node probe failed to start", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+}
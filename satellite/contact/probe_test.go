@@ -0,0 +1,85 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/nodestatspb"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/contact"
+)
+
+func TestSatelliteProbeEndpoint(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Contact.Probe.PieceSize = 10 * memory.KiB
+				config.Contact.Probe.RateLimitInterval = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		sat := planet.Satellites[0]
+
+		conn, err := node.Dialer.DialNodeURL(ctx, sat.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		client := nodestatspb.NewDRPCNodeProbeClient(conn)
+
+		resp, err := client.Probe(ctx, &nodestatspb.ProbeRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+
+		for i, transport := range []string{contact.ProbeTransportTCP, contact.ProbeTransportQUIC} {
+			result := resp.Results[i]
+			require.Equal(t, transport, result.Transport)
+			require.Equal(t, node.Addr(), result.Address)
+			require.Equal(t, (10 * memory.KiB).Int64(), result.PieceSize)
+			require.Empty(t, result.FailedStep, result.Error)
+			require.Empty(t, result.Error)
+			require.Positive(t, result.UploadMicros)
+			require.Positive(t, result.DownloadMicros)
+		}
+
+		// the probes are rate limited
+		_, err = client.Probe(ctx, &nodestatspb.ProbeRequest{})
+		require.Error(t, err)
+		require.Equal(t, rpcstatus.ResourceExhausted, rpcstatus.Code(err))
+	})
+}
+
+func TestSatelliteProbeEndpoint_Failure(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		sat := planet.Satellites[0]
+
+		conn, err := node.Dialer.DialNodeURL(ctx, sat.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		// the satellite can't reach the stopped node, but the probe itself succeeds
+		require.NoError(t, planet.StopPeer(node))
+
+		resp, err := nodestatspb.NewDRPCNodeProbeClient(conn).Probe(ctx, &nodestatspb.ProbeRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+		for _, result := range resp.Results {
+			require.Equal(t, contact.ProbeStepDial, result.FailedStep)
+			require.NotEmpty(t, result.Error)
+		}
+	})
+}
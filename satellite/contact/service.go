@@ -31,6 +31,8 @@ type Config struct {
 	RateLimitInterval  time.Duration `help:"the amount of time that should happen between contact attempts usually" releaseDefault:"10m0s" devDefault:"1ns"`
 	RateLimitBurst     int           `help:"the maximum burst size for the contact rate limit token bucket" releaseDefault:"2" devDefault:"1000"`
 	RateLimitCacheSize int           `help:"the number of nodes or addresses to keep token buckets for" default:"1000"`
+
+	Probe ProbeConfig
 }
 
 // Service is the contact service between storage nodes and satellites.
@@ -146,6 +148,45 @@ func (service *Service) pingNodeQUIC(ctx context.Context, nodeurl storj.NodeURL)
 	return nil
 }
 
+// pingNodeNoise checks whether the node accepts Noise connections. Only the
+// replay safe piecestore methods are served over Noise, so it sends an empty
+// download request, which the node rejects as invalid.
+func (service *Service) pingNodeNoise(ctx context.Context, nodeurl storj.NodeURL) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.timeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, service.timeout)
+		defer cancel()
+	}
+
+	noiseDialer := service.dialer
+	noiseDialer.Connector = rpc.NewDefaultTCPConnector(nil)
+	conn, err := noiseDialer.DialNode(ctx, nodeurl, rpc.DialOptions{ReplaySafe: true})
+	if err != nil {
+		mon.Event("failed_dial_noise")
+		return Error.New("failed to dial storage node (ID: %s) at address %s using Noise: %q", nodeurl.ID.String(), nodeurl.Address, err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	stream, err := pb.NewDRPCReplaySafePiecestoreClient(conn).Download(ctx)
+	if err == nil {
+		err = stream.Send(&pb.PieceDownloadRequest{})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		_ = stream.Close()
+	}
+	if rpcstatus.Code(err) != rpcstatus.InvalidArgument {
+		mon.Event("failed_ping_node_noise")
+		return Error.New("failed to ping storage node using Noise, your node indicated error code: %d, %q", rpcstatus.Code(err), err)
+	}
+
+	return nil
+}
+
 func (service *Service) processNodeTags(ctx context.Context, nodeID storj.NodeID, self signing.Signee, req *pb.SignedNodeTagSets) error {
 	if req != nil {
 		tags := nodeselection.NodeTags{}
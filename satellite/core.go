@@ -6,6 +6,7 @@ package satellite
 import (
 	"context"
 	"errors"
+	"io"
 	"net"
 	"runtime/pprof"
 
@@ -49,7 +50,9 @@ import (
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/satellite/replication"
 	"storj.io/storj/satellite/reputation"
 )
 
@@ -112,6 +115,7 @@ type Core struct {
 		VerifyQueue          audit.VerifyQueue
 		ReverifyQueue        audit.ReverifyQueue
 		ContainmentSyncChore *audit.ContainmentSyncChore
+		HistoryCleanupChore  *audit.HistoryCleanupChore
 	}
 
 	ExpiredDeletion struct {
@@ -153,6 +157,10 @@ type Core struct {
 	RepairQueueStat struct {
 		Chore *repairer.QueueStat
 	}
+
+	Replication struct {
+		Worker *replication.Worker
+	}
 }
 
 // New creates a new satellite.
@@ -257,6 +265,17 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		return nil, err
 	}
 
+	repairQueue, err := queue.Open(config.RepairQueue, db.RepairQueue())
+	if err != nil {
+		return nil, errs.Combine(err, peer.Close())
+	}
+	if closer, ok := repairQueue.(io.Closer); ok {
+		peer.Services.Add(lifecycle.Item{
+			Name:  "repair-queue",
+			Close: closer.Close,
+		})
+	}
+
 	{ // setup overlay
 
 		peer.Overlay.DB = peer.DB.OverlayCache()
@@ -362,6 +381,20 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Audit Containment Sync Chore", peer.Audit.ContainmentSyncChore.Loop))
+
+		if config.HistoryRetention > 0 {
+			peer.Audit.HistoryCleanupChore = audit.NewHistoryCleanupChore(peer.Log.Named("audit:history-cleanup-chore"),
+				db.AuditHistory(),
+				config,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "audit:history-cleanup-chore",
+				Run:   peer.Audit.HistoryCleanupChore.Run,
+				Close: peer.Audit.HistoryCleanupChore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Audit History Cleanup Chore", peer.Audit.HistoryCleanupChore.Loop))
+		}
 	}
 
 	{ // setup expired segment cleanup
@@ -610,7 +643,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 	{
 		if config.RepairQueueCheck.Interval.Seconds() > 0 {
-			peer.RepairQueueStat.Chore = repairer.NewQueueStat(log, monkit.Default, placement.SupportedPlacements(), db.RepairQueue(), config.RepairQueueCheck.Interval)
+			peer.RepairQueueStat.Chore = repairer.NewQueueStat(log, monkit.Default, placement.SupportedPlacements(), repairQueue, config.RepairQueueCheck.Interval)
 
 			peer.Services.Add(lifecycle.Item{
 				Name: "queue-stat",
@@ -635,6 +668,29 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Garbage Collection", peer.GarbageCollection.Sender.Loop))
 	}
 
+	{ // setup bucket replication
+		if config.Replication.Enabled {
+			peer.Replication.Worker = replication.NewWorker(
+				peer.Log.Named("replication:worker"),
+				db.Replication(),
+				metabaseDB,
+				db.Buckets(),
+				repairQueue,
+				peer.Overlay.Service,
+				placement,
+				config.Replication,
+			)
+
+			peer.Services.Add(lifecycle.Item{
+				Name:  "replication:worker",
+				Run:   peer.Replication.Worker.Run,
+				Close: peer.Replication.Worker.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Bucket Replication", peer.Replication.Worker.Loop))
+		}
+	}
+
 	return peer, nil
 }
 
@@ -3,11 +3,18 @@
 
 package durability
 
-import "storj.io/storj/satellite/nodeselection"
+import (
+	"time"
+
+	"storj.io/storj/satellite/nodeselection"
+)
 
 // Config contains configuration for the durability ranged loop observer.
 type Config struct {
 	Classes []string `help:"Node attributes used by the durability segment loop to classify risks" default:"last_net,last_ip,wallet,email"`
+
+	Correlated          []string      `help:"Node attributes, or pairs of node attributes joined with '+', used to calculate the worst-case loss of each placement when all nodes of a class are lost (e.g. email,country,country+email). Each loop iteration stores one result per class and placement. Disabled when empty." default:""`
+	CorrelatedRetention time.Duration `help:"how long the results of the correlated durability report are kept, 0 keeps them forever" default:"720h"`
 }
 
 // CreateNodeClassifiers creates a list of node classifiers based on the configuration.
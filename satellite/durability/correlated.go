@@ -0,0 +1,526 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package durability
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/eventkit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair"
+)
+
+// Result is the worst-case loss of the segments of one placement when all the
+// nodes of a class value (or of a combination of two class values) are lost.
+type Result struct {
+	CreatedAt time.Time
+	Placement storj.PlacementConstraint
+	// Class is the name of the node attribute, or the names of two node
+	// attributes joined with "+" (like "country+email").
+	Class string
+	// HealthyMargin is the lowest number of healthy pieces above the required
+	// number of pieces, checking all the segments of the placement. Negative
+	// value means that some segments would be lost.
+	HealthyMargin int
+	// SegmentCount is the number of segments with the lowest healthy margin.
+	SegmentCount int64
+	// LostSegments is the number of segments which wouldn't be recoverable.
+	LostSegments int64
+	// TotalSegments is the number of checked segments of the placement.
+	TotalSegments int64
+	// SegmentExemplars are some segments with the lowest healthy margin.
+	SegmentExemplars []string
+	// ClassExemplars are the class values causing the lowest healthy margin.
+	ClassExemplars []string
+}
+
+// DB stores the results of the correlated durability report.
+type DB interface {
+	// Insert adds the results of a single report.
+	Insert(ctx context.Context, results []Result) error
+	// Latest returns the results of the most recent report.
+	Latest(ctx context.Context) ([]Result, error)
+	// DeleteBefore removes the results of reports created before the given time.
+	DeleteBefore(ctx context.Context, before time.Time) (deleted int64, err error)
+}
+
+// correlatedClass is a single or a pairwise combination of node attributes.
+type correlatedClass struct {
+	name string
+	// attributes are the indexes of the classified node attributes.
+	attributes []int
+}
+
+// CorrelatedReport is a calculator (rangedloop.Observer) which checks the
+// worst-case loss of segments for each placement, when all the nodes of a
+// class value are removed at once, or all the nodes of two class values are
+// removed together (for example all nodes in one country plus all nodes of
+// one operator). The results of each run are stored in the DB.
+type CorrelatedReport struct {
+	log        *zap.Logger
+	db         DB
+	overlay    overlay.DB
+	metabaseDB *metabase.DB
+	nodeGetter NodeGetter
+
+	asOfSystemInterval time.Duration
+	retention          time.Duration
+	nowFn              func() time.Time
+
+	attributes []nodeselection.NodeAttribute
+	classes    []correlatedClass
+
+	// classified contains the class of each node alias, per attribute.
+	classified [][]ClassID
+	// className contains the names of the classes, per attribute.
+	className [][]string
+
+	stats [][]correlatedStat // class -> placement -> stat
+}
+
+// NewCorrelatedReport creates a new CorrelatedReport for the configured
+// classes.
+func NewCorrelatedReport(log *zap.Logger, db DB, overlay overlay.DB, metabaseDB *metabase.DB, nodeGetter NodeGetter, config Config, asOfSystemInterval time.Duration) (*CorrelatedReport, error) {
+	report := &CorrelatedReport{
+		log:                log,
+		db:                 db,
+		overlay:            overlay,
+		metabaseDB:         metabaseDB,
+		nodeGetter:         nodeGetter,
+		asOfSystemInterval: asOfSystemInterval,
+		retention:          config.CorrelatedRetention,
+		nowFn:              time.Now,
+	}
+
+	attributeIndex := map[string]int{}
+	for _, definition := range config.Correlated {
+		names := strings.Split(definition, "+")
+		if len(names) > 2 {
+			return nil, errs.New("correlated durability class should be a single node attribute or two node attributes joined with '+': %q", definition)
+		}
+
+		if len(names) == 2 && strings.TrimSpace(names[0]) == strings.TrimSpace(names[1]) {
+			return nil, errs.New("correlated durability class should combine two different node attributes: %q", definition)
+		}
+
+		class := correlatedClass{name: definition}
+		for _, name := range names {
+			name = strings.TrimSpace(name)
+			ix, ok := attributeIndex[name]
+			if !ok {
+				attribute, err := nodeselection.CreateNodeAttribute(name)
+				if err != nil {
+					return nil, err
+				}
+				ix = len(report.attributes)
+				attributeIndex[name] = ix
+				report.attributes = append(report.attributes, attribute)
+			}
+			class.attributes = append(class.attributes, ix)
+		}
+		report.classes = append(report.classes, class)
+	}
+	return report, nil
+}
+
+// Start implements rangedloop.Observer.
+func (c *CorrelatedReport) Start(ctx context.Context, startTime time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodes, err := c.overlay.GetParticipatingNodes(ctx, -12*time.Hour, c.asOfSystemInterval)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	aliasMap, err := c.metabaseDB.LatestNodesAliasMap(ctx)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	c.classifyNodes(nodes, aliasMap)
+	c.stats = make([][]correlatedStat, len(c.classes))
+	return nil
+}
+
+// classifyNodes calculates the class of each node alias for each attribute.
+func (c *CorrelatedReport) classifyNodes(nodes []nodeselection.SelectedNode, aliasMap *metabase.NodeAliasMap) {
+	c.classified = make([][]ClassID, len(c.attributes))
+	c.className = make([][]string, len(c.attributes))
+	for ix, attribute := range c.attributes {
+		classes := map[string]ClassID{"unclassified": 0}
+		c.className[ix] = []string{"unclassified"}
+		c.classified[ix] = make([]ClassID, aliasMap.Max()+1)
+		for _, node := range nodes {
+			alias, ok := aliasMap.Alias(node.ID)
+			if !ok {
+				continue
+			}
+			class := attribute(node)
+			id, ok := classes[class]
+			if !ok {
+				id = ClassID(len(classes))
+				classes[class] = id
+				c.className[ix] = append(c.className[ix], class)
+			}
+			c.classified[ix][alias] = id
+		}
+	}
+}
+
+// Fork implements rangedloop.Observer.
+func (c *CorrelatedReport) Fork(ctx context.Context) (rangedloop.Partial, error) {
+	fork := &CorrelatedFork{
+		nodeGetter: c.nodeGetter,
+		classes:    c.classes,
+		classified: c.classified,
+		stats:      make([][]correlatedStat, len(c.classes)),
+		counts:     make([][]int32, len(c.attributes)),
+		otherCount: make([][]int32, len(c.attributes)),
+	}
+	for ix := range c.attributes {
+		fork.counts[ix] = make([]int32, len(c.className[ix]))
+		fork.otherCount[ix] = make([]int32, len(c.className[ix]))
+	}
+	return fork, nil
+}
+
+// Join implements rangedloop.Observer.
+func (c *CorrelatedReport) Join(ctx context.Context, partial rangedloop.Partial) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork := partial.(*CorrelatedFork)
+	for class, stats := range fork.stats {
+		for placement, stat := range stats {
+			if len(c.stats[class]) <= placement {
+				c.stats[class] = append(c.stats[class], make([]correlatedStat, placement-len(c.stats[class])+1)...)
+			}
+			c.stats[class][placement].merge(stat)
+		}
+	}
+	return nil
+}
+
+// Finish implements rangedloop.Observer.
+//
+// Each run stores at most one result per configured class and placement, with
+// at most maxExemplars exemplars each. Older results are removed according to
+// the configured retention.
+func (c *CorrelatedReport) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := c.nowFn()
+	results := c.Results(now)
+	for _, result := range results {
+		ek.Event("durability-correlated",
+			eventkit.String("class", result.Class),
+			eventkit.Int64("placement", int64(result.Placement)),
+			eventkit.Int64("healthy_margin", int64(result.HealthyMargin)),
+			eventkit.Int64("count", result.SegmentCount),
+			eventkit.Int64("lost", result.LostSegments),
+			eventkit.Int64("total", result.TotalSegments),
+			eventkit.String("segment_exemplars", strings.Join(result.SegmentExemplars, ",")),
+			eventkit.String("class_exemplars", strings.Join(result.ClassExemplars, ",")),
+			eventkit.Timestamp("report_time", now),
+		)
+	}
+
+	if err := c.db.Insert(ctx, results); err != nil {
+		return errs.Wrap(err)
+	}
+
+	if c.retention > 0 {
+		deleted, err := c.db.DeleteBefore(ctx, now.Add(-c.retention))
+		if err != nil {
+			c.log.Error("failed to delete expired durability results", zap.Error(err))
+		} else {
+			c.log.Debug("deleted expired durability results", zap.Int64("count", deleted))
+		}
+	}
+	return nil
+}
+
+// Results returns the results collected since the last Start.
+func (c *CorrelatedReport) Results(now time.Time) []Result {
+	var results []Result
+	for ix, class := range c.classes {
+		for placement, stat := range c.stats[ix] {
+			if stat.totalSegments == 0 {
+				continue
+			}
+			result := Result{
+				CreatedAt:        now,
+				Placement:        storj.PlacementConstraint(placement),
+				Class:            class.name,
+				HealthyMargin:    stat.margin,
+				SegmentCount:     stat.segmentCount,
+				LostSegments:     stat.lostSegments,
+				TotalSegments:    stat.totalSegments,
+				SegmentExemplars: stat.segmentExemplars,
+			}
+			for _, exemplar := range stat.classExemplars {
+				var names []string
+				for i, attribute := range class.attributes {
+					// the first class may contain all the healthy pieces.
+					if i > 0 && exemplar[i] == 0 {
+						continue
+					}
+					names = append(names, c.className[attribute][exemplar[i]])
+				}
+				result.ClassExemplars = append(result.ClassExemplars, strings.Join(names, "+"))
+			}
+			results = append(results, result)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Placement != results[j].Placement {
+			return results[i].Placement < results[j].Placement
+		}
+		return results[i].Class < results[j].Class
+	})
+	return results
+}
+
+// TestingSetNow allows tests to have the report act as if the current time is
+// different than it is.
+func (c *CorrelatedReport) TestingSetNow(nowFn func() time.Time) {
+	c.nowFn = nowFn
+}
+
+// correlatedStat is the worst-case loss of the segments of one placement.
+type correlatedStat struct {
+	margin           int
+	segmentCount     int64
+	lostSegments     int64
+	totalSegments    int64
+	segmentExemplars []string
+	classExemplars   [][2]ClassID
+}
+
+// add adds the healthy margin of a segment.
+func (s *correlatedStat) add(margin int, segment *rangedloop.Segment, classExemplar [2]ClassID) {
+	if s.totalSegments == 0 || margin < s.margin {
+		s.margin = margin
+		s.segmentCount = 0
+		s.segmentExemplars = s.segmentExemplars[:0]
+		s.classExemplars = s.classExemplars[:0]
+	}
+	s.totalSegments++
+	if margin < 0 {
+		s.lostSegments++
+	}
+	if margin != s.margin {
+		return
+	}
+	s.segmentCount++
+	if len(s.segmentExemplars) < maxExemplars {
+		s.segmentExemplars = append(s.segmentExemplars, fmt.Sprintf("%s/%d", segment.StreamID, segment.Position.Encode()))
+		s.classExemplars = append(s.classExemplars, classExemplar)
+	}
+}
+
+// merge merges the stat of another range.
+func (s *correlatedStat) merge(o correlatedStat) {
+	if o.totalSegments == 0 {
+		return
+	}
+	if s.totalSegments == 0 || o.margin < s.margin {
+		s.margin = o.margin
+		s.segmentCount = 0
+		s.segmentExemplars = nil
+		s.classExemplars = nil
+	}
+	s.totalSegments += o.totalSegments
+	s.lostSegments += o.lostSegments
+	if o.margin != s.margin {
+		return
+	}
+	s.segmentCount += o.segmentCount
+	for i, exemplar := range o.segmentExemplars {
+		if len(s.segmentExemplars) >= maxExemplars {
+			break
+		}
+		s.segmentExemplars = append(s.segmentExemplars, exemplar)
+		s.classExemplars = append(s.classExemplars, o.classExemplars[i])
+	}
+}
+
+// CorrelatedFork is the correlated durability calculator for each segment range.
+type CorrelatedFork struct {
+	nodeGetter NodeGetter
+	classes    []correlatedClass
+	classified [][]ClassID
+
+	stats [][]correlatedStat // class -> placement -> stat
+
+	// reuse those slices to optimize memory usage
+	nodeIDs    []storj.NodeID
+	nodes      []nodeselection.SelectedNode
+	aliases    []metabase.NodeAlias
+	values     []ClassID
+	counts     [][]int32 // attribute -> class -> number of pieces
+	otherCount [][]int32 // attribute -> class -> number of pieces
+}
+
+// Process implements rangedloop.Partial.
+func (c *CorrelatedFork) Process(ctx context.Context, segments []rangedloop.Segment) (err error) {
+	for i := range segments {
+		s := &segments[i]
+
+		if s.Inline() {
+			continue
+		}
+		if s.Expired(time.Now()) {
+			continue
+		}
+		if len(s.Pieces) == 0 {
+			continue
+		}
+
+		if cap(c.nodeIDs) < len(s.Pieces) {
+			c.nodeIDs = make([]storj.NodeID, len(s.Pieces))
+			c.nodes = make([]nodeselection.SelectedNode, len(s.Pieces))
+		} else {
+			c.nodeIDs = c.nodeIDs[:len(s.Pieces)]
+			c.nodes = c.nodes[:len(s.Pieces)]
+		}
+		for i, piece := range s.Pieces {
+			c.nodeIDs[i] = piece.StorageNode
+		}
+
+		selectedNodes, err := c.nodeGetter.GetNodes(ctx, s.CreatedAt, c.nodeIDs, c.nodes)
+		if err != nil {
+			continue
+		}
+
+		piecesCheck := repair.ClassifySegmentPieces(s.Pieces, selectedNodes, nil, false, false, nodeselection.Placement{}, int(s.Redundancy.RepairShares))
+
+		c.aliases = c.aliases[:0]
+		for _, piece := range s.AliasPieces {
+			if !piecesCheck.Healthy.Contains(int(piece.Number)) {
+				continue
+			}
+			// new nodes will be included in the next execution cycle.
+			if len(c.classified) == 0 || len(c.classified[0]) <= int(piece.Alias) {
+				continue
+			}
+			// unavailable/offline nodes were not classified
+			if c.classified[0][piece.Alias] == 0 {
+				continue
+			}
+			c.aliases = append(c.aliases, piece.Alias)
+		}
+
+		for ix, class := range c.classes {
+			removed, exemplar := c.worstLoss(class)
+			margin := len(c.aliases) - removed - int(s.Redundancy.RequiredShares)
+
+			if len(c.stats[ix]) <= int(s.Placement) {
+				c.stats[ix] = append(c.stats[ix], make([]correlatedStat, int(s.Placement)-len(c.stats[ix])+1)...)
+			}
+			c.stats[ix][s.Placement].add(margin, s, exemplar)
+		}
+	}
+	return nil
+}
+
+// worstLoss returns the highest number of healthy pieces of the current
+// segment which can be lost together, by removing all the nodes of one class
+// value of each attribute of the class.
+func (c *CorrelatedFork) worstLoss(class correlatedClass) (removed int, exemplar [2]ClassID) {
+	first := class.attributes[0]
+	firstCounts := c.counts[first]
+
+	// distinct values of the first attribute.
+	c.values = c.values[:0]
+	for _, alias := range c.aliases {
+		value := c.classified[first][alias]
+		if firstCounts[value] == 0 {
+			c.values = append(c.values, value)
+		}
+		firstCounts[value]++
+	}
+	defer func() {
+		for _, value := range c.values {
+			firstCounts[value] = 0
+		}
+	}()
+
+	if len(class.attributes) == 1 {
+		for _, value := range c.values {
+			if int(firstCounts[value]) > removed {
+				removed = int(firstCounts[value])
+				exemplar[0] = value
+			}
+		}
+		return removed, exemplar
+	}
+
+	second := class.attributes[1]
+	secondCounts := c.counts[second]
+
+	maxSecond := 0
+	for _, alias := range c.aliases {
+		value := c.classified[second][alias]
+		secondCounts[value]++
+		if int(secondCounts[value]) > maxSecond {
+			maxSecond = int(secondCounts[value])
+		}
+	}
+	defer func() {
+		for _, alias := range c.aliases {
+			secondCounts[c.classified[second][alias]] = 0
+		}
+	}()
+
+	// check the biggest classes of the first attribute first, so the
+	// remaining ones can be skipped when they can't remove more pieces.
+	sort.Slice(c.values, func(i, j int) bool {
+		return firstCounts[c.values[i]] > firstCounts[c.values[j]]
+	})
+
+	other := c.otherCount[second]
+	for _, value := range c.values {
+		if int(firstCounts[value])+maxSecond <= removed {
+			break
+		}
+
+		// the biggest class of the second attribute among the pieces which
+		// are not removed with the first class.
+		maxOther, maxOtherValue := 0, ClassID(0)
+		for _, alias := range c.aliases {
+			if c.classified[first][alias] == value {
+				continue
+			}
+			otherValue := c.classified[second][alias]
+			other[otherValue]++
+			if int(other[otherValue]) > maxOther {
+				maxOther = int(other[otherValue])
+				maxOtherValue = otherValue
+			}
+		}
+		for _, alias := range c.aliases {
+			other[c.classified[second][alias]] = 0
+		}
+
+		if int(firstCounts[value])+maxOther > removed {
+			removed = int(firstCounts[value]) + maxOther
+			exemplar = [2]ClassID{value, maxOtherValue}
+		}
+	}
+	return removed, exemplar
+}
+
+var _ rangedloop.Observer = &CorrelatedReport{}
+
+var _ rangedloop.Partial = &CorrelatedFork{}
@@ -0,0 +1,113 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package durability
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
+)
+
+func TestCorrelatedReport(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	// 4 nodes in Germany and 2 nodes in Hungary, operator "a" has nodes in both countries.
+	countries := []location.CountryCode{location.Germany, location.Germany, location.Germany, location.Germany, location.Hungary, location.Hungary}
+	emails := []string{"a", "b", "c", "d", "a", "a"}
+
+	var storageNodes []*nodeselection.SelectedNode
+	var nodes []nodeselection.SelectedNode
+	var aliases []metabase.NodeAliasEntry
+	for i := range countries {
+		node := &nodeselection.SelectedNode{
+			ID:          testrand.NodeID(),
+			CountryCode: countries[i],
+			Email:       emails[i],
+			Online:      true,
+		}
+		storageNodes = append(storageNodes, node)
+		nodes = append(nodes, *node)
+		aliases = append(aliases, metabase.NodeAliasEntry{ID: node.ID, Alias: metabase.NodeAlias(i)})
+	}
+
+	segment := func(ix ...int) rangedloop.Segment {
+		s := rangedloop.Segment{
+			StreamID:    testrand.UUID(),
+			RootPieceID: testrand.PieceID(),
+			Redundancy:  storj.RedundancyScheme{RequiredShares: 2, ShareSize: 256},
+		}
+		for n, i := range ix {
+			s.Pieces = append(s.Pieces, metabase.Piece{Number: uint16(n), StorageNode: storageNodes[i].ID})
+			s.AliasPieces = append(s.AliasPieces, metabase.AliasPiece{Number: uint16(n), Alias: metabase.NodeAlias(i)})
+		}
+		return s
+	}
+
+	_, err := NewCorrelatedReport(nil, nil, nil, nil, nil, Config{Correlated: []string{"email+email"}}, 0)
+	require.Error(t, err)
+	_, err = NewCorrelatedReport(nil, nil, nil, nil, nil, Config{Correlated: []string{"country+email+wallet"}}, 0)
+	require.Error(t, err)
+
+	report, err := NewCorrelatedReport(nil, nil, nil, nil, nodeList{nodes: storageNodes}, Config{
+		Correlated: []string{"email", "country", "country+email"},
+	}, 0)
+	require.NoError(t, err)
+
+	// usually called with report.Start()
+	report.classifyNodes(nodes, metabase.NewNodeAliasMap(aliases))
+	report.stats = make([][]correlatedStat, len(report.classes))
+
+	first, err := report.Fork(ctx)
+	require.NoError(t, err)
+	require.NoError(t, first.Process(ctx, []rangedloop.Segment{segment(0, 1, 2, 3, 4, 5)}))
+
+	second, err := report.Fork(ctx)
+	require.NoError(t, err)
+	require.NoError(t, second.Process(ctx, []rangedloop.Segment{segment(1, 2, 4, 5)}))
+
+	require.NoError(t, report.Join(ctx, first))
+	require.NoError(t, report.Join(ctx, second))
+
+	now := time.Now()
+	results := report.Results(now)
+	require.Len(t, results, 3)
+
+	byClass := map[string]Result{}
+	for _, result := range results {
+		require.Equal(t, now, result.CreatedAt)
+		require.Equal(t, storj.DefaultPlacement, result.Placement)
+		require.EqualValues(t, 2, result.TotalSegments)
+		byClass[result.Class] = result
+	}
+
+	// without operator "a" the first segment keeps 3 pieces, the second one 2 pieces.
+	email := byClass["email"]
+	require.Equal(t, 0, email.HealthyMargin)
+	require.EqualValues(t, 1, email.SegmentCount)
+	require.Zero(t, email.LostSegments)
+	require.Equal(t, []string{"a"}, email.ClassExemplars)
+
+	// without Germany the first segment keeps 2 pieces, the second one 2 pieces.
+	country := byClass["country"]
+	require.Equal(t, 0, country.HealthyMargin)
+	require.EqualValues(t, 2, country.SegmentCount)
+	require.Zero(t, country.LostSegments)
+
+	// without Germany and operator "a" no pieces are left from any segment.
+	pair := byClass["country+email"]
+	require.Equal(t, -2, pair.HealthyMargin)
+	require.EqualValues(t, 2, pair.SegmentCount)
+	require.EqualValues(t, 2, pair.LostSegments)
+	require.Equal(t, []string{"DE+a", "DE+a"}, pair.ClassExemplars)
+	require.Len(t, pair.SegmentExemplars, 2)
+}
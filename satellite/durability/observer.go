@@ -339,7 +339,7 @@ func (c *ObserverFork) Process(ctx context.Context, segments []rangedloop.Segmen
 			continue
 		}
 
-		piecesCheck := repair.ClassifySegmentPieces(s.Pieces, selectedNodes, nil, false, false, c.placements[s.Placement])
+		piecesCheck := repair.ClassifySegmentPieces(s.Pieces, selectedNodes, nil, false, false, c.placements[s.Placement], int(s.Redundancy.RepairShares))
 
 		counters := ClassGroupCounters{}
 		healthyPieceCount := 0
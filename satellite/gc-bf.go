@@ -109,7 +109,9 @@ func NewGarbageCollectionBF(log *zap.Logger, db DB, metabaseDB *metabase.DB, rev
 			observer,
 		})
 
-		if !peer.GarbageCollection.Config.RunOnce {
+		if peer.GarbageCollection.Config.RunOnce {
+			peer.RangedLoop.Service.DisableCheckpoints()
+		} else {
 			peer.Services.Add(lifecycle.Item{
 				Name:  "garbage-collection-bf",
 				Run:   peer.RangedLoop.Service.Run,
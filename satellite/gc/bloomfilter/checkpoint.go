@@ -0,0 +1,147 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/shared/bloomfilter"
+	"storj.io/storj/shared/nodeidmap"
+)
+
+var _ (rangedloop.CheckpointObserver) = (*Observer)(nil)
+
+// observerCheckpoint is the state of the Observer created by Start.
+type observerCheckpoint struct {
+	LastPieceCounts map[storj.NodeID]int64
+	CreationTime    time.Time
+	Seed            byte
+	Round           int
+}
+
+// forkCheckpoint is the state of an observerFork.
+type forkCheckpoint struct {
+	Filters            map[storj.NodeID][]byte
+	Counts             map[storj.NodeID]int
+	LatestCreationTime map[string]time.Time
+
+	InlineCount, ExpiredCount, RemoteCount int
+}
+
+// CheckpointState serializes the state created by Start.
+func (obs *Observer) CheckpointState(ctx context.Context) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return encodeCheckpoint(observerCheckpoint{
+		LastPieceCounts: obs.lastPieceCounts,
+		CreationTime:    obs.creationTime,
+		Seed:            obs.seed,
+		Round:           obs.round,
+	})
+}
+
+// Resume restores the state created by Start of an interrupted loop.
+func (obs *Observer) Resume(ctx context.Context, startTime time.Time, state []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := obs.upload.CheckConfig(); err != nil {
+		return err
+	}
+
+	var checkpoint observerCheckpoint
+	if err := decodeCheckpoint(state, &checkpoint); err != nil {
+		return err
+	}
+
+	obs.log.Info("Collecting bloom filters resumed", zap.Time("start_time", startTime))
+
+	obs.startTime = startTime
+	obs.lastPieceCounts = checkpoint.LastPieceCounts
+	if obs.lastPieceCounts == nil {
+		obs.lastPieceCounts = make(map[storj.NodeID]int64)
+	}
+	obs.retainInfos = nodeidmap.MakeSized[*RetainInfo](len(obs.lastPieceCounts))
+	obs.creationTime = checkpoint.CreationTime
+	obs.seed = checkpoint.Seed
+	obs.round = checkpoint.Round
+	obs.inlineCount, obs.expiredCount, obs.remoteCount = 0, 0, 0
+	return nil
+}
+
+// CheckpointPartial serializes the bloom filters collected by a Partial.
+func (obs *Observer) CheckpointPartial(ctx context.Context, partial rangedloop.Partial) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork, ok := partial.(*observerFork)
+	if !ok {
+		return nil, errs.New("expected %T but got %T", fork, partial)
+	}
+
+	checkpoint := forkCheckpoint{
+		Filters:            make(map[storj.NodeID][]byte, fork.retainInfos.Count()),
+		Counts:             make(map[storj.NodeID]int, fork.retainInfos.Count()),
+		LatestCreationTime: fork.latestCreationTime,
+		InlineCount:        fork.inlineCount,
+		ExpiredCount:       fork.expiredCount,
+		RemoteCount:        fork.remoteCount,
+	}
+	fork.retainInfos.Range(func(nodeID storj.NodeID, info *RetainInfo) bool {
+		checkpoint.Filters[nodeID] = info.Filter.Bytes()
+		checkpoint.Counts[nodeID] = info.Count
+		return true
+	})
+
+	return encodeCheckpoint(checkpoint)
+}
+
+// ResumePartial recreates a Partial from the bloom filters collected before
+// the loop was interrupted.
+func (obs *Observer) ResumePartial(ctx context.Context, state []byte) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var checkpoint forkCheckpoint
+	if err := decodeCheckpoint(state, &checkpoint); err != nil {
+		return nil, err
+	}
+
+	fork := newObserverFork(obs.log.Named("gc observer"), obs.config, obs.lastPieceCounts, obs.seed, obs.round, obs.startTime, obs.forcedTableSize)
+	for nodeID, data := range checkpoint.Filters {
+		filter, err := bloomfilter.NewFromBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		fork.retainInfos.Store(nodeID, &RetainInfo{
+			Filter: filter,
+			Count:  checkpoint.Counts[nodeID],
+		})
+	}
+	if checkpoint.LatestCreationTime != nil {
+		fork.latestCreationTime = checkpoint.LatestCreationTime
+	}
+	fork.inlineCount = checkpoint.InlineCount
+	fork.expiredCount = checkpoint.ExpiredCount
+	fork.remoteCount = checkpoint.RemoteCount
+
+	return fork, nil
+}
+
+func encodeCheckpoint(value any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeCheckpoint(data []byte, value any) error {
+	return errs.Wrap(gob.NewDecoder(bytes.NewReader(data)).Decode(value))
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/gc/bloomfilter"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/rangedloop/rangedlooptest"
+)
+
+func TestObserverCheckpoint(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	nodes := []storj.NodeID{testrand.NodeID(), testrand.NodeID(), testrand.NodeID()}
+	pieceCounts := map[storj.NodeID]int64{}
+	for _, node := range nodes {
+		pieceCounts[node] = 100
+	}
+
+	var segments []rangedloop.Segment
+	for i := 0; i < 50; i++ {
+		var pieces metabase.Pieces
+		for n, node := range nodes {
+			pieces = append(pieces, metabase.Piece{Number: uint16(n), StorageNode: node})
+		}
+		segments = append(segments, rangedloop.Segment{
+			StreamID:      testrand.UUID(),
+			CreatedAt:     time.Now().Add(-time.Hour),
+			RootPieceID:   testrand.PieceID(),
+			EncryptedSize: 1024,
+			Pieces:        pieces,
+		})
+	}
+
+	loopConfig := rangedloop.Config{
+		Parallelism:   2,
+		BatchSize:     5,
+		CheckpointDir: ctx.Dir("checkpoint"),
+	}
+	config := bloomfilter.Config{
+		Directory:          ctx.Dir("bloomfilters"),
+		InitialPieces:      100,
+		FalsePositiveRate:  0.1,
+		MaxBloomFilterSize: 1024,
+		ZipBatchSize:       10,
+	}
+
+	observer := bloomfilter.NewObserver(zaptest.NewLogger(t), config, &staticOverlay{pieceCounts: pieceCounts})
+	splitter := &rangedlooptest.RangeSplitter{Segments: segments}
+
+	_, err := rangedloop.NewService(zaptest.NewLogger(t), loopConfig, &failingSplitter{RangeSplitter: splitter}, []rangedloop.Observer{observer}).RunOnce(ctx)
+	require.Error(t, err)
+
+	durations, err := rangedloop.NewService(zaptest.NewLogger(t), loopConfig, splitter, []rangedloop.Observer{observer}).RunOnce(ctx)
+	require.NoError(t, err)
+	require.Len(t, durations, 1)
+	require.GreaterOrEqual(t, durations[0].Duration, time.Duration(0))
+
+	infos := observer.TestingRetainInfos()
+	require.Equal(t, len(nodes), infos.Count())
+	for n, node := range nodes {
+		info, ok := infos.Load(node)
+		require.True(t, ok)
+		// every piece is counted once, even though some were processed before the restart.
+		require.Equal(t, len(segments), info.Count)
+
+		for _, segment := range segments {
+			require.True(t, info.Filter.Contains(segment.RootPieceID.Derive(node, int32(n))))
+		}
+	}
+}
+
+type staticOverlay struct {
+	pieceCounts map[storj.NodeID]int64
+}
+
+func (o *staticOverlay) ActiveNodesPieceCounts(ctx context.Context) (map[storj.NodeID]int64, error) {
+	return o.pieceCounts, nil
+}
+
+// failingSplitter creates ranges which fail after the first batch.
+type failingSplitter struct {
+	*rangedlooptest.RangeSplitter
+}
+
+func (splitter *failingSplitter) CreateRanges(nRanges int, batchSize int) ([]rangedloop.SegmentProvider, error) {
+	providers, err := splitter.RangeSplitter.CreateRanges(nRanges, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	for i, provider := range providers {
+		providers[i] = &failingProvider{SegmentProvider: provider}
+	}
+	return providers, nil
+}
+
+type failingProvider struct {
+	rangedloop.SegmentProvider
+}
+
+func (provider *failingProvider) Iterate(ctx context.Context, fn func([]rangedloop.Segment) error) error {
+	first := true
+	return provider.SegmentProvider.Iterate(ctx, func(segments []rangedloop.Segment) error {
+		if !first {
+			return errors.New("interrupted")
+		}
+		first = false
+		return fn(segments)
+	})
+}
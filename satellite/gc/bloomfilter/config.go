@@ -21,8 +21,13 @@ type Config struct {
 	MaxBloomFilterSize   memory.Size `help:"maximum size of a single bloom filter" default:"2m"`
 	ExcludeExpiredPieces bool        `help:"do not include expired pieces into bloom filter" default:"true"`
 
+	// storage nodes which don't support sharded bloom filters reject them, hence
+	// they don't collect garbage while it's enabled.
+	Incremental bool `help:"nodes with more pieces than fit into a single bloom filter of maximum size at the false positive rate get filters covering only a piece ID prefix shard, a different one on each run" default:"false"`
+
 	AccessGrant  string        `help:"Access Grant which will be used to upload bloom filters to the bucket" default:""`
 	Bucket       string        `help:"Bucket which will be used to upload bloom filters" default:"" testDefault:"gc-queue"` // TODO do we need full location?
+	Directory    string        `help:"local or shared directory which will be used to store bloom filters instead of a bucket" default:""`
 	ZipBatchSize int           `help:"how many bloom filters will be packed in a single zip" default:"40" testDefault:"2"`
 	ExpireIn     time.Duration `help:"how long bloom filters will remain in the bucket for gc/sender to consume before being automatically deleted" default:"336h"`
 }
@@ -17,9 +17,20 @@ segments can be processed in parallel to speed up process.
 The bloomfilter.Observer will send that requests to the Storj bucket after a full
 ranged loop iteration. After that bloom filters will be downloaded and sent
 to the storage nodes with separate service from storj/satellite/gc/sender package.
+Both sides exchange the bloom filters through a Store, which is either a Storj
+bucket or, when a directory is configured, a local or shared directory.
 
 This bloom filter service should be run only against immutable database snapshot.
 
+When the incremental mode is enabled, nodes which store more pieces than fit into
+a single bloom filter of MaxBloomFilterSize at FalsePositiveRate get a filter
+covering only the pieces whose ID starts with a given prefix. The prefix changes
+with each run, hence the false positive rate is kept for the largest nodes at the
+cost of collecting their garbage over several runs.
+
+Observer supports ranged loop checkpoints, so the bloom filters collected before
+a restart of the ranged loop are restored instead of collected from scratch.
+
 See storj/docs/design/garbage-collection.md for more info.
 */
 package bloomfilter
@@ -5,6 +5,7 @@ package bloomfilter
 
 import (
 	"context"
+	"math/rand"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -45,6 +46,9 @@ type Observer struct {
 	upload  *Upload
 	overlay Overlay
 
+	// round selects the piece ID prefix shard of the incremental bloom filters.
+	round int
+
 	// The following fields are reset for each loop.
 	startTime       time.Time
 	lastPieceCounts map[storj.NodeID]int64
@@ -67,6 +71,7 @@ func NewObserver(log *zap.Logger, config Config, overlay Overlay) *Observer {
 		overlay: overlay,
 		upload:  NewUpload(log, config),
 		config:  config,
+		round:   rand.Intn(1 << bloomfilter.MaxShardBits),
 	}
 }
 
@@ -95,6 +100,7 @@ func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error)
 	obs.retainInfos = nodeidmap.MakeSized[*RetainInfo](len(lastPieceCounts))
 	obs.creationTime = time.Now()
 	obs.seed = bloomfilter.GenerateSeed()
+	obs.round++
 	return nil
 }
 
@@ -102,7 +108,7 @@ func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error)
 func (obs *Observer) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return newObserverFork(obs.log.Named("gc observer"), obs.config, obs.lastPieceCounts, obs.seed, obs.startTime, obs.forcedTableSize), nil
+	return newObserverFork(obs.log.Named("gc observer"), obs.config, obs.lastPieceCounts, obs.seed, obs.round, obs.startTime, obs.forcedTableSize), nil
 }
 
 // Join merges the bloom filters gathered by each Partial.
@@ -184,6 +190,7 @@ type observerFork struct {
 	// TODO: should we use int or int64 consistently for piece count (db type is int64)?
 	pieceCounts map[storj.NodeID]int64
 	seed        byte
+	round       int
 	startTime   time.Time
 
 	retainInfos nodeidmap.Map[*RetainInfo]
@@ -201,12 +208,13 @@ type observerFork struct {
 
 // newObserverFork instantiates a new observer fork to process different segment range.
 // The seed is passed so that it can be shared among all parallel forks.
-func newObserverFork(log *zap.Logger, config Config, pieceCounts map[storj.NodeID]int64, seed byte, startTime time.Time, forcedTableSize int) *observerFork {
+func newObserverFork(log *zap.Logger, config Config, pieceCounts map[storj.NodeID]int64, seed byte, round int, startTime time.Time, forcedTableSize int) *observerFork {
 	return &observerFork{
 		log:                log,
 		config:             config,
 		pieceCounts:        pieceCounts,
 		seed:               seed,
+		round:              round,
 		startTime:          startTime,
 		forcedTableSize:    forcedTableSize,
 		retainInfos:        nodeidmap.MakeSized[*RetainInfo](len(pieceCounts)),
@@ -266,17 +274,18 @@ func (fork *observerFork) add(nodeID storj.NodeID, pieceID storj.PieceID) {
 			return
 		}
 
-		hashCount, tableSize := bloomfilter.OptimalParameters(numPieces, fork.config.FalsePositiveRate, fork.config.MaxBloomFilterSize)
-		// limit size of bloom filter to ensure we are under the limit for RPC
-		if fork.forcedTableSize > 0 {
-			tableSize = fork.forcedTableSize
+		shard := shardFor(fork.config, numPieces, fork.round)
+		if !shard.Includes(pieceID) {
+			return
 		}
 
-		filter := bloomfilter.NewExplicit(fork.seed, hashCount, tableSize)
+		filter := newFilter(fork.config, fork.seed, numPieces, shard, fork.forcedTableSize)
 		info = &RetainInfo{
 			Filter: filter,
 		}
 		fork.retainInfos.Store(nodeID, info)
+	} else if !info.Filter.Shard().Includes(pieceID) {
+		return
 	}
 
 	info.Filter.Add(pieceID)
@@ -5,6 +5,7 @@ package bloomfilter
 
 import (
 	"context"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -24,6 +25,9 @@ type SyncObserver struct {
 	overlay Overlay
 	upload  *Upload
 
+	// round selects the piece ID prefix shard of the incremental bloom filters.
+	round int
+
 	// The following fields are reset for each loop.
 	startTime       time.Time
 	lastPieceCounts map[storj.NodeID]int64
@@ -51,6 +55,7 @@ func NewSyncObserver(log *zap.Logger, config Config, overlay Overlay) *SyncObser
 		overlay: overlay,
 		upload:  NewUpload(log, config),
 		config:  config,
+		round:   rand.Intn(1 << bloomfilter.MaxShardBits),
 	}
 }
 
@@ -79,6 +84,7 @@ func (obs *SyncObserver) Start(ctx context.Context, startTime time.Time) (err er
 	obs.retainInfos = nodeidmap.MakeSized[*RetainInfo](len(lastPieceCounts))
 	obs.latestCreationTime = time.Time{}
 	obs.seed = bloomfilter.GenerateSeed()
+	obs.round++
 	return nil
 }
 
@@ -180,16 +186,18 @@ func (obs *SyncObserver) add(nodeID storj.NodeID, pieceID storj.PieceID) {
 			return
 		}
 
-		hashCount, tableSize := bloomfilter.OptimalParameters(numPieces, obs.config.FalsePositiveRate, obs.config.MaxBloomFilterSize)
-		// limit size of bloom filter to ensure we are under the limit for RPC
-		if obs.forcedTableSize > 0 {
-			tableSize = obs.forcedTableSize
+		shard := shardFor(obs.config, numPieces, obs.round)
+		if !shard.Includes(pieceID) {
+			return
 		}
-		filter := bloomfilter.NewExplicit(obs.seed, hashCount, tableSize)
+
+		filter := newFilter(obs.config, obs.seed, numPieces, shard, obs.forcedTableSize)
 		info = &RetainInfo{
 			Filter: filter,
 		}
 		obs.retainInfos.Store(nodeID, info)
+	} else if !info.Filter.Shard().Includes(pieceID) {
+		return
 	}
 
 	info.Filter.Add(pieceID)
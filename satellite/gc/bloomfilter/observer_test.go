@@ -430,3 +430,85 @@ type mockOverlay struct {
 func (o *mockOverlay) ActiveNodesPieceCounts(ctx context.Context) (pieceCounts map[storj.NodeID]int64, err error) {
 	return pieceCounts, nil
 }
+
+func TestObserverGarbageCollection_Incremental(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 4,
+		UplinkCount:      1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		access := planet.Uplinks[0].Access[planet.Satellites[0].ID()]
+		accessString, err := access.Serialize()
+		require.NoError(t, err)
+
+		var loopSegments []rangedloop.Segment
+		for i := 0; i < 100; i++ {
+			var pieces metabase.Pieces
+			for n, node := range planet.StorageNodes {
+				pieces = append(pieces, metabase.Piece{Number: uint16(n), StorageNode: node.ID()})
+			}
+			loopSegments = append(loopSegments, rangedloop.Segment{
+				StreamID:      testrand.UUID(),
+				CreatedAt:     time.Now().Add(-time.Hour),
+				RootPieceID:   testrand.PieceID(),
+				EncryptedSize: 1024,
+				Pieces:        pieces,
+			})
+		}
+
+		config := planet.Satellites[0].Config.GarbageCollectionBF
+		config.AccessGrant = accessString
+		config.Bucket = "bloomfilters"
+		// the filters of the expected number of pieces don't fit into a single byte.
+		config.InitialPieces = 100
+		config.MaxBloomFilterSize = 1
+		config.Incremental = true
+
+		observers := []bloomfilter.TestingObserver{
+			bloomfilter.NewObserver(zaptest.NewLogger(t), config, planet.Satellites[0].Overlay.DB),
+			bloomfilter.NewSyncObserver(zaptest.NewLogger(t), config, planet.Satellites[0].Overlay.DB),
+		}
+
+		for _, observer := range observers {
+			t.Run(fmt.Sprintf("%T", observer), func(t *testing.T) {
+				shards := map[byte]bool{}
+				for run := 0; run < 2; run++ {
+					rangedLoop := rangedloop.NewService(zap.NewNop(), planet.Satellites[0].Config.RangedLoop,
+						&rangedlooptest.RangeSplitter{Segments: loopSegments},
+						[]rangedloop.Observer{observer.(rangedloop.Observer)})
+					_, err = rangedLoop.RunOnce(ctx)
+					require.NoError(t, err)
+
+					infos := observer.TestingRetainInfos()
+					require.Equal(t, len(planet.StorageNodes), infos.Count())
+
+					for _, node := range planet.StorageNodes {
+						info, ok := infos.Load(node.ID())
+						require.True(t, ok)
+
+						shard := info.Filter.Shard()
+						require.NotZero(t, shard.Bits)
+						shards[shard.Index] = true
+
+						expectedCount := 0
+						for _, segment := range loopSegments {
+							for _, piece := range segment.Pieces {
+								if piece.StorageNode != node.ID() {
+									continue
+								}
+								pieceID := segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+								if shard.Includes(pieceID) {
+									expectedCount++
+								}
+								require.True(t, info.Filter.Contains(pieceID))
+							}
+						}
+						require.Equal(t, expectedCount, info.Count)
+					}
+				}
+				// the shard rotates with each run.
+				require.Len(t, shards, 2)
+			})
+		}
+	})
+}
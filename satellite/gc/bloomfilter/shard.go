@@ -0,0 +1,41 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"storj.io/storj/shared/bloomfilter"
+)
+
+// shardFor returns the piece ID prefix shard which is covered by the bloom filter
+// of a node with the expected number of pieces on the given run.
+//
+// The number of shard bits is the lowest one which makes the filter of a single
+// shard fit into MaxBloomFilterSize at FalsePositiveRate. The shard index
+// rotates with each run, so all the shards are covered after 2^bits runs.
+func shardFor(config Config, numPieces int64, round int) bloomfilter.Shard {
+	if !config.Incremental || config.MaxBloomFilterSize <= 0 {
+		return bloomfilter.Shard{}
+	}
+
+	_, size := bloomfilter.OptimalParameters(numPieces, config.FalsePositiveRate, 0)
+
+	var shard bloomfilter.Shard
+	for shard.Bits < bloomfilter.MaxShardBits && int64(size)>>shard.Bits > config.MaxBloomFilterSize.Int64() {
+		shard.Bits++
+	}
+	shard.Index = byte(round % shard.Count())
+	return shard
+}
+
+// newFilter creates the bloom filter covering the shard of a node with the
+// expected number of pieces.
+func newFilter(config Config, seed byte, numPieces int64, shard bloomfilter.Shard, forcedTableSize int) *bloomfilter.Filter {
+	hashCount, tableSize := bloomfilter.OptimalParameters(numPieces>>shard.Bits, config.FalsePositiveRate, config.MaxBloomFilterSize)
+	// limit size of bloom filter to ensure we are under the limit for RPC
+	if forcedTableSize > 0 {
+		tableSize = forcedTableSize
+	}
+
+	return bloomfilter.NewExplicitSharded(seed, hashCount, tableSize, shard)
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrObjectNotFound is returned when an object doesn't exist in a Store.
+var ErrObjectNotFound = errs.Class("object not found")
+
+// Store is the storage used to exchange bloom filters between the process which
+// creates them and the process which sends them to the storage nodes.
+//
+// Object keys use '/' as separator.
+type Store interface {
+	// Prepare creates the location where the objects are stored if it doesn't exist.
+	Prepare(ctx context.Context) error
+	// List calls fn with the keys of the objects which start with the prefix,
+	// without descending into nested prefixes.
+	List(ctx context.Context, prefix string, fn func(objectKey string) error) error
+	// Download returns the content of an object.
+	Download(ctx context.Context, objectKey string) ([]byte, error)
+	// Upload starts the upload of an object which can be removed after the
+	// expiration time. Zero expiration time means that the object doesn't expire.
+	Upload(ctx context.Context, objectKey string, expires time.Time) (ObjectUpload, error)
+	// Move changes the key of an object.
+	Move(ctx context.Context, oldObjectKey, newObjectKey string) error
+	// Close releases the resources of the store.
+	Close() error
+}
+
+// ObjectUpload is an upload of an object, which is only visible once it's committed.
+type ObjectUpload interface {
+	io.Writer
+	// Commit finishes the upload.
+	Commit() error
+	// Abort cancels the upload.
+	Abort() error
+}
+
+// OpenStore opens the bucket, when directory is empty, or the local directory
+// used to exchange bloom filters.
+func OpenStore(ctx context.Context, accessGrant, bucket, directory string) (Store, error) {
+	if directory != "" {
+		return NewDirStore(directory), nil
+	}
+	return OpenBucketStore(ctx, accessGrant, bucket)
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/uplink"
+)
+
+// BucketStore is a Store backed by a bucket of a Storj satellite.
+type BucketStore struct {
+	project *uplink.Project
+	bucket  string
+}
+
+var _ Store = (*BucketStore)(nil)
+
+// OpenBucketStore opens the project of the access grant to store objects in the bucket.
+func OpenBucketStore(ctx context.Context, accessGrant, bucket string) (_ *BucketStore, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case accessGrant == "":
+		return nil, errs.New("Access Grant is not set")
+	case bucket == "":
+		return nil, errs.New("Bucket is not set")
+	}
+
+	access, err := uplink.ParseAccess(accessGrant)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := uplink.OpenProject(ctx, access)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BucketStore{
+		project: project,
+		bucket:  bucket,
+	}, nil
+}
+
+// Prepare creates the bucket if it doesn't exist.
+func (store *BucketStore) Prepare(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = store.project.EnsureBucket(ctx, store.bucket)
+	return err
+}
+
+// List calls fn with the keys of the objects which start with the prefix.
+func (store *BucketStore) List(ctx context.Context, prefix string, fn func(objectKey string) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	objects := store.project.ListObjects(ctx, store.bucket, &uplink.ListObjectsOptions{
+		System:    true,
+		Recursive: false,
+		Prefix:    prefix,
+	})
+
+	for objects.Next() {
+		object := objects.Item()
+		if object.IsPrefix {
+			continue
+		}
+
+		if err := fn(object.Key); err != nil {
+			return err
+		}
+	}
+
+	return objects.Err()
+}
+
+// Download returns the content of an object.
+func (store *BucketStore) Download(ctx context.Context, objectKey string) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	download, err := store.project.DownloadObject(ctx, store.bucket, objectKey, nil)
+	if err != nil {
+		if errors.Is(err, uplink.ErrObjectNotFound) {
+			return nil, ErrObjectNotFound.Wrap(err)
+		}
+		return nil, err
+	}
+	defer func() {
+		err = errs.Combine(err, download.Close())
+	}()
+
+	return io.ReadAll(download)
+}
+
+// Upload starts the upload of an object.
+func (store *BucketStore) Upload(ctx context.Context, objectKey string, expires time.Time) (_ ObjectUpload, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return store.project.UploadObject(ctx, store.bucket, objectKey, &uplink.UploadOptions{
+		Expires: expires,
+	})
+}
+
+// Move changes the key of an object.
+func (store *BucketStore) Move(ctx context.Context, oldObjectKey, newObjectKey string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return store.project.MoveObject(ctx, store.bucket, oldObjectKey, store.bucket, newObjectKey, nil)
+}
+
+// Close closes the project.
+func (store *BucketStore) Close() error {
+	return store.project.Close()
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// DirStore is a Store backed by a local or a shared directory.
+//
+// Objects don't expire, removing them is left to the operator.
+type DirStore struct {
+	dir string
+}
+
+var _ Store = (*DirStore)(nil)
+
+// NewDirStore returns a store for the objects in the directory.
+func NewDirStore(dir string) *DirStore {
+	return &DirStore{dir: dir}
+}
+
+// Prepare creates the directory if it doesn't exist.
+func (store *DirStore) Prepare(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return os.MkdirAll(store.dir, 0755)
+}
+
+// List calls fn with the keys of the objects which start with the prefix.
+func (store *DirStore) List(ctx context.Context, prefix string, fn func(objectKey string) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dirKey, namePrefix := "", prefix
+	if i := strings.LastIndexByte(prefix, '/'); i >= 0 {
+		dirKey, namePrefix = prefix[:i+1], prefix[i+1:]
+	}
+
+	entries, err := os.ReadDir(store.path(dirKey))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !strings.HasPrefix(entry.Name(), namePrefix) || isPartial(entry.Name()) {
+			continue
+		}
+
+		if err := fn(dirKey + entry.Name()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Download returns the content of an object.
+func (store *DirStore) Download(ctx context.Context, objectKey string) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := os.ReadFile(store.path(objectKey))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrObjectNotFound.Wrap(err)
+	}
+	return data, err
+}
+
+// Upload starts the upload of an object. The object is written into a temporary
+// file, which is renamed when the upload is committed.
+func (store *DirStore) Upload(ctx context.Context, objectKey string, expires time.Time) (_ ObjectUpload, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path := store.path(objectKey)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*"+partialSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dirUpload{file: file, path: path}, nil
+}
+
+// Move changes the key of an object.
+func (store *DirStore) Move(ctx context.Context, oldObjectKey, newObjectKey string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	newPath := store.path(newObjectKey)
+	if err := os.MkdirAll(filepath.Dir(newPath), 0755); err != nil {
+		return err
+	}
+
+	err = os.Rename(store.path(oldObjectKey), newPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrObjectNotFound.Wrap(err)
+	}
+	return err
+}
+
+// Close is a no-op.
+func (store *DirStore) Close() error {
+	return nil
+}
+
+func (store *DirStore) path(objectKey string) string {
+	return filepath.Join(store.dir, filepath.FromSlash(objectKey))
+}
+
+// partialSuffix is the suffix of the files of uncommitted uploads.
+const partialSuffix = ".partial"
+
+func isPartial(name string) bool {
+	return strings.HasSuffix(name, partialSuffix)
+}
+
+// dirUpload is an upload into a temporary file of a DirStore.
+type dirUpload struct {
+	file *os.File
+	path string
+}
+
+// Write writes data into the temporary file.
+func (upload *dirUpload) Write(data []byte) (int, error) {
+	return upload.file.Write(data)
+}
+
+// Commit syncs the temporary file and renames it to the object path.
+func (upload *dirUpload) Commit() error {
+	err := upload.file.Sync()
+	err = errs.Combine(err, upload.file.Close())
+	if err != nil {
+		return errs.Combine(err, os.Remove(upload.file.Name()))
+	}
+	return os.Rename(upload.file.Name(), upload.path)
+}
+
+// Abort removes the temporary file.
+func (upload *dirUpload) Abort() error {
+	return errs.Combine(upload.file.Close(), os.Remove(upload.file.Name()))
+}
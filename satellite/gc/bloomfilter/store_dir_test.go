@@ -0,0 +1,75 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/gc/bloomfilter"
+)
+
+func TestDirStore(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	store := bloomfilter.NewDirStore(ctx.File("bloomfilters"))
+	defer ctx.Check(store.Close)
+
+	require.NoError(t, store.Prepare(ctx))
+
+	_, err := store.Download(ctx, bloomfilter.LATEST)
+	require.True(t, bloomfilter.ErrObjectNotFound.Has(err))
+
+	list := func(prefix string) []string {
+		var keys []string
+		require.NoError(t, store.List(ctx, prefix, func(objectKey string) error {
+			keys = append(keys, objectKey)
+			return nil
+		}))
+		return keys
+	}
+
+	upload := func(objectKey, data string) {
+		upload, err := store.Upload(ctx, objectKey, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		_, err = upload.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, upload.Commit())
+	}
+
+	upload("prefix/a.zip", "a")
+	upload("prefix/b.zip", "b")
+	upload("prefix/nested/c.zip", "c")
+
+	// aborted uploads aren't visible.
+	aborted, err := store.Upload(ctx, "prefix/d.zip", time.Time{})
+	require.NoError(t, err)
+	_, err = aborted.Write([]byte("d"))
+	require.NoError(t, err)
+	require.NoError(t, aborted.Abort())
+
+	// uncommitted uploads aren't visible.
+	pending, err := store.Upload(ctx, "prefix/e.zip", time.Time{})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"prefix/a.zip", "prefix/b.zip"}, list("prefix/"))
+	require.Equal(t, []string{"prefix/b.zip"}, list("prefix/b"))
+	require.Equal(t, []string{"prefix/nested/c.zip"}, list("prefix/nested/"))
+	require.Empty(t, list("missing/"))
+
+	require.NoError(t, pending.Abort())
+
+	require.NoError(t, store.Move(ctx, "prefix/a.zip", "sent-prefix/a.zip"))
+	require.Equal(t, []string{"prefix/b.zip"}, list("prefix/"))
+
+	data, err := store.Download(ctx, "sent-prefix/a.zip")
+	require.NoError(t, err)
+	require.Equal(t, "a", string(data))
+
+	err = store.Move(ctx, "prefix/a.zip", "sent-prefix/a.zip")
+	require.True(t, bloomfilter.ErrObjectNotFound.Has(err))
+}
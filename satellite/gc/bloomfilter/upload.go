@@ -6,6 +6,7 @@ package bloomfilter
 import (
 	"archive/zip"
 	"context"
+	"errors"
 	"strconv"
 	"time"
 
@@ -16,13 +17,12 @@ import (
 	"storj.io/common/storj"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/shared/nodeidmap"
-	"storj.io/uplink"
 )
 
 // LATEST is the name of the file that contains the most recently completed bloomfilter generation prefix.
 const LATEST = "LATEST"
 
-// Upload is used to upload bloom filters to specified bucket.
+// Upload is used to upload bloom filters to specified bucket or directory.
 type Upload struct {
 	log    *zap.Logger
 	config Config
@@ -39,6 +39,8 @@ func NewUpload(log *zap.Logger, config Config) *Upload {
 // CheckConfig check configuration values.
 func (bfu *Upload) CheckConfig() error {
 	switch {
+	case bfu.config.Directory != "":
+		return nil
 	case bfu.config.AccessGrant == "":
 		return errs.New("Access Grant is not set")
 	case bfu.config.Bucket == "":
@@ -47,7 +49,7 @@ func (bfu *Upload) CheckConfig() error {
 	return nil
 }
 
-// UploadBloomFilters stores a zipfile with multiple bloom filters in a bucket.
+// UploadBloomFilters stores a zipfile with multiple bloom filters in a bucket or directory.
 func (bfu *Upload) UploadBloomFilters(ctx context.Context, creationDate time.Time, retainInfos nodeidmap.Map[*RetainInfo]) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -59,12 +61,7 @@ func (bfu *Upload) UploadBloomFilters(ctx context.Context, creationDate time.Tim
 
 	expirationTime := time.Now().Add(bfu.config.ExpireIn)
 
-	accessGrant, err := uplink.ParseAccess(bfu.config.AccessGrant)
-	if err != nil {
-		return err
-	}
-
-	project, err := uplink.OpenProject(ctx, accessGrant)
+	store, err := OpenStore(ctx, bfu.config.AccessGrant, bfu.config.Bucket, bfu.config.Directory)
 	if err != nil {
 		return err
 	}
@@ -72,29 +69,27 @@ func (bfu *Upload) UploadBloomFilters(ctx context.Context, creationDate time.Tim
 		// do cleanup in case of any error while uploading bloom filters
 		if err != nil {
 			// TODO should we drop whole bucket if cleanup will fail
-			err = errs.Combine(err, bfu.cleanup(ctx, project, prefix))
+			err = errs.Combine(err, bfu.cleanup(ctx, store, prefix))
 		}
-		err = errs.Combine(err, project.Close())
+		err = errs.Combine(err, store.Close())
 	}()
 
-	_, err = project.EnsureBucket(ctx, bfu.config.Bucket)
+	err = store.Prepare(ctx)
 	if err != nil {
 		return err
 	}
 
 	// TODO move it before segment loop is started
-	o := uplink.ListObjectsOptions{
-		Prefix: prefix + "/",
-	}
-	iterator := project.ListObjects(ctx, bfu.config.Bucket, &o)
-	for iterator.Next() {
-		if iterator.Item().IsPrefix {
-			continue
-		}
-
-		bfu.log.Warn("target bucket was not empty, stop operation and wait for next execution", zap.String("bucket", bfu.config.Bucket))
+	errNotEmpty := errs.New("not empty")
+	err = store.List(ctx, prefix+"/", func(string) error { return errNotEmpty })
+	if errors.Is(err, errNotEmpty) {
+		bfu.log.Warn("target bucket was not empty, stop operation and wait for next execution",
+			zap.String("bucket", bfu.config.Bucket), zap.String("directory", bfu.config.Directory))
 		return nil
 	}
+	if err != nil {
+		return err
+	}
 
 	infos := make([]internalpb.RetainInfo, 0, bfu.config.ZipBatchSize)
 	batchNumber := 0
@@ -109,7 +104,7 @@ func (bfu *Upload) UploadBloomFilters(ctx context.Context, creationDate time.Tim
 		})
 
 		if len(infos) == bfu.config.ZipBatchSize {
-			err = bfu.uploadPack(ctx, project, prefix, batchNumber, expirationTime, infos)
+			err = bfu.uploadPack(ctx, store, prefix, batchNumber, expirationTime, infos)
 			if err != nil {
 				return false
 			}
@@ -125,34 +120,32 @@ func (bfu *Upload) UploadBloomFilters(ctx context.Context, creationDate time.Tim
 	}
 
 	// upload rest of infos if any
-	if err := bfu.uploadPack(ctx, project, prefix, batchNumber, expirationTime, infos); err != nil {
+	if err := bfu.uploadPack(ctx, store, prefix, batchNumber, expirationTime, infos); err != nil {
 		return err
 	}
 
 	// update LATEST file
-	upload, err := project.UploadObject(ctx, bfu.config.Bucket, LATEST, nil)
+	upload, err := store.Upload(ctx, LATEST, time.Time{})
 	if err != nil {
 		return err
 	}
 	_, err = upload.Write([]byte(prefix))
 	if err != nil {
-		return err
+		return errs.Combine(err, upload.Abort())
 	}
 
 	return upload.Commit()
 }
 
 // uploadPack uploads single zip pack with multiple bloom filters.
-func (bfu *Upload) uploadPack(ctx context.Context, project *uplink.Project, prefix string, batchNumber int, expirationTime time.Time, infos []internalpb.RetainInfo) (err error) {
+func (bfu *Upload) uploadPack(ctx context.Context, store Store, prefix string, batchNumber int, expirationTime time.Time, infos []internalpb.RetainInfo) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if len(infos) == 0 {
 		return nil
 	}
 
-	upload, err := project.UploadObject(ctx, bfu.config.Bucket, prefix+"/bloomfilters-"+strconv.Itoa(batchNumber)+".zip", &uplink.UploadOptions{
-		Expires: expirationTime,
-	})
+	upload, err := store.Upload(ctx, prefix+"/bloomfilters-"+strconv.Itoa(batchNumber)+".zip", expirationTime)
 	if err != nil {
 		return err
 	}
@@ -192,26 +185,26 @@ func (bfu *Upload) uploadPack(ctx context.Context, project *uplink.Project, pref
 
 // cleanup moves all objects from root location to unique prefix. Objects will be deleted
 // automatically when expires.
-func (bfu *Upload) cleanup(ctx context.Context, project *uplink.Project, prefix string) (err error) {
+func (bfu *Upload) cleanup(ctx context.Context, store Store, prefix string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	errPrefix := "upload-error-" + time.Now().Format(time.RFC3339)
-	o := uplink.ListObjectsOptions{
-		Prefix: prefix + "/",
-	}
-	iterator := project.ListObjects(ctx, bfu.config.Bucket, &o)
 
-	for iterator.Next() {
-		item := iterator.Item()
-		if item.IsPrefix {
-			continue
-		}
+	var objectKeys []string
+	err = store.List(ctx, prefix+"/", func(objectKey string) error {
+		objectKeys = append(objectKeys, objectKey)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-		err := project.MoveObject(ctx, bfu.config.Bucket, item.Key, bfu.config.Bucket, prefix+"/"+errPrefix+"/"+item.Key, nil)
+	for _, objectKey := range objectKeys {
+		err := store.Move(ctx, objectKey, prefix+"/"+errPrefix+"/"+objectKey)
 		if err != nil {
 			return err
 		}
 	}
 
-	return iterator.Err()
+	return nil
 }
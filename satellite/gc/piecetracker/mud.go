@@ -13,5 +13,6 @@ import (
 func Module(ball *mud.Ball) {
 	mud.Provide[*Observer](ball, NewObserver)
 	mud.Implementation[[]rangedloop.Observer, *Observer](ball)
+	mud.Tag[*Observer, rangedloop.ObserverName](ball, rangedloop.ObserverName{Name: "piecetracker"})
 	config.RegisterConfig[Config](ball, "piece-tracker")
 }
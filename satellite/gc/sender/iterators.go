@@ -14,62 +14,48 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/pb"
+	"storj.io/storj/satellite/gc/bloomfilter"
 	"storj.io/storj/satellite/internalpb"
-	"storj.io/uplink"
 )
 
-// IterateZipObjectKeys checks inside the top-level of a bucket and yields the keys which look like zip files.
+// IterateZipObjectKeys checks inside the top-level of a store prefix and yields the keys which look like zip files.
 func IterateZipObjectKeys(
 	ctx context.Context,
-	project uplink.Project,
-	bucket string,
+	store bloomfilter.Store,
 	prefix string,
 	fn func(objectKey string) error,
 ) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	objects := project.ListObjects(ctx, bucket, &uplink.ListObjectsOptions{
-		System:    true,
-		Recursive: false,
-		Prefix:    prefix,
-	})
-
-	for objects.Next() {
-		object := objects.Item()
-		if object.IsPrefix {
-			continue
-		}
-
-		if !strings.HasSuffix(object.Key, ".zip") {
-			continue
+	// the keys are collected first, because fn moves the objects.
+	var objectKeys []string
+	err = store.List(ctx, prefix, func(objectKey string) error {
+		if strings.HasSuffix(objectKey, ".zip") {
+			objectKeys = append(objectKeys, objectKey)
 		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-		if err := fn(object.Key); err != nil {
+	for _, objectKey := range objectKeys {
+		if err := fn(objectKey); err != nil {
 			return err
 		}
 	}
 
-	return objects.Err()
+	return nil
 }
 
 // IterateZipContent opens a zip file at an object key and yields the files inside.
 func IterateZipContent(
 	ctx context.Context,
-	project uplink.Project,
-	bucket string,
+	store bloomfilter.Store,
 	objectKey string,
 	fn func(file *zip.File) error,
 ) (err error) {
-	download, err := project.DownloadObject(ctx, bucket, objectKey, nil)
-	if err != nil {
-		return err
-	}
-
-	defer func() {
-		err = errs.Combine(err, download.Close())
-	}()
-
-	zipContents, err := io.ReadAll(download)
+	zipContents, err := store.Download(ctx, objectKey)
 	if err != nil {
 		return err
 	}
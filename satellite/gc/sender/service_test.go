@@ -115,6 +115,68 @@ func retainTest(t *testing.T, tableSize int) {
 	})
 }
 
+func TestSendRetainFiltersDirectory(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 1,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				// stop processing at storagenode side so it can be inspected
+				config.Retain.Concurrency = 0
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		dir := ctx.Dir("bloomfilters")
+
+		// configure sender
+		gcsender := planet.Satellites[0].GarbageCollection.Sender
+		gcsender.Config.AccessGrant = ""
+		gcsender.Config.Directory = dir
+
+		// upload 1 piece
+		err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/path/1", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		// configure filter uploader
+		config := planet.Satellites[0].Config.GarbageCollectionBF
+		config.AccessGrant = ""
+		config.Directory = dir
+
+		observer := bloomfilter.NewObserver(zaptest.NewLogger(t), config, planet.Satellites[0].Overlay.DB)
+		segments := rangedloop.NewMetabaseRangeSplitter(zap.NewNop(), planet.Satellites[0].Metabase.DB, planet.Satellites[0].Config.RangedLoop)
+		rangedLoop := rangedloop.NewService(zap.NewNop(), planet.Satellites[0].Config.RangedLoop, segments,
+			[]rangedloop.Observer{observer})
+
+		_, err = rangedLoop.RunOnce(ctx)
+		require.NoError(t, err)
+
+		require.Zero(t, planet.StorageNodes[0].Storage2.BloomFilterManager.GetCreatedTime(planet.Satellites[0].ID()))
+
+		// send to storagenode
+		err = gcsender.RunOnce(ctx)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			return !planet.StorageNodes[0].Storage2.BloomFilterManager.GetCreatedTime(planet.Satellites[0].ID()).IsZero()
+		}, 10*time.Second, 50*time.Millisecond)
+
+		// check that zip was moved to sent
+		store := bloomfilter.NewDirStore(dir)
+		prefix, err := store.Download(ctx, bloomfilter.LATEST)
+		require.NoError(t, err)
+
+		var keys []string
+		err = store.List(ctx, "sent-"+string(prefix)+"/", func(objectKey string) error {
+			keys = append(keys, objectKey)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		require.Regexp(t, "sent-.*/.*.zip$", keys[0])
+	})
+}
+
 func TestSendRetainFiltersDisqualifiedNode(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount:   1,
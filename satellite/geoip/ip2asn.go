@@ -0,0 +1,18 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip
+
+// ASInfo describes the autonomous system of an IP address.
+type ASInfo struct {
+	// Number is the autonomous system number, 0 if it's unknown.
+	Number uint32
+	// Organization is the organization registered for the autonomous system.
+	Organization string
+}
+
+// IPToASN defines an abstraction for resolving the autonomous system given the string representation of an IP address.
+type IPToASN interface {
+	Close() error
+	LookupASN(address string) (ASInfo, error)
+}
@@ -0,0 +1,30 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip
+
+// MockIPToASN provides a mock solution for looking up autonomous systems in tests. This is done using the
+// last byte of the ip address and mod'ing it into the list of autonomous systems.
+type MockIPToASN []ASInfo
+
+// Close does nothing for the MockIPToASN.
+func (m MockIPToASN) Close() error {
+	return nil
+}
+
+// LookupASN accepts an IP address.
+func (m MockIPToASN) LookupASN(address string) (ASInfo, error) {
+	if len(m) == 0 {
+		return ASInfo{}, nil
+	}
+
+	ip, err := addressToIP(address)
+	if err != nil || ip == nil {
+		return ASInfo{}, err
+	}
+
+	lastBlock := int(ip[len(ip)-1])
+	return m[lastBlock%len(m)], nil
+}
+
+var _ IPToASN = MockIPToASN{}
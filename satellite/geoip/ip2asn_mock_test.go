@@ -0,0 +1,35 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/geoip"
+)
+
+func TestIP2ASNMock(t *testing.T) {
+	empty := geoip.MockIPToASN{}
+	info, err := empty.LookupASN("127.0.0.1:1234")
+	require.NoError(t, err)
+	require.Equal(t, geoip.ASInfo{}, info)
+
+	ipLookup := geoip.MockIPToASN{
+		{Number: 16509, Organization: "AMAZON-02"},
+		{Number: 24940, Organization: "Hetzner Online GmbH"},
+	}
+
+	info, err = ipLookup.LookupASN("127.0.0.1:1234")
+	require.NoError(t, err)
+	require.Equal(t, geoip.ASInfo{Number: 24940, Organization: "Hetzner Online GmbH"}, info)
+
+	info, err = ipLookup.LookupASN("127.0.0.2:1234")
+	require.NoError(t, err)
+	require.Equal(t, geoip.ASInfo{Number: 16509, Organization: "AMAZON-02"}, info)
+
+	_, err = ipLookup.LookupASN("not at all")
+	require.Error(t, err)
+}
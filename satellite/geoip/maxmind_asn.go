@@ -0,0 +1,56 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip
+
+import (
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// OpenMaxmindASNDB will use the provided filepath to open the target maxmind ASN database (GeoLite2-ASN or GeoIP2-ISP).
+func OpenMaxmindASNDB(filepath string) (*MaxmindASNDB, error) {
+	asnDB, err := maxminddb.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MaxmindASNDB{
+		db: asnDB,
+	}, nil
+}
+
+type asnInfo struct {
+	Number       uint32 `maxminddb:"autonomous_system_number"`
+	Organization string `maxminddb:"autonomous_system_organization"`
+}
+
+// MaxmindASNDB provides access to the autonomous system data via the maxmind ASN databases.
+type MaxmindASNDB struct {
+	db *maxminddb.Reader
+}
+
+var _ IPToASN = &MaxmindASNDB{}
+
+// Close will disconnect the underlying connection to the database.
+func (m *MaxmindASNDB) Close() error {
+	return m.db.Close()
+}
+
+// LookupASN accepts an IP address.
+func (m *MaxmindASNDB) LookupASN(address string) (ASInfo, error) {
+	ip, err := addressToIP(address)
+	if err != nil || ip == nil {
+		return ASInfo{}, err
+	}
+
+	info := &asnInfo{}
+	err = m.db.Lookup(ip, info)
+	if err != nil {
+		return ASInfo{}, err
+	}
+
+	return ASInfo{
+		Number:       info.Number,
+		Organization: info.Organization,
+	}, nil
+}
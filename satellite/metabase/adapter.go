@@ -77,6 +77,8 @@ type Adapter interface {
 	IterateZombieObjects(ctx context.Context, opts DeleteZombieObjects, process func(context.Context, []ObjectStream) error) (err error)
 	DeleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, opts DeleteZombieObjects) (objectsDeleted, segmentsDeleted int64, err error)
 	DeleteAllBucketObjects(ctx context.Context, opts DeleteAllBucketObjects) (deletedObjectCount, deletedSegmentCount int64, err error)
+	MoveBucketBatch(ctx context.Context, opts MoveBucket) (movedObjects int64, err error)
+	SetStreamsPlacement(ctx context.Context, opts SetStreamsPlacement) (updated int64, err error)
 
 	EnsureNodeAliases(ctx context.Context, opts EnsureNodeAliases) error
 	ListNodeAliases(ctx context.Context) (entries []NodeAliasEntry, err error)
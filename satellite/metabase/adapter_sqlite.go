@@ -0,0 +1,264 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/migrate"
+	"storj.io/storj/shared/dbutil"
+	"storj.io/storj/shared/dbutil/txutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// SQLiteDriverName is the name of the database/sql driver used by SQLiteAdapter.
+//
+// The driver is registered by importing storj.io/storj/satellite/metabase/sqlitedriver,
+// which requires cgo.
+const SQLiteDriverName = "metabase-sqlite3"
+
+// sqliteDefaultParams are connection parameters needed for concurrent access
+// to the database file.
+var sqliteDefaultParams = []string{
+	"_journal_mode=WAL",
+	"_busy_timeout=30000",
+	"_txlock=immediate",
+}
+
+// SQLiteAdapter implements Adapter for an embedded SQLite database.
+//
+// It's intended for tests and local development. Queries which are valid in
+// both dialects are shared with PostgresAdapter, sqlitedriver rewrites them
+// into the SQLite dialect.
+type SQLiteAdapter struct {
+	PostgresAdapter
+}
+
+// NewSQLiteAdapter creates a new SQLite adapter for the database file in source.
+func NewSQLiteAdapter(ctx context.Context, log *zap.Logger, source string, config Config) (*SQLiteAdapter, error) {
+	if !strings.Contains(source, "?") {
+		source += "?"
+	} else {
+		source += "&"
+	}
+	source += strings.Join(sqliteDefaultParams, "&")
+
+	rawdb, err := tagsql.Open(ctx, SQLiteDriverName, source)
+	if err != nil {
+		return nil, Error.New("unable to open sqlite database (is storj.io/storj/satellite/metabase/sqlitedriver imported?): %w", err)
+	}
+	dbutil.Configure(ctx, rawdb, "metabase", mon)
+
+	return &SQLiteAdapter{
+		PostgresAdapter{
+			log:                      log,
+			db:                       rawdb,
+			impl:                     dbutil.SQLite3,
+			connstr:                  source,
+			testingUniqueUnversioned: config.TestingUniqueUnversioned,
+		},
+	}, nil
+}
+
+// Name returns the name of the adapter.
+func (s *SQLiteAdapter) Name() string {
+	return "sqlite"
+}
+
+// Close closes the underlying database.
+func (s *SQLiteAdapter) Close() error {
+	return s.db.Close()
+}
+
+var _ Adapter = &SQLiteAdapter{}
+
+// Now returns the current time.
+func (s *SQLiteAdapter) Now(ctx context.Context) (time.Time, error) {
+	return time.Now().UTC().Truncate(time.Microsecond), nil
+}
+
+// MigrateToLatest migrates database to the latest version.
+func (s *SQLiteAdapter) MigrateToLatest(ctx context.Context) error {
+	return s.SQLiteMigration().Run(ctx, s.log.Named("migrate"))
+}
+
+// CheckVersion checks the database is the correct version.
+func (s *SQLiteAdapter) CheckVersion(ctx context.Context) error {
+	return s.SQLiteMigration().ValidateVersions(ctx, s.log)
+}
+
+// TestMigrateToLatest creates a database and applies all the migration for test purposes.
+func (s *SQLiteAdapter) TestMigrateToLatest(ctx context.Context) error {
+	return s.MigrateToLatest(ctx)
+}
+
+// SQLiteMigration returns steps needed for migrating sqlite database.
+//
+// The database is not used in production, hence it has only a single step
+// creating the latest schema.
+func (s *SQLiteAdapter) SQLiteMigration() *migrate.Migration {
+	migration := &migrate.Migration{
+		Table: "metabase_versions",
+		Steps: []*migrate.Step{
+			{
+				DB:          &s.db,
+				Description: "initial setup",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE objects (
+						project_id   BLOB    NOT NULL,
+						bucket_name  BLOB    NOT NULL,
+						object_key   BLOB    NOT NULL,
+						version      INTEGER NOT NULL,
+						stream_id    BLOB    NOT NULL,
+
+						created_at TIMESTAMP NOT NULL DEFAULT (now()),
+						expires_at TIMESTAMP,
+
+						status        INTEGER NOT NULL DEFAULT ` + statusPending + `,
+						segment_count INTEGER NOT NULL DEFAULT 0,
+
+						encrypted_metadata_nonce         BLOB DEFAULT NULL,
+						encrypted_metadata               BLOB DEFAULT NULL,
+						encrypted_metadata_encrypted_key BLOB DEFAULT NULL,
+
+						total_plain_size     INTEGER NOT NULL DEFAULT 0,
+						total_encrypted_size INTEGER NOT NULL DEFAULT 0,
+						fixed_segment_size   INTEGER NOT NULL DEFAULT 0,
+
+						encryption INTEGER NOT NULL DEFAULT 0,
+
+						zombie_deletion_deadline TIMESTAMP,
+
+						retention_mode INTEGER,
+						retain_until   TIMESTAMP,
+
+						PRIMARY KEY (project_id, bucket_name, object_key, version)
+					)`,
+					`CREATE TABLE segments (
+						stream_id BLOB    NOT NULL,
+						position  INTEGER NOT NULL,
+
+						root_piece_id       BLOB NOT NULL,
+						encrypted_key_nonce BLOB NOT NULL,
+						encrypted_key       BLOB NOT NULL,
+						remote_alias_pieces BLOB,
+
+						encrypted_size INTEGER NOT NULL,
+						plain_offset   INTEGER NOT NULL,
+						plain_size     INTEGER NOT NULL,
+
+						redundancy INTEGER NOT NULL DEFAULT 0,
+
+						inline_data BLOB DEFAULT NULL,
+
+						created_at  TIMESTAMP NOT NULL DEFAULT (now()),
+						repaired_at TIMESTAMP,
+						expires_at  TIMESTAMP,
+
+						placement      INTEGER,
+						encrypted_etag BLOB DEFAULT NULL,
+
+						PRIMARY KEY (stream_id, position)
+					)`,
+					`CREATE TABLE node_aliases (
+						node_id    BLOB    NOT NULL UNIQUE,
+						node_alias INTEGER PRIMARY KEY AUTOINCREMENT
+					)`,
+				},
+			},
+		},
+	}
+
+	if s.testingUniqueUnversioned {
+		// This is only part of testing, because we do not want to affect the production performance.
+		migration.Steps = append(migration.Steps, &migrate.Step{
+			DB:          &s.db,
+			Description: "Constraint for ensuring our metabase correctness.",
+			Version:     2,
+			Action: migrate.SQL{
+				`CREATE UNIQUE INDEX objects_one_unversioned_per_location ON objects (project_id, bucket_name, object_key) WHERE status IN ` + statusesUnversioned + `;`,
+			},
+		})
+	}
+
+	return migration
+}
+
+// sqliteQueryer is implemented by both tagsql.DB and tagsql.Tx.
+type sqliteQueryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error)
+}
+
+// sqliteTransactionAdapter implements TransactionAdapter for SQLite.
+type sqliteTransactionAdapter struct {
+	postgresTransactionAdapter
+}
+
+var _ TransactionAdapter = &sqliteTransactionAdapter{}
+
+// WithTx provides a TransactionAdapter for the context of a database transaction.
+func (s *SQLiteAdapter) WithTx(ctx context.Context, f func(context.Context, TransactionAdapter) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = f(ctx, &sqliteTransactionAdapter{
+		postgresTransactionAdapter{postgresAdapter: &s.PostgresAdapter, tx: tx},
+	})
+	if err != nil {
+		return errs.Combine(err, tx.Rollback())
+	}
+	return Error.Wrap(tx.Commit())
+}
+
+// insertRows inserts all rows from source into the table, since SQLite
+// doesn't support COPY.
+func (s *SQLiteAdapter) insertRows(ctx context.Context, table string, columns []string, source pgx.CopyFromSource) error {
+	placeholders := make([]string, len(columns))
+	for i := range placeholders {
+		placeholders[i] = "$" + strconv.Itoa(i+1)
+	}
+	query := `INSERT INTO ` + table + ` (` + strings.Join(columns, ", ") + `) VALUES (` + strings.Join(placeholders, ", ") + `)`
+
+	return txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		for source.Next() {
+			values, err := source.Values()
+			if err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, query, values...); err != nil {
+				return err
+			}
+		}
+		return source.Err()
+	})
+}
+
+// sqlitePlaceholders returns a list of count placeholders starting from $first,
+// which is used instead of array parameters. An empty list is NULL, which
+// doesn't match anything in an IN expression.
+func sqlitePlaceholders(first, count int) string {
+	if count == 0 {
+		return "NULL"
+	}
+	var b strings.Builder
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString("$" + strconv.Itoa(first+i))
+	}
+	return b.String()
+}
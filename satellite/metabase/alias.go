@@ -15,6 +15,8 @@ import (
 	"storj.io/common/storj"
 	"storj.io/storj/shared/dbutil/pgutil"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/txutil"
+	"storj.io/storj/shared/tagsql"
 )
 
 // NodeAlias is a metabase local alias for NodeID-s to reduce segment table size.
@@ -101,6 +103,29 @@ func (s *SpannerAdapter) EnsureNodeAliases(ctx context.Context, opts EnsureNodeA
 
 }
 
+// EnsureNodeAliases implements Adapter.
+func (s *SQLiteAdapter) EnsureNodeAliases(ctx context.Context, opts EnsureNodeAliases) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	unique, err := ensureNodesUniqueness(opts.Nodes)
+	if err != nil {
+		return err
+	}
+
+	return Error.Wrap(txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		for _, node := range unique {
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO node_aliases(node_id) VALUES ($1)
+				ON CONFLICT DO NOTHING
+			`, node)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
 func ensureNodesUniqueness(nodes []storj.NodeID) ([]storj.NodeID, error) {
 	unique := make([]storj.NodeID, 0, len(nodes))
 	seen := make(map[storj.NodeID]bool, len(nodes))
@@ -252,6 +277,45 @@ func (s *SpannerAdapter) GetNodeAliasEntries(ctx context.Context, opts GetNodeAl
 		})
 }
 
+// GetNodeAliasEntries implements Adapter.
+func (s *SQLiteAdapter) GetNodeAliasEntries(ctx context.Context, opts GetNodeAliasEntries) (_ []NodeAliasEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	args := make([]any, 0, len(opts.Nodes)+len(opts.Aliases))
+	for _, node := range opts.Nodes {
+		args = append(args, node)
+	}
+	for _, alias := range opts.Aliases {
+		args = append(args, alias)
+	}
+
+	var entries []NodeAliasEntry
+	rows, err := s.db.Query(ctx, `
+		SELECT node_id, node_alias
+		FROM node_aliases
+		WHERE node_id IN (`+sqlitePlaceholders(1, len(opts.Nodes))+`)
+			OR node_alias IN (`+sqlitePlaceholders(len(opts.Nodes)+1, len(opts.Aliases))+`)
+	`, args...)
+	if err != nil {
+		return nil, Error.New("GetNodeAliasEntries query: %w", err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var entry NodeAliasEntry
+		err := rows.Scan(&entry.ID, &entry.Alias)
+		if err != nil {
+			return nil, Error.New("GetNodeAliasEntries scan failed: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, Error.New("GetNodeAliasEntries scan failed: %w", err)
+	}
+
+	return entries, nil
+}
+
 // LatestNodesAliasMap returns the latest mapping between storj.NodeID and NodeAlias.
 func (db *DB) LatestNodesAliasMap(ctx context.Context) (_ *NodeAliasMap, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -509,6 +509,36 @@ func (stx *spannerTransactionAdapter) updateSegmentOffsets(ctx context.Context,
 	return nil
 }
 
+func (stx *sqliteTransactionAdapter) updateSegmentOffsets(ctx context.Context, streamID uuid.UUID, updates []segmentToCommit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// When none of the segments have changed, then the update will be skipped.
+
+	expectedOffset := int64(0)
+	for _, u := range updates {
+		if u.OldPlainOffset != expectedOffset {
+			updateResult, err := stx.tx.ExecContext(ctx, `
+				UPDATE segments SET plain_offset = $3
+				WHERE stream_id = $1 AND position = $2
+			`, streamID, u.Position, expectedOffset)
+			if err != nil {
+				return Error.New("unable to update segments offsets: %w", err)
+			}
+
+			affected, err := updateResult.RowsAffected()
+			if err != nil {
+				return Error.New("unable to get number of affected segments: %w", err)
+			}
+			if affected != 1 {
+				return Error.New("segment %v was not updated", u.Position)
+			}
+		}
+		expectedOffset += int64(u.PlainSize)
+	}
+
+	return nil
+}
+
 // deleteSegmentsNotInCommit deletes the listed segments inside the tx.
 func (ptx *postgresTransactionAdapter) deleteSegmentsNotInCommit(ctx context.Context, streamID uuid.UUID, segments []SegmentPosition) (deletedSegmentCount int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -567,6 +597,28 @@ func (stx *spannerTransactionAdapter) deleteSegmentsNotInCommit(ctx context.Cont
 	return deletedSegmentCount, nil
 }
 
+func (stx *sqliteTransactionAdapter) deleteSegmentsNotInCommit(ctx context.Context, streamID uuid.UUID, segments []SegmentPosition) (deletedSegmentCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, segment := range segments {
+		result, err := stx.tx.ExecContext(ctx, `
+			DELETE FROM segments
+			WHERE stream_id = $1 AND position = $2
+		`, streamID, segment)
+		if err != nil {
+			return 0, Error.New("unable to delete segments: %w", err)
+		}
+
+		deletedCount, err := result.RowsAffected()
+		if err != nil {
+			return 0, Error.New("unable to count deleted segments: %w", err)
+		}
+		deletedSegmentCount += deletedCount
+	}
+
+	return deletedSegmentCount, nil
+}
+
 // diffSegmentsWithDatabase matches up segment positions with their database information.
 func diffSegmentsWithDatabase(as []SegmentPosition, bs []segmentInfoForCommit, cb func(a *SegmentPosition, b *segmentInfoForCommit)) {
 	for len(as) > 0 && len(bs) > 0 {
@@ -72,6 +72,11 @@ type FinishCopyObject struct {
 	// LegalHold indicates whether the object copy is under legal hold.
 	LegalHold bool
 
+	// NewPlacement, when set, overrides the placement of the copied segments.
+	// The pieces are not moved; it is up to the repairer to move them into the
+	// new placement.
+	NewPlacement *storj.PlacementConstraint
+
 	// VerifyLimits holds a callback by which the caller can interrupt the copy
 	// if it turns out completing the copy would exceed a limit.
 	// It will be called only once.
@@ -200,6 +205,12 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 			return err
 		}
 
+		if opts.NewPlacement != nil {
+			for index := range newSegments.Placements {
+				newSegments.Placements[index] = *opts.NewPlacement
+			}
+		}
+
 		newSegments.EncryptedKeys = make([][]byte, len(opts.NewSegmentKeys))
 		newSegments.EncryptedKeyNonces = make([][]byte, len(opts.NewSegmentKeys))
 		for index, u := range opts.NewSegmentKeys {
@@ -543,6 +554,86 @@ func (stx *spannerTransactionAdapter) finalizeObjectCopy(ctx context.Context, op
 	return newObject, nil
 }
 
+func (stx *sqliteTransactionAdapter) finalizeObjectCopy(ctx context.Context, opts FinishCopyObject, nextVersion Version, newStatus ObjectStatus, sourceObject Object, copyMetadata []byte, newSegments transposedSegmentList) (newObject Object, err error) {
+	row := stx.tx.QueryRowContext(ctx, `
+			INSERT INTO objects (
+				project_id, bucket_name, object_key, version, stream_id,
+				status, expires_at, segment_count,
+				encryption,
+				encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				zombie_deletion_deadline,
+				retention_mode, retain_until
+			) VALUES (
+				$1, $2, $3, $4, $5,
+				$6, $7, $8,
+				$9,
+				$10, $11, $12,
+				$13, $14, $15,
+				null,
+				$16, $17
+			)
+			RETURNING
+				created_at`,
+		opts.ProjectID, opts.NewBucket, opts.NewEncryptedObjectKey, nextVersion, opts.NewStreamID,
+		newStatus, sourceObject.ExpiresAt, sourceObject.SegmentCount,
+		encryptionParameters{&sourceObject.Encryption},
+		copyMetadata, opts.NewEncryptedMetadataKeyNonce, opts.NewEncryptedMetadataKey,
+		sourceObject.TotalPlainSize, sourceObject.TotalEncryptedSize, sourceObject.FixedSegmentSize,
+		lockModeWrapper{retentionMode: &opts.Retention.Mode, legalHold: &opts.LegalHold},
+		timeWrapper{&opts.Retention.RetainUntil},
+	)
+
+	newObject = sourceObject
+	newObject.Version = nextVersion
+	newObject.Status = newStatus
+
+	err = row.Scan(&newObject.CreatedAt)
+	if err != nil {
+		return Object{}, Error.New("unable to copy object: %w", err)
+	}
+
+	for i := range newSegments.Positions {
+		// match pgutil.ByteaArray, which stores nil inline data as an empty value.
+		inlineData := newSegments.InlineDatas[i]
+		if inlineData == nil {
+			inlineData = []byte{}
+		}
+
+		_, err = stx.tx.ExecContext(ctx, `
+			INSERT INTO segments (
+				stream_id, position, expires_at,
+				encrypted_key_nonce, encrypted_key,
+				root_piece_id,
+				redundancy,
+				encrypted_size, plain_offset, plain_size,
+				remote_alias_pieces, placement,
+				inline_data
+			) VALUES (
+				$1, $2, $3,
+				$4, $5,
+				$6,
+				$7,
+				$8, $9, $10,
+				$11, $12,
+				$13
+			)
+		`, opts.NewStreamID, newSegments.Positions[i], newSegments.ExpiresAts[i],
+			newSegments.EncryptedKeyNonces[i], newSegments.EncryptedKeys[i],
+			newSegments.RootPieceIDs[i],
+			newSegments.RedundancySchemes[i],
+			newSegments.EncryptedSizes[i], newSegments.PlainOffsets[i], newSegments.PlainSizes[i],
+			newSegments.PiecesLists[i], newSegments.Placements[i],
+			inlineData,
+		)
+		if err != nil {
+			return Object{}, Error.New("unable to copy segments: %w", err)
+		}
+	}
+
+	return newObject, nil
+}
+
 // getObjectNonPendingExactVersion returns object information for exact version.
 //
 // Note: this returns both committed objects and delete markers.
@@ -1000,6 +1000,56 @@ func TestFinishCopyObject(t *testing.T) {
 			}.Check(ctx, t, db)
 		})
 
+		t.Run("copied segments use overridden placement", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			objStream := metabasetest.RandObjectStream()
+			copyStream := metabasetest.RandObjectStream()
+			copyStream.ProjectID = objStream.ProjectID
+
+			originalObj, _ := metabasetest.CreateTestObject{}.Run(ctx, t, db, objStream, 3)
+
+			newEncryptedKeysNonces := make([]metabase.EncryptedKeyAndNonce, originalObj.SegmentCount)
+			for i := 0; i < int(originalObj.SegmentCount); i++ {
+				newEncryptedKeysNonces[i] = metabase.EncryptedKeyAndNonce{
+					Position:          metabase.SegmentPosition{Index: uint32(i)},
+					EncryptedKeyNonce: testrand.Nonce().Bytes(),
+					EncryptedKey:      testrand.Bytes(32),
+				}
+			}
+
+			newPlacement := storj.PlacementConstraint(5)
+			copyObj, err := db.FinishCopyObject(ctx, metabase.FinishCopyObject{
+				ObjectStream:          objStream,
+				NewBucket:             copyStream.BucketName,
+				NewStreamID:           copyStream.StreamID,
+				NewEncryptedObjectKey: copyStream.ObjectKey,
+				NewSegmentKeys:        newEncryptedKeysNonces,
+				NewPlacement:          &newPlacement,
+			})
+			require.NoError(t, err)
+
+			originalSegments, err := db.ListSegments(ctx, metabase.ListSegments{
+				StreamID: originalObj.StreamID,
+			})
+			require.NoError(t, err)
+			require.Len(t, originalSegments.Segments, 3)
+			for _, segment := range originalSegments.Segments {
+				require.Equal(t, storj.DefaultPlacement, segment.Placement)
+			}
+
+			copiedSegments, err := db.ListSegments(ctx, metabase.ListSegments{
+				StreamID: copyObj.StreamID,
+			})
+			require.NoError(t, err)
+			require.Len(t, copiedSegments.Segments, 3)
+			for i, segment := range copiedSegments.Segments {
+				require.Equal(t, newPlacement, segment.Placement)
+				require.Equal(t, originalSegments.Segments[i].Pieces, segment.Pieces)
+				require.Equal(t, originalSegments.Segments[i].RootPieceID, segment.RootPieceID)
+			}
+		})
+
 		t.Run("finish copy object to same destination", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -139,6 +139,13 @@ func Open(ctx context.Context, log *zap.Logger, connstr string, config Config) (
 			for projectID := range config.TestingSpannerProjects {
 				db.projectsAdapters[projectID] = adapter
 			}
+		case dbutil.SQLite3:
+			adapter, err := NewSQLiteAdapter(ctx, log, source, config)
+			if err != nil {
+				return nil, err
+			}
+			db.db = adapter.db
+			db.adapters[i] = adapter
 		default:
 			return nil, Error.New("unsupported implementation: %s", connstr)
 		}
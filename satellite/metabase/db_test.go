@@ -71,13 +71,18 @@ func TestDisallowDoubleUnversioned(t *testing.T) {
 		err := db.TestingBatchInsertObjects(ctx, []metabase.RawObject{object})
 
 		require.True(t, pgerrcode.IsConstraintViolation(err))
-		require.ErrorContains(t, err, "objects_one_unversioned_per_location")
+		if db.Implementation() != dbutil.SQLite3 {
+			// SQLite doesn't report the name of the violated index.
+			require.ErrorContains(t, err, "objects_one_unversioned_per_location")
+		}
 
 		object.Status = metabase.DeleteMarkerUnversioned
 		err = db.TestingBatchInsertObjects(ctx, []metabase.RawObject{object})
 
 		require.True(t, pgerrcode.IsConstraintViolation(err))
-		require.ErrorContains(t, err, "objects_one_unversioned_per_location")
+		if db.Implementation() != dbutil.SQLite3 {
+			require.ErrorContains(t, err, "objects_one_unversioned_per_location")
+		}
 
 		metabasetest.Verify{
 			Objects: []metabase.RawObject{
@@ -18,6 +18,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/txutil"
 	"storj.io/storj/shared/tagsql"
 )
 
@@ -400,6 +401,56 @@ func (s *SpannerAdapter) deleteObjectExactVersionUsingObjectLock(ctx context.Con
 	return result, err
 }
 
+// DeleteObjectExactVersion deletes an exact object version.
+func (s *SQLiteAdapter) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExactVersion) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	args := []interface{}{
+		opts.ProjectID,
+		opts.BucketName,
+		opts.ObjectKey,
+		opts.Version,
+	}
+
+	var streamIDFilter string
+	if !opts.StreamIDSuffix.IsZero() {
+		streamIDFilter = "AND SUBSTR(stream_id, 9) = $5"
+		args = append(args, opts.StreamIDSuffix)
+	}
+
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		result.Removed, result.DeletedSegmentCount, err = sqliteDeleteObjects(ctx, tx, opts.ObjectLocation, `
+			(project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+			`+streamIDFilter,
+			args...)
+		if err != nil {
+			return err
+		}
+
+		// The transaction is rolled back when the object is protected.
+		if opts.ObjectLock.Enabled && len(result.Removed) == 1 && result.Removed[0].Status != Pending {
+			object := result.Removed[0]
+			if err := object.Retention.Verify(); err != nil {
+				return err
+			}
+			switch {
+			case object.LegalHold:
+				return ErrObjectLock.New(legalHoldErrMsg)
+			case isRetentionProtected(object.Retention, opts.ObjectLock.BypassGovernance, time.Now()):
+				return ErrObjectLock.New(retentionErrMsg)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if ErrObjectLock.Has(err) {
+			return DeleteObjectResult{}, err
+		}
+		return DeleteObjectResult{}, Error.Wrap(err)
+	}
+	return result, nil
+}
+
 // DeletePendingObject contains arguments necessary for deleting a pending object.
 type DeletePendingObject struct {
 	ObjectStream
@@ -505,6 +556,18 @@ func (s *SpannerAdapter) DeletePendingObject(ctx context.Context, opts DeletePen
 	return result, nil
 }
 
+// DeletePendingObject deletes a pending object with specified version and streamID.
+func (s *SQLiteAdapter) DeletePendingObject(ctx context.Context, opts DeletePendingObject) (result DeleteObjectResult, err error) {
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		result.Removed, result.DeletedSegmentCount, err = sqliteDeleteObjects(ctx, tx, opts.Location(), `
+			(project_id, bucket_name, object_key, version, stream_id) = ($1, $2, $3, $4, $5) AND
+			status = `+statusPending,
+			opts.ProjectID, opts.BucketName, opts.ObjectKey, opts.Version, opts.StreamID)
+		return err
+	})
+	return result, Error.Wrap(err)
+}
+
 // scanObjectDeletionPostgres reads in the results of an object deletion from the database.
 func scanObjectDeletionPostgres(ctx context.Context, location ObjectLocation, rows tagsql.Rows) (objects []Object, deletedSegmentCount int, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -582,6 +645,68 @@ func collectDeletedObjectsSpanner(ctx context.Context, location ObjectLocation,
 	return objects, nil
 }
 
+// sqliteDeleteObjects deletes the objects at location matching the condition
+// together with their segments. SQLite doesn't allow data modifying statements
+// in WITH clauses, hence the segments are deleted separately.
+func sqliteDeleteObjects(ctx context.Context, db sqliteQueryer, location ObjectLocation, condition string, args ...any) (objects []Object, deletedSegmentCount int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(db.QueryContext(ctx, `
+		DELETE FROM objects
+		WHERE `+condition+`
+		RETURNING`+collectDeletedObjectsSpannerFields,
+		args...,
+	))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			object := Object{}
+			object.ProjectID = location.ProjectID
+			object.BucketName = location.BucketName
+			object.ObjectKey = location.ObjectKey
+
+			err := rows.Scan(&object.Version, &object.StreamID,
+				&object.CreatedAt, &object.ExpiresAt,
+				&object.Status, &object.SegmentCount,
+				&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
+				&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+				encryptionParameters{&object.Encryption},
+				lockModeWrapper{
+					retentionMode: &object.Retention.Mode,
+					legalHold:     &object.LegalHold,
+				},
+				timeWrapper{&object.Retention.RetainUntil},
+			)
+			if err != nil {
+				return Error.New("unable to delete object: %w", err)
+			}
+			objects = append(objects, object)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, object := range objects {
+		count, err := sqliteDeleteSegments(ctx, db, object.StreamID)
+		if err != nil {
+			return nil, 0, err
+		}
+		deletedSegmentCount += count
+	}
+
+	return objects, deletedSegmentCount, nil
+}
+
+// sqliteDeleteSegments deletes all segments of the stream.
+func sqliteDeleteSegments(ctx context.Context, db sqliteQueryer, streamID uuid.UUID) (deleted int, err error) {
+	result, err := db.ExecContext(ctx, `DELETE FROM segments WHERE stream_id = $1`, streamID)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	return int(affected), err
+}
+
 // DeleteObjectLastCommitted contains arguments necessary for deleting last committed version of object.
 type DeleteObjectLastCommitted struct {
 	ObjectLocation
@@ -920,6 +1045,45 @@ func (s *SpannerAdapter) deleteObjectLastCommittedPlainUsingObjectLock(ctx conte
 	return result, nil
 }
 
+// DeleteObjectLastCommittedPlain deletes an object last committed version when
+// opts.Suspended and opts.Versioned are both false.
+func (s *SQLiteAdapter) DeleteObjectLastCommittedPlain(ctx context.Context, opts DeleteObjectLastCommitted) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		result.Removed, result.DeletedSegmentCount, err = sqliteDeleteObjects(ctx, tx, opts.ObjectLocation, `
+			(project_id, bucket_name, object_key) = ($1, $2, $3) AND
+			status = `+statusCommittedUnversioned+` AND
+			(expires_at IS NULL OR expires_at > now())`,
+			opts.ProjectID, opts.BucketName, opts.ObjectKey)
+		if err != nil {
+			return err
+		}
+
+		// The transaction is rolled back when the object is protected.
+		if opts.ObjectLock.Enabled && len(result.Removed) == 1 {
+			object := result.Removed[0]
+			if err := object.Retention.Verify(); err != nil {
+				return err
+			}
+			switch {
+			case object.LegalHold:
+				return ErrObjectLock.New(legalHoldErrMsg)
+			case isRetentionProtected(object.Retention, opts.ObjectLock.BypassGovernance, time.Now()):
+				return ErrObjectLock.New(retentionErrMsg)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if ErrObjectLock.Has(err) {
+			return DeleteObjectResult{}, err
+		}
+		return DeleteObjectResult{}, Error.Wrap(err)
+	}
+	return result, nil
+}
+
 type deleteTransactionAdapter interface {
 	PrecommitDeleteUnversionedWithNonPending(ctx context.Context, opts PrecommitDeleteUnversionedWithNonPending) (result PrecommitConstraintWithNonPendingResult, err error)
 }
@@ -1072,6 +1236,69 @@ func (s *SpannerAdapter) DeleteObjectLastCommittedSuspended(ctx context.Context,
 	return result, nil
 }
 
+// DeleteObjectLastCommittedSuspended deletes an object last committed version when opts.Suspended is true.
+func (s *SQLiteAdapter) DeleteObjectLastCommittedSuspended(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID) (result DeleteObjectResult, err error) {
+	var precommit PrecommitConstraintWithNonPendingResult
+
+	marker := Object{
+		ObjectStream: ObjectStream{
+			ProjectID:  opts.ProjectID,
+			BucketName: opts.BucketName,
+			ObjectKey:  opts.ObjectKey,
+			StreamID:   deleterMarkerStreamID,
+		},
+		Status: DeleteMarkerUnversioned,
+	}
+
+	err = s.WithTx(ctx, func(ctx context.Context, atx TransactionAdapter) (err error) {
+		result = DeleteObjectResult{}
+		stx := atx.(*sqliteTransactionAdapter)
+
+		precommit, err = stx.PrecommitDeleteUnversionedWithNonPending(ctx, PrecommitDeleteUnversionedWithNonPending{
+			ObjectLocation: opts.ObjectLocation,
+			ObjectLock:     opts.ObjectLock,
+		})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if precommit.HighestVersion == 0 || precommit.HighestNonPendingVersion == 0 {
+			// an object didn't exist in the first place
+			return ErrObjectNotFound.New("unable to delete object")
+		}
+		result.Removed = precommit.Deleted
+		result.DeletedSegmentCount = precommit.DeletedSegmentCount
+
+		row := stx.tx.QueryRowContext(ctx, `
+				INSERT INTO objects (
+					project_id, bucket_name, object_key, version, stream_id,
+					status,
+					zombie_deletion_deadline
+				)
+				VALUES (
+					$1, $2, $3, $4, $5,
+					`+statusDeleteMarkerUnversioned+`,
+					NULL
+				)
+				RETURNING
+					version,
+					created_at
+			`, opts.ProjectID, opts.BucketName, opts.ObjectKey, precommit.HighestVersion+1, deleterMarkerStreamID)
+
+		return errs.Wrap(row.Scan(&marker.Version, &marker.CreatedAt))
+	})
+	if err != nil {
+		if ErrObjectNotFound.Has(err) || ErrObjectLock.Has(err) {
+			return DeleteObjectResult{}, err
+		}
+		return DeleteObjectResult{}, Error.Wrap(err)
+	}
+
+	result.Markers = []Object{marker}
+
+	precommit.submitMetrics()
+	return result, nil
+}
+
 // DeleteObjectLastCommittedVersioned deletes an object last committed version when opts.Versioned is true.
 func (p *PostgresAdapter) DeleteObjectLastCommittedVersioned(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID) (result DeleteObjectResult, err error) {
 	row := p.db.QueryRowContext(ctx, `
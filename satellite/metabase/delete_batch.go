@@ -15,6 +15,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/shared/dbutil/pgutil"
 	"storj.io/storj/shared/dbutil/pgxutil"
+	"storj.io/storj/shared/dbutil/txutil"
 	"storj.io/storj/shared/tagsql"
 )
 
@@ -127,6 +128,47 @@ func (s *SpannerAdapter) DeleteObjectsAndSegmentsNoVerify(ctx context.Context, o
 	return objectsDeleted, segmentsDeleted, nil
 }
 
+// DeleteObjectsAndSegmentsNoVerify deletes expired objects and associated segments.
+//
+// The implementation does not do extra verification whether the stream id-s belong or belonged to the objects.
+// So, if the callers supplies objects with incorrect StreamID-s it may end up deleting unrelated segments.
+func (s *SQLiteAdapter) DeleteObjectsAndSegmentsNoVerify(ctx context.Context, objects []ObjectStream) (objectsDeleted, segmentsDeleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(objects) == 0 {
+		return 0, 0, nil
+	}
+
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		objectsDeleted, segmentsDeleted = 0, 0
+		for _, obj := range objects {
+			result, err := tx.ExecContext(ctx, `
+				DELETE FROM objects
+				WHERE (project_id, bucket_name, object_key, version, stream_id) = ($1, $2, $3, $4, $5)
+			`, obj.ProjectID, obj.BucketName, obj.ObjectKey, obj.Version, obj.StreamID)
+			if err != nil {
+				return err
+			}
+			affected, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+			objectsDeleted += affected
+
+			deleted, err := sqliteDeleteSegments(ctx, tx, obj.StreamID)
+			if err != nil {
+				return err
+			}
+			segmentsDeleted += int64(deleted)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, Error.New("unable to delete expired objects: %w", err)
+	}
+	return objectsDeleted, segmentsDeleted, nil
+}
+
 // DeleteInactiveObjectsAndSegments deletes inactive objects and associated segments.
 func (p *PostgresAdapter) DeleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, opts DeleteZombieObjects) (objectsDeleted, segmentsDeleted int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -254,6 +296,54 @@ func (s *SpannerAdapter) DeleteInactiveObjectsAndSegments(ctx context.Context, o
 	return objectsDeleted, segmentsDeleted, nil
 }
 
+// DeleteInactiveObjectsAndSegments deletes inactive objects and associated segments.
+func (s *SQLiteAdapter) DeleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, opts DeleteZombieObjects) (objectsDeleted, segmentsDeleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(objects) == 0 {
+		return 0, 0, nil
+	}
+
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		objectsDeleted, segmentsDeleted = 0, 0
+		for _, obj := range objects {
+			result, err := tx.ExecContext(ctx, `
+				DELETE FROM objects
+				WHERE
+					(project_id, bucket_name, object_key, version, stream_id) = ($1, $2, $3, $4, $5)
+					AND NOT EXISTS (
+						SELECT 1 FROM segments
+						WHERE
+							segments.stream_id = objects.stream_id
+							AND segments.created_at > $6
+					)
+			`, obj.ProjectID, obj.BucketName, obj.ObjectKey, obj.Version, obj.StreamID, opts.InactiveDeadline)
+			if err != nil {
+				return err
+			}
+			affected, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if affected == 0 {
+				continue
+			}
+			objectsDeleted += affected
+
+			deleted, err := sqliteDeleteSegments(ctx, tx, obj.StreamID)
+			if err != nil {
+				return err
+			}
+			segmentsDeleted += int64(deleted)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, Error.New("unable to delete zombie objects: %w", err)
+	}
+	return objectsDeleted, segmentsDeleted, nil
+}
+
 // processObjectStreamBatches scans and processes object streams in batches of batchSize based on the query.
 func (p *PostgresAdapter) processObjectStreamBatches(ctx context.Context, asOfSystemInterval time.Duration, batchSize int, stmt postgresStatement, process func(context.Context, []ObjectStream) error) (err error) {
 	return Error.Wrap(withRows(
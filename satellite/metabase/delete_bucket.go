@@ -8,7 +8,10 @@ import (
 
 	"cloud.google.com/go/spanner"
 
+	"storj.io/common/uuid"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/txutil"
+	"storj.io/storj/shared/tagsql"
 )
 
 const (
@@ -173,3 +176,53 @@ func (s *SpannerAdapter) DeleteAllBucketObjects(ctx context.Context, opts Delete
 
 	return deletedObjectCount, deletedSegmentCount, nil
 }
+
+// DeleteAllBucketObjects deletes all objects in the specified bucket.
+// Deletion performs in batches, so in case of error while processing,
+// this method will return the number of objects deleted to the moment
+// when an error occurs.
+func (s *SQLiteAdapter) DeleteAllBucketObjects(ctx context.Context, opts DeleteAllBucketObjects) (deletedObjectCount, deletedSegmentCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		deletedObjectCount, deletedSegmentCount = 0, 0
+
+		var streamIDs []uuid.UUID
+		err := withRows(tx.QueryContext(ctx, `
+			DELETE FROM objects
+			WHERE (project_id, bucket_name) = ($1, $2) AND
+				stream_id IN (
+					SELECT stream_id FROM objects
+					WHERE (project_id, bucket_name) = ($1, $2)
+					LIMIT $3
+				)
+			RETURNING stream_id, segment_count
+		`, opts.Bucket.ProjectID, opts.Bucket.BucketName, opts.BatchSize))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var streamID uuid.UUID
+				var segmentCount int64
+				if err := rows.Scan(&streamID, &segmentCount); err != nil {
+					return err
+				}
+				streamIDs = append(streamIDs, streamID)
+				deletedObjectCount++
+				deletedSegmentCount += segmentCount
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, streamID := range streamIDs {
+			if _, err := sqliteDeleteSegments(ctx, tx, streamID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, Error.Wrap(err)
+	}
+	return deletedObjectCount, deletedSegmentCount, nil
+}
@@ -5,6 +5,7 @@ package metabase
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/spanner"
@@ -178,3 +179,47 @@ func (s *SpannerAdapter) ListBucketsStreamIDs(ctx context.Context, opts ListBuck
 	}
 	return result, nil
 }
+
+// ListBucketsStreamIDs lists the streamIDs of a list of buckets.
+func (s *SQLiteAdapter) ListBucketsStreamIDs(ctx context.Context, opts ListBucketsStreamIDs, bucketNamesBytes [][]byte, projectIDs []uuid.UUID) (result ListBucketsStreamIDsResult, err error) {
+	if len(projectIDs) == 0 {
+		return ListBucketsStreamIDsResult{}, nil
+	}
+
+	args := []any{opts.Limit, opts.CursorBucket.ProjectID, opts.CursorBucket.BucketName, opts.CursorStreamID}
+	buckets := make([]string, len(projectIDs))
+	for i := range projectIDs {
+		buckets[i] = "(" + sqlitePlaceholders(len(args)+1, 2) + ")"
+		args = append(args, projectIDs[i], bucketNamesBytes[i])
+	}
+
+	err = withRows(s.db.QueryContext(ctx, `
+		SELECT DISTINCT project_id, bucket_name, stream_id, segment_count
+		FROM objects
+		WHERE
+			(project_id, bucket_name, stream_id) > ($2, $3, $4) AND
+			(project_id, bucket_name) IN (VALUES `+strings.Join(buckets, ", ")+`)
+		ORDER BY project_id, bucket_name, stream_id ASC
+		LIMIT $1
+	`, args...))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var streamID uuid.UUID
+			var count int
+			err := rows.Scan(
+				&result.LastBucket.ProjectID,
+				&result.LastBucket.BucketName,
+				&streamID,
+				&count,
+			)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			result.addStreamID(streamID, count)
+		}
+		return nil
+	})
+	if err != nil {
+		return ListBucketsStreamIDsResult{}, err
+	}
+	return result, nil
+}
@@ -205,6 +205,36 @@ func (step DeleteAllBucketObjects) Check(ctx *testcontext.Context, t testing.TB,
 	checkError(t, err, step.ErrClass, step.ErrText)
 }
 
+// MoveBucket is for testing metabase.MoveBucket.
+type MoveBucket struct {
+	Opts     metabase.MoveBucket
+	Moved    int64
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step MoveBucket) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	moved, err := db.MoveBucket(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	require.Equal(t, step.Moved, moved)
+}
+
+// SetStreamsPlacement is for testing metabase.SetStreamsPlacement.
+type SetStreamsPlacement struct {
+	Opts     metabase.SetStreamsPlacement
+	Updated  int64
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step SetStreamsPlacement) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	updated, err := db.SetStreamsPlacement(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	require.Equal(t, step.Updated, updated)
+}
+
 // UpdateObjectLastCommittedMetadata is for testing metabase.UpdateObjectLastCommittedMetadata.
 type UpdateObjectLastCommittedMetadata struct {
 	Opts     metabase.UpdateObjectLastCommittedMetadata
@@ -14,8 +14,10 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/dbschema"
 	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/dbutil/sqliteutil"
 )
 
 func TestMigration(t *testing.T) {
@@ -57,7 +59,11 @@ func schemaFromMigration(t *testing.T, ctx *testcontext.Context, dbinfo satellit
 	err = migration(ctx, db)
 	require.NoError(t, err)
 
-	scheme, err = pgutil.QuerySnapshot(ctx, db.UnderlyingTagSQL())
+	if db.Implementation() == dbutil.SQLite3 {
+		scheme, err = sqliteutil.QuerySnapshot(ctx, db.UnderlyingTagSQL())
+	} else {
+		scheme, err = pgutil.QuerySnapshot(ctx, db.UnderlyingTagSQL())
+	}
 	require.NoError(t, err)
 
 	return scheme
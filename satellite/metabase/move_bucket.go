@@ -0,0 +1,216 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	pgxerrcode "github.com/jackc/pgerrcode"
+	"google.golang.org/grpc/codes"
+
+	"storj.io/storj/shared/dbutil/pgutil/pgerrcode"
+	"storj.io/storj/shared/dbutil/spannerutil"
+)
+
+// moveBucketBatchSize is the default number of objects moved in a single
+// transaction.
+const moveBucketBatchSize = 1000
+
+// MoveBucket contains arguments for moving all objects of a bucket into
+// a different bucket location.
+type MoveBucket struct {
+	From BucketLocation
+	To   BucketLocation
+
+	// BatchSize is the number of objects moved in a single transaction.
+	BatchSize int
+}
+
+// Verify verifies move bucket fields.
+func (opts MoveBucket) Verify() error {
+	if err := opts.From.Verify(); err != nil {
+		return err
+	}
+	if err := opts.To.Verify(); err != nil {
+		return err
+	}
+	if opts.From == opts.To {
+		return ErrInvalidRequest.New("source and destination bucket are the same")
+	}
+	if opts.BatchSize < 0 {
+		return ErrInvalidRequest.New("BatchSize is negative")
+	}
+	return nil
+}
+
+// MoveBucket moves all objects, including pending objects, of a bucket into
+// a different bucket location. The destination may be in a different project.
+//
+// Only object rows are changed, segments are identified by the stream id and
+// are left untouched. Objects are moved in batches, each in its own
+// transaction, so the move is idempotent: when it's interrupted, calling it
+// again moves the remaining objects. It fails with ErrObjectAlreadyExists when
+// the destination already contains a moved object version.
+func (db *DB) MoveBucket(ctx context.Context, opts MoveBucket) (movedObjects int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return 0, err
+	}
+	if opts.BatchSize == 0 {
+		opts.BatchSize = moveBucketBatchSize
+	}
+
+	adapter := db.ChooseAdapter(opts.From.ProjectID)
+	if adapter != db.ChooseAdapter(opts.To.ProjectID) {
+		return 0, ErrInvalidRequest.New("moving a bucket between projects stored in different databases is not supported")
+	}
+
+	for {
+		moved, err := adapter.MoveBucketBatch(ctx, opts)
+		movedObjects += moved
+		mon.Meter("move_bucket_objects").Mark64(moved)
+		if err != nil {
+			return movedObjects, err
+		}
+		if moved < int64(opts.BatchSize) {
+			return movedObjects, nil
+		}
+	}
+}
+
+// MoveBucketBatch moves up to opts.BatchSize objects of a bucket into a different bucket location.
+func (p *PostgresAdapter) MoveBucketBatch(ctx context.Context, opts MoveBucket) (movedObjects int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := p.db.ExecContext(ctx, `
+		WITH batch AS (
+			SELECT object_key, version FROM objects
+			WHERE (project_id, bucket_name) = ($1, $2)
+			ORDER BY object_key, version
+			LIMIT $5
+		)
+		UPDATE objects
+		SET project_id = $3, bucket_name = $4
+		WHERE (project_id, bucket_name) = ($1, $2)
+			AND (object_key, version) IN (SELECT object_key, version FROM batch)
+	`, opts.From.ProjectID, opts.From.BucketName, opts.To.ProjectID, opts.To.BucketName, opts.BatchSize)
+	if err != nil {
+		if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
+			return 0, ErrObjectAlreadyExists.New("destination bucket contains a moved object")
+		}
+		return 0, Error.New("unable to move objects: %w", err)
+	}
+
+	movedObjects, err = result.RowsAffected()
+	if err != nil {
+		return 0, Error.New("unable to move objects: %w", err)
+	}
+	return movedObjects, nil
+}
+
+// MoveBucketBatch moves up to opts.BatchSize objects of a bucket into a different bucket location.
+//
+// Spanner doesn't allow updating primary key columns, so objects are
+// re-inserted under the new location and the old rows are deleted.
+func (s *SpannerAdapter) MoveBucketBatch(ctx context.Context, opts MoveBucket) (movedObjects int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	type objectVersion struct {
+		ObjectKey ObjectKey
+		Version   Version
+	}
+
+	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		movedObjects = 0
+
+		batch, err := spannerutil.CollectRows(tx.Query(ctx, spanner.Statement{
+			SQL: `
+				SELECT object_key, version FROM objects
+				WHERE project_id = @project_id AND bucket_name = @bucket_name
+				ORDER BY object_key, version
+				LIMIT @limit
+			`,
+			Params: map[string]interface{}{
+				"project_id":  opts.From.ProjectID,
+				"bucket_name": opts.From.BucketName,
+				"limit":       int64(opts.BatchSize),
+			},
+		}), func(row *spanner.Row, item *objectVersion) error {
+			return row.Columns(&item.ObjectKey, &item.Version)
+		})
+		if err != nil {
+			return Error.New("unable to query objects: %w", err)
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		params := map[string]interface{}{
+			"from_project_id":  opts.From.ProjectID,
+			"from_bucket_name": opts.From.BucketName,
+			"to_project_id":    opts.To.ProjectID,
+			"to_bucket_name":   opts.To.BucketName,
+			"objects":          batch,
+		}
+
+		counts, err := tx.BatchUpdate(ctx, []spanner.Statement{
+			{
+				SQL: `
+					INSERT INTO objects (
+						project_id, bucket_name, object_key, version, stream_id,
+						created_at, expires_at,
+						status, segment_count,
+						encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+						total_plain_size, total_encrypted_size, fixed_segment_size,
+						encryption,
+						zombie_deletion_deadline,
+						retention_mode, retain_until
+					)
+					SELECT
+						@to_project_id, @to_bucket_name, object_key, version, stream_id,
+						created_at, expires_at,
+						status, segment_count,
+						encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+						total_plain_size, total_encrypted_size, fixed_segment_size,
+						encryption,
+						zombie_deletion_deadline,
+						retention_mode, retain_until
+					FROM objects
+					WHERE project_id = @from_project_id AND bucket_name = @from_bucket_name
+						AND STRUCT<ObjectKey BYTES, Version INT64>(object_key, version) IN UNNEST(@objects)
+				`,
+				Params: params,
+			},
+			{
+				SQL: `
+					DELETE FROM objects
+					WHERE project_id = @from_project_id AND bucket_name = @from_bucket_name
+						AND STRUCT<ObjectKey BYTES, Version INT64>(object_key, version) IN UNNEST(@objects)
+				`,
+				Params: params,
+			},
+		})
+		if err != nil {
+			if spanner.ErrCode(err) == codes.AlreadyExists {
+				return ErrObjectAlreadyExists.New("destination bucket contains a moved object")
+			}
+			return Error.New("unable to move objects: %w", err)
+		}
+		if counts[0] != counts[1] {
+			return Error.New("objects changed while moving: inserted %d, deleted %d", counts[0], counts[1])
+		}
+
+		movedObjects = counts[0]
+		return nil
+	})
+	if err != nil {
+		if ErrObjectAlreadyExists.Has(err) {
+			return 0, err
+		}
+		return 0, Error.Wrap(err)
+	}
+	return movedObjects, nil
+}
@@ -0,0 +1,202 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestMoveBucket(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		from := obj.Location().Bucket()
+
+		t.Run("invalid request", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.MoveBucket{
+				Opts:     metabase.MoveBucket{To: from},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.MoveBucket{
+				Opts:     metabase.MoveBucket{From: from, To: metabase.BucketLocation{ProjectID: obj.ProjectID}},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.MoveBucket{
+				Opts:     metabase.MoveBucket{From: from, To: from},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "source and destination bucket are the same",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("empty bucket", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.MoveBucket{
+				Opts: metabase.MoveBucket{
+					From: from,
+					To:   metabase.BucketLocation{ProjectID: obj.ProjectID, BucketName: "renamed"},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("destination contains moved object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			source := metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			// same key and version in the destination.
+			other := obj
+			other.ProjectID = testrand.UUID()
+			other.StreamID = testrand.UUID()
+			destination := metabasetest.CreateObject(ctx, t, db, other, 0)
+
+			metabasetest.MoveBucket{
+				Opts: metabase.MoveBucket{
+					From: from,
+					To:   other.Location().Bucket(),
+				},
+				ErrClass: &metabase.ErrObjectAlreadyExists,
+			}.Check(ctx, t, db)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(source),
+					metabase.RawObject(destination),
+				},
+				Segments: metabasetest.SegmentsToRaw(segments),
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("batches and resume", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			to := metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: obj.BucketName}
+
+			for i := 0; i < 3; i++ {
+				object := obj
+				object.ObjectKey = metabasetest.RandObjectKey()
+				object.StreamID = testrand.UUID()
+				metabasetest.CreateObject(ctx, t, db, object, 0)
+			}
+
+			// an object which was moved by an interrupted move.
+			moved := obj
+			moved.ProjectID = to.ProjectID
+			moved.ObjectKey = metabasetest.RandObjectKey()
+			moved.StreamID = testrand.UUID()
+			metabasetest.CreateObject(ctx, t, db, moved, 0)
+
+			metabasetest.MoveBucket{
+				Opts: metabase.MoveBucket{
+					From:      from,
+					To:        to,
+					BatchSize: 2,
+				},
+				Moved: 3,
+			}.Check(ctx, t, db)
+
+			// moving again is a no-op.
+			metabasetest.MoveBucket{
+				Opts: metabase.MoveBucket{
+					From: from,
+					To:   to,
+				},
+			}.Check(ctx, t, db)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 4)
+			for _, object := range objects {
+				require.Equal(t, to, object.Location().Bucket())
+			}
+		})
+
+		for _, tc := range []struct {
+			name string
+			to   metabase.BucketLocation
+		}{
+			{"rename", metabase.BucketLocation{ProjectID: obj.ProjectID, BucketName: "renamed"}},
+			{"another project", metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: obj.BucketName}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+				first := metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+				pending := obj
+				pending.ObjectKey = metabasetest.RandObjectKey()
+				pending.StreamID = testrand.UUID()
+				metabasetest.BeginObjectExactVersion{
+					Opts: metabase.BeginObjectExactVersion{
+						ObjectStream: pending,
+						Encryption:   metabasetest.DefaultEncryption,
+					},
+				}.Check(ctx, t, db)
+
+				unrelated := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+				objects, err := db.TestingAllObjects(ctx)
+				require.NoError(t, err)
+				segments, err := db.TestingAllSegments(ctx)
+				require.NoError(t, err)
+
+				metabasetest.MoveBucket{
+					Opts: metabase.MoveBucket{
+						From: from,
+						To:   tc.to,
+					},
+					Moved: 2,
+				}.Check(ctx, t, db)
+
+				var expected []metabase.RawObject
+				for _, object := range objects {
+					if object.StreamID != unrelated.StreamID {
+						object.ProjectID = tc.to.ProjectID
+						object.BucketName = tc.to.BucketName
+					}
+					expected = append(expected, metabase.RawObject(object))
+				}
+
+				metabasetest.Verify{
+					Objects:  expected,
+					Segments: metabasetest.SegmentsToRaw(segments),
+				}.Check(ctx, t, db)
+
+				movedFirst := first.ObjectStream
+				movedFirst.ProjectID = tc.to.ProjectID
+				movedFirst.BucketName = tc.to.BucketName
+				metabasetest.GetObjectExactVersion{
+					Opts: metabase.GetObjectExactVersion{
+						ObjectLocation: movedFirst.Location(),
+						Version:        movedFirst.Version,
+					},
+					Result: func() metabase.Object {
+						object := first
+						object.ObjectStream = movedFirst
+						return object
+					}(),
+				}.Check(ctx, t, db)
+			})
+		}
+	})
+}
@@ -508,6 +508,69 @@ func (stx *spannerTransactionAdapter) objectMove(ctx context.Context, opts Finis
 	return oldStatus, segmentsCount, len(encryptedMetadata) > 0, streamID, info, nil
 }
 
+func (stx *sqliteTransactionAdapter) objectMove(ctx context.Context, opts FinishMoveObject, newStatus ObjectStatus, nextVersion Version) (oldStatus ObjectStatus, segmentsCount int, hasMetadata bool, streamID uuid.UUID, info lockInfo, err error) {
+	err = stx.tx.QueryRowContext(ctx, `
+		SELECT status, expires_at, retention_mode, retain_until
+		FROM objects
+		WHERE (project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+	`, opts.ProjectID, opts.BucketName, opts.ObjectKey, opts.Version).Scan(
+		&oldStatus,
+		&info.objectExpiresAt,
+		lockModeWrapper{retentionMode: &info.retention.Mode, legalHold: &info.legalHold},
+		timeWrapper{&info.retention.RetainUntil},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, 0, false, uuid.UUID{}, lockInfo{}, ErrObjectNotFound.New("object not found")
+		}
+		return 0, 0, false, uuid.UUID{}, lockInfo{}, Error.New("unable to read old object record: %w", err)
+	}
+
+	err = stx.tx.QueryRowContext(ctx, `
+		UPDATE objects SET
+			bucket_name = $1,
+			object_key = $2,
+			version = $10,
+			status = $9,
+			encrypted_metadata_encrypted_key =
+				CASE WHEN encrypted_metadata IS NOT NULL
+					THEN $3
+					ELSE encrypted_metadata_encrypted_key
+				END,
+			encrypted_metadata_nonce =
+				CASE WHEN encrypted_metadata IS NOT NULL
+					THEN $4
+					ELSE encrypted_metadata_nonce
+				END,
+			retention_mode = $11,
+			retain_until = $12
+		WHERE
+			(project_id, bucket_name, object_key, version) = ($5, $6, $7, $8)
+		RETURNING
+			segment_count,
+			encrypted_metadata IS NOT NULL AND LENGTH(encrypted_metadata) > 0 AS has_metadata,
+			stream_id
+		`,
+		opts.NewBucket,
+		opts.NewEncryptedObjectKey,
+		opts.NewEncryptedMetadataKey,
+		opts.NewEncryptedMetadataKeyNonce,
+		opts.ProjectID, opts.BucketName, opts.ObjectKey, opts.Version,
+		newStatus,
+		nextVersion,
+		lockModeWrapper{retentionMode: &opts.Retention.Mode, legalHold: &opts.LegalHold},
+		timeWrapper{&opts.Retention.RetainUntil},
+	).Scan(
+		&segmentsCount,
+		&hasMetadata,
+		&streamID,
+	)
+	if err != nil {
+		return 0, 0, false, uuid.UUID{}, lockInfo{}, Error.New("unable to update object: %w", err)
+	}
+	return oldStatus, segmentsCount, hasMetadata, streamID, info, nil
+}
+
 func (ptx *postgresTransactionAdapter) objectMoveEncryption(ctx context.Context, opts FinishMoveObject, positions []int64, encryptedKeys [][]byte, encryptedKeyNonces [][]byte) (numAffected int64, err error) {
 	updateResult, err := ptx.tx.ExecContext(ctx, `
 			UPDATE segments SET
@@ -562,3 +625,26 @@ func (stx *spannerTransactionAdapter) objectMoveEncryption(ctx context.Context,
 	}
 	return totalFound, nil
 }
+
+func (stx *sqliteTransactionAdapter) objectMoveEncryption(ctx context.Context, opts FinishMoveObject, positions []int64, encryptedKeys [][]byte, encryptedKeyNonces [][]byte) (numAffected int64, err error) {
+	for i := range positions {
+		// nil keys are stored as empty values, to match pgutil.ByteaArray.
+		updateResult, err := stx.tx.ExecContext(ctx, `
+			UPDATE segments SET
+				encrypted_key_nonce = COALESCE($3, X''),
+				encrypted_key = COALESCE($4, X'')
+			WHERE
+				stream_id = $1 AND
+				position = $2
+		`, opts.StreamID, positions[i], encryptedKeyNonces[i], encryptedKeys[i])
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+		affected, err := updateResult.RowsAffected()
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+		numAffected += affected
+	}
+	return numAffected, nil
+}
@@ -445,6 +445,43 @@ func (stx *spannerTransactionAdapter) precommitDeleteUnversioned(ctx context.Con
 	return result, Error.Wrap(err)
 }
 
+func (stx *sqliteTransactionAdapter) precommitDeleteUnversioned(ctx context.Context, loc ObjectLocation) (result PrecommitConstraintResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result.HighestVersion, err = stx.precommitQueryHighest(ctx, loc)
+	if err != nil {
+		return PrecommitConstraintResult{}, err
+	}
+
+	result.Deleted, result.DeletedSegmentCount, err = sqliteDeleteObjects(ctx, stx.tx, loc, `
+		(project_id, bucket_name, object_key) = ($1, $2, $3)
+		AND status IN `+statusesUnversioned,
+		loc.ProjectID, loc.BucketName, loc.ObjectKey)
+	if err != nil {
+		return PrecommitConstraintResult{}, Error.Wrap(err)
+	}
+	result.DeletedObjectCount = len(result.Deleted)
+
+	if len(result.Deleted) > 1 {
+		logMultipleCommittedVersionsError(stx.postgresAdapter.log, loc)
+		return result, Error.New(multipleCommittedVersionsErrMsg)
+	}
+
+	if len(result.Deleted) == 1 {
+		// Avoid deleting if Object Lock restrictions are imposed.
+		// This should never occur unless we have a bug allowing
+		// such settings to exist on unversioned objects.
+		switch {
+		case result.Deleted[0].LegalHold:
+			return PrecommitConstraintResult{}, ErrObjectLock.New(legalHoldErrMsg)
+		case result.Deleted[0].Retention.ActiveNow():
+			return PrecommitConstraintResult{}, ErrObjectLock.New(retentionErrMsg)
+		}
+	}
+
+	return result, nil
+}
+
 // PrecommitConstraintWithNonPendingResult contains the result for enforcing precommit constraint.
 type PrecommitConstraintWithNonPendingResult struct {
 	Deleted []Object
@@ -1005,3 +1042,55 @@ func (stx *spannerTransactionAdapter) precommitDeleteUnversionedWithNonPendingUs
 
 	return result, nil
 }
+
+// PrecommitDeleteUnversionedWithNonPending deletes the unversioned object at loc and also returns the highest version and highest committed version.
+func (stx *sqliteTransactionAdapter) PrecommitDeleteUnversionedWithNonPending(ctx context.Context, opts PrecommitDeleteUnversionedWithNonPending) (result PrecommitConstraintWithNonPendingResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return PrecommitConstraintWithNonPendingResult{}, Error.Wrap(err)
+	}
+
+	err = stx.tx.QueryRowContext(ctx, `
+		SELECT
+			coalesce(max(version), 0),
+			coalesce(max(CASE WHEN status <> `+statusPending+` THEN version END), 0)
+		FROM objects
+		WHERE (project_id, bucket_name, object_key) = ($1, $2, $3)
+	`, opts.ProjectID, opts.BucketName, opts.ObjectKey).
+		Scan(&result.HighestVersion, &result.HighestNonPendingVersion)
+	if err != nil {
+		return PrecommitConstraintWithNonPendingResult{}, Error.Wrap(err)
+	}
+
+	result.Deleted, result.DeletedSegmentCount, err = sqliteDeleteObjects(ctx, stx.tx, opts.ObjectLocation, `
+		(project_id, bucket_name, object_key) = ($1, $2, $3)
+		AND status IN `+statusesUnversioned,
+		opts.ProjectID, opts.BucketName, opts.ObjectKey)
+	if err != nil {
+		return PrecommitConstraintWithNonPendingResult{}, Error.Wrap(err)
+	}
+	result.DeletedObjectCount = len(result.Deleted)
+
+	if len(result.Deleted) > 1 {
+		logMultipleCommittedVersionsError(stx.postgresAdapter.log, opts.ObjectLocation)
+		return result, Error.New(multipleCommittedVersionsErrMsg)
+	}
+
+	// The whole transaction is rolled back when the object is protected,
+	// hence it's fine to check the restrictions after deleting it.
+	if opts.ObjectLock.Enabled && len(result.Deleted) == 1 {
+		deleted := result.Deleted[0]
+		if err = deleted.Retention.Verify(); err != nil {
+			return PrecommitConstraintWithNonPendingResult{}, Error.Wrap(err)
+		}
+		switch {
+		case deleted.LegalHold:
+			return PrecommitConstraintWithNonPendingResult{}, ErrObjectLock.New(legalHoldErrMsg)
+		case isRetentionProtected(deleted.Retention, opts.ObjectLock.BypassGovernance, time.Now()):
+			return PrecommitConstraintWithNonPendingResult{}, ErrObjectLock.New(retentionErrMsg)
+		}
+	}
+
+	return result, nil
+}
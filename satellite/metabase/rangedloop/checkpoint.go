@@ -0,0 +1,303 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// CheckpointObserver is an Observer which can serialize and restore its state,
+// so a loop iteration interrupted by a restart continues where it left off.
+//
+// Observers which don't implement it are excluded from a resumed iteration.
+type CheckpointObserver interface {
+	Observer
+
+	// CheckpointState serializes the state created by Start.
+	CheckpointState(ctx context.Context) ([]byte, error)
+
+	// Resume is called instead of Start when the loop continues an interrupted
+	// iteration, with the state returned by CheckpointState.
+	Resume(ctx context.Context, startTime time.Time, state []byte) error
+
+	// CheckpointPartial serializes the state of a Partial created by Fork or
+	// ResumePartial. It's called concurrently for different partials, but never
+	// concurrently with Process on the same partial.
+	CheckpointPartial(ctx context.Context, partial Partial) ([]byte, error)
+
+	// ResumePartial recreates a Partial from the state returned by
+	// CheckpointPartial. It's called after Resume instead of Fork. It is not
+	// called concurrently.
+	ResumePartial(ctx context.Context, state []byte) (Partial, error)
+}
+
+// ResumableRangeSplitter is a RangeSplitter which can continue iterating over
+// the ranges of an interrupted loop iteration.
+type ResumableRangeSplitter interface {
+	RangeSplitter
+
+	// CreateResumedRanges creates a provider for each of the ranges, which skips
+	// the segments up to and including the cursor of the range, if it isn't nil.
+	CreateResumedRanges(ranges []UUIDRange, cursors []*Cursor, batchSize int) ([]SegmentProvider, error)
+}
+
+// Cursor is the position of the last processed segment in a range.
+type Cursor struct {
+	StreamID uuid.UUID                `json:"streamId"`
+	Position metabase.SegmentPosition `json:"position"`
+}
+
+// After returns true if the segment comes after the cursor.
+func (cursor *Cursor) After(segment *Segment) bool {
+	switch cursor.StreamID.Compare(segment.StreamID) {
+	case -1:
+		return true
+	case 0:
+		return cursor.Position.Less(segment.Position)
+	default:
+		return false
+	}
+}
+
+// loopCheckpoint contains the state of a loop iteration which is shared by all
+// the ranges.
+type loopCheckpoint struct {
+	StartTime time.Time `json:"startTime"`
+	// Observers contains the names of all the observers of the iteration.
+	Observers []string `json:"observers"`
+	// States contains the state of the observers which implement CheckpointObserver.
+	States map[string][]byte `json:"states"`
+	Ranges []UUIDRange       `json:"ranges"`
+}
+
+// rangeCheckpoint contains the progress of a single range.
+type rangeCheckpoint struct {
+	Cursor *Cursor `json:"cursor"`
+	Done   bool    `json:"done"`
+	// Partials contains the state of the partials of the observers which
+	// implement CheckpointObserver.
+	Partials map[string][]byte `json:"partials"`
+}
+
+// checkpointer stores the progress of a loop iteration in a directory.
+type checkpointer struct {
+	log *zap.Logger
+	dir string
+	// key identifies the set of observers of the loop, so loops with
+	// different observers can share the directory.
+	key      string
+	interval time.Duration
+	splitter ResumableRangeSplitter
+}
+
+// checkpointName returns the name of an observer used in checkpoints.
+func checkpointName(index int, observer Observer) string {
+	return fmt.Sprintf("%d:%T", index, observer)
+}
+
+// checkpointKey returns the key of the checkpoint files of a loop with the
+// specified observers.
+func checkpointKey(observers []Observer) string {
+	names := make([]string, len(observers))
+	for i, observer := range observers {
+		names[i] = checkpointName(i, observer)
+	}
+	sum := sha256.Sum256([]byte(strings.Join(names, "\n")))
+	return hex.EncodeToString(sum[:8])
+}
+
+func (c *checkpointer) loopFile() string {
+	return filepath.Join(c.dir, "loop-"+c.key+".json")
+}
+
+func (c *checkpointer) rangeFile(index int) string {
+	return filepath.Join(c.dir, "range-"+c.key+"-"+strconv.Itoa(index)+".json")
+}
+
+// load returns the checkpoint of an interrupted iteration, or nil if there
+// isn't any, along with the observers of the iteration.
+func (c *checkpointer) load(observers []Observer) (*loopCheckpoint, []Observer, []*rangeCheckpoint, error) {
+	var loop loopCheckpoint
+	if err := readJSON(c.loopFile(), &loop); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil, nil, nil
+		}
+		return nil, nil, nil, err
+	}
+
+	byName := map[string]Observer{}
+	for i, observer := range observers {
+		byName[checkpointName(i, observer)] = observer
+	}
+	var resumed []Observer
+	for _, name := range loop.Observers {
+		observer, ok := byName[name]
+		if !ok {
+			return nil, nil, nil, Error.New("checkpoint contains unknown observer %q", name)
+		}
+		resumed = append(resumed, observer)
+	}
+
+	ranges := make([]*rangeCheckpoint, len(loop.Ranges))
+	for i := range ranges {
+		var progress rangeCheckpoint
+		err := readJSON(c.rangeFile(i), &progress)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		ranges[i] = &progress
+	}
+
+	return &loop, resumed, ranges, nil
+}
+
+// start stores the state shared by all the ranges of a new iteration.
+func (c *checkpointer) start(ctx context.Context, startTime time.Time, observerStates []observerState, providers []SegmentProvider) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := c.remove(); err != nil {
+		return err
+	}
+
+	loop := loopCheckpoint{
+		StartTime: startTime,
+		States:    map[string][]byte{},
+	}
+	for _, state := range observerStates {
+		name := state.name
+		loop.Observers = append(loop.Observers, name)
+
+		observer, ok := state.observer.(CheckpointObserver)
+		if !ok || state.err != nil {
+			continue
+		}
+		loop.States[name], err = observer.CheckpointState(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	for _, provider := range providers {
+		loop.Ranges = append(loop.Ranges, provider.Range())
+	}
+
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return Error.Wrap(err)
+	}
+	return writeJSON(c.loopFile(), loop)
+}
+
+// remove removes the checkpoint of the iteration.
+func (c *checkpointer) remove() error {
+	files, err := filepath.Glob(filepath.Join(c.dir, "range-"+c.key+"-*.json"))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	files = append(files, c.loopFile())
+
+	var group errs.Group
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			group.Add(err)
+		}
+	}
+	return Error.Wrap(group.Err())
+}
+
+// rangeProgress tracks the progress of a single range and stores it when the
+// checkpoint interval elapses.
+type rangeProgress struct {
+	checkpointer *checkpointer
+	index        int
+	states       []*rangeObserverState
+
+	cursor   *Cursor
+	lastSave time.Time
+}
+
+// processed is called after a batch of segments is processed.
+func (progress *rangeProgress) processed(ctx context.Context, segments []Segment) {
+	if len(segments) == 0 {
+		return
+	}
+	last := segments[len(segments)-1]
+	progress.cursor = &Cursor{StreamID: last.StreamID, Position: last.Position}
+
+	if time.Since(progress.lastSave) < progress.checkpointer.interval {
+		return
+	}
+	progress.save(ctx, false)
+}
+
+// save stores the progress of the range.
+func (progress *rangeProgress) save(ctx context.Context, done bool) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	checkpoint := rangeCheckpoint{
+		Cursor:   progress.cursor,
+		Done:     done,
+		Partials: map[string][]byte{},
+	}
+	for _, state := range progress.states {
+		if state.checkpoint == nil || state.err != nil {
+			continue
+		}
+		checkpoint.Partials[state.name], err = state.checkpoint.CheckpointPartial(ctx, state.rangeObserver)
+		if err != nil {
+			progress.checkpointer.log.Warn("unable to checkpoint partial",
+				zap.String("observer", state.name), zap.Error(err))
+			return
+		}
+	}
+
+	err = writeJSON(progress.checkpointer.rangeFile(progress.index), checkpoint)
+	if err != nil {
+		progress.checkpointer.log.Warn("unable to store range checkpoint", zap.Int("index", progress.index), zap.Error(err))
+		return
+	}
+	progress.lastSave = time.Now()
+}
+
+func readJSON(path string, value any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return Error.Wrap(json.Unmarshal(data, value))
+}
+
+// writeJSON writes the value into a temporary file, which replaces the file
+// at path, so an interrupted write doesn't corrupt the previous checkpoint.
+func writeJSON(path string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(os.Rename(tmp, path))
+}
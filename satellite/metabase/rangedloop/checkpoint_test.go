@@ -0,0 +1,253 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/rangedloop/rangedlooptest"
+)
+
+func TestCheckpointResume(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var segments []rangedloop.Segment
+	for i := 0; i < 10; i++ {
+		streamID := testrand.UUID()
+		for part := 0; part < 3; part++ {
+			segments = append(segments, rangedloop.Segment{
+				StreamID: streamID,
+				Position: metabase.SegmentPosition{Part: uint32(part)},
+			})
+		}
+	}
+
+	config := rangedloop.Config{
+		Parallelism:   2,
+		BatchSize:     2,
+		CheckpointDir: ctx.Dir("checkpoint"),
+	}
+
+	collector := &collectObserver{}
+	counter := &rangedlooptest.CountObserver{}
+	observers := []rangedloop.Observer{collector, counter}
+
+	// the first run is interrupted in the middle of every range.
+	splitter := &interruptedSplitter{
+		RangeSplitter: &rangedlooptest.RangeSplitter{Segments: segments},
+		batches:       3,
+	}
+	_, err := rangedloop.NewService(zaptest.NewLogger(t), config, splitter, observers).RunOnce(ctx)
+	require.ErrorIs(t, err, errInterrupted)
+	require.Nil(t, collector.processed)
+
+	startTime := collector.startTime
+
+	// the second run continues where the first one left off.
+	durations, err := rangedloop.NewService(zaptest.NewLogger(t), config, splitter.RangeSplitter, observers).RunOnce(ctx)
+	require.NoError(t, err)
+	require.Len(t, durations, 2)
+	require.GreaterOrEqual(t, durations[0].Duration, time.Duration(0))
+	// observers without checkpoint support are excluded from the resumed run.
+	require.Equal(t, -1*time.Second, durations[1].Duration)
+
+	require.True(t, startTime.Equal(collector.startTime))
+	require.Len(t, collector.processed, len(segments))
+	for _, segment := range segments {
+		require.Equal(t, 1, collector.processed[segmentKey(segment)])
+	}
+
+	// the checkpoint is removed when the loop finishes.
+	entries, err := os.ReadDir(config.CheckpointDir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// the next run starts from the beginning.
+	durations, err = rangedloop.NewService(zaptest.NewLogger(t), config, splitter.RangeSplitter, observers).RunOnce(ctx)
+	require.NoError(t, err)
+	require.Len(t, collector.processed, len(segments))
+	require.NotEqual(t, startTime, collector.startTime)
+	require.GreaterOrEqual(t, durations[1].Duration, time.Duration(0))
+	require.Equal(t, len(segments), counter.NumSegments)
+}
+
+func TestCheckpointObserverSet(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var segments []rangedloop.Segment
+	for i := 0; i < 10; i++ {
+		segments = append(segments, rangedloop.Segment{StreamID: testrand.UUID()})
+	}
+
+	config := rangedloop.Config{
+		Parallelism:   2,
+		BatchSize:     2,
+		CheckpointDir: ctx.Dir("checkpoint"),
+	}
+	splitter := &interruptedSplitter{
+		RangeSplitter: &rangedlooptest.RangeSplitter{Segments: segments},
+		batches:       2,
+	}
+
+	collector := &collectObserver{}
+	_, err := rangedloop.NewService(zaptest.NewLogger(t), config, splitter, []rangedloop.Observer{collector}).RunOnce(ctx)
+	require.ErrorIs(t, err, errInterrupted)
+
+	// a loop with different observers doesn't continue the interrupted iteration.
+	other := &collectObserver{}
+	counter := &rangedlooptest.CountObserver{}
+	_, err = rangedloop.NewService(zaptest.NewLogger(t), config, splitter.RangeSplitter, []rangedloop.Observer{other, counter}).RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(segments), counter.NumSegments)
+
+	// a one-shot run neither continues it.
+	other = &collectObserver{}
+	service := rangedloop.NewService(zaptest.NewLogger(t), config, splitter.RangeSplitter, []rangedloop.Observer{other})
+	service.DisableCheckpoints()
+	_, err = service.RunOnce(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, collector.startTime, other.startTime)
+
+	// the original loop still continues where it left off.
+	startTime := collector.startTime
+	_, err = rangedloop.NewService(zaptest.NewLogger(t), config, splitter.RangeSplitter, []rangedloop.Observer{collector}).RunOnce(ctx)
+	require.NoError(t, err)
+	require.True(t, startTime.Equal(collector.startTime))
+	require.Len(t, collector.processed, len(segments))
+
+	entries, err := os.ReadDir(config.CheckpointDir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+var errInterrupted = errors.New("interrupted")
+
+// interruptedSplitter creates ranges which fail after a number of batches.
+type interruptedSplitter struct {
+	*rangedlooptest.RangeSplitter
+	batches int
+}
+
+func (splitter *interruptedSplitter) CreateRanges(nRanges int, batchSize int) ([]rangedloop.SegmentProvider, error) {
+	providers, err := splitter.RangeSplitter.CreateRanges(nRanges, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	for i, provider := range providers {
+		providers[i] = &interruptedProvider{SegmentProvider: provider, batches: splitter.batches}
+	}
+	return providers, nil
+}
+
+type interruptedProvider struct {
+	rangedloop.SegmentProvider
+	batches int
+}
+
+func (provider *interruptedProvider) Iterate(ctx context.Context, fn func([]rangedloop.Segment) error) error {
+	batches := 0
+	return provider.SegmentProvider.Iterate(ctx, func(segments []rangedloop.Segment) error {
+		if batches == provider.batches {
+			return errInterrupted
+		}
+		batches++
+		return fn(segments)
+	})
+}
+
+func segmentKey(segment rangedloop.Segment) string {
+	return segment.StreamID.String() + "/" + strconv.FormatUint(segment.Position.Encode(), 10)
+}
+
+// collectObserver counts how many times each segment was processed.
+type collectObserver struct {
+	startTime time.Time
+	processed map[string]int
+}
+
+type collectPartial struct {
+	processed map[string]int
+}
+
+func (observer *collectObserver) Start(ctx context.Context, startTime time.Time) error {
+	observer.startTime = startTime
+	observer.processed = nil
+	return nil
+}
+
+func (observer *collectObserver) Fork(ctx context.Context) (rangedloop.Partial, error) {
+	return &collectPartial{processed: map[string]int{}}, nil
+}
+
+func (observer *collectObserver) Join(ctx context.Context, partial rangedloop.Partial) error {
+	if observer.processed == nil {
+		observer.processed = map[string]int{}
+	}
+	for key, count := range partial.(*collectPartial).processed {
+		observer.processed[key] += count
+	}
+	return nil
+}
+
+func (observer *collectObserver) Finish(ctx context.Context) error { return nil }
+
+func (observer *collectObserver) CheckpointState(ctx context.Context) ([]byte, error) {
+	return json.Marshal(observer.startTime)
+}
+
+func (observer *collectObserver) Resume(ctx context.Context, startTime time.Time, state []byte) error {
+	var stored time.Time
+	if err := json.Unmarshal(state, &stored); err != nil {
+		return err
+	}
+	if !stored.Equal(startTime) {
+		return errors.New("unexpected start time")
+	}
+	observer.startTime = startTime
+	observer.processed = nil
+	return nil
+}
+
+func (observer *collectObserver) CheckpointPartial(ctx context.Context, partial rangedloop.Partial) ([]byte, error) {
+	return json.Marshal(partial.(*collectPartial).processed)
+}
+
+func (observer *collectObserver) ResumePartial(ctx context.Context, state []byte) (rangedloop.Partial, error) {
+	partial := &collectPartial{}
+	return partial, json.Unmarshal(state, &partial.processed)
+}
+
+func (partial *collectPartial) Process(ctx context.Context, segments []rangedloop.Segment) error {
+	for _, segment := range segments {
+		partial.processed[segmentKey(segment)]++
+	}
+	return nil
+}
+
+var _ rangedloop.CheckpointObserver = (*collectObserver)(nil)
+
+func TestCursorAfter(t *testing.T) {
+	streamID := uuid.UUID{5}
+	cursor := &rangedloop.Cursor{StreamID: streamID, Position: metabase.SegmentPosition{Part: 1, Index: 2}}
+
+	require.False(t, cursor.After(&rangedloop.Segment{StreamID: uuid.UUID{4}, Position: metabase.SegmentPosition{Part: 9}}))
+	require.False(t, cursor.After(&rangedloop.Segment{StreamID: streamID, Position: metabase.SegmentPosition{Part: 1, Index: 1}}))
+	require.False(t, cursor.After(&rangedloop.Segment{StreamID: streamID, Position: metabase.SegmentPosition{Part: 1, Index: 2}}))
+	require.True(t, cursor.After(&rangedloop.Segment{StreamID: streamID, Position: metabase.SegmentPosition{Part: 1, Index: 3}}))
+	require.True(t, cursor.After(&rangedloop.Segment{StreamID: uuid.UUID{6}}))
+}
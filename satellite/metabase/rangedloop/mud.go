@@ -4,16 +4,34 @@
 package rangedloop
 
 import (
+	"go.uber.org/zap"
+
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/shared/modular/config"
 	"storj.io/storj/shared/mud"
 )
 
+// ObserverName is a mud tag of the observer components. It sets the name of
+// the observer in the configuration, which should be the same as the name
+// used by the satellite ranged loop peer.
+type ObserverName struct {
+	Name string
+}
+
 // Module is a mud module.
 func Module(ball *mud.Ball) {
 
 	mud.Provide[RangeSplitter](ball, NewMetabaseRangeSplitter)
-	mud.Provide[*Service](ball, NewService)
+	mud.Provide[*Service](ball, func(log *zap.Logger, config Config, provider RangeSplitter, db *metabase.DB, observers []Observer) (*Service, error) {
+		registry, err := namedRegistry(ball, observers)
+		if err != nil {
+			return nil, err
+		}
+		if err := registry.AddRegistered(log, db); err != nil {
+			return nil, err
+		}
+		return NewServiceFromRegistry(log, config, provider, registry)
+	})
 	mud.Provide[*LiveCountObserver](ball, func(db *metabase.DB, cfg Config) *LiveCountObserver {
 		return NewLiveCountObserver(db, cfg.SuspiciousProcessedRatio, cfg.AsOfSystemInterval)
 	})
@@ -21,5 +39,33 @@ func Module(ball *mud.Ball) {
 	config.RegisterConfig[Config](ball, "ranged-loop")
 	mud.RegisterImplementation[[]Observer](ball)
 	mud.Implementation[[]Observer, *LiveCountObserver](ball)
+	mud.Tag[*LiveCountObserver, ObserverName](ball, ObserverName{Name: "live-count"})
+
+}
+
+// namedRegistry creates a registry of the observers with the names from the
+// ObserverName tags of their components.
+func namedRegistry(ball *mud.Ball, observers []Observer) (*Registry, error) {
+	names := map[Observer]string{}
+	err := mud.ForEach(ball, func(component *mud.Component) error {
+		tag, ok := mud.GetTagOf[ObserverName](component)
+		if !ok {
+			return Error.New("observer %s has no name", component.Name())
+		}
+		if observer, ok := component.Instance().(Observer); ok {
+			names[observer] = tag.Name
+		}
+		return nil
+	}, mud.ImplementationOf[[]Observer](ball))
+	if err != nil {
+		return nil, err
+	}
 
+	registry := NewRegistry()
+	for _, observer := range observers {
+		if err := registry.Add(names[observer], observer); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
 }
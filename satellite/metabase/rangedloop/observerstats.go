@@ -5,6 +5,7 @@ package rangedloop
 
 import (
 	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -21,7 +22,8 @@ func sendObserverDurations(observerDurations []ObserverDuration) {
 	for _, od := range observerDurations {
 		ev.Event("rangedloop",
 			eventkit.String("observer", observerName(od.Observer)),
-			eventkit.Duration("duration", od.Duration))
+			eventkit.Duration("duration", od.Duration),
+			eventkit.Duration("finish_duration", od.FinishDuration))
 	}
 
 	completedObserverStatsInstance.setObserverDurations(observerDurations)
@@ -31,7 +33,8 @@ func sendObserverDurations(observerDurations []ObserverDuration) {
 }
 
 // Implements monkit.StatSource.
-// Reports the duration per observer from the last completed run of the ranged segment loop.
+// Reports the duration per observer from the last completed run of the ranged segment loop,
+// which the observer was scheduled for.
 type completedObserverStats struct {
 	mu                sync.Mutex
 	observerDurations []ObserverDuration
@@ -48,15 +51,27 @@ func (o *completedObserverStats) Stats(cb func(key monkit.SeriesKey, field strin
 		key = key.WithTag("observer", observerName(observerDuration.Observer))
 
 		cb(key, "duration", observerDuration.Duration.Seconds())
+		cb(key, "finish_duration", observerDuration.FinishDuration.Seconds())
 	}
 }
 
 // setObserverDurations sets the observer durations to report at ranged segment loop completion.
+// The durations of observers which didn't run in this loop iteration are kept.
 func (o *completedObserverStats) setObserverDurations(observerDurations []ObserverDuration) {
 	o.mu.Lock()
 	defer o.mu.Unlock()
 
-	o.observerDurations = observerDurations
+	for _, observerDuration := range observerDurations {
+		name := observerName(observerDuration.Observer)
+		index := slices.IndexFunc(o.observerDurations, func(od ObserverDuration) bool {
+			return observerName(od.Observer) == name
+		})
+		if index >= 0 {
+			o.observerDurations[index] = observerDuration
+		} else {
+			o.observerDurations = append(o.observerDurations, observerDuration)
+		}
+	}
 }
 
 type withClass interface {
@@ -25,7 +25,9 @@ type MetabaseRangeSplitter struct {
 type MetabaseSegmentProvider struct {
 	db *metabase.DB
 
-	uuidRange            UUIDRange
+	uuidRange UUIDRange
+	// cursor is the last segment processed by an interrupted iteration.
+	cursor               *Cursor
 	asOfSystemInterval   time.Duration
 	spannerReadTimestamp time.Time
 	spannerQueryType     string
@@ -48,6 +50,19 @@ func (provider *MetabaseRangeSplitter) CreateRanges(nRanges int, batchSize int)
 		return nil, err
 	}
 
+	return provider.createProviders(uuidRanges, make([]*Cursor, len(uuidRanges)), batchSize), nil
+}
+
+// CreateResumedRanges creates providers for the ranges of an interrupted
+// iteration, which continue after the cursors.
+func (provider *MetabaseRangeSplitter) CreateResumedRanges(ranges []UUIDRange, cursors []*Cursor, batchSize int) ([]SegmentProvider, error) {
+	if len(ranges) != len(cursors) {
+		return nil, Error.New("got %d cursors for %d ranges", len(cursors), len(ranges))
+	}
+	return provider.createProviders(ranges, cursors, batchSize), nil
+}
+
+func (provider *MetabaseRangeSplitter) createProviders(uuidRanges []UUIDRange, cursors []*Cursor, batchSize int) []SegmentProvider {
 	spannerReadTimestamp := time.Time{}
 	if provider.config.SpannerStaleInterval > 0 {
 		spannerReadTimestamp = time.Now().Add(-provider.config.SpannerStaleInterval)
@@ -56,10 +71,11 @@ func (provider *MetabaseRangeSplitter) CreateRanges(nRanges int, batchSize int)
 	}
 
 	rangeProviders := []SegmentProvider{}
-	for _, uuidRange := range uuidRanges {
+	for i, uuidRange := range uuidRanges {
 		rangeProviders = append(rangeProviders, &MetabaseSegmentProvider{
 			db:                   provider.db,
 			uuidRange:            uuidRange,
+			cursor:               cursors[i],
 			asOfSystemInterval:   provider.config.AsOfSystemInterval,
 			spannerReadTimestamp: spannerReadTimestamp,
 			spannerQueryType:     provider.config.TestingSpannerQueryType,
@@ -67,7 +83,7 @@ func (provider *MetabaseRangeSplitter) CreateRanges(nRanges int, batchSize int)
 		})
 	}
 
-	return rangeProviders, err
+	return rangeProviders
 }
 
 // Range returns range which is processed by this provider.
@@ -86,6 +102,11 @@ func (provider *MetabaseSegmentProvider) Iterate(ctx context.Context, fn func([]
 	if provider.uuidRange.End != nil {
 		endStreamID = *provider.uuidRange.End
 	}
+	if provider.cursor != nil && !provider.cursor.StreamID.IsZero() {
+		// StartStreamID is exclusive, hence the stream of the cursor is
+		// included by starting right before it.
+		startStreamID = previousUUID(provider.cursor.StreamID)
+	}
 
 	return provider.db.IterateLoopSegments(ctx, metabase.IterateLoopSegments{
 		BatchSize:            provider.batchSize,
@@ -104,6 +125,10 @@ func (provider *MetabaseSegmentProvider) Iterate(ctx context.Context, fn func([]
 				return err
 			}
 
+			if provider.cursor != nil && !provider.cursor.After((*Segment)(&segment)) {
+				continue
+			}
+
 			segments = append(segments, Segment(segment))
 
 			if len(segments) >= provider.batchSize {
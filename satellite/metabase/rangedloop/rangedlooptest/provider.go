@@ -11,7 +11,7 @@ import (
 	"storj.io/storj/satellite/metabase/rangedloop"
 )
 
-var _ rangedloop.RangeSplitter = (*RangeSplitter)(nil)
+var _ rangedloop.ResumableRangeSplitter = (*RangeSplitter)(nil)
 
 // RangeSplitter allows to iterate over segments from an in-memory source.
 type RangeSplitter struct {
@@ -49,6 +49,32 @@ func (m *RangeSplitter) CreateRanges(nRanges int, batchSize int) ([]rangedloop.S
 	return rangeProviders, nil
 }
 
+// CreateResumedRanges splits the segments into the same ranges as CreateRanges
+// and skips the segments up to the cursors.
+func (m *RangeSplitter) CreateResumedRanges(ranges []rangedloop.UUIDRange, cursors []*rangedloop.Cursor, batchSize int) ([]rangedloop.SegmentProvider, error) {
+	rangeProviders, err := m.CreateRanges(len(ranges), batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, cursor := range cursors {
+		if cursor == nil {
+			continue
+		}
+		provider := rangeProviders[i].(*SegmentProvider)
+
+		var segments []rangedloop.Segment
+		for _, segment := range provider.Segments {
+			if cursor.After(&segment) {
+				segments = append(segments, segment)
+			}
+		}
+		provider.Segments = segments
+	}
+
+	return rangeProviders, nil
+}
+
 // Range returns range which is processed by this provider.
 func (m *SegmentProvider) Range() rangedloop.UUIDRange {
 	return rangedloop.UUIDRange{}
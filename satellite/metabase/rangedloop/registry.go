@@ -0,0 +1,137 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+// ObserverFactory creates an observer registered with RegisterObserver.
+type ObserverFactory func(log *zap.Logger, metabaseDB *metabase.DB) (Observer, error)
+
+var (
+	factoriesMu sync.Mutex
+	factories   = map[string]ObserverFactory{}
+)
+
+// RegisterObserver makes an observer compiled in from another package available
+// to the ranged loop. It's intended to be called from the init function of the
+// package, similarly to database/sql drivers.
+func RegisterObserver(name string, factory ObserverFactory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	if _, ok := factories[name]; ok {
+		panic("rangedloop: observer " + name + " is already registered")
+	}
+	factories[name] = factory
+}
+
+// Registry contains the observers available to the ranged loop by name.
+type Registry struct {
+	names     []string
+	observers map[string]Observer
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		observers: map[string]Observer{},
+	}
+}
+
+// Add adds an observer to the registry.
+func (registry *Registry) Add(name string, observer Observer) error {
+	if _, ok := registry.observers[name]; ok {
+		return Error.New("observer %q is already added", name)
+	}
+	registry.names = append(registry.names, name)
+	registry.observers[name] = observer
+	return nil
+}
+
+// AddRegistered creates and adds the observers registered with RegisterObserver.
+func (registry *Registry) AddRegistered(log *zap.Logger, metabaseDB *metabase.DB) error {
+	factoriesMu.Lock()
+	registered := maps.Clone(factories)
+	factoriesMu.Unlock()
+
+	for _, name := range slices.Sorted(maps.Keys(registered)) {
+		factory := registered[name]
+		observer, err := factory(log.Named(name), metabaseDB)
+		if err != nil {
+			return Error.New("unable to create observer %q: %v", name, err)
+		}
+		if err := registry.Add(name, observer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Names returns the names of the observers in the order they were added.
+func (registry *Registry) Names() []string {
+	return append([]string(nil), registry.names...)
+}
+
+// Select returns the observers enabled by the config, and how often each of
+// them runs in loop iterations.
+func (registry *Registry) Select(config Config) (observers []Observer, every []int, err error) {
+	for _, name := range append(append([]string{}, config.Observers...), config.DisabledObservers...) {
+		if _, ok := registry.observers[name]; !ok {
+			return nil, nil, Error.New("unknown observer %q, available observers: %s", name, strings.Join(registry.names, ","))
+		}
+	}
+
+	schedule, err := parseObserverSchedule(config.ObserverSchedule)
+	if err != nil {
+		return nil, nil, err
+	}
+	for name := range schedule {
+		if _, ok := registry.observers[name]; !ok {
+			return nil, nil, Error.New("unknown observer %q in schedule, available observers: %s", name, strings.Join(registry.names, ","))
+		}
+	}
+
+	for _, name := range registry.names {
+		if slices.Contains(config.DisabledObservers, name) {
+			continue
+		}
+		if len(config.Observers) > 0 && !slices.Contains(config.Observers, name) {
+			continue
+		}
+		observers = append(observers, registry.observers[name])
+		if n, ok := schedule[name]; ok {
+			every = append(every, n)
+		} else {
+			every = append(every, 1)
+		}
+	}
+	return observers, every, nil
+}
+
+// parseObserverSchedule parses name:N pairs.
+func parseObserverSchedule(pairs []string) (map[string]int, error) {
+	schedule := map[string]int{}
+	for _, pair := range pairs {
+		name, value, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, Error.New("invalid observer schedule %q, expected name:N", pair)
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return nil, Error.New("invalid observer schedule %q, expected a positive number of iterations", pair)
+		}
+		schedule[name] = n
+	}
+	return schedule, nil
+}
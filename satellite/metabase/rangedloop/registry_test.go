@@ -0,0 +1,95 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/rangedloop/rangedlooptest"
+)
+
+func TestRegistrySelect(t *testing.T) {
+	checker := &rangedlooptest.CountObserver{}
+	tally := &rangedlooptest.CountObserver{}
+	metrics := &rangedlooptest.CountObserver{}
+
+	registry := rangedloop.NewRegistry()
+	require.NoError(t, registry.Add("checker", checker))
+	require.NoError(t, registry.Add("tally", tally))
+	require.NoError(t, registry.Add("metrics", metrics))
+	require.Error(t, registry.Add("tally", tally))
+	require.Equal(t, []string{"checker", "tally", "metrics"}, registry.Names())
+
+	observers, every, err := registry.Select(rangedloop.Config{})
+	require.NoError(t, err)
+	require.Equal(t, []rangedloop.Observer{checker, tally, metrics}, observers)
+	require.Equal(t, []int{1, 1, 1}, every)
+
+	observers, every, err = registry.Select(rangedloop.Config{
+		Observers:        []string{"tally", "checker"},
+		ObserverSchedule: []string{"tally:3"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []rangedloop.Observer{checker, tally}, observers)
+	require.Equal(t, []int{1, 3}, every)
+
+	observers, _, err = registry.Select(rangedloop.Config{
+		DisabledObservers: []string{"metrics"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []rangedloop.Observer{checker, tally}, observers)
+
+	for _, config := range []rangedloop.Config{
+		{Observers: []string{"unknown"}},
+		{DisabledObservers: []string{"unknown"}},
+		{ObserverSchedule: []string{"unknown:2"}},
+		{ObserverSchedule: []string{"tally"}},
+		{ObserverSchedule: []string{"tally:0"}},
+	} {
+		_, _, err := registry.Select(config)
+		require.Error(t, err)
+	}
+}
+
+func TestServiceObserverSchedule(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	everyLoop := &rangedlooptest.CountObserver{}
+	everyThirdLoop := &rangedlooptest.CountObserver{}
+
+	registry := rangedloop.NewRegistry()
+	require.NoError(t, registry.Add("every", everyLoop))
+	require.NoError(t, registry.Add("third", everyThirdLoop))
+
+	config := rangedloop.Config{
+		Parallelism:      2,
+		BatchSize:        2,
+		ObserverSchedule: []string{"third:3"},
+	}
+	splitter := &rangedlooptest.RangeSplitter{Segments: make([]rangedloop.Segment, 5)}
+
+	service, err := rangedloop.NewServiceFromRegistry(zaptest.NewLogger(t), config, splitter, registry)
+	require.NoError(t, err)
+
+	for iteration := 0; iteration < 4; iteration++ {
+		everyThirdLoop.NumSegments = -1
+
+		durations, err := service.RunOnce(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 5, everyLoop.NumSegments)
+
+		if iteration%3 == 0 {
+			require.Len(t, durations, 2)
+			require.Equal(t, 5, everyThirdLoop.NumSegments)
+		} else {
+			require.Len(t, durations, 1)
+			require.Equal(t, -1, everyThirdLoop.NumSegments)
+		}
+	}
+}
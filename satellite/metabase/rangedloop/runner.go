@@ -20,11 +20,11 @@ type RunOnce struct {
 }
 
 // NewRunOnce creates a new RunOnce.
-func NewRunOnce(log *zap.Logger, stop *modular.StopTrigger, config Config, provider RangeSplitter, observers []Observer) *RunOnce {
-	rls := NewService(log, config, provider, observers)
+func NewRunOnce(log *zap.Logger, stop *modular.StopTrigger, service *Service) *RunOnce {
+	service.DisableCheckpoints()
 	return &RunOnce{
 		log:     log,
-		Service: rls,
+		Service: service,
 		stop:    stop,
 	}
 }
@@ -41,6 +41,7 @@ func (r *RunOnce) Run(ctx context.Context) error {
 	for _, duration := range durations {
 		r.log.Info("Ranged-loop observer finished",
 			zap.Duration("duration", duration.Duration),
+			zap.Duration("finish_duration", duration.FinishDuration),
 			zap.String("observer", fmt.Sprintf("%T", duration.Observer)))
 	}
 	return nil
@@ -36,6 +36,13 @@ type Config struct {
 	TestingSpannerQueryType string `help:"use to select query type which will be used to execute ranged loop (sql|read)" default:"" testDefault:"read" hidden:"true"`
 
 	SuspiciousProcessedRatio float64 `help:"ratio where to consider processed count as supicious" default:"0.03"`
+
+	CheckpointDir      string        `help:"directory where to store the progress of the loop, so an interrupted loop continues where it left off (disabled when empty)" default:""`
+	CheckpointInterval time.Duration `help:"how often to store the progress of each range" default:"15m"`
+
+	Observers         []string `help:"comma separated list of observers to run, all the available observers are run when empty" default:""`
+	DisabledObservers []string `help:"comma separated list of observers which are not run" default:""`
+	ObserverSchedule  []string `help:"comma separated list of name:N pairs to run the observer only every Nth loop iteration" default:""`
 }
 
 // Service iterates through all segments and calls the attached observers for every segment
@@ -46,6 +53,10 @@ type Service struct {
 	config    Config
 	provider  RangeSplitter
 	observers []Observer
+	// every contains how often the observer at the same index runs, in loop
+	// iterations. All the observers run in each iteration when it's nil.
+	every     []int
+	iteration int
 
 	Loop *sync2.Cycle
 }
@@ -61,8 +72,23 @@ func NewService(log *zap.Logger, config Config, provider RangeSplitter, observer
 	}
 }
 
+// NewServiceFromRegistry creates a new instance of the ranged loop service,
+// which runs the observers of the registry enabled by the config.
+func NewServiceFromRegistry(log *zap.Logger, config Config, provider RangeSplitter, registry *Registry) (*Service, error) {
+	observers, every, err := registry.Select(config)
+	if err != nil {
+		return nil, err
+	}
+
+	service := NewService(log, config, provider, observers)
+	service.every = every
+	return service, nil
+}
+
 // observerState contains information to manage an observer during a loop iteration.
 type observerState struct {
+	// name identifies the observer in checkpoints.
+	name           string
 	observer       Observer
 	rangeObservers []*rangeObserverState
 	// err is the error that occurred during the observer's Start method.
@@ -71,6 +97,10 @@ type observerState struct {
 }
 
 type rangeObserverState struct {
+	// name identifies the observer in checkpoints.
+	name string
+	// checkpoint is set when the observer implements CheckpointObserver.
+	checkpoint    CheckpointObserver
 	rangeObserver Partial
 	duration      time.Duration
 	// err is the error that is returned by the observer's Fork or Process method.
@@ -84,6 +114,16 @@ type ObserverDuration struct {
 	// Duration is set to -1 when the observer has errored out
 	// so someone watching metrics can tell that something went wrong.
 	Duration time.Duration
+	// FinishDuration is how long it took the observer to finish, e.g. to
+	// store its results. It's not included in Duration.
+	FinishDuration time.Duration
+}
+
+// DisableCheckpoints disables storing the progress of the loop. It should be
+// used for one-shot runs, which shouldn't continue an interrupted iteration of
+// the regular loop or leave checkpoints behind.
+func (service *Service) DisableCheckpoints() {
+	service.config.CheckpointDir = ""
 }
 
 // Close stops the ranged loop.
@@ -139,14 +179,56 @@ func (service *Service) RunOnce(ctx context.Context) (observerDurations []Observ
 		}
 	}()
 
-	observerStates, err := startObservers(ctx, service.log, service.observers)
-	if err != nil {
-		return nil, err
+	observers, names := service.scheduledObservers()
+	checkpoint := service.checkpointer()
+
+	var observerStates []observerState
+	var rangeProviders []SegmentProvider
+	var rangeCheckpoints []*rangeCheckpoint
+	if checkpoint != nil {
+		loop, resumed, ranges, err := checkpoint.load(service.observers)
+		if err != nil {
+			service.log.Warn("unable to load checkpoint, the loop will start from the beginning", zap.Error(err))
+		} else if loop != nil {
+			service.log.Info("resuming ranged loop from checkpoint", zap.Time("start_time", loop.StartTime))
+
+			cursors := make([]*Cursor, len(ranges))
+			for i, progress := range ranges {
+				if progress != nil {
+					cursors[i] = progress.Cursor
+				}
+			}
+
+			rangeProviders, err = checkpoint.splitter.CreateResumedRanges(loop.Ranges, cursors, service.config.BatchSize)
+			if err != nil {
+				return nil, err
+			}
+			observerStates = resumeObservers(ctx, service.log, resumed, loop)
+			rangeCheckpoints = ranges
+		}
 	}
 
-	rangeProviders, err := service.provider.CreateRanges(service.config.Parallelism, service.config.BatchSize)
-	if err != nil {
-		return nil, err
+	if rangeCheckpoints == nil {
+		startTime := time.Now()
+		observerStates, err = startObservers(ctx, service.log, startTime, observers)
+		if err != nil {
+			return nil, err
+		}
+		for i := range observerStates {
+			observerStates[i].name = names[i]
+		}
+
+		rangeProviders, err = service.provider.CreateRanges(service.config.Parallelism, service.config.BatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if checkpoint != nil {
+			if err := checkpoint.start(ctx, startTime, observerStates, rangeProviders); err != nil {
+				service.log.Warn("unable to store checkpoint, the loop will run without checkpoints", zap.Error(err))
+				checkpoint = nil
+			}
+		}
 	}
 
 	group := errs2.Group{}
@@ -154,23 +236,42 @@ func (service *Service) RunOnce(ctx context.Context) (observerDurations []Observ
 		uuidRange := rangeProvider.Range()
 		service.log.Debug("creating range", zap.Int("index", index), zap.Stringer("start", uuidRange.Start), zap.Stringer("end", uuidRange.End))
 
+		var saved *rangeCheckpoint
+		if rangeCheckpoints != nil {
+			saved = rangeCheckpoints[index]
+		}
+
 		rangeObservers := []*rangeObserverState{}
 		for i, observerState := range observerStates {
 			if observerState.err != nil {
 				service.log.Debug("observer returned error", zap.Error(observerState.err))
 				continue
 			}
-			rangeObserver, err := observerState.observer.Fork(ctx)
-			rangeState := &rangeObserverState{
-				rangeObserver: rangeObserver,
-				err:           err,
-			}
+			rangeState := forkObserver(ctx, observerState.name, observerState.observer, saved)
 			rangeObservers = append(rangeObservers, rangeState)
 			observerStates[i].rangeObservers = append(observerStates[i].rangeObservers, rangeState)
 		}
 
+		if saved != nil && saved.Done {
+			service.log.Debug("range was already processed", zap.Int("index", index))
+			continue
+		}
+
+		var progress *rangeProgress
+		if checkpoint != nil {
+			progress = &rangeProgress{
+				checkpointer: checkpoint,
+				index:        index,
+				states:       rangeObservers,
+				lastSave:     time.Now(),
+			}
+			if saved != nil {
+				progress.cursor = saved.Cursor
+			}
+		}
+
 		// Create closure to capture loop variables.
-		group.Go(createGoroutineClosure(ctx, rangeProvider, rangeObservers))
+		group.Go(createGoroutineClosure(ctx, rangeProvider, rangeObservers, progress))
 	}
 
 	// Improvement: stop all ranges when one has an error.
@@ -179,28 +280,81 @@ func (service *Service) RunOnce(ctx context.Context) (observerDurations []Observ
 		return nil, errs.Combine(errList...)
 	}
 
-	return finishObservers(ctx, service.log, observerStates), nil
+	observerDurations = finishObservers(ctx, service.log, observerStates)
+
+	if checkpoint != nil {
+		if err := checkpoint.remove(); err != nil {
+			service.log.Warn("unable to remove checkpoint", zap.Error(err))
+		}
+	}
+
+	return observerDurations, nil
 }
 
-func createGoroutineClosure(ctx context.Context, rangeProvider SegmentProvider, states []*rangeObserverState) func() error {
+// scheduledObservers returns the observers which run in the next loop
+// iteration along with their names used in checkpoints.
+func (service *Service) scheduledObservers() (observers []Observer, names []string) {
+	iteration := service.iteration
+	service.iteration++
+
+	for i, observer := range service.observers {
+		if service.every != nil && iteration%service.every[i] != 0 {
+			service.log.Debug("observer is not scheduled for this iteration", zap.String("observer", observerName(observer)))
+			continue
+		}
+		observers = append(observers, observer)
+		names = append(names, checkpointName(i, observer))
+	}
+	return observers, names
+}
+
+// checkpointer returns the checkpointer of the loop progress, or nil when
+// checkpoints are disabled.
+func (service *Service) checkpointer() *checkpointer {
+	if service.config.CheckpointDir == "" {
+		return nil
+	}
+	splitter, ok := service.provider.(ResumableRangeSplitter)
+	if !ok {
+		service.log.Warn("range splitter doesn't support checkpoints", zap.String("provider", fmt.Sprintf("%T", service.provider)))
+		return nil
+	}
+	return &checkpointer{
+		log:      service.log,
+		dir:      service.config.CheckpointDir,
+		key:      checkpointKey(service.observers),
+		interval: service.config.CheckpointInterval,
+		splitter: splitter,
+	}
+}
+
+func createGoroutineClosure(ctx context.Context, rangeProvider SegmentProvider, states []*rangeObserverState, progress *rangeProgress) func() error {
 	return func() (err error) {
 		defer mon.Task()(&ctx)(&err)
 
-		return rangeProvider.Iterate(ctx, func(segments []Segment) error {
+		err = rangeProvider.Iterate(ctx, func(segments []Segment) error {
 			// check for cancellation every segment batch
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
 			default:
-				return processBatch(ctx, states, segments)
+				if err := processBatch(ctx, states, segments); err != nil {
+					return err
+				}
+				if progress != nil {
+					progress.processed(ctx, segments)
+				}
+				return nil
 			}
 		})
+		if err == nil && progress != nil {
+			progress.save(ctx, true)
+		}
+		return err
 	}
 }
 
-func startObservers(ctx context.Context, log *zap.Logger, observers []Observer) (observerStates []observerState, err error) {
-	startTime := time.Now()
-
+func startObservers(ctx context.Context, log *zap.Logger, startTime time.Time, observers []Observer) (observerStates []observerState, err error) {
 	for _, obs := range observers {
 		observerStates = append(observerStates, startObserver(ctx, log, startTime, obs))
 	}
@@ -225,6 +379,60 @@ func startObserver(ctx context.Context, log *zap.Logger, startTime time.Time, ob
 	}
 }
 
+func resumeObservers(ctx context.Context, log *zap.Logger, observers []Observer, loop *loopCheckpoint) (observerStates []observerState) {
+	for i, obs := range observers {
+		var err error
+		checkpointObserver, ok := obs.(CheckpointObserver)
+		state, found := loop.States[loop.Observers[i]]
+		switch {
+		case !ok:
+			err = Error.New("observer doesn't support checkpoints")
+		case !found:
+			err = Error.New("checkpoint doesn't contain observer state")
+		default:
+			err = checkpointObserver.Resume(ctx, loop.StartTime, state)
+		}
+
+		if err != nil {
+			log.Error(
+				"Resuming observer failed. This observer will be excluded from this run of the ranged segment loop.",
+				zap.String("observer", fmt.Sprintf("%T", obs)),
+				zap.Error(err),
+			)
+		}
+
+		observerStates = append(observerStates, observerState{
+			name:     loop.Observers[i],
+			observer: obs,
+			err:      err,
+		})
+	}
+	return observerStates
+}
+
+// forkObserver creates the partial of an observer for a range, which is
+// restored from the checkpoint of the range when there's one.
+func forkObserver(ctx context.Context, name string, observer Observer, saved *rangeCheckpoint) *rangeObserverState {
+	checkpointObserver, _ := observer.(CheckpointObserver)
+	state := &rangeObserverState{
+		name:       name,
+		checkpoint: checkpointObserver,
+	}
+
+	if saved == nil {
+		state.rangeObserver, state.err = observer.Fork(ctx)
+		return state
+	}
+
+	partial, ok := saved.Partials[state.name]
+	if !ok || checkpointObserver == nil {
+		state.err = Error.New("checkpoint doesn't contain partial state")
+		return state
+	}
+	state.rangeObserver, state.err = checkpointObserver.ResumePartial(ctx, partial)
+	return state
+}
+
 func finishObservers(ctx context.Context, log *zap.Logger, observerStates []observerState) (observerDurations []ObserverDuration) {
 	for _, state := range observerStates {
 		observerDurations = append(observerDurations, finishObserver(ctx, log, state))
@@ -275,7 +483,9 @@ func finishObserver(ctx context.Context, log *zap.Logger, state observerState) O
 		duration += rangeObserver.duration
 	}
 
+	finishStart := time.Now()
 	err := state.observer.Finish(ctx)
+	finishDuration := time.Since(finishStart)
 	if err != nil {
 		log.Error(
 			"Observer failed during Finish()",
@@ -289,8 +499,9 @@ func finishObserver(ctx context.Context, log *zap.Logger, state observerState) O
 	}
 
 	return ObserverDuration{
-		Duration: duration,
-		Observer: state.observer,
+		Duration:       duration,
+		FinishDuration: finishDuration,
+		Observer:       state.observer,
 	}
 }
 
@@ -79,3 +79,15 @@ func MakeUUIDWithTopBits(topBits uint32) (uuid.UUID, error) {
 
 	return uuid.FromBytes(bytes)
 }
+
+// previousUUID returns the UUID right before the given one, when treated as
+// a 128-bit big-endian number.
+func previousUUID(id uuid.UUID) uuid.UUID {
+	for i := len(id) - 1; i >= 0; i-- {
+		id[i]--
+		if id[i] != 0xFF {
+			break
+		}
+	}
+	return id
+}
@@ -163,6 +163,17 @@ func (s *SpannerAdapter) TestingDeleteAll(ctx context.Context) (err error) {
 	return Error.Wrap(err)
 }
 
+// TestingDeleteAll implements Adapter.
+func (s *SQLiteAdapter) TestingDeleteAll(ctx context.Context) (err error) {
+	_, err = s.db.ExecContext(ctx, `
+		DELETE FROM objects;
+		DELETE FROM segments;
+		DELETE FROM node_aliases;
+		DELETE FROM sqlite_sequence WHERE name = 'node_aliases';
+	`)
+	return Error.Wrap(err)
+}
+
 // TestingGetAllObjects returns the state of the database.
 func (p *PostgresAdapter) TestingGetAllObjects(ctx context.Context) (_ []RawObject, err error) {
 	objs := []RawObject{}
@@ -385,6 +396,12 @@ func (s *SpannerAdapter) TestingBatchInsertObjects(ctx context.Context, objects
 	return nil
 }
 
+// TestingBatchInsertObjects batch inserts objects for testing.
+func (s *SQLiteAdapter) TestingBatchInsertObjects(ctx context.Context, objects []RawObject) (err error) {
+	source := newCopyFromRawObjects(objects)
+	return Error.Wrap(s.insertRows(ctx, "objects", source.Columns(), source))
+}
+
 type copyFromRawObjects struct {
 	idx  int
 	rows []RawObject
@@ -739,6 +756,20 @@ func (s *SpannerAdapter) TestingBatchInsertSegments(ctx context.Context, aliasCa
 	return Error.Wrap(err)
 }
 
+// TestingBatchInsertSegments implements SQLiteAdapter.
+func (s *SQLiteAdapter) TestingBatchInsertSegments(ctx context.Context, aliasCache *NodeAliasCache, segments []RawSegment) (err error) {
+	aliases := make([]AliasPieces, len(segments))
+	for i, segment := range segments {
+		aliases[i], err = aliasCache.EnsurePiecesToAliases(ctx, segment.Pieces)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	source := newCopyFromRawSegments(segments, aliases)
+	return Error.Wrap(s.insertRows(ctx, "segments", source.Columns(), source))
+}
+
 // TestingSetObjectVersion sets the version of the object to the given value.
 func (db *DB) TestingSetObjectVersion(ctx context.Context, object ObjectStream, randomVersion Version) (rowsAffected int64, err error) {
 	return db.ChooseAdapter(object.ProjectID).TestingSetObjectVersion(ctx, object, randomVersion)
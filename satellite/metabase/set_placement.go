@@ -0,0 +1,130 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil/pgutil"
+)
+
+// SetStreamsPlacement contains arguments for changing the placement of all
+// segments of streams.
+type SetStreamsPlacement struct {
+	ProjectID uuid.UUID
+	StreamIDs []uuid.UUID
+	Placement storj.PlacementConstraint
+}
+
+// Verify verifies set streams placement fields.
+func (opts SetStreamsPlacement) Verify() error {
+	if opts.ProjectID.IsZero() {
+		return ErrInvalidRequest.New("ProjectID missing")
+	}
+	for _, streamID := range opts.StreamIDs {
+		if streamID.IsZero() {
+			return ErrInvalidRequest.New("StreamID missing")
+		}
+	}
+	return nil
+}
+
+// SetStreamsPlacement changes the placement of all segments of the streams.
+// The pieces are not moved, the repairer moves pieces which are out of the
+// placement. It returns the number of segments which were changed.
+func (db *DB) SetStreamsPlacement(ctx context.Context, opts SetStreamsPlacement) (updated int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return 0, err
+	}
+	if len(opts.StreamIDs) == 0 {
+		return 0, nil
+	}
+
+	updated, err = db.ChooseAdapter(opts.ProjectID).SetStreamsPlacement(ctx, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	mon.Meter("set_streams_placement_segments").Mark64(updated)
+
+	return updated, nil
+}
+
+// SetStreamsPlacement changes the placement of all segments of the streams.
+func (p *PostgresAdapter) SetStreamsPlacement(ctx context.Context, opts SetStreamsPlacement) (updated int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := p.db.ExecContext(ctx, `
+		UPDATE segments SET placement = $1
+		WHERE stream_id = ANY($2::BYTEA[]) AND COALESCE(placement, 0) <> $1
+	`, opts.Placement, pgutil.UUIDArray(opts.StreamIDs))
+	if err != nil {
+		return 0, Error.New("unable to set placement: %w", err)
+	}
+
+	updated, err = result.RowsAffected()
+	if err != nil {
+		return 0, Error.New("unable to set placement: %w", err)
+	}
+	return updated, nil
+}
+
+// SetStreamsPlacement changes the placement of all segments of the streams.
+func (s *SpannerAdapter) SetStreamsPlacement(ctx context.Context, opts SetStreamsPlacement) (updated int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	streamIDs := make([][]byte, len(opts.StreamIDs))
+	for i, streamID := range opts.StreamIDs {
+		streamIDs[i] = streamID.Bytes()
+	}
+
+	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		updated, err = tx.Update(ctx, spanner.Statement{
+			SQL: `
+				UPDATE segments SET placement = @placement
+				WHERE stream_id IN UNNEST(@stream_ids) AND COALESCE(placement, 0) <> @placement
+			`,
+			Params: map[string]any{
+				"placement":  opts.Placement,
+				"stream_ids": streamIDs,
+			},
+		})
+		return err
+	})
+	if err != nil {
+		return 0, Error.New("unable to set placement: %w", err)
+	}
+	return updated, nil
+}
+
+// SetStreamsPlacement changes the placement of all segments of the streams.
+func (s *SQLiteAdapter) SetStreamsPlacement(ctx context.Context, opts SetStreamsPlacement) (updated int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	args := make([]any, 0, len(opts.StreamIDs)+1)
+	args = append(args, opts.Placement)
+	for _, streamID := range opts.StreamIDs {
+		args = append(args, streamID)
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE segments SET placement = $1
+		WHERE stream_id IN (`+sqlitePlaceholders(2, len(opts.StreamIDs))+`) AND COALESCE(placement, 0) <> $1
+	`, args...)
+	if err != nil {
+		return 0, Error.New("unable to set placement: %w", err)
+	}
+
+	updated, err = result.RowsAffected()
+	if err != nil {
+		return 0, Error.New("unable to set placement: %w", err)
+	}
+	return updated, nil
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestSetStreamsPlacement(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("invalid request", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.SetStreamsPlacement{
+				Opts:     metabase.SetStreamsPlacement{StreamIDs: []uuid.UUID{obj.StreamID}},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.SetStreamsPlacement{
+				Opts:     metabase.SetStreamsPlacement{ProjectID: obj.ProjectID, StreamIDs: []uuid.UUID{{}}},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "StreamID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("no streams", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.SetStreamsPlacement{
+				Opts: metabase.SetStreamsPlacement{ProjectID: obj.ProjectID, Placement: storj.EU},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("update", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 2)
+			unrelated := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+			metabasetest.SetStreamsPlacement{
+				Opts: metabase.SetStreamsPlacement{
+					ProjectID: obj.ProjectID,
+					StreamIDs: []uuid.UUID{obj.StreamID},
+					Placement: storj.EU,
+				},
+				Updated: 2,
+			}.Check(ctx, t, db)
+
+			// segments which already have the placement are not updated.
+			metabasetest.SetStreamsPlacement{
+				Opts: metabase.SetStreamsPlacement{
+					ProjectID: obj.ProjectID,
+					StreamIDs: []uuid.UUID{obj.StreamID},
+					Placement: storj.EU,
+				},
+			}.Check(ctx, t, db)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 3)
+			for _, segment := range segments {
+				if segment.StreamID == object.StreamID {
+					require.Equal(t, storj.EU, segment.Placement)
+				} else {
+					require.Equal(t, unrelated.StreamID, segment.StreamID)
+					require.Equal(t, storj.DefaultPlacement, segment.Placement)
+				}
+			}
+		})
+	})
+}
@@ -0,0 +1,180 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package sqlitedriver registers the SQLite driver used by the embedded
+// metabase adapter.
+//
+// The metabase SQLite adapter shares most of the queries with the Postgres
+// adapter. The driver rewrites the Postgres dialect into the SQLite one:
+// numbered placeholders are rebound, type casts are dropped, times are stored
+// in a sortable text format and constraint violations are reported with the
+// matching Postgres error codes.
+package sqlitedriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+func init() {
+	sql.Register(metabase.SQLiteDriverName, &Driver{
+		SQLiteDriver: sqlite3.SQLiteDriver{
+			ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+				return conn.RegisterFunc("now", func() string {
+					return FormatTime(time.Now())
+				}, false)
+			},
+		},
+	})
+}
+
+// TimeLayout is the layout used for storing times. All times are stored in
+// UTC with a fixed width, hence the text ordering matches the time ordering.
+const TimeLayout = "2006-01-02 15:04:05.000000-07:00"
+
+// FormatTime formats the time the same way as the driver stores it.
+func FormatTime(t time.Time) string {
+	return t.UTC().Truncate(time.Microsecond).Format(TimeLayout)
+}
+
+// Driver wraps the go-sqlite3 driver.
+type Driver struct {
+	sqlite3.SQLiteDriver
+}
+
+// Open implements driver.Driver.
+func (d *Driver) Open(name string) (driver.Conn, error) {
+	conn, err := d.SQLiteDriver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{conn: conn.(*sqlite3.SQLiteConn)}, nil
+}
+
+// Conn wraps a go-sqlite3 connection.
+type Conn struct {
+	conn *sqlite3.SQLiteConn
+}
+
+var (
+	_ driver.Conn               = (*Conn)(nil)
+	_ driver.ConnBeginTx        = (*Conn)(nil)
+	_ driver.ConnPrepareContext = (*Conn)(nil)
+	_ driver.ExecerContext      = (*Conn)(nil)
+	_ driver.QueryerContext     = (*Conn)(nil)
+	_ driver.NamedValueChecker  = (*Conn)(nil)
+	_ driver.Pinger             = (*Conn)(nil)
+)
+
+// Prepare implements driver.Conn.
+func (c *Conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+// PrepareContext implements driver.ConnPrepareContext.
+func (c *Conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.conn.PrepareContext(ctx, Rewrite(query))
+	return stmt, convertError(err)
+}
+
+// Close implements driver.Conn.
+func (c *Conn) Close() error { return c.conn.Close() }
+
+// Begin implements driver.Conn.
+func (c *Conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+// BeginTx implements driver.ConnBeginTx.
+func (c *Conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	tx, err := c.conn.BeginTx(ctx, opts)
+	return tx, convertError(err)
+}
+
+// Ping implements driver.Pinger.
+func (c *Conn) Ping(ctx context.Context) error {
+	return c.conn.Ping(ctx)
+}
+
+// ExecContext implements driver.ExecerContext.
+func (c *Conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	result, err := c.conn.ExecContext(ctx, Rewrite(query), args)
+	return result, convertError(err)
+}
+
+// QueryContext implements driver.QueryerContext.
+func (c *Conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.conn.QueryContext(ctx, Rewrite(query), args)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &Rows{Rows: rows}, nil
+}
+
+// CheckNamedValue implements driver.NamedValueChecker.
+//
+// Strings are stored as blobs, since all the metabase text-like columns are
+// byte arrays, and times are stored in the fixed width text format.
+func (c *Conn) CheckNamedValue(nv *driver.NamedValue) (err error) {
+	nv.Value, err = driver.DefaultParameterConverter.ConvertValue(nv.Value)
+	if err != nil {
+		return err
+	}
+	switch v := nv.Value.(type) {
+	case string:
+		nv.Value = []byte(v)
+	case time.Time:
+		nv.Value = FormatTime(v)
+	}
+	return nil
+}
+
+// Rows wraps go-sqlite3 rows, since constraint violations of statements
+// using RETURNING are reported while reading the rows.
+type Rows struct {
+	driver.Rows
+}
+
+// Next implements driver.Rows.
+func (rows *Rows) Next(dest []driver.Value) error {
+	return convertError(rows.Rows.Next(dest))
+}
+
+// Error is a constraint violation with the matching Postgres error code.
+type Error struct {
+	err  sqlite3.Error
+	code string
+}
+
+// Error implements error.
+func (err *Error) Error() string { return err.err.Error() }
+
+// Unwrap returns the original go-sqlite3 error.
+func (err *Error) Unwrap() error { return err.err }
+
+// SQLState returns the matching Postgres error code.
+func (err *Error) SQLState() string { return err.code }
+
+func convertError(err error) error {
+	var sqliteErr sqlite3.Error
+	if err == nil || !errors.As(err, &sqliteErr) {
+		return err
+	}
+
+	switch sqliteErr.ExtendedCode {
+	case sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey:
+		return &Error{err: sqliteErr, code: "23505"}
+	case sqlite3.ErrConstraintNotNull:
+		return &Error{err: sqliteErr, code: "23502"}
+	case sqlite3.ErrConstraintCheck:
+		return &Error{err: sqliteErr, code: "23514"}
+	}
+	return err
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sqlitedriver
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// byRowValue matches `ORDER BY (a, b) DESC` and `GROUP BY (a, b)`,
+	// SQLite doesn't allow row values there.
+	byRowValue = regexp.MustCompile(`(?i)\b(ORDER|GROUP)\s+BY\s*\(([\w\s,]+)\)(\s+(?:ASC|DESC)\b)?`)
+	// substringFrom matches `substring(column from`.
+	substringFrom = regexp.MustCompile(`(?i)\bsubstring\((\w+)\s+from\s+`)
+)
+
+// Rewrite converts a query written for Postgres into the SQLite dialect.
+//
+// Numbered placeholders ($1) are replaced with SQLite numbered placeholders
+// (?1) and type casts (::INT8, ::BYTEA[]) are removed. String literals,
+// quoted identifiers and comments are left untouched.
+//
+// Additionally row values in ORDER BY and GROUP BY clauses are expanded and
+// `substring(x from n)` is replaced with `substr(x, n)`.
+func Rewrite(sql string) string {
+	sql = rewriteTokens(sql)
+	sql = byRowValue.ReplaceAllStringFunc(sql, expandRowValue)
+	sql = substringFrom.ReplaceAllString(sql, "substr($1, ")
+	return sql
+}
+
+// expandRowValue converts `ORDER BY (a, b) DESC` into `ORDER BY a DESC, b DESC`.
+func expandRowValue(clause string) string {
+	match := byRowValue.FindStringSubmatch(clause)
+	keyword, columns, direction := match[1], strings.Split(match[2], ","), match[3]
+	for i := range columns {
+		columns[i] = strings.TrimSpace(columns[i]) + direction
+	}
+	return keyword + " BY " + strings.Join(columns, ", ")
+}
+
+// rewriteTokens replaces placeholders and removes type casts.
+func rewriteTokens(sql string) string {
+	type parseState int
+	const (
+		parseStart parseState = iota
+		parseInStringLiteral
+		parseInQuotedIdentifier
+		parseInComment
+	)
+
+	out := make([]byte, 0, len(sql))
+
+	state := parseStart
+	for i := 0; i < len(sql); i++ {
+		ch := sql[i]
+		switch state {
+		case parseStart:
+			switch {
+			case ch == '$' && i+1 < len(sql) && isDigit(sql[i+1]):
+				out = append(out, '?')
+				continue
+			case ch == ':' && i+1 < len(sql) && sql[i+1] == ':':
+				i += 2
+				for i < len(sql) && isIdentifier(sql[i]) {
+					i++
+				}
+				if i+1 < len(sql) && sql[i] == '[' && sql[i+1] == ']' {
+					i += 2
+				}
+				i--
+				continue
+			case ch == '-' && i+1 < len(sql) && sql[i+1] == '-':
+				state = parseInComment
+			case ch == '"':
+				state = parseInQuotedIdentifier
+			case ch == '\'':
+				state = parseInStringLiteral
+			}
+		case parseInStringLiteral:
+			if ch == '\'' {
+				state = parseStart
+			}
+		case parseInQuotedIdentifier:
+			if ch == '"' {
+				state = parseStart
+			}
+		case parseInComment:
+			if ch == '\n' {
+				state = parseStart
+			}
+		}
+		out = append(out, ch)
+	}
+
+	return string(out)
+}
+
+func isDigit(ch byte) bool {
+	return '0' <= ch && ch <= '9'
+}
+
+func isIdentifier(ch byte) bool {
+	return isDigit(ch) || 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_'
+}
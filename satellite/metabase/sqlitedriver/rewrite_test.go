@@ -0,0 +1,29 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sqlitedriver_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/metabase/sqlitedriver"
+)
+
+func TestRewrite(t *testing.T) {
+	for _, tc := range []struct {
+		in, out string
+	}{
+		{"SELECT 1", "SELECT 1"},
+		{"SELECT * FROM objects WHERE project_id = $1 AND version = $12", "SELECT * FROM objects WHERE project_id = ?1 AND version = ?12"},
+		{"SELECT $1::BYTEA, $2::INT8[], now()::TIMESTAMPTZ", "SELECT ?1, ?2, now()"},
+		{"SELECT '$1::TEXT', \"$2\" -- $3::INT8\nFROM x", "SELECT '$1::TEXT', \"$2\" -- $3::INT8\nFROM x"},
+		{"SELECT a FROM x ORDER BY (a, b)", "SELECT a FROM x ORDER BY a, b"},
+		{"SELECT a FROM x ORDER BY (a, b) DESC LIMIT 1", "SELECT a FROM x ORDER BY a DESC, b DESC LIMIT 1"},
+		{"SELECT a FROM x GROUP BY (a, b) ORDER BY (a, b) ASC", "SELECT a FROM x GROUP BY a, b ORDER BY a ASC, b ASC"},
+		{"SELECT substring(stream_id from 9)", "SELECT substr(stream_id, 9)"},
+	} {
+		require.Equal(t, tc.out, sqlitedriver.Rewrite(tc.in), tc.in)
+	}
+}
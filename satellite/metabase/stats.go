@@ -112,6 +112,15 @@ func (s *SpannerAdapter) GetTableStats(ctx context.Context, opts GetTableStats)
 	}, nil
 }
 
+// GetTableStats implements Adapter.
+func (s *SQLiteAdapter) GetTableStats(ctx context.Context, opts GetTableStats) (result TableStats, err error) {
+	err = s.db.QueryRowContext(ctx, `SELECT count(1) FROM segments`).Scan(&result.SegmentCount)
+	if err != nil {
+		return TableStats{}, err
+	}
+	return result, nil
+}
+
 // UpdateTableStats forces an update of table statistics. Probably useful mostly in test scenarios.
 func (db *DB) UpdateTableStats(ctx context.Context) (err error) {
 	for _, adapter := range db.adapters {
@@ -139,3 +148,9 @@ func (c *CockroachAdapter) UpdateTableStats(ctx context.Context) error {
 func (s *SpannerAdapter) UpdateTableStats(ctx context.Context) error {
 	return nil
 }
+
+// UpdateTableStats forces an update of table statistics. Probably useful mostly in test scenarios.
+func (s *SQLiteAdapter) UpdateTableStats(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, "ANALYZE segments")
+	return Error.Wrap(err)
+}
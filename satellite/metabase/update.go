@@ -17,6 +17,8 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/txutil"
+	"storj.io/storj/shared/tagsql"
 )
 
 const (
@@ -513,6 +515,55 @@ func (s *SpannerAdapter) SetObjectLastCommittedLegalHold(ctx context.Context, op
 	return nil
 }
 
+// SetObjectLastCommittedLegalHold sets the legal hold configuration of the most
+// recently committed version of an object.
+func (s *SQLiteAdapter) SetObjectLastCommittedLegalHold(ctx context.Context, opts SetObjectLastCommittedLegalHold) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		var (
+			version Version
+			info    preUpdateRetentionInfo
+		)
+		err := tx.QueryRowContext(ctx, `
+			SELECT status, version, expires_at
+			FROM objects
+			WHERE
+				(project_id, bucket_name, object_key) = ($1, $2, $3)
+				AND status <> `+statusPending+`
+			ORDER BY version DESC
+			LIMIT 1
+		`, opts.ProjectID, opts.BucketName, opts.ObjectKey).Scan(&info.Status, &version, &info.ExpiresAt)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrObjectNotFound.New("")
+			}
+			return errs.New("unable to query object info before setting legal hold: %w", err)
+		}
+
+		switch {
+		case info.Status.IsDeleteMarker():
+			return ErrObjectStatus.New(noLockOnDeleteMarkerErrMsg)
+		case info.ExpiresAt != nil:
+			return ErrObjectExpiration.New(noLockWithExpirationErrMsg)
+		}
+
+		return errs.Wrap(sqliteSetObjectExactVersionLegalHold(ctx, tx, SetObjectExactVersionLegalHold{
+			ObjectLocation: opts.ObjectLocation,
+			Version:        version,
+			Enabled:        opts.Enabled,
+		}))
+	})
+	if err != nil {
+		if ErrObjectNotFound.Has(err) || ErrObjectExpiration.Has(err) || ErrObjectStatus.Has(err) {
+			return errs.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
 func (s *SpannerAdapter) setObjectExactVersionLegalHold(ctx context.Context, tx *spanner.ReadWriteTransaction, opts SetObjectExactVersionLegalHold, existingRetMode storj.RetentionMode) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -543,6 +594,73 @@ func (s *SpannerAdapter) setObjectExactVersionLegalHold(ctx context.Context, tx
 	return nil
 }
 
+// SetObjectExactVersionLegalHold sets the legal hold configuration of an exact version of an object.
+func (s *SQLiteAdapter) SetObjectExactVersionLegalHold(ctx context.Context, opts SetObjectExactVersionLegalHold) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		var info preUpdateRetentionInfo
+		err := tx.QueryRowContext(ctx, `
+			SELECT status, expires_at
+			FROM objects
+			WHERE (project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+		`, opts.ProjectID, opts.BucketName, opts.ObjectKey, opts.Version).Scan(&info.Status, &info.ExpiresAt)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrObjectNotFound.New("")
+			}
+			return errs.New("unable to query object info before setting legal hold: %w", err)
+		}
+
+		switch {
+		case info.Status.IsDeleteMarker():
+			return ErrObjectStatus.New(noLockOnDeleteMarkerErrMsg)
+		case !info.Status.IsCommitted():
+			return ErrObjectStatus.New(noLockOnUncommittedErrMsg)
+		case info.ExpiresAt != nil:
+			return ErrObjectExpiration.New(noLockWithExpirationErrMsg)
+		}
+
+		return errs.Wrap(sqliteSetObjectExactVersionLegalHold(ctx, tx, opts))
+	})
+	if err != nil {
+		if ErrObjectNotFound.Has(err) || ErrObjectExpiration.Has(err) || ErrObjectStatus.Has(err) {
+			return errs.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+func sqliteSetObjectExactVersionLegalHold(ctx context.Context, tx tagsql.Tx, opts SetObjectExactVersionLegalHold) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE objects
+		SET
+			retention_mode = CASE
+				WHEN $5 THEN COALESCE(retention_mode, 0) | `+retentionModeLegalHold+` -- Enable legal hold
+				ELSE retention_mode & ~`+retentionModeLegalHold+` -- Disable legal hold
+			END
+		WHERE
+			(project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+	`, opts.ProjectID, opts.BucketName, opts.ObjectKey, opts.Version, opts.Enabled)
+	if err != nil {
+		return errs.New("unable to update object legal hold configuration: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errs.New("unable to update object legal hold configuration: %w", err)
+	}
+	if affected == 0 {
+		return ErrObjectNotFound.New("")
+	}
+
+	return nil
+}
+
 // SetObjectExactVersionRetention contains arguments necessary for setting
 // the retention configuration of an exact version of an object.
 type SetObjectExactVersionRetention struct {
@@ -741,6 +859,79 @@ func (s *SpannerAdapter) setObjectExactVersionRetention(ctx context.Context, tx
 	return nil
 }
 
+// SetObjectExactVersionRetention sets the retention configuration of an exact version of an object.
+func (s *SQLiteAdapter) SetObjectExactVersionRetention(ctx context.Context, opts SetObjectExactVersionRetention) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		var info preUpdateRetentionInfo
+		err := tx.QueryRowContext(ctx, `
+			SELECT status, expires_at, retention_mode, retain_until
+			FROM objects
+			WHERE (project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+		`, opts.ProjectID, opts.BucketName, opts.ObjectKey, opts.Version).Scan(
+			&info.Status,
+			&info.ExpiresAt,
+			lockModeWrapper{retentionMode: &info.Retention.Mode},
+			timeWrapper{&info.Retention.RetainUntil},
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrObjectNotFound.New("")
+			}
+			return errs.New("unable to query object info before setting retention: %w", err)
+		}
+
+		if err = info.verify(opts.Retention, opts.BypassGovernance, now); err != nil {
+			return errs.Wrap(err)
+		}
+
+		return errs.Wrap(sqliteSetObjectExactVersionRetention(ctx, tx, opts))
+	})
+	if err != nil {
+		if ErrObjectNotFound.Has(err) || ErrObjectExpiration.Has(err) || ErrObjectLock.Has(err) || ErrObjectStatus.Has(err) {
+			return errs.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+func sqliteSetObjectExactVersionRetention(ctx context.Context, tx tagsql.Tx, opts SetObjectExactVersionRetention) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE objects
+		SET
+			retention_mode = CASE
+				WHEN $5 != `+retentionModeNone+` THEN (COALESCE(retention_mode, `+retentionModeNone+`) & ~`+retentionModeComplianceAndGovernanceMask+`) | $5
+				ELSE retention_mode & ~`+retentionModeComplianceAndGovernanceMask+`
+			END,
+			retain_until   = $6
+		WHERE
+			(project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+	`, opts.ProjectID, opts.BucketName, opts.ObjectKey, opts.Version,
+		lockModeWrapper{retentionMode: &opts.Retention.Mode},
+		timeWrapper{&opts.Retention.RetainUntil},
+	)
+	if err != nil {
+		return errs.New("unable to update object retention configuration: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errs.New("unable to update object retention configuration: %w", err)
+	}
+	if affected == 0 {
+		return ErrObjectNotFound.New("")
+	}
+
+	return nil
+}
+
 // SetObjectLastCommittedRetention contains arguments necessary for setting
 // the retention configuration of the most recently committed version of an object.
 type SetObjectLastCommittedRetention struct {
@@ -923,6 +1114,60 @@ func (s *SpannerAdapter) SetObjectLastCommittedRetention(ctx context.Context, op
 	return nil
 }
 
+// SetObjectLastCommittedRetention sets the retention configuration of the most
+// recently committed version of an object.
+func (s *SQLiteAdapter) SetObjectLastCommittedRetention(ctx context.Context, opts SetObjectLastCommittedRetention) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		var (
+			version Version
+			info    preUpdateRetentionInfo
+		)
+		err := tx.QueryRowContext(ctx, `
+			SELECT status, version, expires_at, retention_mode, retain_until
+			FROM objects
+			WHERE
+				(project_id, bucket_name, object_key) = ($1, $2, $3)
+				AND status <> `+statusPending+`
+			ORDER BY version DESC
+			LIMIT 1
+		`, opts.ProjectID, opts.BucketName, opts.ObjectKey).Scan(
+			&info.Status,
+			&version,
+			&info.ExpiresAt,
+			lockModeWrapper{retentionMode: &info.Retention.Mode},
+			timeWrapper{&info.Retention.RetainUntil},
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrObjectNotFound.New("")
+			}
+			return errs.New("unable to query object info before setting retention: %w", err)
+		}
+
+		if err = info.verify(opts.Retention, opts.BypassGovernance, now); err != nil {
+			return errs.Wrap(err)
+		}
+
+		return errs.Wrap(sqliteSetObjectExactVersionRetention(ctx, tx, SetObjectExactVersionRetention{
+			ObjectLocation: opts.ObjectLocation,
+			Version:        version,
+			Retention:      opts.Retention,
+		}))
+	})
+	if err != nil {
+		if ErrObjectNotFound.Has(err) || ErrObjectExpiration.Has(err) || ErrObjectLock.Has(err) || ErrObjectStatus.Has(err) {
+			return errs.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
 // preUpdateRetentionInfo contains information about an object that is collected
 // before the object's retention configuration is updated.
 type preUpdateRetentionInfo struct {
@@ -197,6 +197,7 @@ type Config struct {
 	SuccessTrackerUplinks        []string              `help:"list of uplinks for success tracker"`
 	FailureTrackerChanceToSkip   float64               `help:"the chance to skip a failure tracker generation bump" default:".6"`
 	TrustedUplinks               []string              `help:"list of trusted uplinks"`
+	RegionalSuccessTracker       bool                  `help:"track the upload success of the nodes per region of the uplinks, which is available for the proximity node selector" default:"false"`
 
 	// TODO remove this flag when server-side copy implementation will be finished
 	ServerSideCopy         bool `help:"enable code for server-side copy, deprecated. please leave this to true." default:"true"`
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"cloud.google.com/go/spanner"
@@ -36,6 +37,7 @@ import (
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/replication"
 	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/trust"
 	"storj.io/storj/shared/lrucache"
@@ -97,9 +99,12 @@ type Endpoint struct {
 	zstdEncoder                    *zstd.Encoder
 	successTrackers                *SuccessTrackers
 	failureTracker                 SuccessTracker
+	regionalSuccessTrackers        *RegionalSuccessTrackers
 	trustedUplinks                 *trust.TrustedPeersList
+	placementMu                    sync.RWMutex
 	placement                      nodeselection.PlacementDefinitions
 	placementEdgeUrlOverrides      console.PlacementEdgeURLOverrides
+	replication                    *replication.Service
 
 	// rateLimiterTime is a function that returns the time to check with the rate limiter.
 	// It's handy for testing purposes. It defaults to time.Now.
@@ -111,8 +116,8 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 	orders *orders.Service, cache *overlay.Service, attributions attribution.DB, peerIdentities overlay.PeerIdentities,
 	apiKeys APIKeys, projectUsage *accounting.Service, projects console.Projects, projectMembers console.ProjectMembers, users console.Users,
 	satellite signing.Signer, revocations revocation.DB, successTrackers *SuccessTrackers, failureTracker SuccessTracker,
-	trustedUplinks *trust.TrustedPeersList, config Config, migrationModeFlag *MigrationModeFlagExtension,
-	placement nodeselection.PlacementDefinitions, placementEdgeUrlOverrides console.PlacementEdgeURLOverrides, trustedOrders bool) (
+	regionalSuccessTrackers *RegionalSuccessTrackers, trustedUplinks *trust.TrustedPeersList, config Config, migrationModeFlag *MigrationModeFlagExtension,
+	placement nodeselection.PlacementDefinitions, placementEdgeUrlOverrides console.PlacementEdgeURLOverrides, replication *replication.Service, trustedOrders bool) (
 	*Endpoint, error) {
 
 	// TODO do something with too many params
@@ -181,13 +186,33 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 		zstdEncoder:               encoder,
 		successTrackers:           successTrackers,
 		failureTracker:            failureTracker,
+		regionalSuccessTrackers:   regionalSuccessTrackers,
 		trustedUplinks:            trustedUplinks,
 		placement:                 placement,
 		placementEdgeUrlOverrides: placementEdgeUrlOverrides,
+		replication:               replication,
 		rateLimiterTime:           time.Now,
 	}, nil
 }
 
+// SetPlacements replaces the placement definitions used by the endpoint.
+func (endpoint *Endpoint) SetPlacements(ctx context.Context, placements nodeselection.PlacementDefinitions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.placementMu.Lock()
+	endpoint.placement = placements
+	endpoint.placementMu.Unlock()
+	return nil
+}
+
+// getPlacement returns the active definition of the placement.
+func (endpoint *Endpoint) getPlacement(id storj.PlacementConstraint) nodeselection.Placement {
+	endpoint.placementMu.RLock()
+	defer endpoint.placementMu.RUnlock()
+
+	return endpoint.placement[id]
+}
+
 // TestingNewAPIKeysEndpoint returns an endpoint suitable for testing api keys behaviour.
 func TestingNewAPIKeysEndpoint(log *zap.Logger, apiKeys APIKeys) *Endpoint {
 	return &Endpoint{
@@ -211,6 +236,9 @@ func (endpoint *Endpoint) Run(ctx context.Context) error {
 			return nil
 		case <-successTicker.C:
 			endpoint.successTrackers.BumpGeneration()
+			if endpoint.regionalSuccessTrackers != nil {
+				endpoint.regionalSuccessTrackers.BumpGeneration()
+			}
 		case <-failureTicker.C:
 			endpoint.failureTracker.BumpGeneration()
 		}
@@ -493,7 +521,7 @@ func (endpoint *Endpoint) usageTracking(keyInfo *console.APIKeyInfo, header *pb.
 }
 
 func (endpoint *Endpoint) getRSProto(placementID storj.PlacementConstraint) *pb.RedundancyScheme {
-	rs := endpoint.config.RS.Override(endpoint.placement[placementID].EC)
+	rs := endpoint.config.RS.Override(endpoint.getPlacement(placementID).EC)
 	return &pb.RedundancyScheme{
 		Type:             pb.RedundancyScheme_RS,
 		MinReq:           int32(rs.Min),
@@ -16,6 +16,7 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/restorepb"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metabase"
@@ -156,6 +157,84 @@ func (endpoint *Endpoint) SetBucketVersioning(ctx context.Context, req *pb.SetBu
 	return &pb.SetBucketVersioningResponse{}, nil
 }
 
+// maxRestoreKeysPerRequest is the number of object keys processed by a
+// single RestoreBucket request.
+const maxRestoreKeysPerRequest = 1000
+
+// RestoreBucket restores objects of a versioned bucket, optionally limited to
+// an encrypted key prefix, to their state at the specified time.
+func (endpoint *Endpoint) RestoreBucket(ctx context.Context, req *restorepb.RestoreBucketRequest) (resp *restorepb.RestoreBucketResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	header := &pb.RequestHeader{
+		ApiKey:    req.ApiKey,
+		UserAgent: req.UserAgent,
+	}
+	endpoint.versionCollector.collect(header.UserAgent, mon.Func().ShortName())
+
+	now := time.Now()
+	keyInfo, err := endpoint.ValidateAuthN(ctx, header, console.RateLimitPut,
+		VerifyPermission{
+			Action: macaroon.Action{
+				Op:            macaroon.ActionWrite,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedPrefix,
+				Time:          now,
+			},
+		},
+		VerifyPermission{
+			Action: macaroon.Action{
+				Op:            macaroon.ActionDelete,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedPrefix,
+				Time:          now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, header, fmt.Sprintf("%T", req))
+
+	if !endpoint.config.UseBucketLevelObjectVersioning {
+		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "versioning not allowed")
+	}
+
+	result, err := endpoint.buckets.RestoreToTime(ctx, buckets.RestoreToTime{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: metabase.BucketName(req.Bucket),
+		Prefix:     metabase.ObjectKey(req.EncryptedPrefix),
+		Time:       req.Time,
+		Cursor:     metabase.ObjectKey(req.Cursor),
+		Limit:      maxRestoreKeysPerRequest,
+	})
+	if err != nil && result.Cursor != metabase.ObjectKey(req.Cursor) {
+		// keys were restored before the failure, so we return them and let the
+		// client continue from the cursor, which retries the failed key.
+		endpoint.log.Warn("bucket restore interrupted",
+			zap.Stringer("Project ID", keyInfo.ProjectID),
+			zap.Error(err))
+		result.More, err = true, nil
+	}
+	if err != nil {
+		switch {
+		case buckets.ErrBucketNotFound.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		case buckets.ErrRestoreInvalid.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+		}
+		return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to restore bucket")
+	}
+
+	return &restorepb.RestoreBucketResponse{
+		Restored:      int64(result.Restored),
+		DeleteMarkers: int64(result.DeleteMarkers),
+		Unchanged:     int64(result.Unchanged),
+		Cursor:        []byte(result.Cursor),
+		More:          result.More,
+	}, nil
+}
+
 // CreateBucket creates a new bucket.
 func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreateRequest) (resp *pb.BucketCreateResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
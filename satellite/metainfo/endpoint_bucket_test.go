@@ -22,6 +22,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/storj/private/restorepb"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/buckets"
@@ -676,6 +677,70 @@ func TestGetBucketLocation(t *testing.T) {
 	})
 }
 
+func TestRestoreBucket(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.UseBucketLevelObjectVersioning = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+		bucketName := "testbucket"
+
+		require.NoError(t, uplink.CreateBucket(ctx, satellite, bucketName))
+		require.NoError(t, satellite.API.Buckets.Service.EnableBucketVersioning(ctx, []byte(bucketName), projectID))
+
+		original := testrand.Bytes(100)
+		require.NoError(t, uplink.Upload(ctx, satellite, bucketName, "overwritten", original))
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		restoreTime := objects[0].CreatedAt
+
+		time.Sleep(time.Millisecond)
+
+		require.NoError(t, uplink.Upload(ctx, satellite, bucketName, "overwritten", testrand.Bytes(100)))
+		require.NoError(t, uplink.Upload(ctx, satellite, bucketName, "created", testrand.Bytes(100)))
+
+		conn, err := uplink.Dialer.DialNodeURL(ctx, satellite.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := restorepb.NewDRPCBucketRestoreClient(conn)
+
+		request := &restorepb.RestoreBucketRequest{
+			ApiKey: uplink.APIKey[satellite.ID()].SerializeRaw(),
+			Bucket: []byte(bucketName),
+			Time:   restoreTime,
+		}
+
+		_, err = client.RestoreBucket(ctx, &restorepb.RestoreBucketRequest{
+			ApiKey: request.ApiKey,
+			Bucket: []byte("non-existing-bucket"),
+			Time:   restoreTime,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		response, err := client.RestoreBucket(ctx, request)
+		require.NoError(t, err)
+		require.False(t, response.More)
+		require.EqualValues(t, 1, response.Restored)
+		require.EqualValues(t, 1, response.DeleteMarkers)
+		require.EqualValues(t, 0, response.Unchanged)
+
+		data, err := uplink.Download(ctx, satellite, bucketName, "overwritten")
+		require.NoError(t, err)
+		require.Equal(t, original, data)
+
+		_, err = uplink.Download(ctx, satellite, bucketName, "created")
+		require.Error(t, err)
+	})
+}
+
 func TestEnableSuspendBucketVersioning(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, UplinkCount: 1,
@@ -24,11 +24,13 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/eventkit"
+	"storj.io/storj/private/replicationpb"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/replication"
 )
 
 const (
@@ -159,6 +161,10 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to get bucket placement")
 	}
 
+	if err := endpoint.checkBucketsNotMoving(ctx, keyInfo.ProjectID, req.Bucket); err != nil {
+		return nil, err
+	}
+
 	if retention.Enabled() || req.LegalHold {
 		if bucket.Versioning != buckets.VersioningEnabled {
 			return nil, rpcstatus.Errorf(rpcstatus.ObjectLockInvalidBucketState, "cannot specify Object Lock settings when uploading into a bucket without Versioning enabled")
@@ -391,12 +397,18 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 		return nil, err
 	}
 
+	if err := endpoint.checkBucketsNotMoving(ctx, keyInfo.ProjectID, streamID.Bucket); err != nil {
+		return nil, err
+	}
+
 	object, err := endpoint.metabase.CommitObject(ctx, request)
 	if err != nil {
 		return nil, endpoint.ConvertMetabaseErr(err)
 	}
 	committedObject = &object
 
+	endpoint.replicateCommitted(ctx, object)
+
 	pbObject, err := endpoint.objectToProto(ctx, object)
 	if err != nil {
 		return nil, endpoint.ConvertKnownErrWithMessage(err, "internal error")
@@ -563,6 +575,10 @@ func (endpoint *Endpoint) CommitInlineObject(ctx context.Context, beginObjectReq
 		return nil, nil, nil, endpoint.ConvertKnownErrWithMessage(err, "unable to get bucket placement")
 	}
 
+	if err := endpoint.checkBucketsNotMoving(ctx, keyInfo.ProjectID, beginObjectReq.Bucket); err != nil {
+		return nil, nil, nil, err
+	}
+
 	if (retention.Enabled() || beginObjectReq.LegalHold) && !bucket.ObjectLock.Enabled {
 		return nil, nil, nil, rpcstatus.Errorf(rpcstatus.ObjectLockBucketRetentionConfigurationMissing, "cannot specify Object Lock settings when uploading into a bucket without Object Lock enabled")
 	}
@@ -661,6 +677,8 @@ func (endpoint *Endpoint) CommitInlineObject(ctx context.Context, beginObjectReq
 		return nil, nil, nil, endpoint.ConvertMetabaseErr(err)
 	}
 
+	endpoint.replicateCommitted(ctx, object)
+
 	err = endpoint.orders.UpdatePutInlineOrder(ctx, metabase.BucketLocation{
 		ProjectID: keyInfo.ProjectID, BucketName: metabase.BucketName(beginObjectReq.Bucket),
 	}, inlineUsed)
@@ -2567,6 +2585,8 @@ func (endpoint *Endpoint) DeleteCommittedObject(ctx context.Context, opts Delete
 		return nil, Error.Wrap(err)
 	}
 
+	endpoint.replicateDeleted(ctx, result)
+
 	deletedObjects, err = endpoint.deleteObjectResultToProto(ctx, result)
 	if err != nil {
 		endpoint.log.Error("failed to convert delete object result",
@@ -2581,6 +2601,98 @@ func (endpoint *Endpoint) DeleteCommittedObject(ctx context.Context, opts Delete
 	return deletedObjects, nil
 }
 
+// replicateCommitted queues replication of a committed object. The object
+// is already committed, so a failure doesn't fail the request; the job is
+// queued later by the reconciliation of the replication worker.
+func (endpoint *Endpoint) replicateCommitted(ctx context.Context, object metabase.Object) {
+	if endpoint.replication == nil {
+		return
+	}
+	if err := endpoint.replication.ObjectCommitted(ctx, object); err != nil {
+		mon.Event("replication_queue_failed")
+		endpoint.log.Error("unable to queue object replication",
+			zap.Stringer("Project ID", object.ProjectID),
+			zap.Stringer("Stream ID", object.StreamID),
+			zap.Error(err))
+	}
+}
+
+// replicateDeleted queues replication of a delete. The object is already
+// deleted, so a failure doesn't fail the request; delete markers are queued
+// later by the reconciliation of the replication worker.
+func (endpoint *Endpoint) replicateDeleted(ctx context.Context, result metabase.DeleteObjectResult) {
+	if endpoint.replication == nil {
+		return
+	}
+	if err := endpoint.replication.ObjectsDeleted(ctx, result); err != nil {
+		mon.Event("replication_queue_failed")
+		endpoint.log.Error("unable to queue delete replication", zap.Error(err))
+	}
+}
+
+// GetObjectReplicationStatus returns the replication status of an object
+// version into each destination bucket.
+func (endpoint *Endpoint) GetObjectReplicationStatus(ctx context.Context, req *replicationpb.GetObjectReplicationStatusRequest) (resp *replicationpb.GetObjectReplicationStatusResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	header := &pb.RequestHeader{
+		ApiKey:    req.ApiKey,
+		UserAgent: req.UserAgent,
+	}
+	endpoint.versionCollector.collect(header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.ValidateAuthN(ctx, header, console.RateLimitHead,
+		VerifyPermission{
+			Action: macaroon.Action{
+				Op:            macaroon.ActionRead,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedObjectKey,
+				Time:          time.Now(),
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, header, fmt.Sprintf("%T", req))
+
+	if endpoint.replication == nil {
+		return nil, rpcstatus.Error(rpcstatus.Unimplemented, "bucket replication is not enabled")
+	}
+
+	objectLocation := metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: metabase.BucketName(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+	}
+
+	var version metabase.Version
+	if len(req.ObjectVersion) == 0 {
+		object, err := endpoint.metabase.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+			ObjectLocation: objectLocation,
+		})
+		if err != nil {
+			return nil, endpoint.ConvertMetabaseErr(err)
+		}
+		version = object.Version
+	} else {
+		sv, err := metabase.StreamVersionIDFromBytes(req.ObjectVersion)
+		if err != nil {
+			return nil, endpoint.ConvertMetabaseErr(err)
+		}
+		version = sv.Version()
+	}
+
+	jobs, err := endpoint.replication.ObjectStatus(ctx, objectLocation, version)
+	if err != nil {
+		return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to get object replication status")
+	}
+
+	return &replicationpb.GetObjectReplicationStatusResponse{
+		ReplicationStatus: replication.StatusToProto(jobs),
+	}, nil
+}
+
 // DeletePendingObject deletes all the pieces of the storage nodes that belongs
 // to the specified pending object.
 //
@@ -2677,6 +2789,10 @@ func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *pb.ObjectBeg
 		}
 	}
 
+	if err := endpoint.checkBucketsNotMoving(ctx, keyInfo.ProjectID, req.Bucket, req.NewBucket); err != nil {
+		return nil, err
+	}
+
 	result, err := endpoint.metabase.BeginMoveObject(ctx, metabase.BeginMoveObject{
 		ObjectLocation: metabase.ObjectLocation{
 			ProjectID:  keyInfo.ProjectID,
@@ -2868,6 +2984,10 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
 	}
 
+	if err := endpoint.checkBucketsNotMoving(ctx, keyInfo.ProjectID, streamID.Bucket, req.NewBucket); err != nil {
+		return nil, err
+	}
+
 	err = endpoint.metabase.FinishMoveObject(ctx, metabase.FinishMoveObject{
 		ObjectStream: metabase.ObjectStream{
 			ProjectID:  keyInfo.ProjectID,
@@ -2971,6 +3091,10 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 		version = sv.Version()
 	}
 
+	if err := endpoint.checkBucketsNotMoving(ctx, keyInfo.ProjectID, req.Bucket, req.NewBucket); err != nil {
+		return nil, err
+	}
+
 	result, err := endpoint.metabase.BeginCopyObject(ctx, metabase.BeginCopyObject{
 		ObjectLocation: metabase.ObjectLocation{
 			ProjectID:  keyInfo.ProjectID,
@@ -3144,6 +3268,10 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
 	}
 
+	if err := endpoint.checkBucketsNotMoving(ctx, keyInfo.ProjectID, streamID.Bucket, req.NewBucket); err != nil {
+		return nil, err
+	}
+
 	newStreamID, err := uuid.New()
 	if err != nil {
 		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
@@ -3182,6 +3310,8 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		return nil, endpoint.ConvertMetabaseErr(err)
 	}
 
+	endpoint.replicateCommitted(ctx, object)
+
 	// we can return nil redundancy because this request won't be used for downloading
 	protoObject, err := endpoint.objectToProto(ctx, object)
 	if err != nil {
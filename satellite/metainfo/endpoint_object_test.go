@@ -41,6 +41,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/common/time2"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/replicationpb"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/buckets"
@@ -49,6 +50,7 @@ import (
 	"storj.io/storj/satellite/metabase/metabasetest"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/replication"
 	"storj.io/storj/shared/nodetag"
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/contact"
@@ -6805,3 +6807,64 @@ func randObjectStream(projectID uuid.UUID, bucketName string) metabase.ObjectStr
 		StreamID:   testrand.UUID(),
 	}
 }
+
+func TestGetObjectReplicationStatus(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Replication.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+
+		require.NoError(t, uplink.CreateBucket(ctx, satellite, "source"))
+		require.NoError(t, uplink.CreateBucket(ctx, satellite, "destination"))
+		require.NoError(t, satellite.API.Replication.Service.SetRule(ctx, replication.Rule{
+			ProjectID:         projectID,
+			BucketName:        "source",
+			DestinationBucket: "destination",
+		}))
+
+		require.NoError(t, uplink.Upload(ctx, satellite, "source", "object", testrand.Bytes(100)))
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		var object metabase.Object
+		for _, o := range objects {
+			if o.BucketName == "source" {
+				object = o
+			}
+		}
+		require.NotZero(t, object.Version)
+
+		conn, err := uplink.Dialer.DialNodeURL(ctx, satellite.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := replicationpb.NewDRPCObjectReplicationClient(conn)
+
+		apiKey := uplink.APIKey[satellite.ID()].SerializeRaw()
+		for _, objectVersion := range [][]byte{nil, object.StreamVersionID().Bytes()} {
+			response, err := client.GetObjectReplicationStatus(ctx, &replicationpb.GetObjectReplicationStatusRequest{
+				ApiKey:             apiKey,
+				Bucket:             []byte("source"),
+				EncryptedObjectKey: []byte(object.ObjectKey),
+				ObjectVersion:      objectVersion,
+			})
+			require.NoError(t, err)
+			require.Len(t, response.ReplicationStatus, 1)
+			require.Equal(t, []byte("destination"), response.ReplicationStatus[0].DestinationBucket)
+			require.NotEqual(t, replicationpb.ReplicationStatus_INVALID, response.ReplicationStatus[0].Status)
+		}
+
+		_, err = client.GetObjectReplicationStatus(ctx, &replicationpb.GetObjectReplicationStatusRequest{
+			ApiKey:             apiKey,
+			Bucket:             []byte("source"),
+			EncryptedObjectKey: []byte("missing"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"storj.io/common/identity"
 	"storj.io/common/macaroon"
 	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcpeer"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
@@ -76,7 +77,7 @@ func (endpoint *Endpoint) beginSegment(ctx context.Context, req *pb.SegmentBegin
 		}
 	}
 
-	placement := endpoint.placement[storj.PlacementConstraint(streamID.Placement)]
+	placement := endpoint.getPlacement(storj.PlacementConstraint(streamID.Placement))
 	config := endpoint.config
 	rsParams := config.RS.Override(placement.EC)
 	defaultRedundancy := storj.RedundancyScheme{
@@ -90,6 +91,8 @@ func (endpoint *Endpoint) beginSegment(ctx context.Context, req *pb.SegmentBegin
 
 	maxPieceSize := defaultRedundancy.PieceSize(req.MaxOrderLimit)
 
+	endpoint.trackUplinkRegion(ctx, peer.ID)
+
 	nodes, err := endpoint.overlay.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
 		RequestedCount: int(defaultRedundancy.TotalShares),
 		Placement:      storj.PlacementConstraint(streamID.Placement),
@@ -242,11 +245,15 @@ func (endpoint *Endpoint) RetryBeginSegmentPieces(ctx context.Context, req *pb.R
 	// TODO: It's possible that a node gets reused across multiple calls to RetryBeginSegmentPieces.
 	excludedIDs := make([]storj.NodeID, 0, len(segmentID.OriginalOrderLimits))
 	successTracker := endpoint.successTrackers.GetTracker(peer.ID)
+	regionalTracker := endpoint.regionalSuccessTracker(peer.ID)
 	isTrusted := endpoint.trustedUplinks.IsTrusted(peer.ID)
 	for pieceNumber, orderLimit := range segmentID.OriginalOrderLimits {
 		excludedIDs = append(excludedIDs, orderLimit.Limit.StorageNodeId)
 		if _, found := retryingPieceNumberSet[int32(pieceNumber)]; found {
 			successTracker.Increment(orderLimit.Limit.StorageNodeId, false)
+			if regionalTracker != nil {
+				regionalTracker.Increment(orderLimit.Limit.StorageNodeId, false)
+			}
 			if isTrusted {
 				endpoint.failureTracker.Increment(orderLimit.Limit.StorageNodeId, false)
 			}
@@ -469,8 +476,12 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		tracker := endpoint.successTrackers.GetTracker(peer.ID)
 		isTrusted := endpoint.trustedUplinks.IsTrusted(peer.ID)
 		validPieceSet := make(map[storj.NodeID]struct{}, len(validPieces))
+		regionalTracker := endpoint.regionalSuccessTracker(peer.ID)
 		for _, piece := range validPieces {
 			tracker.Increment(piece.NodeId, true)
+			if regionalTracker != nil {
+				regionalTracker.Increment(piece.NodeId, true)
+			}
 			if isTrusted {
 				endpoint.failureTracker.Increment(piece.NodeId, true)
 			}
@@ -479,6 +490,9 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		for _, limit := range originalLimits {
 			if _, ok := validPieceSet[limit.StorageNodeId]; !ok {
 				tracker.Increment(limit.StorageNodeId, false)
+				if regionalTracker != nil {
+					regionalTracker.Increment(limit.StorageNodeId, false)
+				}
 				if isTrusted {
 					endpoint.failureTracker.Increment(limit.StorageNodeId, false)
 				}
@@ -840,3 +854,31 @@ func (endpoint *Endpoint) DeletePart(ctx context.Context, req *pb.PartDeleteRequ
 
 	return &pb.PartDeleteResponse{}, nil
 }
+
+// trackUplinkRegion records the approximate region of the uplink based on the
+// address of the request, which is used by the proximity aware node selection.
+func (endpoint *Endpoint) trackUplinkRegion(ctx context.Context, uplink storj.NodeID) {
+	if endpoint.regionalSuccessTrackers == nil || endpoint.regionalSuccessTrackers.Region(uplink) != "" {
+		return
+	}
+
+	peer, err := rpcpeer.FromContext(ctx)
+	if err != nil {
+		return
+	}
+	country, err := endpoint.overlay.GeoIP.LookupISOCountryCode(peer.Addr.String())
+	if err != nil {
+		endpoint.log.Debug("unable to resolve the country of the uplink", zap.Stringer("Uplink", uplink), zap.Error(err))
+		return
+	}
+	endpoint.regionalSuccessTrackers.SetRegion(uplink, country)
+}
+
+// regionalSuccessTracker returns the success tracker of the region of the
+// uplink, or nil if it's unknown.
+func (endpoint *Endpoint) regionalSuccessTracker(uplink storj.NodeID) SuccessTracker {
+	if endpoint.regionalSuccessTrackers == nil {
+		return nil
+	}
+	return endpoint.regionalSuccessTrackers.GetTracker(uplink)
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"golang.org/x/exp/maps"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
+)
+
+// RegionalSuccessTrackers manages success trackers for the approximate
+// regions (continents) of the uplinks. The success of the uploads (long tail
+// cancellation) is a good estimation of the latency and throughput between
+// the nodes and the uplinks of a region.
+type RegionalSuccessTrackers struct {
+	trackers map[string]SuccessTracker
+
+	mu sync.Mutex
+	// regions of the uplinks seen in the current and the previous generation.
+	regions         map[storj.NodeID]string
+	previousRegions map[storj.NodeID]string
+}
+
+// NewRegionalSuccessTrackers creates a new success tracker for each region.
+func NewRegionalSuccessTrackers(newTracker func() SuccessTracker) *RegionalSuccessTrackers {
+	trackers := make(map[string]SuccessTracker, len(location.Continents))
+	for continent := range location.Continents {
+		trackers[continent] = newTracker()
+	}
+	return &RegionalSuccessTrackers{
+		trackers:        trackers,
+		regions:         map[storj.NodeID]string{},
+		previousRegions: map[storj.NodeID]string{},
+	}
+}
+
+// SetRegion records the country of the uplink, based on the address of the
+// request.
+func (t *RegionalSuccessTrackers) SetRegion(uplink storj.NodeID, country location.CountryCode) {
+	region := nodeselection.ContinentOf(country)
+	if region == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.regions[uplink] = region
+}
+
+// Region implements nodeselection.ProximityTracker.
+func (t *RegionalSuccessTrackers) Region(uplink storj.NodeID) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if region, ok := t.regions[uplink]; ok {
+		return region
+	}
+	region, ok := t.previousRegions[uplink]
+	if ok {
+		// the uplink is still active, keep it for the next generation.
+		t.regions[uplink] = region
+	}
+	return region
+}
+
+// GetTracker returns the tracker of the region of the uplink, or nil if the
+// region is unknown.
+func (t *RegionalSuccessTrackers) GetTracker(uplink storj.NodeID) SuccessTracker {
+	return t.trackers[t.Region(uplink)]
+}
+
+// Get implements nodeselection.ProximityTracker.
+func (t *RegionalSuccessTrackers) Get(uplink storj.NodeID) func(node *nodeselection.SelectedNode) float64 {
+	tracker := t.GetTracker(uplink)
+	if tracker == nil {
+		return func(node *nodeselection.SelectedNode) float64 { return math.NaN() }
+	}
+	return tracker.Get
+}
+
+// BumpGeneration bumps all the regional trackers, and forgets the regions of
+// the uplinks which were not seen in the last two generations.
+func (t *RegionalSuccessTrackers) BumpGeneration() {
+	for _, tracker := range t.trackers {
+		tracker.BumpGeneration()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.previousRegions = t.regions
+	t.regions = map[storj.NodeID]string{}
+}
+
+// Stats reports monkit statistics for all of the regional trackers.
+func (t *RegionalSuccessTrackers) Stats(cb func(monkit.SeriesKey, string, float64)) {
+	regions := maps.Keys(t.trackers)
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		t.trackers[region].Stats(func(key monkit.SeriesKey, field string, val float64) {
+			cb(key.WithTag("region", region), field, val)
+		})
+	}
+}
+
+var _ nodeselection.ProximityTracker = (*RegionalSuccessTrackers)(nil)
@@ -4,6 +4,7 @@
 package metainfo
 
 import (
+	"math"
 	"sync"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
 )
 
 func TestBitshiftSuccessTracker(t *testing.T) {
@@ -433,3 +435,35 @@ func TestBigBitList_small(t *testing.T) {
 	}
 	require.Equal(t, float64(3), tracker.Get(&nodeselection.SelectedNode{}))
 }
+
+func TestRegionalSuccessTrackers(t *testing.T) {
+	t.Parallel()
+
+	trackers := NewRegionalSuccessTrackers(NewPercentSuccessTracker)
+
+	uplinkEU, uplinkAS, unknown := storj.NodeID{0: 1}, storj.NodeID{0: 2}, storj.NodeID{0: 3}
+	node := &nodeselection.SelectedNode{ID: storj.NodeID{0: 10}}
+
+	trackers.SetRegion(uplinkEU, location.Germany)
+	trackers.SetRegion(uplinkAS, location.Japan)
+	trackers.SetRegion(unknown, location.None)
+
+	require.Equal(t, "EU", trackers.Region(uplinkEU))
+	require.Equal(t, "AS", trackers.Region(uplinkAS))
+	require.Equal(t, "", trackers.Region(unknown))
+	require.Nil(t, trackers.GetTracker(unknown))
+	require.True(t, math.IsNaN(trackers.Get(unknown)(node)))
+
+	trackers.GetTracker(uplinkEU).Increment(node.ID, true)
+	trackers.GetTracker(uplinkAS).Increment(node.ID, false)
+
+	require.Equal(t, 1.0, trackers.Get(uplinkEU)(node))
+	require.Equal(t, 0.0, trackers.Get(uplinkAS)(node))
+
+	// the region of the active uplinks is kept, inactive uplinks are forgotten.
+	trackers.BumpGeneration()
+	require.Equal(t, "EU", trackers.Region(uplinkEU))
+	trackers.BumpGeneration()
+	require.Equal(t, "EU", trackers.Region(uplinkEU))
+	require.Equal(t, "", trackers.Region(uplinkAS))
+}
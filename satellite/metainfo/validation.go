@@ -4,11 +4,9 @@
 package metainfo
 
 import (
-	"bytes"
 	"context"
 	"crypto/subtle"
 	"fmt"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -41,7 +39,6 @@ const (
 )
 
 var (
-	ipRegexp           = regexp.MustCompile(`^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$`)
 	unauthorizedErrMsg = "Unauthorized API credentials"
 )
 
@@ -413,44 +410,7 @@ func validateBucketName(bucket []byte) error {
 	if err := validateBucketNameLength(bucket); err != nil {
 		return err
 	}
-
-	// Regexp not used because benchmark shows it will be slower for valid bucket names
-	// https://gist.github.com/mniewrzal/49de3af95f36e63e88fac24f565e444c
-	labels := bytes.Split(bucket, []byte("."))
-	for _, label := range labels {
-		err := validateBucketLabel(label)
-		if err != nil {
-			return err
-		}
-	}
-
-	if ipRegexp.MatchString(string(bucket)) {
-		return Error.New("bucket name cannot be formatted as an IP address")
-	}
-
-	return nil
-}
-
-func validateBucketLabel(label []byte) error {
-	if len(label) == 0 {
-		return Error.New("bucket label cannot be empty")
-	}
-
-	if !isLowerLetter(label[0]) && !isDigit(label[0]) {
-		return Error.New("bucket label must start with a lowercase letter or number")
-	}
-
-	if !isLowerLetter(label[len(label)-1]) && !isDigit(label[len(label)-1]) {
-		return Error.New("bucket label must end with a lowercase letter or number")
-	}
-
-	for i := 1; i < len(label)-1; i++ {
-		if !isLowerLetter(label[i]) && !isDigit(label[i]) && (label[i] != '-') && (label[i] != '.') {
-			return Error.New("bucket name must contain only lowercase letters, numbers or hyphens")
-		}
-	}
-
-	return nil
+	return Error.Wrap(buckets.ValidateName(bucket))
 }
 
 func validateObjectVersion(version []byte) error {
@@ -617,14 +577,6 @@ func nilDoublePtr[T any]() **T {
 	return &ptr
 }
 
-func isLowerLetter(r byte) bool {
-	return r >= 'a' && r <= 'z'
-}
-
-func isDigit(r byte) bool {
-	return r >= '0' && r <= '9'
-}
-
 func (endpoint *Endpoint) validateRemoteSegment(ctx context.Context, commitRequest metabase.CommitSegment, originalLimits []*pb.OrderLimit) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -830,6 +782,22 @@ func (endpoint *Endpoint) checkObjectUploadRate(ctx context.Context, projectID u
 	return nil
 }
 
+// checkBucketsNotMoving returns an error when any of the buckets is being
+// moved between projects. Objects written during the move could be left
+// behind in the source project or collide with the moved objects.
+func (endpoint *Endpoint) checkBucketsNotMoving(ctx context.Context, projectID uuid.UUID, bucketNames ...[]byte) error {
+	for _, bucketName := range bucketNames {
+		moving, err := endpoint.buckets.IsBucketMoving(ctx, bucketName, projectID)
+		if err != nil {
+			return endpoint.ConvertKnownErrWithMessage(err, "unable to check bucket state")
+		}
+		if moving {
+			return rpcstatus.Errorf(rpcstatus.FailedPrecondition, "bucket is being moved into a different project: %s", bucketName)
+		}
+	}
+	return nil
+}
+
 func keyInfoToLimits(keyInfo *console.APIKeyInfo) accounting.ProjectLimits {
 	if keyInfo == nil {
 		return accounting.ProjectLimits{}
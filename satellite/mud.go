@@ -12,6 +12,8 @@ import (
 	"storj.io/common/peertls/tlsopts"
 	"storj.io/common/rpc"
 	"storj.io/common/signing"
+	"storj.io/storj/private/replicationpb"
+	"storj.io/storj/private/restorepb"
 	"storj.io/storj/private/revocation"
 	"storj.io/storj/private/server"
 	"storj.io/storj/satellite/accounting"
@@ -29,6 +31,7 @@ import (
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/piecelist"
+	"storj.io/storj/satellite/replication"
 	srevocation "storj.io/storj/satellite/revocation"
 	sndebug "storj.io/storj/shared/debug"
 	"storj.io/storj/shared/modular/config"
@@ -84,6 +87,9 @@ func Module(ball *mud.Ball) {
 	buckets.Module(ball)
 	mud.View[DB, buckets.DB](ball, DB.Buckets)
 
+	replication.Module(ball)
+	mud.View[DB, replication.DB](ball, DB.Replication)
+
 	mud.View[DB, attribution.DB](ball, DB.Attribution)
 
 	mud.View[DB, overlay.PeerIdentities](ball, DB.PeerIdentities)
@@ -114,6 +120,14 @@ func Module(ball *mud.Ball) {
 		if err != nil {
 			return nil, err
 		}
+		err = restorepb.DRPCRegisterBucketRestore(srv.DRPC(), metainfoEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		err = replicationpb.DRPCRegisterObjectReplication(srv.DRPC(), metainfoEndpoint)
+		if err != nil {
+			return nil, err
+		}
 		return &EndpointRegistration{}, nil
 	})
 
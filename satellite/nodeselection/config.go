@@ -9,6 +9,7 @@ import (
 	"math"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/jtolio/mito"
 	"github.com/zeebo/errs"
@@ -66,8 +67,10 @@ var _ UploadSuccessTracker = NoopSuccessTracker{}
 
 // PlacementConfigEnvironment includes all generic functions and variables, which can be used in the configuration.
 type PlacementConfigEnvironment struct {
-	successTracker UploadSuccessTracker
-	failureTracker UploadFailureTracker
+	successTracker   UploadSuccessTracker
+	failureTracker   UploadFailureTracker
+	proximityTracker ProximityTracker
+	fillRateTracker  *FillRateTracker
 }
 
 // NewPlacementConfigEnvironment creates PlacementConfigEnvironment.
@@ -79,11 +82,27 @@ func NewPlacementConfigEnvironment(successTracker UploadSuccessTracker, failureT
 		failureTracker = uploadFailureTrackerFunc(func(node *SelectedNode) float64 { return math.NaN() })
 	}
 	return &PlacementConfigEnvironment{
-		successTracker: successTracker,
-		failureTracker: failureTracker,
+		successTracker:   successTracker,
+		failureTracker:   failureTracker,
+		proximityTracker: NoopProximityTracker{},
+		fillRateTracker:  NewFillRateTracker(),
 	}
 }
 
+// FillRateTracker returns the tracker, which is used by the fillrate selectors.
+// It should be fed with the free disk space reported by the nodes.
+func (e *PlacementConfigEnvironment) FillRateTracker() *FillRateTracker {
+	return e.fillRateTracker
+}
+
+// WithProximityTracker sets the tracker, which is available as proximityTracker in the configuration.
+func (e *PlacementConfigEnvironment) WithProximityTracker(tracker ProximityTracker) *PlacementConfigEnvironment {
+	if tracker != nil {
+		e.proximityTracker = tracker
+	}
+	return e
+}
+
 func (e *PlacementConfigEnvironment) apply(env map[any]any) {
 	if e == nil {
 		return
@@ -91,6 +110,7 @@ func (e *PlacementConfigEnvironment) apply(env map[any]any) {
 	env["tracker"] = e.successTracker // backcompat
 	env["uploadSuccessTracker"] = e.successTracker
 	env["uploadFailureTracker"] = e.failureTracker
+	env["proximityTracker"] = e.proximityTracker
 }
 
 // LoadConfig loads the placement yaml file and creates the Placement definitions.
@@ -363,6 +383,18 @@ func SelectorFromString(expr string, environment *PlacementConfigEnvironment) (N
 		"topology":   TopologySelector,
 		"filterbest": FilterBest,
 		"bestofn":    BestOfN,
+		"proximity":  ProximitySelector,
+		"fillrate": func(horizon string) (NodeSelectorInit, error) {
+			duration, err := time.ParseDuration(horizon)
+			if err != nil {
+				return nil, errs.New("invalid fill rate horizon %q: %v", horizon, err)
+			}
+			tracker := NewFillRateTracker()
+			if environment != nil {
+				tracker = environment.fillRateTracker
+			}
+			return FillRateSelector(tracker, duration), nil
+		},
 		"eq": func(a, b string) func(SelectedNode) bool {
 			attr, err := CreateNodeAttribute(a)
 			if err != nil {
@@ -273,6 +273,15 @@ func TestAttributeFilter(t *testing.T) {
 	require.False(t, filter.Match(&SelectedNode{
 		Email: "email@test",
 	}))
+
+	filter, err = NewAttributeFilter("quic_reachable", "==", "true")
+	require.NoError(t, err)
+	require.True(t, filter.Match(&SelectedNode{
+		Reachable: Transports{TCP: true, QUIC: true},
+	}))
+	require.False(t, filter.Match(&SelectedNode{
+		Reachable: Transports{TCP: true},
+	}))
 }
 
 // BenchmarkNodeFilterFullTable checks performances of rule evaluation on ALL storage nodes.
@@ -56,3 +56,38 @@ func TestClumpingByAnyTag(t *testing.T) {
 	require.Equal(t, 1, result.Count())
 	require.True(t, result.Contains(11))
 }
+
+func TestClumpingByASN(t *testing.T) {
+	node := func(ix int, asn uint32) SelectedNode {
+		return SelectedNode{
+			ID:  testidentity.MustPregeneratedSignedIdentity(ix, storj.LatestIDVersion()).ID,
+			ASN: asn,
+		}
+	}
+	piece := func(ix int, nodeIx int) metabase.Piece {
+		return metabase.Piece{
+			Number: uint16(ix), StorageNode: testidentity.MustPregeneratedSignedIdentity(nodeIx, storj.LatestIDVersion()).ID,
+		}
+	}
+
+	invariant := ClumpingByAttribute(mustCreateNodeAttribute("asn"), 1)
+	result := invariant(
+		metabase.Pieces{
+			piece(1, 1),
+			piece(2, 2),
+			piece(3, 3),
+			piece(4, 4),
+			piece(5, 5),
+		},
+		[]SelectedNode{
+			node(1, 16509),
+			node(2, 16509),
+			node(3, 24940),
+			// nodes with unknown ASN are not clumped together
+			node(4, 0),
+			node(5, 0),
+		})
+
+	require.Equal(t, 1, result.Count())
+	require.True(t, result.Contains(2))
+}
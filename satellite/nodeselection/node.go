@@ -58,6 +58,21 @@ type SelectedNode struct {
 	PieceCount  int64
 	// free disk space in bytes
 	FreeDisk int64
+	// ASN is the autonomous system number of the last IP, 0 if it's unknown.
+	ASN uint32
+	// ASOrganization is the organization registered for the autonomous system (usually the hosting provider).
+	ASOrganization string
+	// InMaintenance is true when the node is in a maintenance window declared by the operator.
+	InMaintenance bool
+	// Reachable contains the transports which succeeded during the last check-in.
+	Reachable Transports
+}
+
+// Transports contains a flag for each transport a node can be dialed with.
+type Transports struct {
+	TCP   bool
+	QUIC  bool
+	Noise bool
 }
 
 // Clone returns a deep clone of the selected node.
@@ -211,10 +226,33 @@ func CreateNodeAttribute(attr string) (NodeAttribute, error) {
 		return func(node SelectedNode) string {
 			return node.CountryCode.String()
 		}, nil
+	case "asn":
+		return func(node SelectedNode) string {
+			if node.ASN == 0 {
+				return ""
+			}
+			return strconv.FormatUint(uint64(node.ASN), 10)
+		}, nil
+	case "asn_org":
+		return func(node SelectedNode) string {
+			return node.ASOrganization
+		}, nil
 	case "vetted":
 		return func(node SelectedNode) string {
 			return fmt.Sprintf("%t", node.Vetted)
 		}, nil
+	case "tcp_reachable":
+		return func(node SelectedNode) string {
+			return fmt.Sprintf("%t", node.Reachable.TCP)
+		}, nil
+	case "quic_reachable":
+		return func(node SelectedNode) string {
+			return fmt.Sprintf("%t", node.Reachable.QUIC)
+		}, nil
+	case "noise_reachable":
+		return func(node SelectedNode) string {
+			return fmt.Sprintf("%t", node.Reachable.Noise)
+		}, nil
 	default:
 		return nil, errors.New("Unsupported node attribute: " + attr)
 	}
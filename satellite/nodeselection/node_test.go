@@ -43,6 +43,17 @@ func TestNodeAttribute(t *testing.T) {
 		CountryCode: location.Germany,
 	}))
 
+	assert.Equal(t, "24940", must(CreateNodeAttribute("asn"))(SelectedNode{
+		ASN: 24940,
+	}))
+
+	assert.Equal(t, "", must(CreateNodeAttribute("asn"))(SelectedNode{}))
+
+	assert.Equal(t, "Hetzner Online GmbH", must(CreateNodeAttribute("asn_org"))(SelectedNode{
+		ASN:            24940,
+		ASOrganization: "Hetzner Online GmbH",
+	}))
+
 	signerID := testidentity.MustPregeneratedIdentity(1, storj.LatestIDVersion()).ID
 	otherSignerID := testidentity.MustPregeneratedIdentity(2, storj.LatestIDVersion()).ID
 
@@ -79,6 +90,13 @@ func TestNodeAttribute(t *testing.T) {
 		Vetted: true,
 	}))
 
+	reachable := SelectedNode{
+		Reachable: Transports{TCP: true, Noise: true},
+	}
+	assert.Equal(t, "true", must(CreateNodeAttribute("tcp_reachable"))(reachable))
+	assert.Equal(t, "false", must(CreateNodeAttribute("quic_reachable"))(reachable))
+	assert.Equal(t, "true", must(CreateNodeAttribute("noise_reachable"))(reachable))
+
 	_, err := CreateNodeAttribute("tag:xxx/foo")
 	require.ErrorContains(t, err, "has invalid NodeID")
 
@@ -130,6 +130,34 @@ func (c *ConfigurablePlacementRule) Type() string {
 // Parse creates the PlacementDefinitions from the string rules.
 // defaultPlacement is used to create the placement if no placement has been set.
 func (c ConfigurablePlacementRule) Parse(defaultPlacement func() (Placement, error), environment *PlacementConfigEnvironment) (PlacementDefinitions, error) {
+	rules, isYAML, err := c.source()
+	if err != nil {
+		return nil, err
+	}
+	return c.parseSource(rules, isYAML, defaultPlacement, environment)
+}
+
+// source returns the placement rules, which are read from the file when
+// PlacementRules is the path of an existing file.
+func (c ConfigurablePlacementRule) source() (rules string, isYAML bool, err error) {
+	rules = c.PlacementRules
+	if _, err := os.Stat(rules); err == nil {
+		// new style of config, all others are deprecated
+		isYAML = strings.HasSuffix(rules, ".yaml")
+		ruleBytes, err := os.ReadFile(rules)
+		if err != nil {
+			if isYAML {
+				return "", false, errs.New("Couldn't read placement config file from %s: %v", rules, err)
+			}
+			return "", false, ErrPlacement.New("Placement definition file couldn't be read: %s %v", rules, err)
+		}
+		rules = string(ruleBytes)
+	}
+	return rules, isYAML, nil
+}
+
+// parseSource creates the PlacementDefinitions from the rules returned by source.
+func (c ConfigurablePlacementRule) parseSource(rules string, isYAML bool, defaultPlacement func() (Placement, error), environment *PlacementConfigEnvironment) (PlacementDefinitions, error) {
 	if environment == nil {
 		environment = NewPlacementConfigEnvironment(nil, nil)
 	}
@@ -142,18 +170,12 @@ func (c ConfigurablePlacementRule) Parse(defaultPlacement func() (Placement, err
 		pdef.AddLegacyStaticRules()
 		return pdef, nil
 	}
-	rules := c.PlacementRules
-	if _, err := os.Stat(rules); err == nil {
-		if strings.HasSuffix(rules, ".yaml") {
-			// new style of config, all others are deprecated
-			return LoadConfig(rules, environment)
-
-		}
-		ruleBytes, err := os.ReadFile(rules)
+	if isYAML {
+		placements, err := LoadConfigFromString(rules, environment)
 		if err != nil {
-			return nil, ErrPlacement.New("Placement definition file couldn't be read: %s %v", rules, err)
+			return nil, errs.New("Couldn't parse placement config file from %s: %v", c.PlacementRules, err)
 		}
-		rules = string(ruleBytes)
+		return placements, nil
 	}
 	if strings.HasPrefix(rules, "/") || strings.HasPrefix(rules, "./") || strings.HasPrefix(rules, "../") {
 		return nil, ErrPlacement.New("Placement definition (%s) looks to be a path, but file doesn't exist at that place", rules)
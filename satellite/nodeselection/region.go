@@ -3,7 +3,11 @@
 
 package nodeselection
 
-import "storj.io/storj/shared/location"
+import (
+	"sync"
+
+	"storj.io/storj/shared/location"
+)
 
 // EuCountries defines the member countries of European Union.
 var EuCountries = []location.CountryCode{
@@ -42,3 +46,18 @@ var EeaCountriesWithoutEu = []location.CountryCode{
 	location.Liechtenstein,
 	location.Norway,
 }
+
+var continentOf = sync.OnceValue(func() map[location.CountryCode]string {
+	continents := map[location.CountryCode]string{}
+	for continent, countries := range location.Continents {
+		for _, country := range countries {
+			continents[country] = continent
+		}
+	}
+	return continents
+})
+
+// ContinentOf returns the continent code of the country, or an empty string if it's unknown.
+func ContinentOf(country location.CountryCode) string {
+	return continentOf()[country]
+}
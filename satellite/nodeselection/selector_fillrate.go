@@ -0,0 +1,157 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// fillRateSmoothing is the weight of the latest ingress rate sample in the
+// moving average.
+const fillRateSmoothing = 0.5
+
+// fillRateExpiration is how long a node is tracked without a check-in.
+const fillRateExpiration = 24 * time.Hour
+
+// FillRateTracker estimates the ingress rate of the nodes, based on the
+// changes of the free disk space, which is reported by the nodes at check-in.
+//
+// A single tracker is shared by all the fillrate selectors of the placement
+// configuration.
+type FillRateTracker struct {
+	mu    sync.Mutex
+	nodes map[storj.NodeID]fillRateState
+}
+
+type fillRateState struct {
+	freeDisk int64
+	since    time.Time
+	// seen is the time of the last check-in.
+	seen time.Time
+	// rate is the estimated ingress in bytes per second, NaN if unknown.
+	rate float64
+}
+
+// NewFillRateTracker creates a new FillRateTracker.
+func NewFillRateTracker() *FillRateTracker {
+	return &FillRateTracker{
+		nodes: map[storj.NodeID]fillRateState{},
+	}
+}
+
+// Observe records the free disk space reported by the node at check-in.
+func (t *FillRateTracker) Observe(id storj.NodeID, freeDisk int64, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, ok := t.nodes[id]
+	if !ok {
+		t.nodes[id] = fillRateState{freeDisk: freeDisk, since: now, seen: now, rate: math.NaN()}
+		return
+	}
+	if now.After(state.seen) {
+		state.seen = now
+	}
+
+	elapsed := now.Sub(state.since).Seconds()
+	if state.freeDisk != freeDisk && elapsed > 0 {
+		// free space can grow because of deletes, which is not ingress.
+		sample := math.Max(0, float64(state.freeDisk-freeDisk)) / elapsed
+		if math.IsNaN(state.rate) {
+			state.rate = sample
+		} else {
+			state.rate = fillRateSmoothing*sample + (1-fillRateSmoothing)*state.rate
+		}
+		state.freeDisk, state.since = freeDisk, now
+	}
+	t.nodes[id] = state
+}
+
+// Prune removes the nodes which didn't check in since the specified time.
+func (t *FillRateTracker) Prune(before time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for id, state := range t.nodes {
+		if state.seen.Before(before) {
+			delete(t.nodes, id)
+		}
+	}
+}
+
+// Rate returns the estimated ingress rate of the node in bytes per second, or
+// NaN if it's unknown.
+func (t *FillRateTracker) Rate(id storj.NodeID) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, ok := t.nodes[id]
+	if !ok {
+		return math.NaN()
+	}
+	return state.rate
+}
+
+// FillRateSelector selects nodes weighted by their expected fill time (free
+// disk space / ingress rate), to make all nodes fill up at the same time.
+// Nodes which are predicted to be full within the horizon (usually the
+// check-in interval of the nodes) are not selected.
+//
+// Nodes without known ingress rate are weighted with the median rate of the
+// other nodes.
+func FillRateSelector(tracker *FillRateTracker, horizon time.Duration) NodeSelectorInit {
+	return func(nodes []*SelectedNode, filter NodeFilter) NodeSelector {
+		tracker.Prune(time.Now().Add(-fillRateExpiration))
+
+		var rates []float64
+		for _, node := range nodes {
+			if rate := tracker.Rate(node.ID); !math.IsNaN(rate) && rate > 0 {
+				rates = append(rates, rate)
+			}
+		}
+		defaultRate, minRate := math.NaN(), float64(1)
+		if len(rates) > 0 {
+			slices.Sort(rates)
+			defaultRate = rates[len(rates)/2]
+			minRate = math.Max(minRate, defaultRate/10)
+		}
+
+		predictedFull := 0
+		weights := make(map[storj.NodeID]float64, len(nodes))
+		for _, node := range nodes {
+			if node.FreeDisk <= 0 {
+				predictedFull++
+				continue
+			}
+			rate := tracker.Rate(node.ID)
+			if math.IsNaN(rate) {
+				rate = defaultRate
+			}
+			if math.IsNaN(rate) {
+				// without any information, the weight is the free space.
+				weights[node.ID] = float64(node.FreeDisk)
+				continue
+			}
+			if rate*horizon.Seconds() >= float64(node.FreeDisk) {
+				predictedFull++
+				continue
+			}
+			weights[node.ID] = float64(node.FreeDisk) / math.Max(rate, minRate)
+		}
+		mon.IntVal("fill_rate_predicted_full").Observe(int64(predictedFull))
+
+		notFull := NodeFilterFunc(func(node *SelectedNode) bool {
+			_, ok := weights[node.ID]
+			return ok
+		})
+		return WeightedSelector(func(node SelectedNode) float64 {
+			return weights[node.ID]
+		}, notFull)(nodes, filter)
+	}
+}
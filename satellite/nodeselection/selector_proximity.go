@@ -0,0 +1,118 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection
+
+import (
+	"math"
+	"slices"
+
+	"storj.io/common/storj"
+)
+
+// ProximityTracker provides information about the network proximity of the
+// nodes to the uplinks.
+type ProximityTracker interface {
+	// Region returns the approximate region (continent code) of the uplink,
+	// or an empty string if it's unknown.
+	Region(uplink storj.NodeID) string
+
+	// Get returns a function which scores the nodes by the expected
+	// latency/throughput from the region of the uplink. Higher is better, NaN
+	// means that there is no information about the node.
+	Get(uplink storj.NodeID) func(node *SelectedNode) float64
+}
+
+// NoopProximityTracker doesn't know the region of any uplink.
+type NoopProximityTracker struct{}
+
+// Region implements ProximityTracker.
+func (NoopProximityTracker) Region(uplink storj.NodeID) string { return "" }
+
+// Get implements ProximityTracker.
+func (NoopProximityTracker) Get(uplink storj.NodeID) func(node *SelectedNode) float64 {
+	return func(node *SelectedNode) float64 { return math.NaN() }
+}
+
+var _ ProximityTracker = NoopProximityTracker{}
+
+// ProximitySelector selects ratio times more nodes with the delegate, and
+// prefers the nodes with the best score for the region of the uplink. Nodes
+// without score are ordered by their continent: nodes in the same region as
+// the uplink are preferred.
+//
+// At least the diversity fraction of the selected nodes are chosen from other
+// regions (when available), to keep the pieces geographically distributed.
+func ProximitySelector(tracker ProximityTracker, ratio float64, diversity float64, delegate NodeSelectorInit) NodeSelectorInit {
+	return func(nodes []*SelectedNode, filter NodeFilter) NodeSelector {
+		wrappedSelector := delegate(nodes, filter)
+		return func(requester storj.NodeID, n int, excluded []storj.NodeID, alreadySelected []*SelectedNode) ([]*SelectedNode, error) {
+			region := tracker.Region(requester)
+			if region == "" {
+				return wrappedSelector(requester, n, excluded, alreadySelected)
+			}
+
+			candidates, err := wrappedSelector(requester, int(math.Ceil(ratio*float64(n))), excluded, alreadySelected)
+			if err != nil {
+				return candidates, err
+			}
+			if len(candidates) <= n {
+				return candidates, nil
+			}
+
+			score := tracker.Get(requester)
+			local := func(node *SelectedNode) bool {
+				return ContinentOf(node.CountryCode) == region
+			}
+			slices.SortStableFunc(candidates, func(a, b *SelectedNode) int {
+				scoreA, scoreB := score(a), score(b)
+				switch {
+				case math.IsNaN(scoreA) && math.IsNaN(scoreB):
+					if local(a) == local(b) {
+						return 0
+					}
+					if local(a) {
+						return -1
+					}
+					return 1
+				case math.IsNaN(scoreA):
+					return 1
+				case math.IsNaN(scoreB):
+					return -1
+				case scoreA > scoreB:
+					return -1
+				case scoreA < scoreB:
+					return 1
+				}
+				return 0
+			})
+
+			maxLocal := n - min(n, int(math.Ceil(diversity*float64(n))))
+
+			var selected, skipped []*SelectedNode
+			localCount := 0
+			for _, node := range candidates {
+				if len(selected) >= n {
+					break
+				}
+				if local(node) {
+					if localCount >= maxLocal {
+						skipped = append(skipped, node)
+						continue
+					}
+					localCount++
+				}
+				selected = append(selected, node)
+			}
+
+			// not enough nodes from other regions.
+			for _, node := range skipped {
+				if len(selected) >= n {
+					break
+				}
+				selected = append(selected, node)
+			}
+			return selected, nil
+		}
+	}
+}
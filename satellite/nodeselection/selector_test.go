@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/jtolio/mito"
 	"github.com/stretchr/testify/assert"
@@ -1235,3 +1236,189 @@ func TestWeightedSelector(t *testing.T) {
 	require.Greater(t, float64(histogram[nodes[0].ID])/float64(histogram[nodes[1].ID]), float64(3))
 
 }
+
+type mockProximityTracker struct {
+	regions map[storj.NodeID]string
+	scores  map[storj.NodeID]float64
+}
+
+func (m *mockProximityTracker) Region(uplink storj.NodeID) string {
+	return m.regions[uplink]
+}
+
+func (m *mockProximityTracker) Get(uplink storj.NodeID) func(node *nodeselection.SelectedNode) float64 {
+	return func(node *nodeselection.SelectedNode) float64 {
+		if score, ok := m.scores[node.ID]; ok {
+			return score
+		}
+		return math.NaN()
+	}
+}
+
+func TestProximitySelector(t *testing.T) {
+	uplink := testrand.NodeID()
+	tracker := &mockProximityTracker{
+		regions: map[storj.NodeID]string{uplink: "AS"},
+		scores:  map[storj.NodeID]float64{},
+	}
+
+	var nodes []*nodeselection.SelectedNode
+	for i := 0; i < 20; i++ {
+		country := location.Germany
+		if i%2 == 0 {
+			country = location.Japan
+		}
+		nodes = append(nodes, &nodeselection.SelectedNode{
+			ID:          testrand.NodeID(),
+			CountryCode: country,
+		})
+	}
+
+	countJapan := func(selected []*nodeselection.SelectedNode) (count int) {
+		for _, node := range selected {
+			if node.CountryCode == location.Japan {
+				count++
+			}
+		}
+		return count
+	}
+
+	selector := nodeselection.ProximitySelector(tracker, 2, 0.2, nodeselection.RandomSelector())(nodes, nil)
+
+	t.Run("without statistics", func(t *testing.T) {
+		selected, err := selector(uplink, 10, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, selected, 10)
+		require.Equal(t, 8, countJapan(selected))
+	})
+
+	t.Run("with statistics", func(t *testing.T) {
+		for i, node := range nodes {
+			// the best nodes are the nodes in Japan, except the first two.
+			if node.CountryCode == location.Japan && i > 2 {
+				tracker.scores[node.ID] = 1
+			} else {
+				tracker.scores[node.ID] = 0
+			}
+		}
+
+		selected, err := selector(uplink, 10, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, selected, 10)
+		require.Equal(t, 8, countJapan(selected))
+		for _, node := range selected {
+			if node.CountryCode == location.Japan {
+				require.Equal(t, float64(1), tracker.scores[node.ID])
+			}
+		}
+	})
+
+	t.Run("not enough remote nodes", func(t *testing.T) {
+		selector := nodeselection.ProximitySelector(tracker, 2, 0.8, nodeselection.RandomSelector())(nodes, nil)
+		selected, err := selector(uplink, 15, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, selected, 15)
+		require.Equal(t, 5, countJapan(selected))
+	})
+
+	t.Run("unknown region", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			selected, err := selector(testrand.NodeID(), 10, nil, nil)
+			require.NoError(t, err)
+			require.Len(t, selected, 10)
+		}
+	})
+
+	t.Run("config", func(t *testing.T) {
+		env := nodeselection.NewPlacementConfigEnvironment(nil, nil).WithProximityTracker(tracker)
+		init, err := nodeselection.SelectorFromString(`proximity(proximityTracker, 2.0, 0.2, random())`, env)
+		require.NoError(t, err)
+
+		selected, err := init(nodes, nil)(uplink, 10, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, 8, countJapan(selected))
+	})
+}
+
+func TestFillRateTracker(t *testing.T) {
+	tracker := nodeselection.NewFillRateTracker()
+	id := testrand.NodeID()
+
+	now := time.Now()
+	tracker.Observe(id, 10000, now)
+	require.True(t, math.IsNaN(tracker.Rate(id)))
+
+	// free space didn't change yet
+	tracker.Observe(id, 10000, now.Add(time.Minute))
+	require.True(t, math.IsNaN(tracker.Rate(id)))
+
+	tracker.Observe(id, 9000, now.Add(100*time.Second))
+	require.Equal(t, float64(10), tracker.Rate(id))
+
+	// deletes are not ingress
+	tracker.Observe(id, 9500, now.Add(200*time.Second))
+	require.Equal(t, float64(5), tracker.Rate(id))
+
+	// nodes without a recent check-in are dropped
+	tracker.Prune(now.Add(200 * time.Second))
+	require.Equal(t, float64(5), tracker.Rate(id))
+	tracker.Prune(now.Add(201 * time.Second))
+	require.True(t, math.IsNaN(tracker.Rate(id)))
+}
+
+func TestFillRateSelector(t *testing.T) {
+	tracker := nodeselection.NewFillRateTracker()
+
+	small := &nodeselection.SelectedNode{ID: testrand.NodeID(), FreeDisk: 10_000_000}
+	large := &nodeselection.SelectedNode{ID: testrand.NodeID(), FreeDisk: 1_000_000_000}
+	almostFull := &nodeselection.SelectedNode{ID: testrand.NodeID(), FreeDisk: 1_000_000}
+	full := &nodeselection.SelectedNode{ID: testrand.NodeID(), FreeDisk: 0}
+	nodes := []*nodeselection.SelectedNode{small, large, almostFull, full}
+
+	// the small and the large node receive 1000 bytes/s, the almost full node
+	// receives ~1MB/s, which fills it up before the next check-in.
+	start := time.Now().Add(-time.Hour)
+	for _, node := range nodes {
+		tracker.Observe(node.ID, node.FreeDisk, start)
+	}
+	small.FreeDisk -= 1000
+	large.FreeDisk -= 1000
+	almostFull.FreeDisk -= 1_000_000 - 1000
+	for _, node := range nodes {
+		tracker.Observe(node.ID, node.FreeDisk, start.Add(time.Second))
+	}
+
+	selector := nodeselection.FillRateSelector(tracker, time.Hour)(nodes, nil)
+
+	counts := map[storj.NodeID]int{}
+	for i := 0; i < 1000; i++ {
+		selected, err := selector(storj.NodeID{}, 1, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, selected, 1)
+		counts[selected[0].ID]++
+	}
+	require.Zero(t, counts[full.ID])
+	require.Zero(t, counts[almostFull.ID])
+	require.Greater(t, counts[large.ID], counts[small.ID])
+
+	_, err := nodeselection.SelectorFromString(`fillrate("1h")`, nil)
+	require.NoError(t, err)
+
+	// the selectors of the environment share the tracker.
+	env := nodeselection.NewPlacementConfigEnvironment(nil, nil)
+	env.FillRateTracker().Observe(almostFull.ID, 1_000_000, start)
+	env.FillRateTracker().Observe(almostFull.ID, 1000, start.Add(time.Second))
+	env.FillRateTracker().Observe(large.ID, large.FreeDisk+1000, start)
+	env.FillRateTracker().Observe(large.ID, large.FreeDisk, start.Add(time.Second))
+	for _, expr := range []string{`fillrate("1h")`, `fillrate("2h")`} {
+		init, err := nodeselection.SelectorFromString(expr, env)
+		require.NoError(t, err)
+		selected, err := init([]*nodeselection.SelectedNode{almostFull, large}, nil)(storj.NodeID{}, 1, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, selected, 1)
+		require.Equal(t, large.ID, selected[0].ID)
+	}
+
+	_, err = nodeselection.SelectorFromString(`fillrate("soon")`, nil)
+	require.Error(t, err)
+}
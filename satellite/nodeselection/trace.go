@@ -0,0 +1,151 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection
+
+import (
+	"fmt"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+)
+
+// traceExamples is the maximum number of rejected node IDs recorded for each step.
+const traceExamples = 5
+
+// SelectionTrace records how the candidate nodes of an upload were narrowed
+// down, step by step, to debug selections which couldn't find enough nodes.
+type SelectionTrace struct {
+	Time      time.Time                 `json:"time"`
+	Placement storj.PlacementConstraint `json:"placement"`
+	Requested int                       `json:"requested"`
+	Selected  int                       `json:"selected"`
+	Error     string                    `json:"error,omitempty"`
+	Steps     []TraceStep               `json:"steps"`
+}
+
+// TraceStep is one step of the node selection.
+type TraceStep struct {
+	// Name describes the step, usually with the definition of the filter.
+	Name string `json:"name"`
+	// Candidates is the number of the nodes remained after the step.
+	Candidates int `json:"candidates"`
+	// Rejected is the number of the nodes rejected by the step.
+	Rejected int `json:"rejected"`
+	// Examples contains the IDs of some of the rejected nodes.
+	Examples []storj.NodeID `json:"examples,omitempty"`
+}
+
+// TraceSelection replays the filters of the placement on the nodes, and
+// records how many candidates are left after each filter of the placement,
+// after removing the excluded and already selected nodes, after the selector
+// and after checking the invariant of the placement.
+//
+// The filters applied internally by the selector (like the filter of
+// `balanced`) are not visible here, they are part of the selector step.
+func TraceSelection(nodes []*SelectedNode, placement Placement, requested int, excluded []storj.NodeID, alreadySelected []*SelectedNode, selected []*SelectedNode, selectionErr error) SelectionTrace {
+	trace := SelectionTrace{
+		Time:      time.Now(),
+		Placement: placement.ID,
+		Requested: requested,
+		Selected:  len(selected),
+		Steps: []TraceStep{{
+			Name:       "all nodes",
+			Candidates: len(nodes),
+		}},
+	}
+	if selectionErr != nil {
+		trace.Error = selectionErr.Error()
+	}
+
+	candidates := nodes
+	step := func(name string, match func(node *SelectedNode) bool) {
+		current := TraceStep{Name: name}
+		remaining := make([]*SelectedNode, 0, len(candidates))
+		for _, node := range candidates {
+			if match(node) {
+				remaining = append(remaining, node)
+				continue
+			}
+			current.Rejected++
+			if len(current.Examples) < traceExamples {
+				current.Examples = append(current.Examples, node.ID)
+			}
+		}
+		current.Candidates = len(remaining)
+		candidates = remaining
+		trace.Steps = append(trace.Steps, current)
+	}
+
+	for _, filter := range flattenFilters(placement.NodeFilter) {
+		step(filterName(filter), filter.Match)
+	}
+	step("excluded", func(node *SelectedNode) bool {
+		return !included(excluded, node)
+	})
+	step("already selected", func(node *SelectedNode) bool {
+		return !includedInNodes(alreadySelected, node)
+	})
+
+	trace.Steps = append(trace.Steps, TraceStep{
+		Name:       "selector",
+		Candidates: len(selected),
+		Rejected:   len(candidates) - len(selected),
+	})
+
+	if placement.Invariant != nil && len(selected) > 0 {
+		all := append(append([]*SelectedNode{}, alreadySelected...), selected...)
+		pieces := make(metabase.Pieces, 0, len(all))
+		selectedNodes := make([]SelectedNode, 0, len(all))
+		for i, node := range all {
+			pieces = append(pieces, metabase.Piece{Number: uint16(i), StorageNode: node.ID})
+			selectedNodes = append(selectedNodes, *node)
+		}
+
+		current := TraceStep{Name: "invariant"}
+		violations := placement.Invariant(pieces, selectedNodes)
+		for i := len(alreadySelected); i < len(all); i++ {
+			if !violations.Contains(i) {
+				continue
+			}
+			current.Rejected++
+			if len(current.Examples) < traceExamples {
+				current.Examples = append(current.Examples, all[i].ID)
+			}
+		}
+		current.Candidates = len(selected) - current.Rejected
+		trace.Steps = append(trace.Steps, current)
+	}
+
+	return trace
+}
+
+// flattenFilters returns the individual filters of the (possibly nested) filter
+// collections, so each of them can be traced separately.
+func flattenFilters(filter NodeFilter) []NodeFilter {
+	switch f := filter.(type) {
+	case nil:
+		return nil
+	case NodeFilters:
+		var result []NodeFilter
+		for _, inner := range f {
+			result = append(result, flattenFilters(inner)...)
+		}
+		return result
+	case AnnotatedNodeFilter:
+		return flattenFilters(f.Filter)
+	case Annotation:
+		// annotations match all the nodes
+		return nil
+	default:
+		return []NodeFilter{filter}
+	}
+}
+
+func filterName(filter NodeFilter) string {
+	if stringer, ok := filter.(fmt.Stringer); ok {
+		return stringer.String()
+	}
+	return fmt.Sprintf("%T", filter)
+}
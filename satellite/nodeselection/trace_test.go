@@ -0,0 +1,77 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/shared/location"
+)
+
+func TestTraceSelection(t *testing.T) {
+	var nodes []*SelectedNode
+	for i := 0; i < 10; i++ {
+		node := &SelectedNode{
+			ID:          testrand.NodeID(),
+			LastNet:     "10.0.0",
+			CountryCode: location.Germany,
+		}
+		if i < 4 {
+			node.CountryCode = location.UnitedStates
+		}
+		nodes = append(nodes, node)
+	}
+
+	placement := Placement{
+		ID: 10,
+		NodeFilter: WithAnnotation(NodeFilters{
+			NewCountryFilter(location.NewSet(location.Germany)),
+			NodeFilterFunc(func(node *SelectedNode) bool { return true }),
+		}, "autoExcludeSubnet", "off"),
+		Invariant: ClumpingByAttribute(LastNetAttribute, 1),
+	}
+
+	trace := TraceSelection(nodes, placement, 5,
+		[]storj.NodeID{nodes[5].ID},
+		[]*SelectedNode{nodes[6]},
+		[]*SelectedNode{nodes[7], nodes[8]},
+		ErrNotEnoughNodes.New("requested from cache 5, found 2"))
+
+	require.Equal(t, storj.PlacementConstraint(10), trace.Placement)
+	require.Equal(t, 5, trace.Requested)
+	require.Equal(t, 2, trace.Selected)
+	require.Contains(t, trace.Error, "requested from cache 5, found 2")
+
+	var names []string
+	for _, step := range trace.Steps {
+		names = append(names, step.Name)
+	}
+	require.Equal(t, []string{"all nodes", `country("DE")`, "nodeselection.NodeFilterFunc", "excluded", "already selected", "selector", "invariant"}, names)
+
+	require.Equal(t, 10, trace.Steps[0].Candidates)
+
+	require.Equal(t, 6, trace.Steps[1].Candidates)
+	require.Equal(t, 4, trace.Steps[1].Rejected)
+	require.ElementsMatch(t, []storj.NodeID{nodes[0].ID, nodes[1].ID, nodes[2].ID, nodes[3].ID}, trace.Steps[1].Examples)
+
+	require.Equal(t, 6, trace.Steps[2].Candidates)
+	require.Zero(t, trace.Steps[2].Rejected)
+
+	require.Equal(t, 5, trace.Steps[3].Candidates)
+	require.Equal(t, []storj.NodeID{nodes[5].ID}, trace.Steps[3].Examples)
+
+	require.Equal(t, 4, trace.Steps[4].Candidates)
+	require.Equal(t, []storj.NodeID{nodes[6].ID}, trace.Steps[4].Examples)
+
+	require.Equal(t, 2, trace.Steps[5].Candidates)
+	require.Equal(t, 2, trace.Steps[5].Rejected)
+
+	// all the nodes are in the same subnet, so both selected nodes violate the invariant.
+	require.Equal(t, 0, trace.Steps[6].Candidates)
+	require.Equal(t, 2, trace.Steps[6].Rejected)
+}
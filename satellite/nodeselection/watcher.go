@@ -0,0 +1,226 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+)
+
+// WatcherConfig contains the configuration of the placement config reloading.
+type WatcherConfig struct {
+	Enabled  bool          `help:"reload the placement configuration when the file is changed or SIGHUP is received" default:"false"`
+	Interval time.Duration `help:"how often the placement configuration file is checked for changes" default:"1m"`
+}
+
+// Watcher reloads the placement configuration when the configuration file is
+// changed, or when the process receives SIGHUP.
+//
+// The new configuration is validated with the same parser which is used at
+// startup. Invalid configuration is logged and ignored, the previous placement
+// definitions stay active.
+type Watcher struct {
+	log    *zap.Logger
+	rule   ConfigurablePlacementRule
+	config WatcherConfig
+
+	defaultPlacement func() (Placement, error)
+	environment      *PlacementConfigEnvironment
+
+	reloadMu sync.Mutex
+
+	mu         sync.Mutex
+	hash       string
+	loadedAt   time.Time
+	placements PlacementDefinitions
+	onChange   []func(ctx context.Context, placements PlacementDefinitions) error
+}
+
+// NewWatcher creates a new watcher of the placement configuration.
+func NewWatcher(log *zap.Logger, rule ConfigurablePlacementRule, config WatcherConfig) *Watcher {
+	return &Watcher{
+		log:    log,
+		rule:   rule,
+		config: config,
+	}
+}
+
+// Load parses the initial placement configuration. It should be called
+// before Run.
+func (w *Watcher) Load(defaultPlacement func() (Placement, error), environment *PlacementConfigEnvironment) (PlacementDefinitions, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.defaultPlacement = defaultPlacement
+	w.environment = environment
+
+	hash, placements, err := w.parse()
+	if err != nil {
+		return nil, err
+	}
+	w.hash, w.placements, w.loadedAt = hash, placements, time.Now()
+	return placements, nil
+}
+
+// OnChange registers a callback, which is called with the new placement
+// definitions after a successful reload.
+func (w *Watcher) OnChange(fn func(ctx context.Context, placements PlacementDefinitions) error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.onChange = append(w.onChange, fn)
+}
+
+// Run checks the configuration file periodically, and reloads it on SIGHUP.
+func (w *Watcher) Run(ctx context.Context) error {
+	if !w.config.Enabled {
+		return nil
+	}
+
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	// without interval the configuration is reloaded only on SIGHUP.
+	var tick <-chan time.Time
+	if w.config.Interval > 0 {
+		ticker := time.NewTicker(w.config.Interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-hangup:
+			w.log.Info("reloading placement configuration, SIGHUP is received")
+		case <-tick:
+		}
+
+		if _, err := w.Reload(ctx); err != nil {
+			w.log.Error("failed to reload placement configuration, keeping the active one", zap.String("hash", w.Hash()), zap.Error(err))
+		}
+	}
+}
+
+// Reload parses the placement configuration, and activates it when it's
+// different from the active one. When any of the OnChange callbacks fails,
+// the previous configuration stays active and the next Reload retries it.
+func (w *Watcher) Reload(ctx context.Context) (changed bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the callbacks are called without holding mu, so the active placements
+	// are available while a callback is refreshing its own state.
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	w.mu.Lock()
+	previous := w.hash
+	hash, placements, err := w.parse()
+	onChange := append([]func(ctx context.Context, placements PlacementDefinitions) error(nil), w.onChange...)
+	w.mu.Unlock()
+
+	if err != nil {
+		mon.Event("placement_reload_failed")
+		return false, err
+	}
+	if hash == previous {
+		return false, nil
+	}
+
+	var group errs.Group
+	for _, fn := range onChange {
+		group.Add(fn(ctx, placements))
+	}
+	if err := group.Err(); err != nil {
+		mon.Event("placement_reload_failed")
+		return false, Error.Wrap(err)
+	}
+
+	w.log.Info("placement configuration is reloaded", zap.String("previous", previous), zap.String("hash", hash))
+	mon.Event("placement_reloaded")
+
+	w.mu.Lock()
+	w.hash, w.placements, w.loadedAt = hash, placements, time.Now()
+	w.mu.Unlock()
+	return true, nil
+}
+
+// Placements returns the active placement definitions.
+func (w *Watcher) Placements() PlacementDefinitions {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.placements
+}
+
+// CreateFilters implements PlacementRules with the active placement
+// definitions.
+func (w *Watcher) CreateFilters(constraint storj.PlacementConstraint) (filter NodeFilter, selector DownloadSelector) {
+	return w.Placements().CreateFilters(constraint)
+}
+
+// Hash returns the SHA-256 hash of the active placement configuration.
+func (w *Watcher) Hash() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.hash
+}
+
+// parse reads and parses the configuration with the same rules which are
+// used at startup. The hash is calculated from the same bytes which are
+// parsed, so a concurrent change of the file is detected by the next check.
+func (w *Watcher) parse() (hash string, placements PlacementDefinitions, err error) {
+	source, isYAML, err := w.rule.source()
+	if err != nil {
+		return "", nil, err
+	}
+	sum := sha256.Sum256([]byte(source))
+
+	placements, err = w.rule.parseSource(source, isYAML, w.defaultPlacement, w.environment)
+	if err != nil {
+		return "", nil, err
+	}
+	return hex.EncodeToString(sum[:]), placements, nil
+}
+
+// Description is a display name for the UI.
+func (w *Watcher) Description() string {
+	return "hash of the active placement configuration"
+}
+
+// Path is the unique HTTP path fragment.
+func (w *Watcher) Path() string {
+	return "/nodeselection/placement/hash"
+}
+
+// Handler is the HTTP handler for the path.
+func (w *Watcher) Handler(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		_, _ = fmt.Fprintf(rw, "Only GET is supported.")
+		return
+	}
+
+	w.mu.Lock()
+	hash, loadedAt := w.hash, w.loadedAt
+	w.mu.Unlock()
+
+	_, _ = fmt.Fprintf(rw, "%s\nloaded at %s\n", hash, loadedAt.Format(time.RFC3339))
+}
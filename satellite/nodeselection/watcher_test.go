@@ -0,0 +1,135 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
+)
+
+func TestWatcher(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	configFile := filepath.Join(t.TempDir(), "placement.yaml")
+	writeConfig := func(name, country string) {
+		require.NoError(t, os.WriteFile(configFile, []byte(`
+placements:
+  - id: 1
+    name: `+name+`
+    filter: country("`+country+`")
+`), 0644))
+	}
+	writeConfig("first", "DE")
+
+	watcher := nodeselection.NewWatcher(zaptest.NewLogger(t), nodeselection.ConfigurablePlacementRule{PlacementRules: configFile}, nodeselection.WatcherConfig{})
+	placements, err := watcher.Load(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "first", placements[1].Name)
+	firstHash := watcher.Hash()
+	require.NotEmpty(t, firstHash)
+
+	var reloaded []nodeselection.PlacementDefinitions
+	watcher.OnChange(func(ctx context.Context, placements nodeselection.PlacementDefinitions) error {
+		reloaded = append(reloaded, placements)
+		return nil
+	})
+
+	// not changed
+	changed, err := watcher.Reload(ctx)
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Empty(t, reloaded)
+
+	germanNode := &nodeselection.SelectedNode{CountryCode: location.Germany}
+	filter, _ := watcher.CreateFilters(1)
+	require.True(t, filter.Match(germanNode))
+
+	writeConfig("second", "FR")
+	changed, err = watcher.Reload(ctx)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Len(t, reloaded, 1)
+	require.Equal(t, "second", reloaded[0][1].Name)
+	require.Equal(t, "second", watcher.Placements()[1].Name)
+	secondHash := watcher.Hash()
+	require.NotEqual(t, firstHash, secondHash)
+
+	filter, _ = watcher.CreateFilters(1)
+	require.False(t, filter.Match(germanNode))
+
+	// invalid configuration keeps the active one
+	require.NoError(t, os.WriteFile(configFile, []byte("placements: [\n"), 0644))
+	changed, err = watcher.Reload(ctx)
+	require.Error(t, err)
+	require.False(t, changed)
+	require.Len(t, reloaded, 1)
+	require.Equal(t, "second", watcher.Placements()[1].Name)
+	require.Equal(t, secondHash, watcher.Hash())
+
+	rec := httptest.NewRecorder()
+	watcher.Handler(rec, httptest.NewRequest(http.MethodGet, watcher.Path(), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), secondHash)
+}
+
+func TestWatcher_RetryFailedCallback(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	configFile := filepath.Join(t.TempDir(), "placement.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte(`
+placements:
+  - id: 1
+    name: first
+    filter: country("DE")
+`), 0644))
+
+	watcher := nodeselection.NewWatcher(zaptest.NewLogger(t), nodeselection.ConfigurablePlacementRule{PlacementRules: configFile}, nodeselection.WatcherConfig{})
+	_, err := watcher.Load(nil, nil)
+	require.NoError(t, err)
+	firstHash := watcher.Hash()
+
+	var calls int
+	failure := errors.New("database is not available")
+	watcher.OnChange(func(ctx context.Context, placements nodeselection.PlacementDefinitions) error {
+		calls++
+		// the active placements are available while the callback is running.
+		require.Equal(t, "first", watcher.Placements()[1].Name)
+		return failure
+	})
+
+	require.NoError(t, os.WriteFile(configFile, []byte(`
+placements:
+  - id: 1
+    name: second
+    filter: country("FR")
+`), 0644))
+
+	changed, err := watcher.Reload(ctx)
+	require.ErrorIs(t, err, failure)
+	require.False(t, changed)
+	require.Equal(t, 1, calls)
+	require.Equal(t, firstHash, watcher.Hash())
+	require.Equal(t, "first", watcher.Placements()[1].Name)
+
+	// the same configuration is applied again by the next reload.
+	failure = nil
+	changed, err = watcher.Reload(ctx)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, 2, calls)
+	require.NotEqual(t, firstHash, watcher.Hash())
+	require.Equal(t, "second", watcher.Placements()[1].Name)
+}
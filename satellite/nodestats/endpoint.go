@@ -13,7 +13,9 @@ import (
 	"storj.io/common/identity"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/nodestatspb"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments/paymentsconfig"
@@ -24,27 +26,36 @@ var (
 	mon = monkit.Package()
 )
 
+// maxAuditHistoryLimit is the maximum number of audit outcomes returned to a
+// node by a single request.
+const maxAuditHistoryLimit = 1000
+
 // Endpoint for querying node stats for the SNO.
 //
 // architecture: Endpoint
 type Endpoint struct {
 	pb.DRPCNodeStatsUnimplementedServer
+	nodestatspb.DRPCAuditHistoryUnimplementedServer
+	nodestatspb.DRPCNodeReachabilityUnimplementedServer
 
 	log        *zap.Logger
 	overlay    overlay.DB
 	reputation *reputation.Service
 	accounting accounting.StoragenodeAccounting
+	history    audit.HistoryDB
 	config     paymentsconfig.Config
 	compConfig compensation.Config
 }
 
-// NewEndpoint creates new endpoint.
-func NewEndpoint(log *zap.Logger, overlay overlay.DB, reputation *reputation.Service, accounting accounting.StoragenodeAccounting, config paymentsconfig.Config, compConfig compensation.Config) *Endpoint {
+// NewEndpoint creates new endpoint. The audit history is not served when
+// history is nil.
+func NewEndpoint(log *zap.Logger, overlay overlay.DB, reputation *reputation.Service, accounting accounting.StoragenodeAccounting, history audit.HistoryDB, config paymentsconfig.Config, compConfig compensation.Config) *Endpoint {
 	return &Endpoint{
 		log:        log,
 		overlay:    overlay,
 		reputation: reputation,
 		accounting: accounting,
+		history:    history,
 		config:     config,
 		compConfig: compConfig,
 	}
@@ -152,6 +163,92 @@ func (e *Endpoint) PricingModel(ctx context.Context, req *pb.PricingModelRequest
 	}, nil
 }
 
+// GetAuditHistory returns the recent audit outcomes of the client node, so
+// that the operator can find out which audits changed the reputation.
+func (e *Endpoint) GetAuditHistory(ctx context.Context, req *nodestatspb.GetAuditHistoryRequest) (_ *nodestatspb.GetAuditHistoryResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+	if e.history == nil {
+		return nil, rpcstatus.Error(rpcstatus.Unimplemented, "audit history is disabled")
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > maxAuditHistoryLimit {
+		limit = maxAuditHistoryLimit
+	}
+
+	entries, err := e.history.List(ctx, peer.ID, limit)
+	if err != nil {
+		e.log.Error("audit history List failed", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &nodestatspb.GetAuditHistoryResponse{
+		Outcomes: toProtoAuditOutcomes(entries),
+	}, nil
+}
+
+// GetReachability sends the transports the satellite could reach the client
+// node with during its last check-in.
+func (e *Endpoint) GetReachability(ctx context.Context, req *nodestatspb.GetReachabilityRequest) (_ *nodestatspb.GetReachabilityResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+	node, err := e.overlay.Get(ctx, peer.ID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		e.log.Error("overlay.Get failed", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &nodestatspb.GetReachabilityResponse{
+		Transports: []*nodestatspb.TransportReachability{
+			{Transport: "tcp", Reachable: node.Reachable.TCP, LastSuccess: node.LastTransportSuccess.TCP},
+			{Transport: "quic", Reachable: node.Reachable.QUIC, LastSuccess: node.LastTransportSuccess.QUIC},
+			{Transport: "noise", Reachable: node.Reachable.Noise, LastSuccess: node.LastTransportSuccess.Noise},
+		},
+	}, nil
+}
+
+// toProtoAuditOutcomes converts audit history entries to PB AuditOutcome.
+func toProtoAuditOutcomes(entries []audit.HistoryEntry) []*nodestatspb.AuditOutcome {
+	outcomes := make([]*nodestatspb.AuditOutcome, 0, len(entries))
+	for _, entry := range entries {
+		var outcome nodestatspb.AuditOutcome_Outcome
+		switch entry.Outcome {
+		case audit.HistorySuccess:
+			outcome = nodestatspb.AuditOutcome_SUCCESS
+		case audit.HistoryFailure:
+			outcome = nodestatspb.AuditOutcome_FAILURE
+		case audit.HistoryOffline:
+			outcome = nodestatspb.AuditOutcome_OFFLINE
+		case audit.HistoryUnknown:
+			outcome = nodestatspb.AuditOutcome_UNKNOWN
+		case audit.HistoryContained:
+			outcome = nodestatspb.AuditOutcome_CONTAINED
+		default:
+			continue
+		}
+		outcomes = append(outcomes, &nodestatspb.AuditOutcome{
+			StreamId:      entry.StreamID.Bytes(),
+			Position:      entry.Position.Encode(),
+			Outcome:       outcome,
+			ReverifyCount: int32(entry.ReverifyCount),
+			CreatedAt:     entry.CreatedAt,
+		})
+	}
+	return outcomes
+}
+
 // toProtoDailyStorageUsage converts StorageNodeUsage to PB DailyStorageUsageResponse_StorageUsage.
 func toProtoDailyStorageUsage(usages []accounting.StorageNodeUsage) []*pb.DailyStorageUsageResponse_StorageUsage {
 	var pbUsages []*pb.DailyStorageUsageResponse_StorageUsage
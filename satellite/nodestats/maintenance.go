@@ -0,0 +1,104 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestats
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/nodestatspb"
+	"storj.io/storj/satellite/overlay"
+)
+
+// MaintenanceEndpoint lets the nodes declare maintenance windows, when they
+// are not counted as offline.
+//
+// architecture: Endpoint
+type MaintenanceEndpoint struct {
+	nodestatspb.DRPCNodeMaintenanceUnimplementedServer
+
+	log     *zap.Logger
+	overlay *overlay.Service
+}
+
+// NewMaintenanceEndpoint creates new maintenance endpoint.
+func NewMaintenanceEndpoint(log *zap.Logger, overlay *overlay.Service) *MaintenanceEndpoint {
+	return &MaintenanceEndpoint{
+		log:     log,
+		overlay: overlay,
+	}
+}
+
+// DeclareMaintenance schedules a maintenance window for the client node.
+func (e *MaintenanceEndpoint) DeclareMaintenance(ctx context.Context, req *nodestatspb.DeclareMaintenanceRequest) (_ *nodestatspb.DeclareMaintenanceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	window, err := e.overlay.DeclareMaintenance(ctx, peer.ID, req.StartTime, time.Duration(req.DurationSeconds)*time.Second)
+	if err != nil {
+		return nil, e.toRPCError(err)
+	}
+
+	e.log.Info("node declared maintenance",
+		zap.Stringer("Node ID", peer.ID),
+		zap.Time("start", window.Start),
+		zap.Time("end", window.End))
+
+	return &nodestatspb.DeclareMaintenanceResponse{
+		Window: toProtoMaintenanceWindow(window),
+	}, nil
+}
+
+// CancelMaintenance cancels the scheduled, or finishes the active maintenance
+// window of the client node.
+func (e *MaintenanceEndpoint) CancelMaintenance(ctx context.Context, req *nodestatspb.CancelMaintenanceRequest) (_ *nodestatspb.CancelMaintenanceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	window, err := e.overlay.CancelMaintenance(ctx, peer.ID)
+	if err != nil {
+		return nil, e.toRPCError(err)
+	}
+
+	e.log.Info("node canceled maintenance", zap.Stringer("Node ID", peer.ID))
+
+	return &nodestatspb.CancelMaintenanceResponse{
+		Window: toProtoMaintenanceWindow(window),
+	}, nil
+}
+
+func (e *MaintenanceEndpoint) toRPCError(err error) error {
+	switch {
+	case overlay.ErrMaintenance.Has(err):
+		return rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+	case overlay.ErrNodeNotFound.Has(err):
+		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	default:
+		e.log.Error("maintenance update failed", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+}
+
+// toProtoMaintenanceWindow converts the maintenance window to PB, a zero window is returned as nil.
+func toProtoMaintenanceWindow(window overlay.MaintenanceWindow) *nodestatspb.MaintenanceWindow {
+	if window.IsZero() {
+		return nil
+	}
+	return &nodestatspb.MaintenanceWindow{
+		StartTime: window.Start,
+		EndTime:   window.End,
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodetags manages the node tags which are assigned by the satellite
+// operators, instead of being signed by the operators of the nodes.
+package nodetags
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+var (
+	// Error is the error class of this package.
+	Error = errs.Class("nodetags")
+
+	// ErrInvalid is returned when the tag or the CSV input is invalid.
+	ErrInvalid = errs.Class("invalid node tag")
+
+	mon = monkit.Package()
+)
+
+const (
+	// ActionSet is the action of the audit log when a tag is created or changed.
+	ActionSet = "set"
+	// ActionDelete is the action of the audit log when a tag is removed.
+	ActionDelete = "delete"
+)
+
+// csvHeader is the header of the exported and imported CSV files.
+var csvHeader = []string{"node_id", "name", "value"}
+
+// Change is a single entry of the audit log.
+type Change struct {
+	NodeID    storj.NodeID
+	Name      string
+	Value     []byte
+	Action    string
+	ChangedBy string
+	ChangedAt time.Time
+}
+
+// DB stores the node tags signed by the satellite and the audit log of their changes.
+type DB interface {
+	// Set inserts or updates the tags and records the changes in the audit log.
+	Set(ctx context.Context, tags nodeselection.NodeTags, changedBy string) error
+	// Delete removes a tag and records the change in the audit log.
+	Delete(ctx context.Context, nodeID storj.NodeID, name string, signer storj.NodeID, changedBy string, changedAt time.Time) error
+	// ListBySigner returns all the tags signed by the signer.
+	ListBySigner(ctx context.Context, signer storj.NodeID) (nodeselection.NodeTags, error)
+	// Changes returns the most recent entries of the audit log, the newest first.
+	// When nodeID is zero, the changes of all the nodes are returned.
+	Changes(ctx context.Context, nodeID storj.NodeID, limit int) ([]Change, error)
+}
+
+// Service assigns tags to nodes, using the satellite as the signer, so the tags
+// can be used by the placement rules the same way as the tags signed by the
+// nodes.
+//
+// The tags are picked up by the node selection at the next refresh of the
+// node caches.
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	signer storj.NodeID
+	nowFn  func() time.Time
+}
+
+// NewService creates a new node tag service. signer is the ID of the satellite.
+func NewService(log *zap.Logger, db DB, signer storj.NodeID) *Service {
+	return &Service{
+		log:    log,
+		db:     db,
+		signer: signer,
+		nowFn:  time.Now,
+	}
+}
+
+// Signer returns the ID which is used as the signer of the tags.
+func (service *Service) Signer() storj.NodeID {
+	return service.signer
+}
+
+// SetTag creates or changes a tag of a node.
+func (service *Service) SetTag(ctx context.Context, nodeID storj.NodeID, name string, value []byte, changedBy string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.SetTags(ctx, []nodeselection.NodeTag{{
+		NodeID: nodeID,
+		Name:   name,
+		Value:  value,
+	}}, changedBy)
+}
+
+// SetTags creates or changes multiple tags at once. The signer and the signing
+// time of the tags are overwritten.
+func (service *Service) SetTags(ctx context.Context, tags nodeselection.NodeTags, changedBy string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if changedBy == "" {
+		return ErrInvalid.New("missing author of the change")
+	}
+
+	now := service.nowFn()
+	signed := make(nodeselection.NodeTags, 0, len(tags))
+	for _, tag := range tags {
+		if err := validate(tag.NodeID, tag.Name); err != nil {
+			return err
+		}
+		tag.Signer = service.signer
+		tag.SignedAt = now
+		signed = append(signed, tag)
+	}
+	if len(signed) == 0 {
+		return nil
+	}
+
+	if err := service.db.Set(ctx, signed, changedBy); err != nil {
+		return Error.Wrap(err)
+	}
+	service.log.Info("node tags are changed", zap.Int("count", len(signed)), zap.String("changed by", changedBy))
+	return nil
+}
+
+// DeleteTag removes a tag of a node.
+func (service *Service) DeleteTag(ctx context.Context, nodeID storj.NodeID, name string, changedBy string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if changedBy == "" {
+		return ErrInvalid.New("missing author of the change")
+	}
+	if err := validate(nodeID, name); err != nil {
+		return err
+	}
+
+	if err := service.db.Delete(ctx, nodeID, name, service.signer, changedBy, service.nowFn()); err != nil {
+		return Error.Wrap(err)
+	}
+	service.log.Info("node tag is deleted", zap.Stringer("node", nodeID), zap.String("name", name), zap.String("changed by", changedBy))
+	return nil
+}
+
+// Tags returns all the tags signed by the satellite.
+func (service *Service) Tags(ctx context.Context) (_ nodeselection.NodeTags, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tags, err := service.db.ListBySigner(ctx, service.signer)
+	return tags, Error.Wrap(err)
+}
+
+// Changes returns the most recent changes of the tags, the newest first. When
+// nodeID is zero, the changes of all the nodes are returned.
+func (service *Service) Changes(ctx context.Context, nodeID storj.NodeID, limit int) (_ []Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	changes, err := service.db.Changes(ctx, nodeID, limit)
+	return changes, Error.Wrap(err)
+}
+
+// ExportCSV writes all the tags signed by the satellite as CSV, with
+// node_id,name,value columns.
+func (service *Service) ExportCSV(ctx context.Context, w io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tags, err := service.Tags(ctx)
+	if err != nil {
+		return err
+	}
+
+	out := csv.NewWriter(w)
+	if err := out.Write(csvHeader); err != nil {
+		return Error.Wrap(err)
+	}
+	for _, tag := range tags {
+		if err := out.Write([]string{tag.NodeID.String(), tag.Name, string(tag.Value)}); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	out.Flush()
+	return Error.Wrap(out.Error())
+}
+
+// ImportCSV sets the tags from CSV with node_id,name,value columns (the
+// header is optional). Either all the tags are imported, or none of them, when
+// the input is invalid.
+func (service *Service) ImportCSV(ctx context.Context, r io.Reader, changedBy string) (_ int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tags, err := ParseCSV(r)
+	if err != nil {
+		return 0, err
+	}
+	if err := service.SetTags(ctx, tags, changedBy); err != nil {
+		return 0, err
+	}
+	return len(tags), nil
+}
+
+// ParseCSV parses tags from CSV with node_id,name,value columns. The header
+// line is optional, and each tag of a node can be set only once.
+func ParseCSV(r io.Reader) (nodeselection.NodeTags, error) {
+	in := csv.NewReader(r)
+	in.FieldsPerRecord = len(csvHeader)
+	in.TrimLeadingSpace = true
+
+	// the changes of a single import are recorded with the same time, so
+	// the same tag can't be set twice.
+	type tagKey struct {
+		nodeID storj.NodeID
+		name   string
+	}
+	seen := map[tagKey]int{}
+
+	var tags nodeselection.NodeTags
+	for line := 1; ; line++ {
+		record, err := in.Read()
+		if errors.Is(err, io.EOF) {
+			return tags, nil
+		}
+		if err != nil {
+			return nil, ErrInvalid.Wrap(err)
+		}
+		if line == 1 && strings.EqualFold(record[0], csvHeader[0]) {
+			continue
+		}
+
+		nodeID, err := storj.NodeIDFromString(record[0])
+		if err != nil {
+			return nil, ErrInvalid.New("line %d: invalid node ID %q: %v", line, record[0], err)
+		}
+		if err := validate(nodeID, record[1]); err != nil {
+			return nil, ErrInvalid.New("line %d: %v", line, err)
+		}
+		key := tagKey{nodeID: nodeID, name: record[1]}
+		if first, ok := seen[key]; ok {
+			return nil, ErrInvalid.New("line %d: tag %q of node %s is already set on line %d", line, record[1], nodeID, first)
+		}
+		seen[key] = line
+		tags = append(tags, nodeselection.NodeTag{
+			NodeID: nodeID,
+			Name:   record[1],
+			Value:  []byte(record[2]),
+		})
+	}
+}
+
+func validate(nodeID storj.NodeID, name string) error {
+	if nodeID.IsZero() {
+		return ErrInvalid.New("missing node ID")
+	}
+	if name == "" {
+		return ErrInvalid.New("missing tag name")
+	}
+	return nil
+}
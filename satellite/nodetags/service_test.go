@@ -0,0 +1,100 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodetags_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/nodetags"
+)
+
+func TestImportExportCSV(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db := &memoryDB{}
+	satelliteID := testrand.NodeID()
+	service := nodetags.NewService(zaptest.NewLogger(t), db, satelliteID)
+
+	node1, node2 := testrand.NodeID(), testrand.NodeID()
+	input := "node_id,name,value\n" +
+		node1.String() + ",soc2,true\n" +
+		node2.String() + ",soc2,\"a,b\"\n"
+
+	_, err := service.ImportCSV(ctx, strings.NewReader(input), "")
+	require.Error(t, err)
+
+	count, err := service.ImportCSV(ctx, strings.NewReader(input), "operator@mail.test")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	for _, tag := range db.tags {
+		require.Equal(t, satelliteID, tag.Signer)
+		require.False(t, tag.SignedAt.IsZero())
+	}
+	require.Equal(t, "operator@mail.test", db.changedBy)
+
+	var output bytes.Buffer
+	require.NoError(t, service.ExportCSV(ctx, &output))
+	require.Equal(t, input, output.String())
+
+	// invalid input is rejected as a whole.
+	_, err = service.ImportCSV(ctx, strings.NewReader(node1.String()+",soc2,false\nnot-a-node-id,soc2,true\n"), "operator@mail.test")
+	require.True(t, nodetags.ErrInvalid.Has(err))
+	require.Len(t, db.tags, 2)
+	require.Equal(t, "true", string(db.tags[0].Value))
+
+	_, err = nodetags.ParseCSV(strings.NewReader(node1.String() + ",soc2\n"))
+	require.True(t, nodetags.ErrInvalid.Has(err))
+
+	// the same tag of a node can't be set twice by an import.
+	_, err = service.ImportCSV(ctx, strings.NewReader(node1.String()+",soc2,false\n"+node2.String()+",soc2,true\n"+node1.String()+",soc2,true\n"), "operator@mail.test")
+	require.True(t, nodetags.ErrInvalid.Has(err))
+	require.ErrorContains(t, err, "line 3")
+	require.Equal(t, "true", string(db.tags[0].Value))
+}
+
+// memoryDB is a minimal in-memory nodetags.DB, which keeps the tags in order
+// of the insertion.
+type memoryDB struct {
+	tags      nodeselection.NodeTags
+	changedBy string
+}
+
+func (db *memoryDB) Set(ctx context.Context, tags nodeselection.NodeTags, changedBy string) error {
+	for _, tag := range tags {
+		replaced := false
+		for i := range db.tags {
+			if db.tags[i].NodeID == tag.NodeID && db.tags[i].Name == tag.Name && db.tags[i].Signer == tag.Signer {
+				db.tags[i], replaced = tag, true
+			}
+		}
+		if !replaced {
+			db.tags = append(db.tags, tag)
+		}
+	}
+	db.changedBy = changedBy
+	return nil
+}
+
+func (db *memoryDB) Delete(ctx context.Context, nodeID storj.NodeID, name string, signer storj.NodeID, changedBy string, changedAt time.Time) error {
+	return nil
+}
+
+func (db *memoryDB) ListBySigner(ctx context.Context, signer storj.NodeID) (nodeselection.NodeTags, error) {
+	return db.tags, nil
+}
+
+func (db *memoryDB) Changes(ctx context.Context, nodeID storj.NodeID, limit int) ([]nodetags.Change, error) {
+	return nil, nil
+}
@@ -620,6 +620,50 @@ func (service *Service) CreateGracefulExitPutOrderLimit(ctx context.Context, buc
 	return limit, signer.PrivateKey, nil
 }
 
+// CreateProbeOrderLimits creates the order limits for uploading a test piece
+// to the node and downloading it back.
+//
+// Repair actions are used, because the probe shouldn't be charged to any
+// bucket, and the node accepts only PUT or PUT_REPAIR uploads.
+func (service *Service) CreateProbeOrderLimits(ctx context.Context, nodeID storj.NodeID, pieceSize int64, pieceExpiration time.Time) (put, get *pb.AddressedOrderLimit, putKey, getKey storj.PiecePrivateKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return nil, nil, storj.PiecePrivateKey{}, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+	if node.Disqualified != nil {
+		return nil, nil, storj.PiecePrivateKey{}, storj.PiecePrivateKey{}, overlay.ErrNodeDisqualified.New("%v", nodeID)
+	}
+	if node.ExitStatus.ExitFinishedAt != nil {
+		return nil, nil, storj.PiecePrivateKey{}, storj.PiecePrivateKey{}, overlay.ErrNodeFinishedGE.New("%v", nodeID)
+	}
+
+	now := time.Now()
+	rootPieceID := storj.NewPieceID()
+	storageNode := resolveStorageNode(&node.Node, node.LastIPPort, true)
+
+	putSigner, err := NewSignerRepairPut(service, rootPieceID, pieceExpiration, now, pieceSize, metabase.BucketLocation{})
+	if err != nil {
+		return nil, nil, storj.PiecePrivateKey{}, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+	put, err = putSigner.Sign(ctx, storageNode, 0)
+	if err != nil {
+		return nil, nil, storj.PiecePrivateKey{}, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	getSigner, err := NewSignerRepairGet(service, rootPieceID, now, pieceSize, metabase.BucketLocation{})
+	if err != nil {
+		return nil, nil, storj.PiecePrivateKey{}, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+	get, err = getSigner.Sign(ctx, storageNode, 0)
+	if err != nil {
+		return nil, nil, storj.PiecePrivateKey{}, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
+	return put, get, putSigner.PrivateKey, getSigner.PrivateKey, nil
+}
+
 // UpdateGetInlineOrder updates amount of inline GET bandwidth for given bucket.
 func (service *Service) UpdateGetInlineOrder(ctx context.Context, bucket metabase.BucketLocation, amount int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -24,6 +24,8 @@ type Config struct {
 	Node                            NodeSelectionConfig
 	NodeSelectionCache              UploadSelectionCacheConfig
 	GeoIP                           GeoIPConfig
+	SelectionTrace                  SelectionTraceConfig
+	Maintenance                     MaintenanceConfig
 	UpdateStatsBatchSize            int           `help:"number of update requests to process per transaction" default:"100"`
 	NodeCheckInWaitPeriod           time.Duration `help:"the amount of time to wait before accepting a redundant check-in from a node (unmodified info since last check-in)" default:"1h10m" testDefault:"30s"`
 	NodeSoftwareUpdateEmailCooldown time.Duration `help:"the amount of time to wait between sending Node Software Update emails" default:"168h"`
@@ -60,6 +62,7 @@ type NodeSelectionConfig struct {
 type GeoIPConfig struct {
 	DB            string   `help:"the location of the maxmind database containing geoip country information"`
 	MockCountries []string `help:"a mock list of countries the satellite will attribute to nodes (useful for testing)"`
+	ASNDB         string   `help:"the location of the maxmind database containing autonomous system (ASN) information"`
 }
 
 func (aost *AsOfSystemTimeConfig) isValid() error {
@@ -5,6 +5,7 @@ package overlay
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -36,7 +37,9 @@ type DownloadSelectionCache struct {
 	db     DownloadSelectionDB
 	config DownloadSelectionCacheConfig
 
-	cache          sync2.ReadCacheOf[*DownloadSelectionCacheState]
+	cache sync2.ReadCacheOf[*DownloadSelectionCacheState]
+
+	mu             sync.Mutex
 	placementRules nodeselection.PlacementRules
 }
 
@@ -87,11 +90,23 @@ func (cache *DownloadSelectionCache) GetNodeIPsFromPlacement(ctx context.Context
 		return nil, Error.Wrap(err)
 	}
 
-	filter, _ := cache.placementRules(placement)
+	cache.mu.Lock()
+	placementRules := cache.placementRules
+	cache.mu.Unlock()
+
+	filter, _ := placementRules(placement)
 
 	return state.FilteredIPs(nodes, filter), nil
 }
 
+// SetPlacementRules replaces the placement rules used to filter the nodes.
+func (cache *DownloadSelectionCache) SetPlacementRules(placementRules nodeselection.PlacementRules) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.placementRules = placementRules
+}
+
 // GetNodes gets nodes by ID from the cache, and refreshes the cache if it is stale.
 func (cache *DownloadSelectionCache) GetNodes(ctx context.Context, nodes []storj.NodeID) (_ map[storj.NodeID]*nodeselection.SelectedNode, err error) {
 	defer mon.Task()(&ctx)(&err)
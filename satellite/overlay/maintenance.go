@@ -0,0 +1,124 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// ErrMaintenance is returned when a maintenance window can't be declared or canceled.
+var ErrMaintenance = errs.Class("maintenance")
+
+// MaintenanceConfig limits the maintenance windows declared by the nodes.
+type MaintenanceConfig struct {
+	MaxDuration time.Duration `help:"the maximum duration of a maintenance window declared by a node. zero disables maintenance windows" default:"4h"`
+	MinInterval time.Duration `help:"the minimum time between the end of a maintenance window and the start of the next one" default:"168h"`
+	MaxLeadTime time.Duration `help:"how far in the future a maintenance window can be scheduled" default:"72h"`
+}
+
+// MaintenanceWindow is a time period declared by the node operator, when the
+// node is expected to be offline.
+//
+// During the window the node is not selected for uploads, offline audits are
+// not counted against the online score, and the pieces of the node are
+// considered temporarily unavailable instead of lost by the repair checker.
+type MaintenanceWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// IsZero returns true when no maintenance window is declared.
+func (window MaintenanceWindow) IsZero() bool {
+	return window.Start.IsZero() && window.End.IsZero()
+}
+
+// Active returns true when the window contains the specified time.
+func (window MaintenanceWindow) Active(now time.Time) bool {
+	return !window.IsZero() && !now.Before(window.Start) && now.Before(window.End)
+}
+
+// DeclareMaintenance schedules a maintenance window of the node. A zero (or
+// past) start means the maintenance starts immediately.
+//
+// A scheduled window which hasn't been started yet is replaced by the new one.
+func (service *Service) DeclareMaintenance(ctx context.Context, nodeID storj.NodeID, start time.Time, duration time.Duration) (_ MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	config := service.config.Maintenance
+	if config.MaxDuration <= 0 {
+		return MaintenanceWindow{}, ErrMaintenance.New("maintenance windows are disabled")
+	}
+	if duration <= 0 || duration > config.MaxDuration {
+		return MaintenanceWindow{}, ErrMaintenance.New("duration should be positive and at most %s", config.MaxDuration)
+	}
+
+	now := time.Now()
+	if start.Before(now) {
+		start = now
+	}
+	if start.After(now.Add(config.MaxLeadTime)) {
+		return MaintenanceWindow{}, ErrMaintenance.New("maintenance can be scheduled at most %s in advance", config.MaxLeadTime)
+	}
+
+	node, err := service.Get(ctx, nodeID)
+	if err != nil {
+		return MaintenanceWindow{}, Error.Wrap(err)
+	}
+	if node.Disqualified != nil || node.ExitStatus.ExitFinishedAt != nil {
+		return MaintenanceWindow{}, ErrMaintenance.New("node is disqualified or exited")
+	}
+
+	previous := node.Maintenance
+	switch {
+	case previous.Active(now):
+		return MaintenanceWindow{}, ErrMaintenance.New("node is already in maintenance until %s", previous.End.UTC().Format(time.RFC3339))
+	case previous.IsZero() || previous.Start.After(now):
+		// there is no window, or it hasn't been started, so it can be replaced.
+	case start.Before(previous.End.Add(config.MinInterval)):
+		return MaintenanceWindow{}, ErrMaintenance.New("next maintenance can start at %s", previous.End.Add(config.MinInterval).UTC().Format(time.RFC3339))
+	}
+
+	window := MaintenanceWindow{
+		Start: start,
+		End:   start.Add(duration),
+	}
+	if err := service.db.SetMaintenanceWindow(ctx, nodeID, window); err != nil {
+		return MaintenanceWindow{}, Error.Wrap(err)
+	}
+	return window, nil
+}
+
+// CancelMaintenance cancels the scheduled maintenance window of the node, or
+// finishes the active one. A finished window still counts when the next window
+// is declared.
+func (service *Service) CancelMaintenance(ctx context.Context, nodeID storj.NodeID) (_ MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.Get(ctx, nodeID)
+	if err != nil {
+		return MaintenanceWindow{}, Error.Wrap(err)
+	}
+
+	now := time.Now()
+	window := node.Maintenance
+	switch {
+	case window.IsZero() || !window.End.After(now):
+		// nothing to cancel.
+		return window, nil
+	case window.Start.After(now):
+		window = MaintenanceWindow{}
+	default:
+		window.End = now
+	}
+
+	if err := service.db.SetMaintenanceWindow(ctx, nodeID, window); err != nil {
+		return MaintenanceWindow{}, Error.Wrap(err)
+	}
+	return window, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
+)
+
+func TestMaintenanceWindowActive(t *testing.T) {
+	now := time.Now()
+
+	require.False(t, overlay.MaintenanceWindow{}.Active(now))
+
+	window := overlay.MaintenanceWindow{Start: now, End: now.Add(time.Hour)}
+	require.True(t, window.Active(now))
+	require.True(t, window.Active(now.Add(time.Minute)))
+	require.False(t, window.Active(now.Add(-time.Minute)))
+	require.False(t, window.Active(now.Add(time.Hour)))
+}
+
+func TestDeclareMaintenance(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Maintenance = overlay.MaintenanceConfig{
+					MaxDuration: 4 * time.Hour,
+					MinInterval: 24 * time.Hour,
+					MaxLeadTime: 48 * time.Hour,
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Overlay.Service
+		nodeID := planet.StorageNodes[0].ID()
+
+		// too long
+		_, err := service.DeclareMaintenance(ctx, nodeID, time.Time{}, 5*time.Hour)
+		require.True(t, overlay.ErrMaintenance.Has(err), err)
+
+		// too far in the future
+		_, err = service.DeclareMaintenance(ctx, nodeID, time.Now().Add(72*time.Hour), time.Hour)
+		require.True(t, overlay.ErrMaintenance.Has(err), err)
+
+		// scheduled window can be replaced before it starts
+		scheduled, err := service.DeclareMaintenance(ctx, nodeID, time.Now().Add(time.Hour), time.Hour)
+		require.NoError(t, err)
+		require.False(t, scheduled.Active(time.Now()))
+
+		window, err := service.DeclareMaintenance(ctx, nodeID, time.Time{}, 2*time.Hour)
+		require.NoError(t, err)
+		require.True(t, window.Active(time.Now()))
+
+		dossier, err := service.Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.True(t, dossier.Maintenance.Active(time.Now()))
+
+		// active window can't be replaced
+		_, err = service.DeclareMaintenance(ctx, nodeID, time.Time{}, time.Hour)
+		require.True(t, overlay.ErrMaintenance.Has(err), err)
+
+		// node in maintenance is not selected for uploads
+		reputable, newNodes, err := sat.Overlay.DB.SelectAllStorageNodesUpload(ctx, sat.Config.Overlay.Node)
+		require.NoError(t, err)
+		for _, node := range append(reputable, newNodes...) {
+			require.NotEqual(t, nodeID, node.ID)
+		}
+		require.Len(t, append(reputable, newNodes...), 1)
+
+		participating, err := service.GetParticipatingNodes(ctx)
+		require.NoError(t, err)
+		require.Len(t, participating, 2)
+		for _, node := range participating {
+			require.Equal(t, node.ID == nodeID, node.InMaintenance)
+		}
+
+		// finished window still counts for the frequency limit
+		finished, err := service.CancelMaintenance(ctx, nodeID)
+		require.NoError(t, err)
+		require.False(t, finished.IsZero())
+		require.False(t, finished.Active(time.Now()))
+
+		_, err = service.DeclareMaintenance(ctx, nodeID, time.Time{}, time.Hour)
+		require.True(t, overlay.ErrMaintenance.Has(err), err)
+
+		scheduled, err = service.DeclareMaintenance(ctx, nodeID, time.Now().Add(25*time.Hour), time.Hour)
+		require.NoError(t, err)
+
+		// canceled scheduled window is removed
+		canceled, err := service.CancelMaintenance(ctx, nodeID)
+		require.NoError(t, err)
+		require.True(t, canceled.IsZero())
+
+		dossier, err = service.Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.True(t, dossier.Maintenance.IsZero())
+	})
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"storj.io/eventkit"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+var ek = eventkit.Package()
+
+// SelectionTraceConfig configures the tracing of the upload node selections.
+type SelectionTraceConfig struct {
+	SampleRate float64 `help:"fraction of the upload node selections which are traced (0 disables sampling)" default:"0"`
+	Failures   bool    `help:"trace all the upload node selections which couldn't find enough nodes" default:"false"`
+	History    int     `help:"number of the most recent traces kept for the debug endpoint" default:"100"`
+}
+
+// SelectionTracer keeps the most recent traces of the upload node selections,
+// and publishes them to eventkit.
+//
+// The traces are available on the debug endpoint.
+type SelectionTracer struct {
+	log    *zap.Logger
+	config SelectionTraceConfig
+
+	mu     sync.Mutex
+	traces []nodeselection.SelectionTrace
+	next   int
+}
+
+// NewSelectionTracer creates a new SelectionTracer.
+func NewSelectionTracer(log *zap.Logger, config SelectionTraceConfig) *SelectionTracer {
+	if config.History < 1 {
+		config.History = 1
+	}
+	return &SelectionTracer{
+		log:    log,
+		config: config,
+	}
+}
+
+// ShouldTrace returns true, if the selection should be traced. A nil tracer
+// doesn't trace anything.
+func (tracer *SelectionTracer) ShouldTrace(failed bool) bool {
+	if tracer == nil {
+		return false
+	}
+	if failed && tracer.config.Failures {
+		return true
+	}
+	return tracer.config.SampleRate > 0 && rand.Float64() < tracer.config.SampleRate
+}
+
+// Record stores the trace, and publishes it to eventkit.
+func (tracer *SelectionTracer) Record(trace nodeselection.SelectionTrace) {
+	tracer.mu.Lock()
+	if len(tracer.traces) < tracer.config.History {
+		tracer.traces = append(tracer.traces, trace)
+	} else {
+		tracer.traces[tracer.next] = trace
+	}
+	tracer.next = (tracer.next + 1) % tracer.config.History
+	tracer.mu.Unlock()
+
+	steps := make([]string, 0, len(trace.Steps))
+	for _, step := range trace.Steps {
+		steps = append(steps, fmt.Sprintf("%s=%d/%d", step.Name, step.Candidates, step.Rejected))
+	}
+
+	ek.Event("node-selection-trace",
+		eventkit.Int64("placement", int64(trace.Placement)),
+		eventkit.Int64("requested", int64(trace.Requested)),
+		eventkit.Int64("selected", int64(trace.Selected)),
+		eventkit.String("error", trace.Error),
+		eventkit.String("steps", strings.Join(steps, "; ")),
+	)
+
+	tracer.log.Debug("upload node selection is traced",
+		zap.Uint16("placement", uint16(trace.Placement)),
+		zap.Int("requested", trace.Requested),
+		zap.Int("selected", trace.Selected),
+		zap.Strings("steps", steps))
+}
+
+// Traces returns the recorded traces, the newest first.
+func (tracer *SelectionTracer) Traces() []nodeselection.SelectionTrace {
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+
+	result := make([]nodeselection.SelectionTrace, 0, len(tracer.traces))
+	for i := 1; i <= len(tracer.traces); i++ {
+		result = append(result, tracer.traces[(tracer.next-i+len(tracer.traces))%len(tracer.traces)])
+	}
+	return result
+}
+
+// Description is a display name for the UI.
+func (tracer *SelectionTracer) Description() string {
+	return "most recent traces of the upload node selections"
+}
+
+// Path is the unique HTTP path fragment.
+func (tracer *SelectionTracer) Path() string {
+	return "/overlay/selection/traces"
+}
+
+// Handler is the HTTP handler for the path.
+func (tracer *SelectionTracer) Handler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		_, _ = fmt.Fprintf(w, "Only GET is supported.")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(tracer.Traces()); err != nil {
+		tracer.log.Debug("failed to write the selection traces", zap.Error(err))
+	}
+}
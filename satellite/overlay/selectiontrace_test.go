@@ -0,0 +1,105 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/overlay"
+)
+
+func TestSelectionTrace(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var nodes []*nodeselection.SelectedNode
+	for i := 0; i < 3; i++ {
+		nodes = append(nodes, &nodeselection.SelectedNode{
+			ID:         testrand.NodeID(),
+			Address:    &pb.NodeAddress{Address: "127.0.0.1"},
+			LastNet:    fmt.Sprintf("127.0.%d", i),
+			LastIPPort: "127.0.0.1:8000",
+			Vetted:     true,
+		})
+	}
+
+	cache, err := overlay.NewUploadSelectionCache(zaptest.NewLogger(t),
+		&mockdb{reputable: nodes},
+		highStaleness,
+		nodeSelectionConfig,
+		nodeselection.NodeFilters{},
+		nodeselection.TestPlacementDefinitions(),
+	)
+	require.NoError(t, err)
+
+	cacheCtx, cacheCancel := context.WithCancel(ctx)
+	defer cacheCancel()
+	ctx.Go(func() error { return cache.Run(cacheCtx) })
+
+	req := overlay.FindStorageNodesRequest{
+		RequestedCount: 3,
+		ExcludedIDs:    []storj.NodeID{nodes[0].ID},
+	}
+	selected, err := cache.GetNodes(ctx, req)
+	require.Error(t, err)
+	require.Len(t, selected, 2)
+
+	trace, err := cache.TraceSelection(ctx, req, selected, err)
+	require.NoError(t, err)
+	require.Equal(t, 3, trace.Requested)
+	require.Equal(t, 2, trace.Selected)
+	require.NotEmpty(t, trace.Error)
+
+	var excluded nodeselection.TraceStep
+	for _, step := range trace.Steps {
+		if step.Name == "excluded" {
+			excluded = step
+		}
+	}
+	require.Equal(t, 1, excluded.Rejected)
+	require.Equal(t, []storj.NodeID{nodes[0].ID}, excluded.Examples)
+
+	tracer := overlay.NewSelectionTracer(zaptest.NewLogger(t), overlay.SelectionTraceConfig{
+		Failures: true,
+		History:  2,
+	})
+	require.True(t, tracer.ShouldTrace(true))
+	require.False(t, tracer.ShouldTrace(false))
+
+	for i := 1; i <= 3; i++ {
+		trace.Requested = i
+		tracer.Record(trace)
+	}
+
+	// only the last 2 are kept, the newest first
+	traces := tracer.Traces()
+	require.Len(t, traces, 2)
+	require.Equal(t, 3, traces[0].Requested)
+	require.Equal(t, 2, traces[1].Requested)
+
+	rec := httptest.NewRecorder()
+	tracer.Handler(rec, httptest.NewRequest(http.MethodGet, tracer.Path(), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var decoded []nodeselection.SelectionTrace
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &decoded))
+	require.Len(t, decoded, 2)
+	require.Equal(t, traces[0].Steps, decoded[0].Steps)
+
+	var nilTracer *overlay.SelectionTracer
+	require.False(t, nilTracer.ShouldTrace(true))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -145,6 +146,9 @@ type DB interface {
 
 	// GetLastIPPortByNodeTagNames gets last IP and port from nodes where node exists in node tags with a particular name.
 	GetLastIPPortByNodeTagNames(ctx context.Context, ids storj.NodeIDList, tagName []string) (lastIPPorts map[storj.NodeID]*string, err error)
+
+	// SetMaintenanceWindow sets the maintenance window of the node, a zero window clears it.
+	SetMaintenanceWindow(ctx context.Context, nodeID storj.NodeID, window MaintenanceWindow) (err error)
 }
 
 // DisqualificationReason is disqualification reason enum type.
@@ -174,8 +178,12 @@ type NodeCheckInInfo struct {
 	Capacity                *pb.NodeCapacity
 	Version                 *pb.NodeVersion
 	CountryCode             location.CountryCode
+	ASN                     uint32
+	ASOrganization          string
 	SoftwareUpdateEmailSent bool
 	VersionBelowMin         bool
+	// Reachable contains the transports the satellite could reach the node with.
+	Reachable nodeselection.Transports
 }
 
 // InfoResponse contains node dossier info requested from the storage node.
@@ -262,6 +270,21 @@ type NodeDossier struct {
 	LastOfflineEmail        *time.Time
 	LastSoftwareUpdateEmail *time.Time
 	CountryCode             location.CountryCode
+	ASN                     uint32
+	ASOrganization          string
+	Maintenance             MaintenanceWindow
+	// Reachable contains the transports which succeeded during the last check-in.
+	Reachable nodeselection.Transports
+	// LastTransportSuccess contains the last time the node was reached with each transport.
+	LastTransportSuccess TransportSuccess
+}
+
+// TransportSuccess contains the last time the node was reached with each
+// transport, nil if it never was.
+type TransportSuccess struct {
+	TCP   *time.Time
+	QUIC  *time.Time
+	Noise *time.Time
 }
 
 // NodeStats contains statistics about a node.
@@ -310,11 +333,18 @@ type Service struct {
 	config               Config
 
 	GeoIP                  geoip.IPToCountry
+	ASN                    geoip.IPToASN
+	SelectionTracer        *SelectionTracer
 	UploadSelectionCache   *UploadSelectionCache
 	DownloadSelectionCache *DownloadSelectionCache
 	LastNetFunc            LastNetFunc
-	placementDefinitions   nodeselection.PlacementDefinitions
-	placementLookup        map[string]storj.PlacementConstraint
+	// FillRateTracker receives the free disk space reported at check-in,
+	// when it's set.
+	FillRateTracker *nodeselection.FillRateTracker
+
+	placementMu          sync.RWMutex
+	placementDefinitions nodeselection.PlacementDefinitions
+	placementLookup      map[string]storj.PlacementConstraint
 }
 
 // LastNetFunc is the type of a function that will be used to derive a network from an ip and port.
@@ -335,6 +365,14 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, placements nod
 		}
 	}
 
+	var asn geoip.IPToASN = geoip.MockIPToASN{}
+	if config.GeoIP.ASNDB != "" {
+		asn, err = geoip.OpenMaxmindASNDB(config.GeoIP.ASNDB)
+		if err != nil {
+			return nil, errs.Combine(Error.Wrap(err), geoIP.Close())
+		}
+	}
+
 	defaultSelection := nodeselection.NodeFilters{}
 
 	if len(config.Node.UploadExcludedCountryCodes) > 0 {
@@ -367,10 +405,6 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, placements nod
 		return nil, errs.Wrap(err)
 	}
 
-	placementLookup := make(map[string]storj.PlacementConstraint, len(placements))
-	for _, placement := range placements {
-		placementLookup[placement.Name] = placement.ID
-	}
 	return &Service{
 		log:                  log,
 		db:                   db,
@@ -381,16 +415,40 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, placements nod
 		config:               config,
 
 		GeoIP: geoIP,
+		ASN:   asn,
 
 		UploadSelectionCache:   uploadSelectionCache,
 		DownloadSelectionCache: downloadSelectionCache,
 		LastNetFunc:            MaskOffLastNet,
 
 		placementDefinitions: placements,
-		placementLookup:      placementLookup,
+		placementLookup:      placementLookupOf(placements),
 	}, nil
 }
 
+// placementLookupOf creates the placement name to ID lookup table.
+func placementLookupOf(placements nodeselection.PlacementDefinitions) map[string]storj.PlacementConstraint {
+	placementLookup := make(map[string]storj.PlacementConstraint, len(placements))
+	for _, placement := range placements {
+		placementLookup[placement.Name] = placement.ID
+	}
+	return placementLookup
+}
+
+// SetPlacements atomically replaces the placement definitions used by the
+// upload and download selection caches.
+func (service *Service) SetPlacements(ctx context.Context, placements nodeselection.PlacementDefinitions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.placementMu.Lock()
+	service.placementDefinitions = placements
+	service.placementLookup = placementLookupOf(placements)
+	service.placementMu.Unlock()
+
+	service.DownloadSelectionCache.SetPlacementRules(placements.CreateFilters)
+	return service.UploadSelectionCache.SetPlacements(ctx, placements)
+}
+
 // Run runs the background processes needed for caches.
 func (service *Service) Run(ctx context.Context) error {
 	return errs.Combine(sync2.Concurrently(
@@ -401,7 +459,7 @@ func (service *Service) Run(ctx context.Context) error {
 
 // Close closes resources.
 func (service *Service) Close() error {
-	return service.GeoIP.Close()
+	return errs.Combine(service.GeoIP.Close(), service.ASN.Close())
 }
 
 // Get looks up the provided nodeID from the overlay.
@@ -454,6 +512,14 @@ func (service *Service) FindStorageNodesForUpload(ctx context.Context, req FindS
 	defer mon.Task()(&ctx)(&err)
 
 	selectedNodes, err := service.UploadSelectionCache.GetNodes(ctx, req)
+	if service.SelectionTracer.ShouldTrace(len(selectedNodes) < req.RequestedCount) {
+		trace, traceErr := service.UploadSelectionCache.TraceSelection(ctx, req, selectedNodes, err)
+		if traceErr != nil {
+			service.log.Warn("Failed to trace the node selection", zap.Error(traceErr))
+		} else {
+			service.SelectionTracer.Record(trace)
+		}
+	}
 	if err != nil {
 		return selectedNodes, err
 	}
@@ -593,6 +659,10 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 		return Error.New("failed to get node info from DB: %w", err)
 	}
 
+	if service.FillRateTracker != nil && node.IsUp && node.Capacity != nil {
+		service.FillRateTracker.Observe(node.NodeID, node.Capacity.FreeDisk, timestamp)
+	}
+
 	if oldInfo == nil {
 		if !node.IsUp {
 			// this is a previously unknown node, and we couldn't pingback to verify that it even
@@ -618,6 +688,7 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 				zap.Stringer("Node ID", node.NodeID),
 				zap.Error(err))
 		}
+		service.lookupASN(&node)
 
 		return service.db.UpdateCheckIn(ctx, node, timestamp, service.config.Node)
 	}
@@ -650,6 +721,7 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 			zap.Stringer("Node ID", node.NodeID),
 			zap.Error(err))
 	}
+	service.lookupASN(&node)
 
 	if service.config.SendNodeEmails && service.config.Node.MinimumVersion != "" {
 		min, err := version.NewSemVer(service.config.Node.MinimumVersion)
@@ -677,7 +749,9 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 
 	if dbStale || addrChanged || walletChanged || verChanged || spaceChanged ||
 		oldInfo.LastNet != node.LastNet || oldInfo.LastIPPort != node.LastIPPort ||
-		oldInfo.CountryCode != node.CountryCode || node.SoftwareUpdateEmailSent {
+		oldInfo.CountryCode != node.CountryCode || oldInfo.ASN != node.ASN ||
+		oldInfo.ASOrganization != node.ASOrganization || oldInfo.Reachable != node.Reachable ||
+		node.SoftwareUpdateEmailSent {
 		err = service.db.UpdateCheckIn(ctx, node, timestamp, service.config.Node)
 		if err != nil {
 			return Error.Wrap(err)
@@ -698,6 +772,22 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 	return nil
 }
 
+// lookupASN resolves the autonomous system of the node's last IP. Failures
+// are only logged, as the ASN is an optional attribute of the node.
+func (service *Service) lookupASN(node *NodeCheckInInfo) {
+	info, err := service.ASN.LookupASN(node.LastIPPort)
+	if err != nil {
+		mon.Meter("asn_lookup_failed").Mark(1)
+		service.log.Debug("failed to resolve ASN for node",
+			zap.String("node address", node.Address.Address),
+			zap.Stringer("Node ID", node.NodeID),
+			zap.Error(err))
+		return
+	}
+	node.ASN = info.Number
+	node.ASOrganization = info.Organization
+}
+
 // DQNodesLastSeenBefore disqualifies nodes who have not been contacted since the cutoff time.
 func (service *Service) DQNodesLastSeenBefore(ctx context.Context, cutoff time.Time, limit int) (count int, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -758,11 +848,17 @@ func (service *Service) GetNodeTags(ctx context.Context, id storj.NodeID) (nodes
 // GetLocationFromPlacement returns the location identifier of the bucket.
 // It comes from the name of the placement (or `nodeselection.Location` in case of legacy config).
 func (service *Service) GetLocationFromPlacement(placement storj.PlacementConstraint) string {
+	service.placementMu.RLock()
+	defer service.placementMu.RUnlock()
+
 	return service.placementDefinitions[placement].Name
 }
 
 // GetPlacementConstraintFromName returns the placement constraint given the placement name.
 func (service *Service) GetPlacementConstraintFromName(name string) (id storj.PlacementConstraint, exists bool) {
+	service.placementMu.RLock()
+	defer service.placementMu.RUnlock()
+
 	id, exists = service.placementLookup[name]
 	return id, exists
 }
@@ -550,6 +550,51 @@ func TestUpdateCheckIn(t *testing.T) {
 	})
 }
 
+func TestUpdateCheckInReachability(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		cache := db.OverlayCache()
+		info := overlay.NodeCheckInInfo{
+			NodeID:     testrand.NodeID(),
+			Address:    &pb.NodeAddress{Address: "1.2.4.4:8080"},
+			IsUp:       true,
+			Version:    &pb.NodeVersion{Version: "v0.0.0"},
+			LastIPPort: "1.2.4.4:8080",
+			LastNet:    "1.2.4",
+			Reachable:  nodeselection.Transports{TCP: true, QUIC: true},
+		}
+
+		firstCheckIn := time.Now()
+		require.NoError(t, cache.UpdateCheckIn(ctx, info, firstCheckIn, overlay.NodeSelectionConfig{}))
+
+		node, err := cache.Get(ctx, info.NodeID)
+		require.NoError(t, err)
+		require.Equal(t, nodeselection.Transports{TCP: true, QUIC: true}, node.Reachable)
+		require.NotNil(t, node.LastTransportSuccess.TCP)
+		require.NotNil(t, node.LastTransportSuccess.QUIC)
+		require.Nil(t, node.LastTransportSuccess.Noise)
+
+		// QUIC is not reachable anymore, but the last success is kept
+		info.Reachable = nodeselection.Transports{TCP: true, Noise: true}
+		require.NoError(t, cache.UpdateCheckIn(ctx, info, firstCheckIn.Add(time.Hour), overlay.NodeSelectionConfig{}))
+
+		node, err = cache.Get(ctx, info.NodeID)
+		require.NoError(t, err)
+		require.Equal(t, nodeselection.Transports{TCP: true, Noise: true}, node.Reachable)
+		require.NotNil(t, node.LastTransportSuccess.QUIC)
+		require.WithinDuration(t, firstCheckIn, *node.LastTransportSuccess.QUIC, time.Second)
+		require.WithinDuration(t, firstCheckIn.Add(time.Hour), *node.LastTransportSuccess.TCP, time.Second)
+
+		// nothing is reachable when the node is offline
+		info.IsUp = false
+		info.Reachable = nodeselection.Transports{}
+		require.NoError(t, cache.UpdateCheckIn(ctx, info, firstCheckIn.Add(2*time.Hour), overlay.NodeSelectionConfig{}))
+
+		node, err = cache.Get(ctx, info.NodeID)
+		require.NoError(t, err)
+		require.Zero(t, node.Reachable)
+	})
+}
+
 func TestUpdateReputation(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
@@ -59,6 +59,11 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			IsUp:        true,
 			CountryCode: location.ToCountryCode(tt.countryCode),
 		}
+		if tt.nodeID == (storj.NodeID{1}) {
+			d.ASN = 24940
+			d.ASOrganization = "Hetzner Online GmbH"
+			d.Reachable = nodeselection.Transports{TCP: true, QUIC: true}
+		}
 		err := cache.UpdateCheckIn(ctx, d, time.Now().UTC(), overlay.NodeSelectionConfig{})
 		require.NoError(t, err)
 
@@ -118,6 +123,9 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		require.Equal(t, nodeIds[0], selectedNodes[0].ID) // #1 is online
 		require.True(t, selectedNodes[0].Online)
 		require.Equal(t, "DE", selectedNodes[0].CountryCode.String())
+		require.Equal(t, uint32(24940), selectedNodes[0].ASN)
+		require.Equal(t, "Hetzner Online GmbH", selectedNodes[0].ASOrganization)
+		require.Equal(t, nodeselection.Transports{TCP: true, QUIC: true}, selectedNodes[0].Reachable)
 		require.Equal(t, nodeIds[2], selectedNodes[2].ID) // #3 is unknown-audit-suspended
 		require.True(t, selectedNodes[2].Online)
 		require.Equal(t, "DE", selectedNodes[2].CountryCode.String())
@@ -133,6 +141,9 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		require.Equal(t, nodeIds[7], selectedNodes[7].ID) // #8 is online but has no country code
 		require.True(t, selectedNodes[7].Online)
 		require.Equal(t, "", selectedNodes[7].CountryCode.String())
+		require.Zero(t, selectedNodes[7].ASN)
+		require.Empty(t, selectedNodes[7].ASOrganization)
+		require.Zero(t, selectedNodes[7].Reachable)
 	})
 
 	t.Run("GetParticipatingNodes", func(t *testing.T) {
@@ -171,6 +182,14 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		expectNotInList(t, allNodes, storj.NodeID{9}) // not in db
 
 		require.Len(t, allNodes, 6)
+
+		for _, n := range allNodes {
+			if n.ID == (storj.NodeID{1}) {
+				require.Equal(t, uint32(24940), n.ASN)
+				require.Equal(t, "Hetzner Online GmbH", n.ASOrganization)
+				require.Equal(t, nodeselection.Transports{TCP: true, QUIC: true}, n.Reachable)
+			}
+		}
 	})
 
 	t.Run("TestUpdateOperator", func(t *testing.T) {
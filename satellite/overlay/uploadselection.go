@@ -5,6 +5,7 @@ package overlay
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -35,10 +36,20 @@ type UploadSelectionCache struct {
 	db              UploadSelectionDB
 	selectionConfig NodeSelectionConfig
 
-	cache sync2.ReadCacheOf[nodeselection.State]
+	cache sync2.ReadCacheOf[uploadSelectionState]
 
 	defaultFilters nodeselection.NodeFilters
-	placements     nodeselection.PlacementDefinitions
+
+	mu         sync.Mutex
+	placements nodeselection.PlacementDefinitions
+}
+
+// uploadSelectionState is the cached state of the upload selection. The nodes
+// and the placements are kept to be able to trace the selections.
+type uploadSelectionState struct {
+	selectors  nodeselection.State
+	nodes      []*nodeselection.SelectedNode
+	placements nodeselection.PlacementDefinitions
 }
 
 // NewUploadSelectionCache creates a new cache that keeps a list of all the storage nodes that are qualified to store data.
@@ -69,20 +80,39 @@ func (cache *UploadSelectionCache) Refresh(ctx context.Context) (err error) {
 // refresh calls out to the database and refreshes the cache with the most up-to-date
 // data from the nodes table, then sets time that the last refresh occurred so we know when
 // to refresh again in the future.
-func (cache *UploadSelectionCache) read(ctx context.Context) (_ nodeselection.State, err error) {
+func (cache *UploadSelectionCache) read(ctx context.Context) (_ uploadSelectionState, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	reputableNodes, newNodes, err := cache.db.SelectAllStorageNodesUpload(ctx, cache.selectionConfig)
 	if err != nil {
-		return nil, Error.Wrap(err)
+		return uploadSelectionState{}, Error.Wrap(err)
 	}
 
 	mon.IntVal("refresh_cache_size_reputable").Observe(int64(len(reputableNodes)))
 	mon.IntVal("refresh_cache_size_new").Observe(int64(len(newNodes)))
 
 	var allNodes = append(append([]*nodeselection.SelectedNode{}, reputableNodes...), newNodes...)
-	state := nodeselection.NewState(allNodes, cache.placements)
-	return state, nil
+	cache.mu.Lock()
+	placements := cache.placements
+	cache.mu.Unlock()
+
+	return uploadSelectionState{
+		selectors:  nodeselection.NewState(allNodes, placements),
+		nodes:      allNodes,
+		placements: placements,
+	}, nil
+}
+
+// SetPlacements replaces the placement definitions, and refreshes the cache
+// to use the new node selectors.
+func (cache *UploadSelectionCache) SetPlacements(ctx context.Context, placements nodeselection.PlacementDefinitions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.mu.Lock()
+	cache.placements = placements
+	cache.mu.Unlock()
+
+	return cache.Refresh(ctx)
 }
 
 // GetNodes selects nodes from the cache that will be used to upload a file.
@@ -96,9 +126,26 @@ func (cache *UploadSelectionCache) GetNodes(ctx context.Context, req FindStorage
 		return nil, Error.Wrap(err)
 	}
 
-	nodes, err := state.Select(req.Requester, req.Placement, req.RequestedCount, req.ExcludedIDs, req.AlreadySelected)
+	nodes, err := state.selectors.Select(req.Requester, req.Placement, req.RequestedCount, req.ExcludedIDs, req.AlreadySelected)
 	if nodeselection.ErrNotEnoughNodes.Has(err) {
 		err = ErrNotEnoughNodes.Wrap(err)
 	}
 	return nodes, err
 }
+
+// TraceSelection replays the filters of the placement on the cached nodes, to
+// explain the result of a GetNodes call.
+func (cache *UploadSelectionCache) TraceSelection(ctx context.Context, req FindStorageNodesRequest, selected []*nodeselection.SelectedNode, selectionErr error) (_ nodeselection.SelectionTrace, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	state, err := cache.cache.Get(ctx, time.Now())
+	if err != nil {
+		return nodeselection.SelectionTrace{}, Error.Wrap(err)
+	}
+
+	placement, found := state.placements[req.Placement]
+	if !found {
+		placement = nodeselection.Placement{ID: req.Placement}
+	}
+	return nodeselection.TraceSelection(state.nodes, placement, req.RequestedCount, req.ExcludedIDs, req.AlreadySelected, selected, selectionErr), nil
+}
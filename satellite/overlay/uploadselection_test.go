@@ -947,3 +947,58 @@ func (m *mockdb) GetLastIPPortByNodeTagNames(ctx context.Context, ids storj.Node
 func (m *mockdb) AccountingNodeInfo(ctx context.Context, nodeIDs storj.NodeIDList) (_ map[storj.NodeID]overlay.NodeAccountingInfo, err error) {
 	panic("implement me")
 }
+
+// SetMaintenanceWindow sets the maintenance window of the node.
+func (m *mockdb) SetMaintenanceWindow(ctx context.Context, nodeID storj.NodeID, window overlay.MaintenanceWindow) (err error) {
+	panic("implement me")
+}
+
+func TestUploadSelectionCacheSetPlacements(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	mockDB := mockdb{}
+	for _, country := range []location.CountryCode{location.Germany, location.UnitedStates} {
+		mockDB.reputable = append(mockDB.reputable, &nodeselection.SelectedNode{
+			ID:          testrand.NodeID(),
+			LastNet:     country.String(),
+			CountryCode: country,
+		})
+	}
+
+	placementsOf := func(country string) nodeselection.PlacementDefinitions {
+		placements, err := nodeselection.LoadConfigFromString(`
+placements:
+  - id: 1
+    name: regional
+    filter: country("`+country+`")
+`, nodeselection.NewPlacementConfigEnvironment(nil, nil))
+		require.NoError(t, err)
+		return placements
+	}
+
+	cache, err := overlay.NewUploadSelectionCache(zap.NewNop(),
+		&mockDB,
+		highStaleness,
+		nodeSelectionConfig,
+		nodeselection.NodeFilters{},
+		placementsOf("DE"),
+	)
+	require.NoError(t, err)
+
+	cacheCtx, cacheCancel := context.WithCancel(ctx)
+	defer cacheCancel()
+	ctx.Go(func() error { return cache.Run(cacheCtx) })
+
+	selected, err := cache.GetNodes(ctx, overlay.FindStorageNodesRequest{Placement: 1, RequestedCount: 1})
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	require.Equal(t, location.Germany, selected[0].CountryCode)
+
+	require.NoError(t, cache.SetPlacements(ctx, placementsOf("US")))
+
+	selected, err = cache.GetNodes(ctx, overlay.FindStorageNodesRequest{Placement: 1, RequestedCount: 1})
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	require.Equal(t, location.UnitedStates, selected[0].CountryCode)
+}
@@ -61,6 +61,7 @@ import (
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/nodetags"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
@@ -71,9 +72,11 @@ import (
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/placementmigration"
 	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/satellite/replication"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/snopayouts"
@@ -114,10 +117,18 @@ type DB interface {
 	ProjectAccounting() accounting.ProjectAccounting
 	// RepairQueue returns queue for segments that need repairing
 	RepairQueue() queue.RepairQueue
+	// Durability returns database for the results of the correlated durability report
+	Durability() durability.DB
+	// NodeTags returns database for the node tags signed by the satellite
+	NodeTags() nodetags.DB
+	// Replication returns database for bucket replication rules and queue
+	Replication() replication.DB
 	// VerifyQueue returns queue for segments chosen for verification
 	VerifyQueue() audit.VerifyQueue
 	// ReverifyQueue returns queue for pieces that need audit reverification
 	ReverifyQueue() audit.ReverifyQueue
+	// AuditHistory returns database for the recent audit outcomes of nodes
+	AuditHistory() audit.HistoryDB
 	// Console returns database for satellite console
 	Console() console.DB
 	// OIDC returns the database for OIDC resources.
@@ -173,7 +184,8 @@ type Config struct {
 	Server   server.Config
 	Debug    debug.Config
 
-	Placement nodeselection.ConfigurablePlacementRule `help:"detailed placement rules in the form 'id:definition;id:definition;...' where id is a 16 bytes integer (use >10 for backward compatibility), definition is a combination of the following functions:country(2 letter country codes,...), tag(nodeId, key, bytes(value)) all(...,...)."`
+	Placement        nodeselection.ConfigurablePlacementRule `help:"detailed placement rules in the form 'id:definition;id:definition;...' where id is a 16 bytes integer (use >10 for backward compatibility), definition is a combination of the following functions:country(2 letter country codes,...), tag(nodeId, key, bytes(value)) all(...,...)."`
+	PlacementWatcher nodeselection.WatcherConfig
 
 	Admin admin.Config
 
@@ -194,11 +206,21 @@ type Config struct {
 	Repairer repairer.Config
 	Audit    audit.Config
 
+	RepairCoordinator repairer.CoordinatorConfig
+
+	AuditInventory audit.InventoryConfig
+
 	GarbageCollection   sender.Config
 	GarbageCollectionBF bloomfilter.Config
 
 	RepairQueueCheck repairer.QueueStatConfig
 
+	RepairQueue queue.Config
+
+	Replication replication.Config
+
+	PlacementMigration placementmigration.Config
+
 	RangedLoop rangedloop.Config
 	Durability durability.Config
 
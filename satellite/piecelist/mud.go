@@ -13,6 +13,7 @@ import (
 func Module(ball *mud.Ball) {
 	mud.Provide[*PieceList](ball, NewPieceList)
 	mud.Implementation[[]rangedloop.Observer, *PieceList](ball)
+	mud.Tag[*PieceList, rangedloop.ObserverName](ball, rangedloop.ObserverName{Name: "piecelist"})
 	config.RegisterConfig[Config](ball, "piecelist")
 
 }
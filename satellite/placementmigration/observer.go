@@ -0,0 +1,479 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package placementmigration
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/repair"
+	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+var (
+	// Error is the error class for this package.
+	Error = errs.Class("placement migration")
+
+	mon = monkit.Package()
+
+	_ rangedloop.Observer = (*Observer)(nil)
+	_ rangedloop.Partial  = (*observerFork)(nil)
+)
+
+// repairSegmentHealth is the health used for segments inserted into the
+// repair queue by the placement migration. It is high, so that the moves are
+// processed after repairs of segments which are really at risk.
+const repairSegmentHealth = 1e9
+
+// Config contains configurable values for the placement migration observer.
+type Config struct {
+	Enabled    bool `help:"whether to move segments of buckets, whose placement was changed, into the new placement with ranged loop" default:"true"`
+	BatchSize  int  `help:"number of objects to update and segments to insert into the repair queue at once" default:"1000"`
+	ListLimit  int  `help:"number of objects to list at once when loading the objects of migrated buckets" default:"10000"`
+	MaxObjects int  `help:"maximum number of objects of migrated buckets to check in one ranged loop iteration" default:"1000000"`
+}
+
+// Observer moves the segments of buckets, whose placement was changed, into
+// the new placement.
+//
+// On start it loads the objects of buckets with an unfinished placement
+// migration, at most MaxObjects of them. When the limit is reached, the next
+// iteration continues with the remaining objects, so buckets are checked over
+// multiple iterations. Segments of these objects which still have the old placement get
+// the new one and segments with pieces outside of the placement are inserted
+// into the repair queue, so the repairer moves the pieces. The progress of a
+// bucket is stored at the end of the loop which checked its last objects and a
+// migration is completed when all segments of the bucket are found within the
+// placement.
+//
+// architecture: Observer
+type Observer struct {
+	log         *zap.Logger
+	buckets     buckets.DB
+	metabase    *metabase.DB
+	repairQueue queue.RepairQueue
+	nodesCache  *checker.ReliabilityCache
+	placements  nodeselection.PlacementDefinitions
+	config      Config
+
+	// resume is where loading the objects stopped in the previous iteration.
+	resume *resumeState
+
+	// the following are reset on each iteration
+	migrations []*migration
+	streams    map[uuid.UUID]*migration
+}
+
+// migration is a placement migration with the progress of the current iteration.
+type migration struct {
+	buckets.PlacementMigration
+
+	total    int64
+	migrated int64
+
+	// partial is set when not all objects of the bucket were loaded and
+	// cursor is the last loaded stream id.
+	partial bool
+	cursor  uuid.UUID
+}
+
+// resumeState is the progress of a bucket, whose objects were partially
+// checked in the previous iterations.
+type resumeState struct {
+	location  metabase.BucketLocation
+	placement storj.PlacementConstraint
+	cursor    uuid.UUID
+	total     int64
+	migrated  int64
+}
+
+// NewObserver creates a new placement migration observer.
+func NewObserver(log *zap.Logger, bucketsDB buckets.DB, metabaseDB *metabase.DB, repairQueue queue.RepairQueue, nodesCache *checker.ReliabilityCache, placements nodeselection.PlacementDefinitions, config Config) *Observer {
+	return &Observer{
+		log:         log,
+		buckets:     bucketsDB,
+		metabase:    metabaseDB,
+		repairQueue: repairQueue,
+		nodesCache:  nodesCache,
+		placements:  placements,
+		config:      config,
+	}
+}
+
+// Start loads the unfinished placement migrations and the objects of the
+// migrated buckets, continuing where the previous iteration stopped.
+func (observer *Observer) Start(ctx context.Context, startTime time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	observer.migrations = nil
+	observer.streams = map[uuid.UUID]*migration{}
+
+	resume := observer.resume
+	observer.resume = nil
+
+	list, err := observer.buckets.ListPlacementMigrations(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	migrations := list[:0]
+	for _, m := range list {
+		if _, ok := observer.placements[m.Placement]; !ok {
+			observer.log.Warn("skipping placement migration to unknown placement",
+				zap.Stringer("Project ID", m.ProjectID),
+				zap.String("Bucket", string(m.BucketName)),
+				zap.Uint16("Placement", uint16(m.Placement)))
+			continue
+		}
+		migrations = append(migrations, m)
+	}
+
+	first := 0
+	if resume != nil {
+		first = max(0, slices.IndexFunc(migrations, func(m buckets.PlacementMigration) bool {
+			return m.Location() == resume.location && m.Placement == resume.placement
+		}))
+	}
+
+	remaining := observer.config.MaxObjects
+	for i := range migrations {
+		if remaining <= 0 {
+			break
+		}
+
+		state := &migration{PlacementMigration: migrations[(first+i)%len(migrations)]}
+
+		var cursor uuid.UUID
+		if resume != nil && state.Location() == resume.location && state.Placement == resume.placement {
+			cursor = resume.cursor
+			state.total = resume.total
+			state.migrated = resume.migrated
+		}
+
+		loaded, err := observer.loadStreams(ctx, state, cursor, remaining)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		remaining -= loaded
+
+		observer.migrations = append(observer.migrations, state)
+	}
+
+	mon.IntVal("placement_migration_buckets").Observe(int64(len(observer.migrations)))
+	mon.IntVal("placement_migration_objects").Observe(int64(len(observer.streams)))
+
+	return nil
+}
+
+// loadStreams loads the stream ids of the migrated bucket following the
+// cursor, until all of them or limit stream ids are loaded.
+func (observer *Observer) loadStreams(ctx context.Context, state *migration, cursor uuid.UUID, limit int) (loaded int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	opts := metabase.ListBucketsStreamIDs{}
+	opts.BucketList.Add(state.ProjectID, state.BucketName)
+	if !cursor.IsZero() {
+		opts.CursorBucket = state.Location()
+		opts.CursorStreamID = cursor
+	}
+
+	for {
+		if loaded >= limit {
+			// check whether any objects remain.
+			opts.Limit = 1
+			result, err := observer.metabase.ListBucketsStreamIDs(ctx, opts)
+			if err != nil {
+				return loaded, err
+			}
+			if len(result.StreamIDs) > 0 {
+				state.partial = true
+				state.cursor = opts.CursorStreamID
+			}
+			return loaded, nil
+		}
+
+		opts.Limit = min(observer.config.ListLimit, limit-loaded)
+		result, err := observer.metabase.ListBucketsStreamIDs(ctx, opts)
+		if err != nil {
+			return loaded, err
+		}
+
+		if len(result.StreamIDs) == 0 {
+			return loaded, nil
+		}
+		for _, streamID := range result.StreamIDs {
+			observer.streams[streamID] = state
+		}
+		loaded += len(result.StreamIDs)
+
+		opts.CursorBucket = state.Location()
+		opts.CursorStreamID = result.StreamIDs[len(result.StreamIDs)-1]
+	}
+}
+
+// Fork creates a Partial to process a chunk of all the segments.
+func (observer *Observer) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return &observerFork{
+		metabase:    observer.metabase,
+		repairQueue: queue.NewInsertBuffer(observer.repairQueue, observer.config.BatchSize),
+		nodesCache:  observer.nodesCache,
+		placements:  observer.placements,
+		batchSize:   observer.config.BatchSize,
+		streams:     observer.streams,
+		progress:    map[*migration]*progress{},
+		pending:     map[*migration]*pendingStreams{},
+	}, nil
+}
+
+// Join flushes the pending changes of the partial and merges its progress.
+func (observer *Observer) Join(ctx context.Context, partial rangedloop.Partial) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork, ok := partial.(*observerFork)
+	if !ok {
+		return Error.New("expected %T but got %T", fork, partial)
+	}
+
+	if err := fork.flush(ctx); err != nil {
+		return Error.Wrap(err)
+	}
+
+	for state, p := range fork.progress {
+		state.total += p.total
+		state.migrated += p.migrated
+	}
+
+	return nil
+}
+
+// Finish stores the progress of the placement migrations.
+func (observer *Observer) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+
+	var completed int64
+	updates := make([]buckets.PlacementMigration, 0, len(observer.migrations))
+	for _, state := range observer.migrations {
+		if state.partial {
+			// the progress is stored once the remaining objects are checked.
+			observer.resume = &resumeState{
+				location:  state.Location(),
+				placement: state.Placement,
+				cursor:    state.cursor,
+				total:     state.total,
+				migrated:  state.migrated,
+			}
+			continue
+		}
+
+		update := state.PlacementMigration
+		update.SegmentsTotal = state.total
+		update.SegmentsMigrated = state.migrated
+		update.CheckedAt = &now
+		if state.migrated == state.total {
+			update.CompletedAt = &now
+			completed++
+		}
+		updates = append(updates, update)
+
+		placement := monkit.NewSeriesTag("placement", strconv.FormatUint(uint64(state.Placement), 10))
+		mon.IntVal("placement_migration_segments_remaining", placement).Observe(state.total - state.migrated)
+	}
+
+	mon.IntVal("placement_migration_buckets_completed").Observe(completed)
+
+	// release the loaded stream ids.
+	observer.streams = nil
+
+	return Error.Wrap(observer.buckets.UpdatePlacementMigrations(ctx, updates))
+}
+
+type progress struct {
+	total    int64
+	migrated int64
+}
+
+// pendingStreams are the objects whose segments need the placement changed,
+// together with their segments, which should be repaired afterwards.
+type pendingStreams struct {
+	streamIDs []uuid.UUID
+	segments  []*queue.InjuredSegment
+}
+
+// observerFork implements the ranged loop Partial interface.
+type observerFork struct {
+	metabase    *metabase.DB
+	repairQueue *queue.InsertBuffer
+	nodesCache  *checker.ReliabilityCache
+	placements  nodeselection.PlacementDefinitions
+	batchSize   int
+
+	// streams is shared between the forks and only read.
+	streams  map[uuid.UUID]*migration
+	progress map[*migration]*progress
+	pending  map[*migration]*pendingStreams
+	pendingN int
+
+	lastStreamID uuid.UUID
+
+	// reuse those slices to optimize memory usage
+	nodeIDs []storj.NodeID
+	nodes   []nodeselection.SelectedNode
+}
+
+// Process checks the segments of the migrated buckets.
+func (fork *observerFork) Process(ctx context.Context, segments []rangedloop.Segment) (err error) {
+	for i := range segments {
+		if err := fork.process(ctx, &segments[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fork *observerFork) process(ctx context.Context, segment *rangedloop.Segment) (err error) {
+	state, ok := fork.streams[segment.StreamID]
+	if !ok {
+		return nil
+	}
+
+	// expired segments are not repaired.
+	if segment.Expired(time.Now()) {
+		return nil
+	}
+
+	p, ok := fork.progress[state]
+	if !ok {
+		p = &progress{}
+		fork.progress[state] = p
+	}
+	p.total++
+
+	if segment.Placement != state.Placement {
+		return fork.changePlacement(ctx, state, segment)
+	}
+
+	if segment.Inline() {
+		p.migrated++
+		return nil
+	}
+
+	inPlacement, err := fork.inPlacement(ctx, segment, state.Placement)
+	if err != nil {
+		return err
+	}
+	if inPlacement {
+		p.migrated++
+		return nil
+	}
+
+	return fork.repairQueue.Insert(ctx, &queue.InjuredSegment{
+		StreamID:      segment.StreamID,
+		Position:      segment.Position,
+		SegmentHealth: repairSegmentHealth,
+		Placement:     state.Placement,
+	}, nil)
+}
+
+// changePlacement schedules changing the placement of all segments of the
+// object and repairing the segment afterwards.
+func (fork *observerFork) changePlacement(ctx context.Context, state *migration, segment *rangedloop.Segment) error {
+	pending, ok := fork.pending[state]
+	if !ok {
+		pending = &pendingStreams{}
+		fork.pending[state] = pending
+	}
+
+	if fork.lastStreamID != segment.StreamID {
+		fork.lastStreamID = segment.StreamID
+		pending.streamIDs = append(pending.streamIDs, segment.StreamID)
+		fork.pendingN++
+	}
+
+	if !segment.Inline() {
+		pending.segments = append(pending.segments, &queue.InjuredSegment{
+			StreamID:      segment.StreamID,
+			Position:      segment.Position,
+			SegmentHealth: repairSegmentHealth,
+			Placement:     state.Placement,
+		})
+	}
+
+	if fork.pendingN < fork.batchSize {
+		return nil
+	}
+	return fork.flush(ctx)
+}
+
+// flush changes the placement of the pending objects and then inserts their
+// segments into the repair queue. The order matters, as the repairer uses the
+// placement of the segment.
+func (fork *observerFork) flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for state, pending := range fork.pending {
+		updated, err := fork.metabase.SetStreamsPlacement(ctx, metabase.SetStreamsPlacement{
+			ProjectID: state.ProjectID,
+			StreamIDs: pending.streamIDs,
+			Placement: state.Placement,
+		})
+		if err != nil {
+			return err
+		}
+		mon.Counter("placement_migration_segments_updated").Inc(updated)
+
+		for _, segment := range pending.segments {
+			if err := fork.repairQueue.Insert(ctx, segment, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	clear(fork.pending)
+	fork.pendingN = 0
+
+	return fork.repairQueue.Flush(ctx)
+}
+
+// inPlacement returns whether all pieces of the segment are on nodes of the placement.
+func (fork *observerFork) inPlacement(ctx context.Context, segment *rangedloop.Segment, placement storj.PlacementConstraint) (bool, error) {
+	pieces := segment.Pieces
+
+	// reuse fork.nodeIDs and fork.nodes slices if large enough
+	if cap(fork.nodeIDs) < len(pieces) {
+		fork.nodeIDs = make([]storj.NodeID, len(pieces))
+		fork.nodes = make([]nodeselection.SelectedNode, len(pieces))
+	} else {
+		fork.nodeIDs = fork.nodeIDs[:len(pieces)]
+		fork.nodes = fork.nodes[:len(pieces)]
+	}
+
+	for i, piece := range pieces {
+		fork.nodeIDs[i] = piece.StorageNode
+	}
+
+	selectedNodes, err := fork.nodesCache.GetNodes(ctx, segment.CreatedAt, fork.nodeIDs, fork.nodes)
+	if err != nil {
+		return false, Error.New("error getting node information for pieces: %w", err)
+	}
+
+	result := repair.ClassifySegmentPieces(pieces, selectedNodes, nil, true, false, fork.placements[placement], int(segment.Redundancy.RepairShares))
+	return result.OutOfPlacement.Count() == 0, nil
+}
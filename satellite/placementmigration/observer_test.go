@@ -0,0 +1,132 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package placementmigration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+func TestObserver(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Placement = nodeselection.ConfigurablePlacementRule{
+					PlacementRules: `10:annotation("location", "placement10")`,
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		sat.RangedLoop.RangedLoop.Service.Loop.Pause()
+		sat.Repair.Repairer.Loop.Pause()
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "testbucket", "remote", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "testbucket", "inline", testrand.Bytes(memory.KiB)))
+
+		_, err := sat.API.Buckets.Service.ChangePlacement(ctx, []byte("testbucket"), projectID, storj.DefaultPlacement)
+		require.Error(t, err)
+
+		bucket, err := sat.API.Buckets.Service.ChangePlacement(ctx, []byte("testbucket"), projectID, 10)
+		require.NoError(t, err)
+		require.Equal(t, storj.PlacementConstraint(10), bucket.Placement)
+
+		migration, err := sat.API.Buckets.Service.GetPlacementMigration(ctx, []byte("testbucket"), projectID)
+		require.NoError(t, err)
+		require.Nil(t, migration.CheckedAt)
+		require.False(t, migration.Completed())
+
+		// the first iteration changes the placement of the segments and
+		// queues the remote segment for repair.
+		_, err = sat.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+		for _, segment := range segments {
+			require.Equal(t, storj.PlacementConstraint(10), segment.Placement)
+		}
+
+		count, err := sat.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		migration, err = sat.API.Buckets.Service.GetPlacementMigration(ctx, []byte("testbucket"), projectID)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, migration.SegmentsTotal)
+		require.EqualValues(t, 0, migration.SegmentsMigrated)
+		require.NotNil(t, migration.CheckedAt)
+		require.False(t, migration.Completed())
+
+		// all nodes belong to the new placement, so the second iteration
+		// finds all segments within the placement.
+		_, err = sat.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+
+		migration, err = sat.API.Buckets.Service.GetPlacementMigration(ctx, []byte("testbucket"), projectID)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, migration.SegmentsTotal)
+		require.EqualValues(t, 2, migration.SegmentsMigrated)
+		require.True(t, migration.Completed())
+	})
+}
+
+func TestObserverMaxObjects(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Placement = nodeselection.ConfigurablePlacementRule{
+					PlacementRules: `10:annotation("location", "placement10")`,
+				}
+				config.PlacementMigration.MaxObjects = 1
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		sat.RangedLoop.RangedLoop.Service.Loop.Pause()
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "testbucket", "first", testrand.Bytes(memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "testbucket", "second", testrand.Bytes(memory.KiB)))
+
+		_, err := sat.API.Buckets.Service.ChangePlacement(ctx, []byte("testbucket"), projectID, 10)
+		require.NoError(t, err)
+
+		// the first iteration checks only one of the objects, so the
+		// progress isn't stored yet.
+		_, err = sat.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+
+		migration, err := sat.API.Buckets.Service.GetPlacementMigration(ctx, []byte("testbucket"), projectID)
+		require.NoError(t, err)
+		require.Nil(t, migration.CheckedAt)
+
+		// the second iteration continues with the other object.
+		_, err = sat.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+
+		migration, err = sat.API.Buckets.Service.GetPlacementMigration(ctx, []byte("testbucket"), projectID)
+		require.NoError(t, err)
+		require.NotNil(t, migration.CheckedAt)
+		require.EqualValues(t, 2, migration.SegmentsTotal)
+		require.EqualValues(t, 2, migration.SegmentsMigrated)
+		require.True(t, migration.Completed())
+	})
+}
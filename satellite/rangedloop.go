@@ -6,6 +6,7 @@ package satellite
 import (
 	"context"
 	"errors"
+	"io"
 	"math/rand"
 	"net"
 	"runtime/pprof"
@@ -17,6 +18,10 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/debug"
+	"storj.io/common/identity"
+	"storj.io/common/peertls/extensions"
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/rpc"
 	"storj.io/storj/private/lifecycle"
 	"storj.io/storj/satellite/accounting/nodetally"
 	"storj.io/storj/satellite/audit"
@@ -26,7 +31,10 @@ import (
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/placementmigration"
 	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/reputation"
 )
 
 // RangedLoop is the satellite ranged loop process.
@@ -39,10 +47,16 @@ type RangedLoop struct {
 	Servers  *lifecycle.Group
 	Services *lifecycle.Group
 
+	Identity *identity.FullIdentity
+	Dialer   rpc.Dialer
+
 	Audit struct {
-		Observer rangedloop.Observer
+		Observer          rangedloop.Observer
+		InventoryObserver *audit.InventoryObserver
 	}
 
+	Reputation *reputation.Service
+
 	Debug struct {
 		Listener net.Listener
 		Server   *debug.Server
@@ -69,7 +83,12 @@ type RangedLoop struct {
 	}
 
 	DurabilityReport struct {
-		Observer []*durability.Report
+		Observer   []*durability.Report
+		Correlated *durability.CorrelatedReport
+	}
+
+	PlacementMigration struct {
+		Observer *placementmigration.Observer
 	}
 
 	RangedLoop struct {
@@ -77,11 +96,13 @@ type RangedLoop struct {
 	}
 }
 
-// NewRangedLoop creates a new satellite ranged loop process.
-func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Config, atomicLogLevel *zap.AtomicLevel) (_ *RangedLoop, err error) {
+// NewRangedLoop creates a new satellite ranged loop process. The identity and
+// revocation database are only needed when piece inventory audits are enabled.
+func NewRangedLoop(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *metabase.DB, revocationDB extensions.RevocationDB, config *Config, atomicLogLevel *zap.AtomicLevel) (_ *RangedLoop, err error) {
 	peer := &RangedLoop{
-		Log: log,
-		DB:  db,
+		Log:      log,
+		Identity: full,
+		DB:       db,
 
 		Servers:  lifecycle.NewGroup(log.Named("servers")),
 		Services: lifecycle.NewGroup(log.Named("services")),
@@ -157,6 +178,14 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 			cache := checker.NewReliabilityCache(peer.Overlay.Service, config.Checker.ReliabilityCacheStaleness)
 			peer.DurabilityReport.Observer = append(peer.DurabilityReport.Observer, durability.NewDurability(db.OverlayCache(), metabaseDB, cache, class, f, config.RangedLoop.AsOfSystemInterval))
 		}
+
+		if len(config.Durability.Correlated) > 0 {
+			cache := checker.NewReliabilityCache(peer.Overlay.Service, config.Checker.ReliabilityCacheStaleness)
+			peer.DurabilityReport.Correlated, err = durability.NewCorrelatedReport(log.Named("durability:correlated"), db.Durability(), db.OverlayCache(), metabaseDB, cache, config.Durability, config.RangedLoop.AsOfSystemInterval)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	{ // setup repair
@@ -169,37 +198,117 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 			config.Checker.RepairExcludedCountryCodes = config.Overlay.RepairExcludedCountryCodes
 		}
 
+		repairQueue, err := queue.Open(config.RepairQueue, peer.DB.RepairQueue())
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		if closer, ok := repairQueue.(io.Closer); ok {
+			peer.Services.Add(lifecycle.Item{
+				Name:  "repair-queue",
+				Close: closer.Close,
+			})
+		}
+
 		peer.Repair.Observer = checker.NewObserver(
 			peer.Log.Named("repair:checker"),
-			peer.DB.RepairQueue(),
+			repairQueue,
 			peer.Overlay.Service,
 			placement,
 			config.Checker,
 		)
+
+		peer.PlacementMigration.Observer = placementmigration.NewObserver(
+			peer.Log.Named("placementmigration"),
+			peer.DB.Buckets(),
+			metabaseDB,
+			repairQueue,
+			checker.NewReliabilityCache(peer.Overlay.Service, config.Checker.ReliabilityCacheStaleness),
+			placement,
+			config.PlacementMigration,
+		)
+	}
+
+	if config.AuditInventory.Enabled { // setup piece inventory audit
+		if peer.Identity == nil {
+			return nil, errs.Combine(errs.New("piece inventory audits require the satellite identity"), peer.Close())
+		}
+
+		tlsOptions, err := tlsopts.NewOptions(peer.Identity, config.Server.Config, revocationDB)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Dialer = rpc.NewDefaultDialer(tlsOptions)
+
+		peer.Reputation = reputation.NewService(log.Named("reputation:service"),
+			peer.Overlay.Service,
+			peer.DB.Reputation(),
+			config.Reputation,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "reputation",
+			Close: peer.Reputation.Close,
+		})
+
+		var auditHistory audit.HistoryDB
+		if config.Audit.HistoryRetention > 0 {
+			auditHistory = peer.DB.AuditHistory()
+		}
+
+		reporter := audit.NewReporter(
+			log.Named("reporter"),
+			peer.Reputation,
+			peer.Overlay.Service,
+			metabaseDB,
+			peer.DB.Containment(),
+			auditHistory,
+			config.Audit.MaxRetriesStatDB,
+			int32(config.Audit.MaxReverifyCount))
+
+		peer.Audit.InventoryObserver = audit.NewInventoryObserver(
+			log.Named("audit:inventory"),
+			peer.Identity.ID,
+			peer.Dialer,
+			peer.Overlay.Service,
+			metabaseDB,
+			reporter,
+			config.AuditInventory,
+		)
 	}
 
 	{ // setup ranged loop
 		rand := rand.New(rand.NewSource(time.Now().UnixNano()))
 
-		observers := []rangedloop.Observer{
-			rangedloop.NewLiveCountObserver(metabaseDB, config.RangedLoop.SuspiciousProcessedRatio, config.RangedLoop.AsOfSystemInterval),
-			peer.Metrics.Observer,
+		registry := rangedloop.NewRegistry()
+		var group errs.Group
+		add := func(name string, observer rangedloop.Observer) {
+			group.Add(registry.Add(name, observer))
 		}
 
+		add("live-count", rangedloop.NewLiveCountObserver(metabaseDB, config.RangedLoop.SuspiciousProcessedRatio, config.RangedLoop.AsOfSystemInterval))
+		add("metrics", peer.Metrics.Observer)
+
 		if config.Audit.UseRangedLoop {
-			observers = append(observers, peer.Audit.Observer)
+			add("audit", peer.Audit.Observer)
+		}
+
+		if config.AuditInventory.Enabled {
+			add("audit-inventory", peer.Audit.InventoryObserver)
 		}
 
 		if config.Tally.UseRangedLoop {
-			observers = append(observers, peer.Accounting.NodeTallyObserver)
+			add("tally", peer.Accounting.NodeTallyObserver)
 		}
 
 		if config.Repairer.UseRangedLoop {
-			observers = append(observers, peer.Repair.Observer)
+			add("checker", peer.Repair.Observer)
 		}
 
 		if config.PieceTracker.UseRangedLoop {
-			observers = append(observers, peer.PieceTracker.Observer)
+			add("piecetracker", peer.PieceTracker.Observer)
+		}
+
+		if config.PlacementMigration.Enabled {
+			add("placement-migration", peer.PlacementMigration.Observer)
 		}
 
 		if config.DurabilityReport.Enabled {
@@ -212,11 +321,26 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 			rand.Shuffle(len(sequenceObservers), func(i, j int) {
 				sequenceObservers[i], sequenceObservers[j] = sequenceObservers[j], sequenceObservers[i]
 			})
-			observers = append(observers, rangedloop.NewSequenceObserver(sequenceObservers...))
+			add("durability", rangedloop.NewSequenceObserver(sequenceObservers...))
+
+			// the correlated report is stored in the database, so it's
+			// executed with each loop iteration.
+			if peer.DurabilityReport.Correlated != nil {
+				add("durability-correlated", peer.DurabilityReport.Correlated)
+			}
+		}
+
+		// observers compiled in from other packages.
+		group.Add(registry.AddRegistered(log.Named("rangedloop"), metabaseDB))
+		if err := group.Err(); err != nil {
+			return nil, errs.Combine(err, peer.Close())
 		}
 
 		segments := rangedloop.NewMetabaseRangeSplitter(log.Named("rangedloop-metabase-range-splitter"), metabaseDB, config.RangedLoop)
-		peer.RangedLoop.Service = rangedloop.NewService(log.Named("rangedloop"), config.RangedLoop, segments, observers)
+		peer.RangedLoop.Service, err = rangedloop.NewServiceFromRegistry(log.Named("rangedloop"), config.RangedLoop, segments, registry)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name: "rangeloop",
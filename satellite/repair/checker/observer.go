@@ -445,8 +445,9 @@ func (fork *observerFork) process(ctx context.Context, segment *rangedloop.Segme
 		stats.iterationAggregates.remoteSegmentsFailedToCheck++
 		return Error.New("error getting node information for pieces: %w", err)
 	}
+	required, repairThreshold, successThreshold, _ := loadRedundancy(segment.Redundancy, fork.repairThresholdOverrides, fork.repairTargetOverrides)
 	piecesCheck := repair.ClassifySegmentPieces(segment.Pieces, selectedNodes, fork.excludedCountryCodes, fork.doPlacementCheck,
-		fork.doDeclumping, fork.placements[segment.Placement])
+		fork.doDeclumping, fork.placements[segment.Placement], repairThreshold)
 
 	segmentTotalCountIntVal.Observe(int64(len(pieces)))
 	stats.segmentStats.segmentTotalCount.Observe(int64(len(pieces)))
@@ -500,7 +501,6 @@ func (fork *observerFork) process(ctx context.Context, segment *rangedloop.Segme
 		stats.segmentStats.yearOldSegmentPiecesLostPerWeek.Observe(piecesLostPerWeek)
 	}
 
-	required, repairThreshold, successThreshold, _ := loadRedundancy(segment.Redundancy, fork.repairThresholdOverrides, fork.repairTargetOverrides)
 	segmentHealth := repair.SegmentHealth(numHealthy, required, totalNumNodes, fork.nodeFailureRate, piecesCheck.ForcingRepair.Count())
 	segmentHealthFloatVal.Observe(segmentHealth)
 	stats.segmentStats.segmentHealth.Observe(segmentHealth)
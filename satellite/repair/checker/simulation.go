@@ -0,0 +1,291 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair"
+	"storj.io/storj/shared/location"
+)
+
+var (
+	_ rangedloop.Observer = (*SimulationObserver)(nil)
+	_ rangedloop.Partial  = (*simulationFork)(nil)
+)
+
+// SimulationStats contains the outcome of a repair simulation for a single placement.
+type SimulationStats struct {
+	// ObjectsChecked is the number of objects with remote segments.
+	ObjectsChecked int64
+	// SegmentsChecked is the number of remote segments.
+	SegmentsChecked int64
+	// SegmentsAffected is the number of segments with at least one piece on an offline node.
+	SegmentsAffected int64
+	// PiecesAffected is the number of pieces stored on offline nodes.
+	PiecesAffected int64
+
+	// SegmentsNeedingRepair is the number of segments which would need repair during the outage.
+	SegmentsNeedingRepair int64
+	// NewSegmentsNeedingRepair is the number of segments which would need repair only because of the outage.
+	NewSegmentsNeedingRepair int64
+	// SegmentsIrreparable is the number of segments which would have less retrievable pieces than required.
+	SegmentsIrreparable int64
+	// NewSegmentsIrreparable is the number of segments which would be irreparable only because of the outage.
+	NewSegmentsIrreparable int64
+	// ObjectsLost is the number of objects with at least one segment which would be irreparable.
+	ObjectsLost int64
+
+	// MinSegmentHealth is the lowest segment health found during the outage.
+	MinSegmentHealth float64
+}
+
+func (stats *SimulationStats) combine(other SimulationStats) {
+	if stats.SegmentsChecked == 0 || (other.SegmentsChecked > 0 && other.MinSegmentHealth < stats.MinSegmentHealth) {
+		stats.MinSegmentHealth = other.MinSegmentHealth
+	}
+	stats.ObjectsChecked += other.ObjectsChecked
+	stats.SegmentsChecked += other.SegmentsChecked
+	stats.SegmentsAffected += other.SegmentsAffected
+	stats.PiecesAffected += other.PiecesAffected
+	stats.SegmentsNeedingRepair += other.SegmentsNeedingRepair
+	stats.NewSegmentsNeedingRepair += other.NewSegmentsNeedingRepair
+	stats.SegmentsIrreparable += other.SegmentsIrreparable
+	stats.NewSegmentsIrreparable += other.NewSegmentsIrreparable
+	stats.ObjectsLost += other.ObjectsLost
+}
+
+// SimulationObserver replays the checker classification against a hypothetical
+// outage: all nodes matching the offline filter are treated as offline. It
+// only reports what would happen and never touches the repair queue.
+//
+// architecture: Observer
+type SimulationObserver struct {
+	log                      *zap.Logger
+	nodesCache               *ReliabilityCache
+	offline                  nodeselection.NodeFilter
+	repairThresholdOverrides RepairThresholdOverrides
+	repairTargetOverrides    RepairTargetOverrides
+	nodeFailureRate          float64
+	excludedCountryCodes     map[location.CountryCode]struct{}
+	doDeclumping             bool
+	doPlacementCheck         bool
+	placements               nodeselection.PlacementDefinitions
+
+	report map[storj.PlacementConstraint]*SimulationStats
+}
+
+// NewSimulationObserver creates a new repair simulation observer. Nodes matching
+// offline are considered offline during the simulation.
+func NewSimulationObserver(log *zap.Logger, overlay *overlay.Service, placements nodeselection.PlacementDefinitions, offline nodeselection.NodeFilter, config Config) *SimulationObserver {
+	excludedCountryCodes := make(map[location.CountryCode]struct{})
+	for _, countryCode := range config.RepairExcludedCountryCodes {
+		if cc := location.ToCountryCode(countryCode); cc != location.None {
+			excludedCountryCodes[cc] = struct{}{}
+		}
+	}
+
+	if config.RepairOverrides.String() != "" {
+		// backwards compatibility
+		config.RepairThresholdOverrides = RepairThresholdOverrides{config.RepairOverrides}
+	}
+
+	return &SimulationObserver{
+		log:                      log,
+		nodesCache:               NewReliabilityCache(overlay, config.ReliabilityCacheStaleness),
+		offline:                  offline,
+		repairThresholdOverrides: config.RepairThresholdOverrides,
+		repairTargetOverrides:    config.RepairTargetOverrides,
+		nodeFailureRate:          config.NodeFailureRate,
+		excludedCountryCodes:     excludedCountryCodes,
+		doDeclumping:             config.DoDeclumping,
+		doPlacementCheck:         config.DoPlacementCheck,
+		placements:               placements,
+		report:                   map[storj.PlacementConstraint]*SimulationStats{},
+	}
+}
+
+// Start implements rangedloop.Observer.
+func (observer *SimulationObserver) Start(ctx context.Context, startTime time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	observer.report = map[storj.PlacementConstraint]*SimulationStats{}
+	return nil
+}
+
+// Fork implements rangedloop.Observer.
+func (observer *SimulationObserver) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return &simulationFork{
+		observer: observer,
+		stats:    map[storj.PlacementConstraint]*SimulationStats{},
+	}, nil
+}
+
+// Join implements rangedloop.Observer.
+func (observer *SimulationObserver) Join(ctx context.Context, partial rangedloop.Partial) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork, ok := partial.(*simulationFork)
+	if !ok {
+		return Error.New("expected partial type %T but got %T", fork, partial)
+	}
+
+	for placement, stats := range fork.stats {
+		total, ok := observer.report[placement]
+		if !ok {
+			total = &SimulationStats{}
+			observer.report[placement] = total
+		}
+		total.combine(*stats)
+	}
+	return nil
+}
+
+// Finish implements rangedloop.Observer.
+func (observer *SimulationObserver) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for placement, stats := range observer.report {
+		observer.log.Info("repair simulation finished",
+			zap.Uint16("Placement", uint16(placement)),
+			zap.Int64("Segments Checked", stats.SegmentsChecked),
+			zap.Int64("Segments Affected", stats.SegmentsAffected),
+			zap.Int64("Segments Needing Repair", stats.SegmentsNeedingRepair),
+			zap.Int64("Segments Irreparable", stats.SegmentsIrreparable))
+	}
+	return nil
+}
+
+// Report returns the simulation results of the last iteration, grouped by placement.
+func (observer *SimulationObserver) Report() map[storj.PlacementConstraint]SimulationStats {
+	report := make(map[storj.PlacementConstraint]SimulationStats, len(observer.report))
+	for placement, stats := range observer.report {
+		report[placement] = *stats
+	}
+	return report
+}
+
+type simulationFork struct {
+	observer     *SimulationObserver
+	stats        map[storj.PlacementConstraint]*SimulationStats
+	lastStreamID uuid.UUID
+	lastLostID   uuid.UUID
+
+	// reuse those slices to optimize memory usage
+	nodeIDs []storj.NodeID
+	nodes   []nodeselection.SelectedNode
+}
+
+// Process implements rangedloop.Partial.
+func (fork *simulationFork) Process(ctx context.Context, segments []rangedloop.Segment) (err error) {
+	for i := range segments {
+		if err := fork.process(ctx, &segments[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fork *simulationFork) process(ctx context.Context, segment *rangedloop.Segment) error {
+	if segment.Inline() || segment.Expired(time.Now()) || len(segment.Pieces) == 0 {
+		return nil
+	}
+	observer := fork.observer
+
+	stats, ok := fork.stats[segment.Placement]
+	if !ok {
+		stats = &SimulationStats{}
+		fork.stats[segment.Placement] = stats
+	}
+	if fork.lastStreamID.Compare(segment.StreamID) != 0 {
+		fork.lastStreamID = segment.StreamID
+		stats.ObjectsChecked++
+	}
+
+	totalNumNodes, err := observer.nodesCache.NumNodes(ctx)
+	if err != nil {
+		return Error.New("could not get estimate of total number of nodes: %w", err)
+	}
+
+	pieces := segment.Pieces
+	if cap(fork.nodeIDs) < len(pieces) {
+		fork.nodeIDs = make([]storj.NodeID, len(pieces))
+		fork.nodes = make([]nodeselection.SelectedNode, len(pieces))
+	} else {
+		fork.nodeIDs = fork.nodeIDs[:len(pieces)]
+		fork.nodes = fork.nodes[:len(pieces)]
+	}
+	for i, piece := range pieces {
+		fork.nodeIDs[i] = piece.StorageNode
+	}
+	selectedNodes, err := observer.nodesCache.GetNodes(ctx, segment.CreatedAt, fork.nodeIDs, fork.nodes)
+	if err != nil {
+		return Error.New("error getting node information for pieces: %w", err)
+	}
+
+	placement := observer.placements[segment.Placement]
+	required, repairThreshold, successThreshold, _ := loadRedundancy(segment.Redundancy, observer.repairThresholdOverrides, observer.repairTargetOverrides)
+
+	classify := func() (needsRepair, irreparable bool, health float64) {
+		piecesCheck := repair.ClassifySegmentPieces(pieces, selectedNodes, observer.excludedCountryCodes, observer.doPlacementCheck,
+			observer.doDeclumping, placement, repairThreshold)
+		numHealthy := piecesCheck.Healthy.Count()
+		health = repair.SegmentHealth(numHealthy, required, totalNumNodes, observer.nodeFailureRate, piecesCheck.ForcingRepair.Count())
+		needsRepair = (numHealthy <= repairThreshold && numHealthy < successThreshold) || piecesCheck.ForcingRepair.Count() > 0
+		irreparable = piecesCheck.Retrievable.Count() < required
+		return needsRepair, irreparable, health
+	}
+
+	neededRepair, wasIrreparable, health := classify()
+
+	affected := 0
+	for i := range selectedNodes {
+		if selectedNodes[i].ID.IsZero() || !observer.offline.Match(&selectedNodes[i]) {
+			continue
+		}
+		selectedNodes[i].Online = false
+		affected++
+	}
+
+	needsRepair, irreparable := neededRepair, wasIrreparable
+	if affected > 0 {
+		needsRepair, irreparable, health = classify()
+	}
+
+	if stats.SegmentsChecked == 0 || health < stats.MinSegmentHealth {
+		stats.MinSegmentHealth = health
+	}
+	stats.SegmentsChecked++
+	stats.PiecesAffected += int64(affected)
+	if affected > 0 {
+		stats.SegmentsAffected++
+	}
+	if needsRepair {
+		stats.SegmentsNeedingRepair++
+		if !neededRepair {
+			stats.NewSegmentsNeedingRepair++
+		}
+	}
+	if irreparable {
+		stats.SegmentsIrreparable++
+		if !wasIrreparable {
+			stats.NewSegmentsIrreparable++
+		}
+		if fork.lastLostID.Compare(segment.StreamID) != 0 {
+			fork.lastLostID = segment.StreamID
+			stats.ObjectsLost++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
+)
+
+func TestSimulationObserver(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var nodes []nodeselection.SelectedNode
+	nodeByID := map[storj.NodeID]nodeselection.SelectedNode{}
+	for i := 0; i < 10; i++ {
+		node := nodeselection.SelectedNode{
+			ID:          testidentity.MustPregeneratedIdentity(i, storj.LatestIDVersion()).ID,
+			Online:      true,
+			CountryCode: location.Germany,
+			LastNet:     "127.0.0.0",
+		}
+		if i == 0 {
+			node.LastNet = "10.0.0.0"
+		}
+		if i >= 7 {
+			node.CountryCode = location.UnitedStates
+		}
+		nodes = append(nodes, node)
+		nodeByID[node.ID] = node
+	}
+
+	pieces := make(metabase.Pieces, len(nodes))
+	for i, node := range nodes {
+		pieces[i] = metabase.Piece{Number: uint16(i), StorageNode: node.ID}
+	}
+
+	segment := rangedloop.Segment{
+		StreamID:    testrand.UUID(),
+		RootPieceID: testrand.PieceID(),
+		Pieces:      pieces,
+		Redundancy: storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			ShareSize:      256,
+			RequiredShares: 4,
+			RepairShares:   6,
+			OptimalShares:  8,
+			TotalShares:    10,
+		},
+	}
+
+	simulate := func(t *testing.T, filter string) SimulationStats {
+		offline, err := nodeselection.FilterFromString(filter, nil)
+		require.NoError(t, err)
+
+		observer := &SimulationObserver{
+			log:        zaptest.NewLogger(t),
+			nodesCache: &ReliabilityCache{staleness: time.Hour},
+			offline:    offline,
+			placements: nodeselection.TestPlacementDefinitions(),
+		}
+		observer.nodesCache.state.Store(&reliabilityState{
+			nodeByID: nodeByID,
+			created:  time.Now(),
+		})
+
+		require.NoError(t, observer.Start(ctx, time.Now()))
+		partial, err := observer.Fork(ctx)
+		require.NoError(t, err)
+		require.NoError(t, partial.Process(ctx, []rangedloop.Segment{segment}))
+		require.NoError(t, observer.Join(ctx, partial))
+		require.NoError(t, observer.Finish(ctx))
+
+		report := observer.Report()
+		require.Len(t, report, 1)
+		return report[storj.DefaultPlacement]
+	}
+
+	t.Run("no outage", func(t *testing.T) {
+		stats := simulate(t, `country("FR")`)
+		require.Equal(t, int64(1), stats.SegmentsChecked)
+		require.Zero(t, stats.SegmentsAffected)
+		require.Zero(t, stats.SegmentsNeedingRepair)
+		require.Zero(t, stats.SegmentsIrreparable)
+	})
+
+	t.Run("healthy after outage", func(t *testing.T) {
+		stats := simulate(t, `country("US")`)
+		require.Equal(t, int64(1), stats.SegmentsAffected)
+		require.Equal(t, int64(3), stats.PiecesAffected)
+		require.Zero(t, stats.SegmentsNeedingRepair)
+		require.Zero(t, stats.SegmentsIrreparable)
+	})
+
+	t.Run("below repair threshold", func(t *testing.T) {
+		stats := simulate(t, `country("US") || select("last_net", "=", "10.0.0.0")`)
+		require.Equal(t, int64(4), stats.PiecesAffected)
+		require.Equal(t, int64(1), stats.SegmentsNeedingRepair)
+		require.Equal(t, int64(1), stats.NewSegmentsNeedingRepair)
+		require.Zero(t, stats.SegmentsIrreparable)
+	})
+
+	t.Run("irreparable", func(t *testing.T) {
+		stats := simulate(t, `country("DE")`)
+		require.Equal(t, int64(7), stats.PiecesAffected)
+		require.Equal(t, int64(1), stats.SegmentsNeedingRepair)
+		require.Equal(t, int64(1), stats.SegmentsIrreparable)
+		require.Equal(t, int64(1), stats.NewSegmentsIrreparable)
+		require.Equal(t, int64(1), stats.ObjectsLost)
+	})
+}
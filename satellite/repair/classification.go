@@ -21,8 +21,13 @@ type PiecesCheckResult struct {
 	// (They reside on offline/disqualified/unknown nodes.)
 	Missing intset.Set
 	// Retrievable contains all Piece Numbers that are retrievable; that is, all piece numbers
-	// from the segment that are NOT in Missing.
+	// from the segment that are NOT in Missing OR Maintenance.
 	Retrievable intset.Set
+	// Maintenance is a set of Piece Numbers which reside on offline nodes in a declared
+	// maintenance window. These pieces are temporarily unavailable, but not lost, so they
+	// are considered healthy as long as the Retrievable pieces are above the repair
+	// threshold. Otherwise they are also in Missing.
+	Maintenance intset.Set
 
 	// Suspended is a set of Piece Numbers which reside on nodes which are suspended.
 	Suspended intset.Set
@@ -56,9 +61,11 @@ type PiecesCheckResult struct {
 
 // ClassifySegmentPieces classifies the pieces of a segment into the categories
 // represented by a PiecesCheckResult. Pieces may be put into multiple
-// categories.
+// categories. repairThreshold is the number of Retrievable pieces, which the
+// segment needs to have above, to not count the pieces on nodes in maintenance
+// as Missing.
 func ClassifySegmentPieces(pieces metabase.Pieces, nodes []nodeselection.SelectedNode, excludedCountryCodes map[location.CountryCode]struct{},
-	doPlacementCheck, doDeclumping bool, placement nodeselection.Placement) (result PiecesCheckResult) {
+	doPlacementCheck, doDeclumping bool, placement nodeselection.Placement, repairThreshold int) (result PiecesCheckResult) {
 
 	maxPieceNum := 0
 	for _, piece := range pieces {
@@ -70,6 +77,7 @@ func ClassifySegmentPieces(pieces metabase.Pieces, nodes []nodeselection.Selecte
 
 	// check excluded countries and remove online nodes from missing pieces
 	result.Missing = intset.NewSet(maxPieceNum)
+	result.Maintenance = intset.NewSet(maxPieceNum)
 	result.Suspended = intset.NewSet(maxPieceNum)
 	result.Exiting = intset.NewSet(maxPieceNum)
 	result.Retrievable = intset.NewSet(maxPieceNum)
@@ -81,7 +89,10 @@ func ClassifySegmentPieces(pieces metabase.Pieces, nodes []nodeselection.Selecte
 			panic("wrong order")
 		}
 
-		if nodeRecord.ID.IsZero() || !nodeRecord.Online {
+		if !nodeRecord.ID.IsZero() && !nodeRecord.Online && nodeRecord.InMaintenance {
+			// node is offline, but it's expected to come back after the maintenance
+			result.Maintenance.Include(int(pieceNum))
+		} else if nodeRecord.ID.IsZero() || !nodeRecord.Online {
 			// node ID was not found, or the node is disqualified or exited,
 			// or it is offline
 			result.Missing.Include(int(pieceNum))
@@ -102,6 +113,12 @@ func ClassifySegmentPieces(pieces metabase.Pieces, nodes []nodeselection.Selecte
 		}
 	}
 
+	// pieces on nodes in maintenance are not lost, but they can't be
+	// downloaded either, so they are lost when too few pieces are reachable.
+	if result.Retrievable.Count() <= repairThreshold {
+		result.Missing.Add(result.Maintenance)
+	}
+
 	if doDeclumping && placement.Invariant != nil {
 		result.Clumped = placement.Invariant(pieces, nodes)
 	}
@@ -158,6 +175,7 @@ func (result PiecesCheckResult) MarshalLogObject(enc zapcore.ObjectEncoder) erro
 	enc.AddInt("Retrievable", result.Retrievable.Count())
 	enc.AddInt("Suspended", result.Suspended.Count())
 	enc.AddInt("Clumped", result.Clumped.Count())
+	enc.AddInt("Maintenance", result.Maintenance.Count())
 	enc.AddInt("Exiting", result.Exiting.Count())
 	enc.AddInt("ForcingRepair", result.ForcingRepair.Count())
 	enc.AddInt("Healthy", result.Healthy.Count())
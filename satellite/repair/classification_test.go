@@ -37,7 +37,7 @@ func TestClassifySegmentPieces(t *testing.T) {
 		})
 
 		pieces := createPieces(selectedNodes, 0, 1, 2, 3, 4)
-		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, false, nodeselection.TestPlacementDefinitions()[0])
+		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, false, nodeselection.TestPlacementDefinitions()[0], 0)
 
 		require.Equal(t, 0, result.Missing.Count())
 		require.Equal(t, 0, result.Clumped.Count())
@@ -63,7 +63,7 @@ func TestClassifySegmentPieces(t *testing.T) {
 		require.NoError(t, err)
 
 		pieces := createPieces(selectedNodes, 1, 2, 3, 4, 7, 8)
-		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, false, c[10])
+		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, false, c[10], 0)
 
 		require.Equal(t, 0, result.Missing.Count())
 		require.Equal(t, 0, result.Clumped.Count())
@@ -86,7 +86,7 @@ func TestClassifySegmentPieces(t *testing.T) {
 		require.NoError(t, err)
 
 		pieces := createPieces(selectedNodes, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
-		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, false, c[10])
+		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, false, c[10], 0)
 
 		// offline nodes
 		require.Equal(t, 5, result.Missing.Count())
@@ -98,6 +98,59 @@ func TestClassifySegmentPieces(t *testing.T) {
 
 	})
 
+	t.Run("offline in maintenance", func(t *testing.T) {
+		// nodes 6-9 are offline, but 8 and 9 declared maintenance
+		var selectedNodes = generateNodes(10, func(ix int) bool {
+			return ix < 6
+		}, func(ix int, node *nodeselection.SelectedNode) {
+			node.InMaintenance = ix == 0 || ix >= 8
+		})
+
+		pieces := createPieces(selectedNodes, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, false, nodeselection.TestPlacementDefinitions()[0], 0)
+
+		require.Equal(t, 2, result.Missing.Count())
+		require.True(t, result.Missing.Contains(6))
+		require.True(t, result.Missing.Contains(7))
+
+		require.Equal(t, 2, result.Maintenance.Count())
+		require.True(t, result.Maintenance.Contains(8))
+		require.True(t, result.Maintenance.Contains(9))
+
+		// online nodes in maintenance are retrievable as usual
+		require.Equal(t, 6, result.Retrievable.Count())
+		require.Equal(t, 8, result.Healthy.Count())
+		require.Equal(t, 0, result.UnhealthyRetrievable.Count())
+	})
+
+	t.Run("offline in maintenance below repair threshold", func(t *testing.T) {
+		// nodes 6-9 are offline, but 8 and 9 declared maintenance
+		var selectedNodes = generateNodes(10, func(ix int) bool {
+			return ix < 6
+		}, func(ix int, node *nodeselection.SelectedNode) {
+			node.InMaintenance = ix >= 8
+		})
+
+		pieces := createPieces(selectedNodes, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+		// the reachable pieces are above the repair threshold
+		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, false, nodeselection.TestPlacementDefinitions()[0], 5)
+		require.Equal(t, 2, result.Missing.Count())
+		require.Equal(t, 8, result.Healthy.Count())
+
+		// the reachable pieces are at the repair threshold, the pieces in
+		// maintenance can't be relied on anymore
+		result = ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, false, nodeselection.TestPlacementDefinitions()[0], 6)
+		require.Equal(t, 4, result.Missing.Count())
+		require.True(t, result.Missing.Contains(8))
+		require.True(t, result.Missing.Contains(9))
+		require.Equal(t, 2, result.Maintenance.Count())
+		require.Equal(t, 6, result.Retrievable.Count())
+		require.Equal(t, 6, result.Healthy.Count())
+		require.True(t, result.Unhealthy.Contains(8))
+		require.True(t, result.Unhealthy.Contains(9))
+	})
+
 	t.Run("normal declumping (subnet check)", func(t *testing.T) {
 		var selectedNodes = generateNodes(10, func(ix int) bool {
 			return ix < 5
@@ -109,7 +162,7 @@ func TestClassifySegmentPieces(t *testing.T) {
 
 		// first 5: online, 2 in each subnet --> healthy: one from (0,1) (2,3) (4), offline: (5,6) but 5 is in the same subnet as 6
 		pieces := createPieces(selectedNodes, 0, 1, 2, 3, 4, 5, 6)
-		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, true, c[0])
+		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, true, c[0], 0)
 
 		// offline nodes
 		require.Equal(t, 2, result.Missing.Count())
@@ -136,7 +189,7 @@ func TestClassifySegmentPieces(t *testing.T) {
 
 		// first 5: online, 2 in each subnet --> healthy: one from (0,1) (2,3) (4), offline: (5,6) but 5 is in the same subnet as 6
 		pieces := createPieces(selectedNodes, 0, 1, 2, 3, 4, 5, 6)
-		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, true, c[10])
+		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, true, c[10], 0)
 
 		// offline nodes
 		require.Equal(t, 2, result.Missing.Count())
@@ -0,0 +1,31 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package queue
+
+import (
+	"strings"
+)
+
+// Config contains configurable values for the repair queue.
+type Config struct {
+	Backend string `help:"backend of the repair queue: empty to use the satellite database or redis://... to use redis" default:""`
+}
+
+// Open returns the repair queue backend specified in the config. The
+// database repair queue is used when no backend is specified.
+//
+// The returned queue implements io.Closer when it needs to be closed.
+func Open(config Config, db RepairQueue) (RepairQueue, error) {
+	if config.Backend == "" {
+		return db, nil
+	}
+
+	backendType, _, _ := strings.Cut(config.Backend, ":")
+	switch backendType {
+	case "redis", "rediss":
+		return OpenRedisRepairQueue(config.Backend)
+	default:
+		return nil, Error.New("unrecognized repair queue backend specifier %q. Currently only redis is supported", backendType)
+	}
+}
@@ -39,7 +39,8 @@ type Stat struct {
 }
 
 // RepairQueue implements queueing for segments that need repairing.
-// Implementation can be found at satellite/satellitedb/repairqueue.go.
+// Implementations can be found at satellite/satellitedb/repairqueue.go and
+// satellite/repair/queue/redis.go.
 //
 // architecture: Database
 type RepairQueue interface {
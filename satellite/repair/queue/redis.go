@@ -0,0 +1,710 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package queue
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// redisRetryInterval is how long a selected segment is hidden from other
+// repairers. It matches the interval used by the database repair queue.
+const redisRetryInterval = 6 * time.Hour
+
+// redisBatchSize is how many segments are read from redis at once.
+const redisBatchSize = 1000
+
+// The redis repair queue uses the following keys:
+//
+//	{<prefix>}:segment:<member>    hash with health, placement, inserted, updated and attempted
+//	{<prefix>}:ready:<placement>   sorted set of segments of the placement which can be selected, scored by health
+//	{<prefix>}:placements          set of the placements which have a ready set
+//	{<prefix>}:attempted           sorted set of selected segments, scored by attempted time
+//	{<prefix>}:updated             sorted set of all segments, scored by updated time
+//
+// The member of a segment is "<stream id>/<encoded position>". All times are
+// stored as unix microseconds.
+//
+// The prefix is a hash tag, so all the keys belong to the same slot of a
+// redis cluster, and every key used by a script is passed in KEYS.
+//
+// When the placement of a segment changes, the member stays in the ready set
+// of the previous placement. These stale members are removed when they are
+// selected.
+
+// redisInsertScript inserts or updates segments.
+//
+// KEYS: placements, attempted, updated, then the segment key and the ready key
+// of each segment.
+// ARGV: now, then the member, health and placement of each segment.
+var redisInsertScript = redis.NewScript(`
+local placements, attempted, updated = KEYS[1], KEYS[2], KEYS[3]
+local now = ARGV[1]
+local result = {}
+for i = 1, (#ARGV - 1) / 3 do
+	local member, health, placement = ARGV[3*i-1], ARGV[3*i], ARGV[3*i+1]
+	local key, ready = KEYS[2*i+2], KEYS[2*i+3]
+	local exists = redis.call("EXISTS", key)
+	if exists == 0 then
+		redis.call("HSET", key, "health", health, "placement", placement, "inserted", now, "updated", now)
+	else
+		redis.call("HSET", key, "health", health, "placement", placement, "updated", now)
+	end
+	redis.call("SADD", placements, placement)
+	if not redis.call("ZSCORE", attempted, member) then
+		redis.call("ZADD", ready, health, member)
+	end
+	redis.call("ZADD", updated, now, member)
+	table.insert(result, 1 - exists)
+end
+return result
+`)
+
+// redisRequeueScript moves segments, which were attempted before the retry
+// time, back to the ready set of their placement. Segments with a different
+// placement than expected are left for the next invocation.
+//
+// KEYS: attempted, then the segment key and the ready key of each segment.
+// ARGV: retry time, then the member and the placement of each segment.
+var redisRequeueScript = redis.NewScript(`
+local attempted = KEYS[1]
+local retry = tonumber(ARGV[1])
+local requeued = 0
+for i = 1, (#ARGV - 1) / 2 do
+	local member, placement = ARGV[2*i], ARGV[2*i+1]
+	local key, ready = KEYS[2*i], KEYS[2*i+1]
+	local score = redis.call("ZSCORE", attempted, member)
+	if score and tonumber(score) < retry then
+		local values = redis.call("HMGET", key, "health", "placement")
+		if not values[1] then
+			redis.call("ZREM", attempted, member)
+		elseif values[2] == placement then
+			redis.call("ZADD", ready, values[1], member)
+			redis.call("ZREM", attempted, member)
+			requeued = requeued + 1
+		end
+	end
+end
+return requeued
+`)
+
+// redisSelectScript marks segments as attempted, when they are still in the
+// ready set of the expected placement. The selected segments are returned
+// with their health, placement, inserted, updated and attempted values.
+//
+// KEYS: attempted, then the segment key and the ready key of each segment.
+// ARGV: now, then the member and the placement of each segment.
+var redisSelectScript = redis.NewScript(`
+local attempted = KEYS[1]
+local now = ARGV[1]
+local result = {}
+for i = 1, (#ARGV - 1) / 2 do
+	local member, placement = ARGV[2*i], ARGV[2*i+1]
+	local key, ready = KEYS[2*i], KEYS[2*i+1]
+	if redis.call("ZSCORE", ready, member) then
+		redis.call("ZREM", ready, member)
+		if redis.call("HGET", key, "placement") == placement then
+			redis.call("HSET", key, "attempted", now)
+			redis.call("ZADD", attempted, now, member)
+			table.insert(result, member)
+			for _, value in ipairs(redis.call("HMGET", key, "health", "placement", "inserted", "updated", "attempted")) do
+				table.insert(result, value)
+			end
+		end
+	end
+end
+return result
+`)
+
+// redisCleanScript removes segments, which were updated before the given
+// time.
+//
+// KEYS: attempted, updated, then the segment key and the ready key of each
+// segment.
+// ARGV: the time, then the member of each segment.
+var redisCleanScript = redis.NewScript(`
+local attempted, updated = KEYS[1], KEYS[2]
+local before = tonumber(ARGV[1])
+local deleted = 0
+for i = 2, #ARGV do
+	local member = ARGV[i]
+	local key, ready = KEYS[2*i-1], KEYS[2*i]
+	local score = redis.call("ZSCORE", updated, member)
+	if score and tonumber(score) < before then
+		redis.call("DEL", key)
+		redis.call("ZREM", ready, member)
+		redis.call("ZREM", attempted, member)
+		redis.call("ZREM", updated, member)
+		deleted = deleted + 1
+	end
+end
+return deleted
+`)
+
+// redisSetAttemptedScript sets the attempted time of a segment.
+//
+// KEYS: segment, ready key of the segment, attempted.
+// ARGV: member, attempted time.
+var redisSetAttemptedScript = redis.NewScript(`
+local key, ready, attempted = KEYS[1], KEYS[2], KEYS[3]
+local member, now = ARGV[1], ARGV[2]
+if redis.call("EXISTS", key) == 0 then
+	return 0
+end
+redis.call("HSET", key, "attempted", now)
+redis.call("ZREM", ready, member)
+redis.call("ZADD", attempted, now, member)
+return 1
+`)
+
+// RedisRepairQueue implements RepairQueue on top of redis.
+//
+// Segments are selected in the order of their health, like the database
+// repair queue, but the segments with the same health are not ordered by
+// attempted time.
+//
+// architecture: Database
+type RedisRepairQueue struct {
+	client redis.UniversalClient
+	prefix string
+	nowFn  func() time.Time
+}
+
+var _ RepairQueue = (*RedisRepairQueue)(nil)
+
+// OpenRedisRepairQueue opens a redis repair queue with the specified redis URL.
+func OpenRedisRepairQueue(address string) (*RedisRepairQueue, error) {
+	opts, err := redis.ParseURL(address)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return NewRedisRepairQueue(redis.NewClient(opts), "repairqueue"), nil
+}
+
+// NewRedisRepairQueue creates a redis repair queue which stores its keys
+// with the specified prefix.
+func NewRedisRepairQueue(client redis.UniversalClient, prefix string) *RedisRepairQueue {
+	return &RedisRepairQueue{
+		client: client,
+		prefix: "{" + prefix + "}",
+		nowFn:  time.Now,
+	}
+}
+
+// Close closes the redis client.
+func (r *RedisRepairQueue) Close() error {
+	return Error.Wrap(r.client.Close())
+}
+
+// Insert adds an injured segment.
+func (r *RedisRepairQueue) Insert(ctx context.Context, s *InjuredSegment) (alreadyInserted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	inserted, err := r.InsertBatch(ctx, []*InjuredSegment{s})
+	if err != nil {
+		return false, err
+	}
+	return len(inserted) == 0, nil
+}
+
+// InsertBatch adds multiple injured segments.
+func (r *RedisRepairQueue) InsertBatch(ctx context.Context, segments []*InjuredSegment) (newlyInsertedSegments []*InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(segments) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, 3+2*len(segments))
+	keys = append(keys, r.placementsKey(), r.attemptedKey(), r.updatedKey())
+	args := make([]interface{}, 0, 1+3*len(segments))
+	args = append(args, r.nowFn().UnixMicro())
+	for _, segment := range segments {
+		member := redisMember(segment.StreamID, segment.Position)
+		placement := strconv.Itoa(int(segment.Placement))
+		keys = append(keys, r.segmentKey(member), r.readyKey(placement))
+		args = append(args,
+			member,
+			strconv.FormatFloat(segment.SegmentHealth, 'g', -1, 64),
+			placement)
+	}
+
+	result, err := redisInsertScript.Run(ctx, r.client, keys, args...).Int64Slice()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(result) != len(segments) {
+		return nil, Error.New("unexpected number of results: got %d want %d", len(result), len(segments))
+	}
+
+	for i, inserted := range result {
+		if inserted == 1 {
+			newlyInsertedSegments = append(newlyInsertedSegments, segments[i])
+		}
+	}
+	return newlyInsertedSegments, nil
+}
+
+// Select gets injured segments.
+func (r *RedisRepairQueue) Select(ctx context.Context, limit int, includedPlacements []storj.PlacementConstraint, excludedPlacements []storj.PlacementConstraint) (_ []InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := r.nowFn()
+
+	// segments which were selected long ago can be selected again.
+	if err := r.requeue(ctx, now.Add(-redisRetryInterval)); err != nil {
+		return nil, err
+	}
+
+	placements, err := r.placements(ctx, includedPlacements, excludedPlacements)
+	if err != nil {
+		return nil, err
+	}
+
+	type candidate struct {
+		member    string
+		placement string
+		health    float64
+	}
+
+	const fields = 6
+	var segments []InjuredSegment
+	for len(segments) < limit {
+		want := limit - len(segments)
+
+		// the healthiest segments of each placement are the candidates, and
+		// the healthiest candidates are selected.
+		cmds := make([]*redis.ZSliceCmd, len(placements))
+		_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i, placement := range placements {
+				cmds[i] = pipe.ZRangeWithScores(ctx, r.readyKey(placement), 0, int64(want-1))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		var candidates []candidate
+		for i, cmd := range cmds {
+			for _, z := range cmd.Val() {
+				member, _ := z.Member.(string)
+				candidates = append(candidates, candidate{member: member, placement: placements[i], health: z.Score})
+			}
+		}
+		if len(candidates) == 0 {
+			break
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].health < candidates[j].health
+		})
+		candidates = candidates[:min(want, len(candidates))]
+
+		// every candidate is removed from its ready set by the script, so
+		// the loop makes progress even when all of them are stale.
+		keys := make([]string, 0, 1+2*len(candidates))
+		keys = append(keys, r.attemptedKey())
+		args := make([]interface{}, 0, 1+2*len(candidates))
+		args = append(args, now.UnixMicro())
+		for _, c := range candidates {
+			keys = append(keys, r.segmentKey(c.member), r.readyKey(c.placement))
+			args = append(args, c.member, c.placement)
+		}
+
+		result, err := redisSelectScript.Run(ctx, r.client, keys, args...).StringSlice()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return nil, Error.Wrap(err)
+		}
+		for i := 0; i+fields <= len(result); i += fields {
+			segment, err := parseRedisSegment(result[i], result[i+1:i+fields])
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, segment)
+		}
+	}
+
+	if len(segments) == 0 {
+		return nil, ErrEmpty.New("")
+	}
+	return segments, nil
+}
+
+// requeue moves the segments, which were attempted before retry, back to the
+// ready sets.
+func (r *RedisRepairQueue) requeue(ctx context.Context, retry time.Time) error {
+	for {
+		members, err := r.client.ZRangeByScore(ctx, r.attemptedKey(), &redis.ZRangeBy{
+			Min:   "-inf",
+			Max:   "(" + strconv.FormatInt(retry.UnixMicro(), 10),
+			Count: redisBatchSize,
+		}).Result()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(members) == 0 {
+			return nil
+		}
+
+		placements, err := r.segmentPlacements(ctx, members)
+		if err != nil {
+			return err
+		}
+
+		keys := make([]string, 0, 1+2*len(members))
+		keys = append(keys, r.attemptedKey())
+		args := make([]interface{}, 0, 1+2*len(members))
+		args = append(args, retry.UnixMicro())
+		for i, member := range members {
+			keys = append(keys, r.segmentKey(member), r.readyKey(placements[i]))
+			args = append(args, member, placements[i])
+		}
+		if err := redisRequeueScript.Run(ctx, r.client, keys, args...).Err(); err != nil {
+			return Error.Wrap(err)
+		}
+
+		if len(members) < redisBatchSize {
+			return nil
+		}
+	}
+}
+
+// placements returns the placements which have a ready set, filtered by the
+// included and excluded placements.
+func (r *RedisRepairQueue) placements(ctx context.Context, included, excluded []storj.PlacementConstraint) ([]string, error) {
+	members, err := r.client.SMembers(ctx, r.placementsKey()).Result()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var placements []string
+	for _, member := range members {
+		value, err := strconv.ParseUint(member, 10, 16)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		placement := storj.PlacementConstraint(value)
+		if len(included) > 0 && !slices.Contains(included, placement) {
+			continue
+		}
+		if slices.Contains(excluded, placement) {
+			continue
+		}
+		placements = append(placements, member)
+	}
+	return placements, nil
+}
+
+// segmentPlacements returns the placement of each segment, or an empty string
+// when the segment doesn't exist.
+func (r *RedisRepairQueue) segmentPlacements(ctx context.Context, members []string) ([]string, error) {
+	cmds := make([]*redis.StringCmd, len(members))
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, member := range members {
+			cmds[i] = pipe.HGet(ctx, r.segmentKey(member), "placement")
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, Error.Wrap(err)
+	}
+
+	placements := make([]string, len(members))
+	for i, cmd := range cmds {
+		placements[i] = cmd.Val()
+	}
+	return placements, nil
+}
+
+// Delete removes an injured segment.
+func (r *RedisRepairQueue) Delete(ctx context.Context, s InjuredSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	member := redisMember(s.StreamID, s.Position)
+	placements, err := r.segmentPlacements(ctx, []string{member})
+	if err != nil {
+		return err
+	}
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, r.segmentKey(member))
+		pipe.ZRem(ctx, r.readyKey(placements[0]), member)
+		pipe.ZRem(ctx, r.attemptedKey(), member)
+		pipe.ZRem(ctx, r.updatedKey(), member)
+		return nil
+	})
+	return Error.Wrap(err)
+}
+
+// Clean removes all segments last updated before a certain time.
+func (r *RedisRepairQueue) Clean(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		members, err := r.client.ZRangeByScore(ctx, r.updatedKey(), &redis.ZRangeBy{
+			Min:   "-inf",
+			Max:   "(" + strconv.FormatInt(before.UnixMicro(), 10),
+			Count: redisBatchSize,
+		}).Result()
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+		if len(members) == 0 {
+			return deleted, nil
+		}
+
+		placements, err := r.segmentPlacements(ctx, members)
+		if err != nil {
+			return deleted, err
+		}
+
+		keys := make([]string, 0, 2+2*len(members))
+		keys = append(keys, r.attemptedKey(), r.updatedKey())
+		args := make([]interface{}, 0, 1+len(members))
+		args = append(args, before.UnixMicro())
+		for i, member := range members {
+			keys = append(keys, r.segmentKey(member), r.readyKey(placements[i]))
+			args = append(args, member)
+		}
+
+		n, err := redisCleanScript.Run(ctx, r.client, keys, args...).Int64()
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+		deleted += n
+		if len(members) < redisBatchSize {
+			return deleted, nil
+		}
+	}
+}
+
+// SelectN lists limit amount of injured segments.
+func (r *RedisRepairQueue) SelectN(ctx context.Context, limit int) (_ []InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit <= 0 || limit > redisBatchSize {
+		limit = redisBatchSize
+	}
+	return r.list(ctx, 0, limit)
+}
+
+// Count counts the number of segments in the repair queue.
+func (r *RedisRepairQueue) Count(ctx context.Context) (count int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	n, err := r.client.ZCard(ctx, r.updatedKey()).Result()
+	return int(n), Error.Wrap(err)
+}
+
+// Stat returns stat of the current queue state.
+func (r *RedisRepairQueue) Stat(ctx context.Context) (_ []Stat, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	type group struct {
+		placement storj.PlacementConstraint
+		attempted bool
+	}
+	stats := map[group]*Stat{}
+
+	for offset := 0; ; offset += redisBatchSize {
+		segments, err := r.list(ctx, offset, redisBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, segment := range segments {
+			key := group{placement: segment.Placement, attempted: segment.AttemptedAt != nil}
+			stat, ok := stats[key]
+			if !ok {
+				stat = &Stat{
+					Placement:        segment.Placement,
+					MinInsertedAt:    segment.InsertedAt,
+					MaxInsertedAt:    segment.InsertedAt,
+					MinAttemptedAt:   segment.AttemptedAt,
+					MaxAttemptedAt:   segment.AttemptedAt,
+					MinSegmentHealth: segment.SegmentHealth,
+					MaxSegmentHealth: segment.SegmentHealth,
+				}
+				stats[key] = stat
+			}
+			stat.Count++
+			if segment.InsertedAt.Before(stat.MinInsertedAt) {
+				stat.MinInsertedAt = segment.InsertedAt
+			}
+			if segment.InsertedAt.After(stat.MaxInsertedAt) {
+				stat.MaxInsertedAt = segment.InsertedAt
+			}
+			if segment.AttemptedAt != nil {
+				if segment.AttemptedAt.Before(*stat.MinAttemptedAt) {
+					stat.MinAttemptedAt = segment.AttemptedAt
+				}
+				if segment.AttemptedAt.After(*stat.MaxAttemptedAt) {
+					stat.MaxAttemptedAt = segment.AttemptedAt
+				}
+			}
+			stat.MinSegmentHealth = min(stat.MinSegmentHealth, segment.SegmentHealth)
+			stat.MaxSegmentHealth = max(stat.MaxSegmentHealth, segment.SegmentHealth)
+		}
+
+		if len(segments) < redisBatchSize {
+			break
+		}
+	}
+
+	result := make([]Stat, 0, len(stats))
+	for _, stat := range stats {
+		result = append(result, *stat)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Placement != result[j].Placement {
+			return result[i].Placement < result[j].Placement
+		}
+		return result[i].MinAttemptedAt == nil && result[j].MinAttemptedAt != nil
+	})
+	return result, nil
+}
+
+// TestingSetAttemptedTime sets attempted time for a segment.
+func (r *RedisRepairQueue) TestingSetAttemptedTime(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, t time.Time) (rowsAffected int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	member := redisMember(streamID, position)
+	placements, err := r.segmentPlacements(ctx, []string{member})
+	if err != nil {
+		return 0, err
+	}
+	rowsAffected, err = redisSetAttemptedScript.Run(ctx, r.client,
+		[]string{r.segmentKey(member), r.readyKey(placements[0]), r.attemptedKey()},
+		member, t.UnixMicro()).Int64()
+	return rowsAffected, Error.Wrap(err)
+}
+
+// TestingSetNow sets the function used to get the current time.
+func (r *RedisRepairQueue) TestingSetNow(nowFn func() time.Time) {
+	r.nowFn = nowFn
+}
+
+// list returns the segments ordered by their updated time.
+func (r *RedisRepairQueue) list(ctx context.Context, offset, limit int) (segments []InjuredSegment, err error) {
+	members, err := r.client.ZRange(ctx, r.updatedKey(), int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	cmds := make([]*redis.SliceCmd, len(members))
+	_, err = r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, member := range members {
+			cmds[i] = pipe.HMGet(ctx, r.segmentKey(member), "health", "placement", "inserted", "updated", "attempted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for i, cmd := range cmds {
+		values := make([]string, 0, 5)
+		for _, value := range cmd.Val() {
+			s, _ := value.(string)
+			values = append(values, s)
+		}
+		if values[0] == "" {
+			// the segment was deleted in the meantime.
+			continue
+		}
+		segment, err := parseRedisSegment(members[i], values)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
+
+func (r *RedisRepairQueue) segmentKey(member string) string {
+	return r.prefix + ":segment:" + member
+}
+
+func (r *RedisRepairQueue) readyKey(placement string) string {
+	return r.prefix + ":ready:" + placement
+}
+
+func (r *RedisRepairQueue) placementsKey() string {
+	return r.prefix + ":placements"
+}
+
+func (r *RedisRepairQueue) attemptedKey() string {
+	return r.prefix + ":attempted"
+}
+
+func (r *RedisRepairQueue) updatedKey() string {
+	return r.prefix + ":updated"
+}
+
+func redisMember(streamID uuid.UUID, position metabase.SegmentPosition) string {
+	return streamID.String() + "/" + strconv.FormatUint(position.Encode(), 10)
+}
+
+// parseRedisSegment parses the member and the health, placement, inserted,
+// updated and attempted values of a segment.
+func parseRedisSegment(member string, values []string) (segment InjuredSegment, err error) {
+	streamID, position, ok := strings.Cut(member, "/")
+	if !ok {
+		return segment, Error.New("invalid member %q", member)
+	}
+	segment.StreamID, err = uuid.FromString(streamID)
+	if err != nil {
+		return segment, Error.Wrap(err)
+	}
+	encoded, err := strconv.ParseUint(position, 10, 64)
+	if err != nil {
+		return segment, Error.Wrap(err)
+	}
+	segment.Position = metabase.SegmentPositionFromEncoded(encoded)
+
+	segment.SegmentHealth, err = strconv.ParseFloat(values[0], 64)
+	if err != nil {
+		return segment, Error.Wrap(err)
+	}
+	placement, err := strconv.ParseUint(values[1], 10, 16)
+	if err != nil {
+		return segment, Error.Wrap(err)
+	}
+	segment.Placement = storj.PlacementConstraint(placement)
+
+	parseTime := func(value string) (time.Time, error) {
+		micros, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return time.Time{}, Error.Wrap(err)
+		}
+		return time.UnixMicro(micros).UTC(), nil
+	}
+	if segment.InsertedAt, err = parseTime(values[2]); err != nil {
+		return segment, err
+	}
+	if segment.UpdatedAt, err = parseTime(values[3]); err != nil {
+		return segment, err
+	}
+	if values[4] != "" {
+		attemptedAt, err := parseTime(values[4])
+		if err != nil {
+			return segment, err
+		}
+		segment.AttemptedAt = &attemptedAt
+	}
+	return segment, nil
+}
@@ -0,0 +1,225 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package queue_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testredis"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func openRedisQueue(ctx *testcontext.Context, t *testing.T) *queue.RedisRepairQueue {
+	redis, err := testredis.Mini(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { ctx.Check(redis.Close) })
+
+	repairQueue, err := queue.OpenRedisRepairQueue("redis://" + redis.Addr() + "?db=0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ctx.Check(repairQueue.Close) })
+	return repairQueue
+}
+
+func TestRedisOrder(t *testing.T) {
+	ctx := testcontext.New(t)
+	repairQueue := openRedisQueue(ctx, t)
+
+	var segments []*queue.InjuredSegment
+	for _, health := range []float64{3, 1, 2} {
+		segments = append(segments, &queue.InjuredSegment{
+			StreamID:      testrand.UUID(),
+			Position:      metabase.SegmentPosition{Part: 1, Index: uint32(health)},
+			SegmentHealth: health,
+		})
+	}
+
+	inserted, err := repairQueue.InsertBatch(ctx, segments)
+	require.NoError(t, err)
+	require.Len(t, inserted, 3)
+
+	// update the health of an existing segment
+	alreadyInserted, err := repairQueue.Insert(ctx, &queue.InjuredSegment{
+		StreamID:      segments[0].StreamID,
+		Position:      segments[0].Position,
+		SegmentHealth: 0.5,
+	})
+	require.NoError(t, err)
+	require.True(t, alreadyInserted)
+
+	count, err := repairQueue.Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	for _, expected := range []*queue.InjuredSegment{segments[0], segments[1], segments[2]} {
+		selected, err := repairQueue.Select(ctx, 1, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, selected, 1)
+		require.Equal(t, expected.StreamID, selected[0].StreamID)
+		require.Equal(t, expected.Position, selected[0].Position)
+		require.NotNil(t, selected[0].AttemptedAt)
+	}
+
+	_, err = repairQueue.Select(ctx, 1, nil, nil)
+	require.True(t, queue.ErrEmpty.Has(err))
+
+	// segments can be selected again after the retry interval.
+	repairQueue.TestingSetNow(func() time.Time { return time.Now().Add(7 * time.Hour) })
+	selected, err := repairQueue.Select(ctx, 10, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, selected, 3)
+	require.Equal(t, 0.5, selected[0].SegmentHealth)
+
+	require.NoError(t, repairQueue.Delete(ctx, selected[0]))
+	count, err = repairQueue.Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func TestRedisPlacements(t *testing.T) {
+	ctx := testcontext.New(t)
+	repairQueue := openRedisQueue(ctx, t)
+
+	for placement := storj.PlacementConstraint(0); placement < 4; placement++ {
+		_, err := repairQueue.Insert(ctx, &queue.InjuredSegment{
+			StreamID:      testrand.UUID(),
+			SegmentHealth: float64(placement),
+			Placement:     placement,
+		})
+		require.NoError(t, err)
+	}
+
+	selected, err := repairQueue.Select(ctx, 10, []storj.PlacementConstraint{1, 3}, nil)
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+	require.Equal(t, storj.PlacementConstraint(1), selected[0].Placement)
+	require.Equal(t, storj.PlacementConstraint(3), selected[1].Placement)
+
+	selected, err = repairQueue.Select(ctx, 10, nil, []storj.PlacementConstraint{0})
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	require.Equal(t, storj.PlacementConstraint(2), selected[0].Placement)
+
+	stats, err := repairQueue.Stat(ctx)
+	require.NoError(t, err)
+	require.Len(t, stats, 4)
+	require.Equal(t, storj.PlacementConstraint(0), stats[0].Placement)
+	require.Nil(t, stats[0].MinAttemptedAt)
+	require.NotNil(t, stats[1].MinAttemptedAt)
+}
+
+func TestRedisClean(t *testing.T) {
+	ctx := testcontext.New(t)
+	repairQueue := openRedisQueue(ctx, t)
+
+	now := time.Now()
+	repairQueue.TestingSetNow(func() time.Time { return now.Add(-time.Hour) })
+
+	old := &queue.InjuredSegment{StreamID: testrand.UUID()}
+	_, err := repairQueue.Insert(ctx, old)
+	require.NoError(t, err)
+
+	repairQueue.TestingSetNow(func() time.Time { return now })
+	_, err = repairQueue.Insert(ctx, &queue.InjuredSegment{StreamID: testrand.UUID()})
+	require.NoError(t, err)
+
+	affected, err := repairQueue.TestingSetAttemptedTime(ctx, old.StreamID, old.Position, now)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, affected)
+
+	deleted, err := repairQueue.Clean(ctx, now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, deleted)
+
+	segments, err := repairQueue.SelectN(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+	require.NotEqual(t, old.StreamID, segments[0].StreamID)
+}
+
+func TestRedisSelectBatches(t *testing.T) {
+	ctx := testcontext.New(t)
+	repairQueue := openRedisQueue(ctx, t)
+
+	// the segments of the other placements are not looked at, when they
+	// are not included.
+	var segments []*queue.InjuredSegment
+	for i := 0; i < 2500; i++ {
+		segments = append(segments, &queue.InjuredSegment{
+			StreamID:      testrand.UUID(),
+			SegmentHealth: 1,
+		})
+	}
+	segments = append(segments, &queue.InjuredSegment{
+		StreamID:      testrand.UUID(),
+		SegmentHealth: 2,
+		Placement:     1,
+	})
+	_, err := repairQueue.InsertBatch(ctx, segments)
+	require.NoError(t, err)
+
+	selected, err := repairQueue.Select(ctx, 10, []storj.PlacementConstraint{1}, nil)
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	require.Equal(t, segments[len(segments)-1].StreamID, selected[0].StreamID)
+
+	_, err = repairQueue.Select(ctx, 10, []storj.PlacementConstraint{1}, nil)
+	require.True(t, queue.ErrEmpty.Has(err))
+
+	// all the attempted segments become selectable after the retry interval.
+	selected, err = repairQueue.Select(ctx, 2000, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, selected, 2000)
+
+	now := time.Now()
+	repairQueue.TestingSetNow(func() time.Time { return now.Add(7 * time.Hour) })
+	selected, err = repairQueue.Select(ctx, 3000, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, selected, len(segments))
+}
+
+func TestRedisPlacementChange(t *testing.T) {
+	ctx := testcontext.New(t)
+	repairQueue := openRedisQueue(ctx, t)
+
+	segment := &queue.InjuredSegment{
+		StreamID:      testrand.UUID(),
+		SegmentHealth: 1,
+		Placement:     0,
+	}
+	_, err := repairQueue.Insert(ctx, segment)
+	require.NoError(t, err)
+
+	segment.Placement = 1
+	alreadyInserted, err := repairQueue.Insert(ctx, segment)
+	require.NoError(t, err)
+	require.True(t, alreadyInserted)
+
+	// the segment is not selected with its previous placement.
+	_, err = repairQueue.Select(ctx, 10, []storj.PlacementConstraint{0}, nil)
+	require.True(t, queue.ErrEmpty.Has(err))
+
+	selected, err := repairQueue.Select(ctx, 10, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	require.Equal(t, storj.PlacementConstraint(1), selected[0].Placement)
+
+	// the segment is requeued with its placement after the retry interval.
+	now := time.Now()
+	repairQueue.TestingSetNow(func() time.Time { return now.Add(7 * time.Hour) })
+	selected, err = repairQueue.Select(ctx, 10, []storj.PlacementConstraint{1}, nil)
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+
+	require.NoError(t, repairQueue.Delete(ctx, selected[0]))
+	repairQueue.TestingSetNow(func() time.Time { return now.Add(14 * time.Hour) })
+	_, err = repairQueue.Select(ctx, 10, nil, nil)
+	require.True(t, queue.ErrEmpty.Has(err))
+}
@@ -0,0 +1,387 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo/pointerverification"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// maxSelectAttempts is the number of segments the coordinator takes from the
+// repair queue while looking for a segment which needs a repair.
+const maxSelectAttempts = 10
+
+// CoordinatorConfig contains configurable values for the delegated repair coordinator.
+type CoordinatorConfig struct {
+	Enabled          bool          `help:"whether to hand out repair jobs to external repair workers" default:"false"`
+	Address          string        `help:"public address to listen on for repair workers" default:":7779"`
+	PrivateAddress   string        `help:"private address to listen on for the repair coordinator" default:"127.0.0.1:7780"`
+	AllowedWorkers   []string      `help:"comma separated list of node IDs of the repair workers which are allowed to get repair jobs" default:""`
+	JobTimeout       time.Duration `help:"time limit for a repair worker to report the result of a repair job" default:"45m" testDefault:"10m"`
+	ComeBackInterval time.Duration `help:"how long repair workers should wait before asking for a repair job again, when there is none" default:"30s" testDefault:"1s"`
+}
+
+// Coordinator hands out repair jobs from the repair queue to external repair
+// workers and applies the results reported by them.
+//
+// architecture: Endpoint
+type Coordinator struct {
+	internalpb.DRPCRepairCoordinatorUnimplementedServer
+
+	log      *zap.Logger
+	queue    queue.RepairQueue
+	repairer *SegmentRepairer
+	verifier *pointerverification.Service
+	config   CoordinatorConfig
+	included []storj.PlacementConstraint
+	excluded []storj.PlacementConstraint
+	allowed  map[storj.NodeID]struct{}
+	nowFn    func() time.Time
+
+	mu       sync.Mutex
+	assigned map[uuid.UUID]*assignedJob
+}
+
+// assignedJob is a repair job handed out to a repair worker.
+type assignedJob struct {
+	*DelegatedJob
+
+	worker    storj.NodeID
+	expiresAt time.Time
+}
+
+// NewCoordinator creates a new delegated repair coordinator.
+func NewCoordinator(log *zap.Logger, repairQueue queue.RepairQueue, repairer *SegmentRepairer, verifier *pointerverification.Service, repairConfig *Config, config CoordinatorConfig) (*Coordinator, error) {
+	allowed := make(map[storj.NodeID]struct{}, len(config.AllowedWorkers))
+	for _, id := range config.AllowedWorkers {
+		nodeID, err := storj.NodeIDFromString(id)
+		if err != nil {
+			return nil, Error.New("invalid repair worker node ID %q: %w", id, err)
+		}
+		allowed[nodeID] = struct{}{}
+	}
+
+	return &Coordinator{
+		log:      log,
+		queue:    repairQueue,
+		repairer: repairer,
+		verifier: verifier,
+		config:   config,
+		included: repairConfig.IncludedPlacements.Placements,
+		excluded: repairConfig.ExcludedPlacements.Placements,
+		allowed:  allowed,
+		nowFn:    time.Now,
+		assigned: map[uuid.UUID]*assignedJob{},
+	}, nil
+}
+
+// RepairJob applies the result of the last job of the worker and hands out
+// the next repair job.
+func (coordinator *Coordinator) RepairJob(ctx context.Context, req *internalpb.RepairJobRequest) (_ *internalpb.RepairJobResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, Error.Wrap(err).Error())
+	}
+	if _, ok := coordinator.allowed[peer.ID]; !ok {
+		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "node is not an allowed repair worker")
+	}
+
+	log := coordinator.log.With(zap.Stringer("Worker ID", peer.ID))
+
+	if req.LastJobResult != nil {
+		if err := coordinator.completeJob(ctx, peer, req.LastJobResult); err != nil {
+			log.Error("failed to apply repair job result", zap.Error(err))
+		}
+	}
+
+	job, err := coordinator.nextJob(ctx, peer.ID)
+	if err != nil {
+		log.Error("failed to create repair job", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if job == nil {
+		return &internalpb.RepairJobResponse{
+			ComeBackInMillis: int32(coordinator.config.ComeBackInterval.Milliseconds()),
+		}, nil
+	}
+
+	return &internalpb.RepairJobResponse{NewJob: job}, nil
+}
+
+// nextJob selects a segment from the repair queue and creates a repair job for
+// it. It returns nil when there is no segment to repair.
+func (coordinator *Coordinator) nextJob(ctx context.Context, worker storj.NodeID) (_ *internalpb.RepairJobDefinition, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	coordinator.expireJobs()
+
+	for attempt := 0; attempt < maxSelectAttempts; attempt++ {
+		segments, err := coordinator.queue.Select(ctx, 1, coordinator.included, coordinator.excluded)
+		if err != nil {
+			if queue.ErrEmpty.Has(err) {
+				return nil, nil
+			}
+			return nil, Error.Wrap(err)
+		}
+
+		for _, segment := range segments {
+			job, shouldDelete, err := coordinator.repairer.PrepareDelegatedJob(ctx, segment)
+			if shouldDelete {
+				if delErr := coordinator.queue.Delete(ctx, segment); delErr != nil {
+					err = errs.Combine(err, Error.New("failed to remove segment from queue: %v", delErr))
+				}
+			}
+			if err != nil {
+				coordinator.log.Error("failed to prepare repair job",
+					zap.Stringer("Stream ID", segment.StreamID),
+					zap.Uint64("Position", segment.Position.Encode()),
+					zap.Error(err))
+				continue
+			}
+			if job == nil {
+				continue
+			}
+
+			return coordinator.assign(worker, job)
+		}
+	}
+
+	return nil, nil
+}
+
+// assign registers the job for the worker and returns its definition.
+func (coordinator *Coordinator) assign(worker storj.NodeID, job *DelegatedJob) (*internalpb.RepairJobDefinition, error) {
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	expiresAt := coordinator.nowFn().Add(coordinator.config.JobTimeout)
+
+	coordinator.mu.Lock()
+	coordinator.assigned[id] = &assignedJob{
+		DelegatedJob: job,
+		worker:       worker,
+		expiresAt:    expiresAt,
+	}
+	coordinator.mu.Unlock()
+
+	mon.Meter("delegated_repair_jobs_assigned").Mark(1)
+
+	rs := job.Redundancy
+	return &internalpb.RepairJobDefinition{
+		JobId:            id.Bytes(),
+		GetOrders:        limitsToProto(job.GetLimits),
+		PrivateKeyForGet: job.GetPrivateKey.Bytes(),
+		PutOrders:        limitsToProto(job.PutLimits),
+		PrivateKeyForPut: job.PutPrivateKey.Bytes(),
+		Redundancy: &pb.RedundancyScheme{
+			Type:             pb.RedundancyScheme_SchemeType(rs.Algorithm),
+			MinReq:           int32(rs.RequiredShares),
+			Total:            int32(rs.TotalShares),
+			RepairThreshold:  int32(rs.RepairShares),
+			SuccessThreshold: int32(rs.OptimalShares),
+			ErasureShareSize: rs.ShareSize,
+		},
+		SegmentSize:       int64(job.Segment.EncryptedSize),
+		DesiredPieceCount: int32(job.PiecesNeeded),
+		ExpirationTime:    expiresAt,
+	}, nil
+}
+
+// expireJobs forgets the jobs whose workers didn't report in time. Their
+// segments are selected from the repair queue again later.
+func (coordinator *Coordinator) expireJobs() {
+	now := coordinator.nowFn()
+
+	coordinator.mu.Lock()
+	defer coordinator.mu.Unlock()
+
+	for id, job := range coordinator.assigned {
+		if now.After(job.expiresAt) {
+			delete(coordinator.assigned, id)
+			mon.Meter("delegated_repair_jobs_expired").Mark(1)
+		}
+	}
+}
+
+// completeJob verifies the result reported by the worker and updates the
+// segment.
+func (coordinator *Coordinator) completeJob(ctx context.Context, worker *identity.PeerIdentity, result *internalpb.RepairJobResult) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.FromBytes(result.JobId)
+	if err != nil {
+		return Error.New("invalid job id: %w", err)
+	}
+
+	coordinator.mu.Lock()
+	job, ok := coordinator.assigned[id]
+	if ok && job.worker == worker.ID {
+		delete(coordinator.assigned, id)
+	}
+	coordinator.mu.Unlock()
+
+	if !ok || job.worker != worker.ID {
+		return Error.New("unknown or expired job %s", id)
+	}
+	if coordinator.nowFn().After(job.expiresAt) {
+		mon.Meter("delegated_repair_jobs_expired").Mark(1)
+		return Error.New("job %s expired", id)
+	}
+
+	if err := verifyPutOrders(job.PutLimits, result.PutOrders); err != nil {
+		return err
+	}
+
+	delegated := DelegatedResult{
+		Irreparable: result.IrreparablePiecesRetrieved > 0 || result.ReconstructError != "",
+	}
+	for _, number := range result.DeletePieceNums {
+		if number >= 0 && number <= int32(^uint16(0)) {
+			delegated.FailedPieces = append(delegated.FailedPieces, uint16(number))
+		}
+	}
+
+	if !delegated.Irreparable {
+		delegated.RepairedPieces, err = coordinator.verifyPieces(ctx, worker, job, result.NewPiecesStored)
+		if err != nil {
+			return err
+		}
+		if result.StoreError != "" {
+			coordinator.log.Debug("repair worker failed to store pieces",
+				zap.Stringer("Stream ID", job.Segment.StreamID),
+				zap.String("error", result.StoreError))
+		}
+	}
+
+	shouldDelete, err := coordinator.repairer.CompleteDelegatedJob(ctx, job.DelegatedJob, delegated)
+	if shouldDelete {
+		if delErr := coordinator.queue.Delete(ctx, job.QueueSegment); delErr != nil {
+			err = errs.Combine(err, Error.New("failed to remove segment from queue: %v", delErr))
+		}
+	}
+	return err
+}
+
+// verifyPieces returns the pieces stored by the worker, which have a valid
+// piece hash signed by the storage node for the order limit of the job.
+func (coordinator *Coordinator) verifyPieces(ctx context.Context, worker *identity.PeerIdentity, job *assignedJob, hashes []*pb.PieceHash) (_ metabase.Pieces, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	originalLimits := make([]*pb.OrderLimit, len(job.PutLimits))
+	for i, limit := range job.PutLimits {
+		if limit != nil {
+			originalLimits[i] = limit.Limit
+		}
+	}
+
+	var uploads []*pb.SegmentPieceUploadResult
+	for _, hash := range hashes {
+		if hash == nil {
+			continue
+		}
+		for i, limit := range originalLimits {
+			if limit != nil && limit.PieceId == hash.PieceId {
+				uploads = append(uploads, &pb.SegmentPieceUploadResult{
+					PieceNum: int32(i),
+					NodeId:   limit.StorageNodeId,
+					Hash:     hash,
+				})
+				break
+			}
+		}
+	}
+	if len(uploads) == 0 {
+		return nil, nil
+	}
+
+	valid, invalid, err := coordinator.verifier.SelectValidPieces(ctx, worker, uploads, originalLimits)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, piece := range invalid {
+		coordinator.log.Warn("repair worker reported invalid piece",
+			zap.Stringer("Node ID", piece.NodeID),
+			zap.Int32("Piece Num", piece.PieceNum),
+			zap.Error(piece.Reason))
+	}
+	if len(valid) == 0 {
+		return nil, nil
+	}
+
+	if err := coordinator.verifier.VerifySizes(ctx, job.Redundancy, int64(job.Segment.EncryptedSize), valid); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	pieces := make(metabase.Pieces, 0, len(valid))
+	for _, piece := range valid {
+		pieces = append(pieces, metabase.Piece{
+			Number:      uint16(piece.PieceNum),
+			StorageNode: piece.NodeId,
+		})
+	}
+	return pieces, nil
+}
+
+// verifyPutOrders checks that the worker returned the PUT_REPAIR order limits
+// of the job.
+func verifyPutOrders(limits []*pb.AddressedOrderLimit, returned []*pb.AddressedOrderLimit) error {
+	if len(limits) != len(returned) {
+		return Error.New("expected %d put orders, got %d", len(limits), len(returned))
+	}
+	for i, limit := range limits {
+		got := returned[i].GetLimit()
+		if limit == nil {
+			if got != nil {
+				return Error.New("unexpected put order for piece %d", i)
+			}
+			continue
+		}
+		if got == nil || got.SerialNumber != limit.Limit.SerialNumber {
+			return Error.New("put order for piece %d doesn't match", i)
+		}
+	}
+	return nil
+}
+
+// limitsToProto replaces the missing order limits with empty ones, so the
+// index of every order limit is still its piece number in the message.
+func limitsToProto(limits []*pb.AddressedOrderLimit) []*pb.AddressedOrderLimit {
+	result := make([]*pb.AddressedOrderLimit, len(limits))
+	for i, limit := range limits {
+		if limit == nil {
+			limit = &pb.AddressedOrderLimit{}
+		}
+		result[i] = limit
+	}
+	return result
+}
+
+// limitsFromProto reverts limitsToProto.
+func limitsFromProto(limits []*pb.AddressedOrderLimit) []*pb.AddressedOrderLimit {
+	result := make([]*pb.AddressedOrderLimit, len(limits))
+	for i, limit := range limits {
+		if limit.GetLimit() != nil {
+			result[i] = limit
+		}
+	}
+	return result
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+)
+
+func TestLimitsProtoRoundTrip(t *testing.T) {
+	limits := []*pb.AddressedOrderLimit{
+		{Limit: &pb.OrderLimit{SerialNumber: testrand.SerialNumber()}},
+		nil,
+		{Limit: &pb.OrderLimit{SerialNumber: testrand.SerialNumber()}},
+		nil,
+	}
+
+	encoded := limitsToProto(limits)
+	require.Len(t, encoded, len(limits))
+	for _, limit := range encoded {
+		require.NotNil(t, limit)
+	}
+
+	require.Equal(t, limits, limitsFromProto(encoded))
+}
+
+func TestVerifyPutOrders(t *testing.T) {
+	limits := []*pb.AddressedOrderLimit{
+		nil,
+		{Limit: &pb.OrderLimit{SerialNumber: testrand.SerialNumber()}},
+	}
+
+	require.NoError(t, verifyPutOrders(limits, limitsToProto(limits)))
+
+	require.Error(t, verifyPutOrders(limits, limitsToProto(limits[:1])))
+
+	modified := limitsToProto([]*pb.AddressedOrderLimit{
+		nil,
+		{Limit: &pb.OrderLimit{SerialNumber: storj.SerialNumber{}}},
+	})
+	require.Error(t, verifyPutOrders(limits, modified))
+
+	extra := limitsToProto([]*pb.AddressedOrderLimit{
+		{Limit: &pb.OrderLimit{SerialNumber: testrand.SerialNumber()}},
+		limits[1],
+	})
+	require.Error(t, verifyPutOrders(limits, extra))
+}
+
+type recordingReporter struct {
+	audit.Reporter
+	reports []audit.Report
+}
+
+func (reporter *recordingReporter) RecordAudits(ctx context.Context, req audit.Report) {
+	reporter.reports = append(reporter.reports, req)
+}
+
+func TestReportFailedPieces(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	segment := metabase.Segment{
+		StreamID: testrand.UUID(),
+		Position: metabase.SegmentPosition{Part: 1, Index: 2},
+		Pieces: metabase.Pieces{
+			{Number: 0, StorageNode: testrand.NodeID()},
+			{Number: 1, StorageNode: testrand.NodeID()},
+			{Number: 2, StorageNode: testrand.NodeID()},
+		},
+	}
+	job := &DelegatedJob{
+		Segment: segment,
+		GetLimits: []*pb.AddressedOrderLimit{
+			{Limit: &pb.OrderLimit{}},
+			nil,
+			{Limit: &pb.OrderLimit{}},
+		},
+		nodesReputation: map[storj.NodeID]overlay.ReputationStatus{},
+	}
+
+	reporter := &recordingReporter{}
+	repairer := &SegmentRepairer{reporter: reporter, reputationUpdateEnabled: true}
+
+	// piece 1 wasn't downloaded and piece 5 doesn't exist.
+	repairer.reportFailedPieces(ctx, job, []uint16{1, 2, 5})
+	require.Len(t, reporter.reports, 1)
+
+	report := reporter.reports[0]
+	require.Empty(t, report.Fails, "worker reports must not remove pieces directly")
+	require.Len(t, report.PendingAudits, 1)
+	require.Equal(t, audit.PieceLocator{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+		NodeID:   segment.Pieces[2].StorageNode,
+		PieceNum: 2,
+	}, report.PendingAudits[0].Locator)
+
+	// nothing to report.
+	repairer.reportFailedPieces(ctx, job, []uint16{1})
+	require.Len(t, reporter.reports, 1)
+
+	// reputation updates are disabled.
+	repairer.reputationUpdateEnabled = false
+	repairer.reportFailedPieces(ctx, job, []uint16{0})
+	require.Len(t, reporter.reports, 1)
+}
@@ -0,0 +1,341 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// DelegatedJob is a segment repair, which is executed by an external repair
+// worker. The satellite prepares the order limits and the worker downloads,
+// reconstructs and uploads the pieces.
+type DelegatedJob struct {
+	QueueSegment queue.InjuredSegment
+	Segment      metabase.Segment
+
+	// Redundancy is the redundancy of the segment after the repair.
+	Redundancy storj.RedundancyScheme
+
+	// GetLimits contains one GET_REPAIR order limit per piece number of the
+	// segment, nil for pieces which should not be downloaded.
+	GetLimits     []*pb.AddressedOrderLimit
+	GetPrivateKey storj.PiecePrivateKey
+	// PutLimits contains one PUT_REPAIR order limit per piece number of the
+	// repaired segment, nil for pieces which should not be uploaded.
+	PutLimits     []*pb.AddressedOrderLimit
+	PutPrivateKey storj.PiecePrivateKey
+
+	// PiecesNeeded is the number of new pieces which need to be stored to
+	// reach the optimal threshold.
+	PiecesNeeded int
+
+	piecesCheck     repair.PiecesCheckResult
+	nodesReputation map[storj.NodeID]overlay.ReputationStatus
+}
+
+// DelegatedResult is the outcome of a delegated repair job, as reported by a
+// repair worker. The repaired pieces have to be verified by the caller.
+type DelegatedResult struct {
+	// Irreparable is set when the worker could not download enough pieces to
+	// reconstruct the segment.
+	Irreparable bool
+	// RepairedPieces are the verified pieces stored by the worker.
+	RepairedPieces metabase.Pieces
+	// FailedPieces are the numbers of the pieces, which the worker couldn't
+	// find on the nodes, or which failed the verification. They are only
+	// hints: the pieces are queued for reverification and are removed from
+	// the segment once the satellite confirms the failure.
+	FailedPieces []uint16
+}
+
+// PrepareDelegatedJob checks the health of the segment and creates the order
+// limits for a delegated repair. It returns a nil job when the segment doesn't
+// need or can't get a repair.
+//
+// Note that shouldDelete is used even in the case where err is not nil.
+func (repairer *SegmentRepairer) PrepareDelegatedJob(ctx context.Context, queueSegment queue.InjuredSegment) (job *DelegatedJob, shouldDelete bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	log := repairer.log.With(zap.Stringer("Stream ID", queueSegment.StreamID), zap.Uint64("Position", queueSegment.Position.Encode()))
+	segment, err := repairer.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: queueSegment.StreamID,
+		Position: queueSegment.Position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			mon.Meter("delegated_repair_unnecessary").Mark(1)
+			log.Info("segment was deleted")
+			return nil, true, nil
+		}
+		return nil, false, metainfoGetError.Wrap(err)
+	}
+
+	if segment.Inline() {
+		return nil, true, invalidRepairError.New("cannot repair inline segment")
+	}
+
+	if segment.Expired(repairer.nowFn()) {
+		mon.Meter("delegated_repair_unnecessary").Mark(1)
+		log.Info("segment has expired")
+		return nil, true, nil
+	}
+
+	allNodeIDs := make([]storj.NodeID, len(segment.Pieces))
+	for i, p := range segment.Pieces {
+		allNodeIDs[i] = p.StorageNode
+	}
+
+	selectedNodes, err := repairer.overlay.GetActiveNodes(ctx, allNodeIDs)
+	if err != nil {
+		return nil, false, overlayQueryError.New("error identifying missing pieces: %w", err)
+	}
+	if len(selectedNodes) != len(segment.Pieces) {
+		return nil, false, overlayQueryError.New("GetActiveNodes returned an invalid result")
+	}
+
+	pieces := segment.Pieces
+	newRedundancy := repairer.newRedundancy(segment.Redundancy)
+	piecesCheck := repair.ClassifySegmentPieces(pieces, selectedNodes, repairer.excludedCountryCodes, repairer.doPlacementCheck, repairer.doDeclumping, repairer.placements[segment.Placement], int(newRedundancy.RepairShares))
+
+	if piecesCheck.Retrievable.Count() < int(newRedundancy.RequiredShares) {
+		mon.Meter("delegated_repair_nodes_unavailable").Mark(1)
+		log.Warn("irreparable segment",
+			zap.Int("Pieces Available", piecesCheck.Retrievable.Count()),
+			zap.Int16("Pieces Required", newRedundancy.RequiredShares),
+			zap.Uint16("Placement", uint16(segment.Placement)),
+		)
+		return nil, false, nil
+	}
+
+	if piecesCheck.Healthy.Count() > int(newRedundancy.RepairShares) {
+		if _, err := repairer.dropForcingRepairPieces(ctx, segment, piecesCheck, newRedundancy); err != nil {
+			return nil, false, err
+		}
+		mon.Meter("delegated_repair_unnecessary").Mark(1)
+		log.Info("segment above repair threshold",
+			zap.Int("numHealthy", piecesCheck.Healthy.Count()),
+			zap.Int16("repairThreshold", newRedundancy.RepairShares))
+		return nil, true, nil
+	}
+
+	retrievablePieces := make(metabase.Pieces, 0, piecesCheck.Retrievable.Count())
+	for _, piece := range pieces {
+		if piecesCheck.Retrievable.Contains(int(piece.Number)) {
+			retrievablePieces = append(retrievablePieces, piece)
+		}
+	}
+	getLimits, getPrivateKey, cachedNodesInfo, err := repairer.orders.CreateGetRepairOrderLimits(ctx, segment, retrievablePieces)
+	if err != nil {
+		if orders.ErrDownloadFailedNotEnoughPieces.Has(err) {
+			mon.Meter("delegated_repair_nodes_unavailable").Mark(1)
+			log.Warn("irreparable segment: too many nodes offline",
+				zap.Int("Pieces Available", len(retrievablePieces)),
+				zap.Int16("Pieces Required", segment.Redundancy.RequiredShares),
+				zap.Error(err),
+			)
+			return nil, false, nil
+		}
+		return nil, false, orderLimitFailureError.New("could not create GET_REPAIR order limits: %w", err)
+	}
+
+	// Pieces without a GET_REPAIR order limit were found to be irretrievable
+	// while creating the order limits.
+	for _, piece := range retrievablePieces {
+		if getLimits[piece.Number] == nil {
+			piecesCheck.Missing.Include(int(piece.Number))
+			piecesCheck.Unhealthy.Include(int(piece.Number))
+
+			piecesCheck.Healthy.Exclude(int(piece.Number))
+			piecesCheck.Retrievable.Exclude(int(piece.Number))
+			piecesCheck.UnhealthyRetrievable.Exclude(int(piece.Number))
+		}
+	}
+
+	totalNeeded := int(math.Ceil(float64(newRedundancy.OptimalShares) * repairer.multiplierOptimalThreshold))
+	if totalNeeded > int(newRedundancy.TotalShares) {
+		totalNeeded = int(newRedundancy.TotalShares)
+	}
+
+	var alreadySelected []*nodeselection.SelectedNode
+	for i := range selectedNodes {
+		alreadySelected = append(alreadySelected, &selectedNodes[i])
+	}
+
+	newNodes, err := repairer.overlay.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
+		RequestedCount:  totalNeeded - piecesCheck.Healthy.Count(),
+		AlreadySelected: alreadySelected,
+		Placement:       segment.Placement,
+	})
+	if err != nil {
+		return nil, false, overlayQueryError.Wrap(err)
+	}
+
+	toKeep := piecesToKeep(pieces, piecesCheck, int(newRedundancy.TotalShares)-len(newNodes))
+	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, segment, newRedundancy, getLimits, toKeep, newNodes)
+	if err != nil {
+		return nil, false, orderLimitFailureError.New("could not create PUT_REPAIR order limits: %w", err)
+	}
+
+	nodesReputation := make(map[storj.NodeID]overlay.ReputationStatus, len(cachedNodesInfo))
+	for id, info := range cachedNodesInfo {
+		nodesReputation[id] = info.Reputation
+	}
+
+	mon.Meter("delegated_repair_jobs_created").Mark(1)
+
+	return &DelegatedJob{
+		QueueSegment: queueSegment,
+		Segment:      segment,
+		Redundancy:   newRedundancy,
+
+		GetLimits:     getLimits,
+		GetPrivateKey: getPrivateKey,
+		PutLimits:     putLimits,
+		PutPrivateKey: putPrivateKey,
+
+		PiecesNeeded: int(newRedundancy.OptimalShares) - piecesCheck.Healthy.Count(),
+
+		piecesCheck:     piecesCheck,
+		nodesReputation: nodesReputation,
+	}, false, nil
+}
+
+// CompleteDelegatedJob updates the pieces of the segment with the result of a
+// delegated repair job.
+//
+// Note that shouldDelete is used even in the case where err is not nil.
+func (repairer *SegmentRepairer) CompleteDelegatedJob(ctx context.Context, job *DelegatedJob, result DelegatedResult) (shouldDelete bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segment := job.Segment
+	log := repairer.log.With(zap.Stringer("Stream ID", segment.StreamID), zap.Uint64("Position", segment.Position.Encode()))
+
+	repairer.reportFailedPieces(ctx, job, result.FailedPieces)
+
+	if result.Irreparable {
+		mon.Meter("delegated_repair_too_many_nodes_failed").Mark(1)
+		log.Warn("irreparable segment: worker could not acquire enough shares",
+			zap.Int("Failed Pieces", len(result.FailedPieces)))
+		// repair will be attempted again if the segment remains unhealthy.
+		return false, nil
+	}
+
+	if err := repairer.checkIfSegmentAltered(ctx, segment); err != nil {
+		if segmentDeletedError.Has(err) || segmentModifiedError.Has(err) {
+			log.Info("segment changed during delegated repair", zap.Error(err))
+			return true, nil
+		}
+		return false, segmentVerificationError.Wrap(err)
+	}
+
+	if len(result.RepairedPieces) == 0 {
+		mon.Meter("delegated_repair_failed").Mark(1)
+		return false, repairPutError.New("worker did not store any valid piece")
+	}
+
+	piecesCheck := job.piecesCheck
+	repairedMap := make(map[uint16]bool, len(result.RepairedPieces))
+	for _, piece := range result.RepairedPieces {
+		if int(piece.Number) >= len(job.PutLimits) || job.PutLimits[piece.Number] == nil {
+			return false, invalidRepairError.New("no order limit for repaired piece %d", piece.Number)
+		}
+		repairedMap[piece.Number] = true
+	}
+
+	healthyAfterRepair := piecesCheck.Healthy.Count() + len(result.RepairedPieces)
+	switch {
+	case healthyAfterRepair >= int(job.Redundancy.OptimalShares):
+		mon.Meter("delegated_repair_success").Mark(1)
+	case healthyAfterRepair <= int(job.Redundancy.RepairShares):
+		mon.Meter("delegated_repair_failed").Mark(1)
+	default:
+		mon.Meter("delegated_repair_partial").Mark(1)
+	}
+
+	toRemove := piecesToRemove(segment.Pieces, piecesCheck, job.Redundancy, healthyAfterRepair, repairedMap)
+
+	newPieces, err := segment.Pieces.Update(result.RepairedPieces, maps.Values(toRemove))
+	if err != nil {
+		return false, repairPutError.Wrap(err)
+	}
+
+	err = repairer.metabase.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+
+		OldPieces:     segment.Pieces,
+		NewRedundancy: job.Redundancy,
+		NewPieces:     newPieces,
+
+		NewRepairedAt: time.Now(),
+	})
+	if err != nil {
+		return false, metainfoPutError.Wrap(err)
+	}
+
+	mon.Meter("repair_bytes_uploaded_delegated").Mark64(int64(len(result.RepairedPieces)) * job.Redundancy.PieceSize(int64(segment.EncryptedSize)))
+
+	log.Info("repaired segment by delegated worker",
+		zap.Int("removed pieces", len(toRemove)),
+		zap.Int("repaired pieces", len(result.RepairedPieces)),
+		zap.Int("healthy before repair", piecesCheck.Healthy.Count()),
+		zap.Int("healthy after repair", healthyAfterRepair),
+		zap.Int("total after repair", len(newPieces)))
+	return true, nil
+}
+
+// reportFailedPieces queues the pieces which the worker reported as failed for
+// reverification. The worker's claim can't be trusted, hence the pieces are
+// only removed from the segment and counted against the node's reputation when
+// the satellite's own audit confirms the failure.
+func (repairer *SegmentRepairer) reportFailedPieces(ctx context.Context, job *DelegatedJob, failed []uint16) {
+	if !repairer.reputationUpdateEnabled || len(failed) == 0 {
+		return
+	}
+
+	segment := job.Segment
+	report := audit.Report{
+		Segment:         &segment,
+		NodesReputation: job.nodesReputation,
+	}
+	for _, number := range failed {
+		// only pieces which the worker was asked to download.
+		if int(number) >= len(job.GetLimits) || job.GetLimits[number] == nil {
+			continue
+		}
+		piece, ok := segment.Pieces.FindByNum(int(number))
+		if !ok {
+			continue
+		}
+		report.PendingAudits = append(report.PendingAudits, &audit.ReverificationJob{
+			Locator: audit.PieceLocator{
+				StreamID: segment.StreamID,
+				Position: segment.Position,
+				NodeID:   piece.StorageNode,
+				PieceNum: int(piece.Number),
+			},
+		})
+	}
+	if len(report.PendingAudits) == 0 {
+		return
+	}
+
+	mon.Meter("delegated_repair_failed_pieces_reported").Mark(len(report.PendingAudits))
+	repairer.reporter.RecordAudits(ctx, report)
+}
@@ -239,9 +239,8 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 		return false, overlayQueryError.New("GetActiveNodes returned an invalid result")
 	}
 	pieces := segment.Pieces
-	piecesCheck := repair.ClassifySegmentPieces(pieces, selectedNodes, repairer.excludedCountryCodes, repairer.doPlacementCheck, repairer.doDeclumping, repairer.placements[segment.Placement])
-
 	newRedundancy := repairer.newRedundancy(segment.Redundancy)
+	piecesCheck := repair.ClassifySegmentPieces(pieces, selectedNodes, repairer.excludedCountryCodes, repairer.doPlacementCheck, repairer.doDeclumping, repairer.placements[segment.Placement], int(newRedundancy.RepairShares))
 
 	// irreparable segment
 	if piecesCheck.Retrievable.Count() < int(newRedundancy.RequiredShares) {
@@ -292,37 +291,9 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 	if piecesCheck.Healthy.Count() > int(newRedundancy.RepairShares) {
 		// No repair is needed (note Healthy does not include pieces in ForcingRepair).
 
-		var dropPieces metabase.Pieces
-		if piecesCheck.ForcingRepair.Count() > 0 {
-			// No repair is needed, but remove forcing-repair pieces without a repair operation,
-			// as we will still be above the repair threshold.
-			for _, piece := range pieces {
-				if piecesCheck.ForcingRepair.Contains(int(piece.Number)) {
-					dropPieces = append(dropPieces, piece)
-				}
-			}
-			if len(dropPieces) > 0 {
-				newPieces, err := segment.Pieces.Update(nil, dropPieces)
-				if err != nil {
-					return false, metainfoPutError.Wrap(err)
-				}
-
-				err = repairer.metabase.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
-					StreamID: segment.StreamID,
-					Position: segment.Position,
-
-					OldPieces:     segment.Pieces,
-					NewRedundancy: newRedundancy,
-					NewPieces:     newPieces,
-
-					NewRepairedAt: time.Now(),
-				})
-				if err != nil {
-					return false, metainfoPutError.Wrap(err)
-				}
-
-				mon.Meter("dropped_undesirable_pieces_without_repair").Mark(len(dropPieces))
-			}
+		dropPieces, err := repairer.dropForcingRepairPieces(ctx, segment, piecesCheck, newRedundancy)
+		if err != nil {
+			return false, err
 		}
 
 		mon.Meter("repair_unnecessary").Mark(1) //mon:locked
@@ -595,38 +566,12 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 	// Once it is possible to suppress or avoid the quiescence error in
 	// eestream.decodedReader, we can remove this tempfile step.
 	if !repairer.ec.inmemoryDownload {
-		err := func() (err error) {
-			tempfile, err := tmpfile.New("", "repaired-segment-*")
-			if err != nil {
-				return repairReconstructError.New("could not open tempfile: %w", err)
-			}
-			defer func() {
-				if recoverErr := recover(); recoverErr != nil {
-					err = repairReconstructError.New("panic during segment reconstruction: %v", recoverErr)
-				}
-				if err != nil {
-					_ = tempfile.Close()
-				}
-			}()
-			_, err = io.Copy(tempfile, segmentReader)
-			if err != nil {
-				return repairReconstructError.New("could not reconstruct segment: %w", err)
-			}
-			_, err = tempfile.Seek(0, io.SeekStart)
-			if err != nil {
-				return repairReconstructError.New("could not seek to beginning of tempfile: %w", err)
-			}
-			err = segmentReader.Close()
-			if err != nil {
-				return repairReconstructError.New("could not close segmentReader: %w", err)
-			}
-			// assign tempfile before proceeding, because we've already defer-closed segmentReader
-			segmentReader = tempfile
-			return nil
-		}()
+		tempfile, err := reconstructToTempfile(segmentReader)
 		if err != nil {
 			return false, err
 		}
+		// assign tempfile before proceeding, because we've already defer-closed segmentReader
+		segmentReader = tempfile
 	}
 
 	// only report audit result when segment can be successfully downloaded
@@ -659,26 +604,8 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 		repairer.reporter.RecordAudits(ctx, report)
 	}
 
-	// Create the order limits for the PUT_REPAIR action. We want to keep pieces in Healthy
-	// as well as pieces in InExcludedCountry (our policy is to let those nodes keep the
-	// pieces they have, as long as they are kept intact and retrievable).
-	maxToKeep := int(newRedundancy.TotalShares) - len(newNodes)
-	toKeep := map[uint16]struct{}{}
-
-	// TODO how to avoid this two loops
-	for _, piece := range pieces {
-		if piecesCheck.Healthy.Contains(int(piece.Number)) {
-			toKeep[piece.Number] = struct{}{}
-		}
-	}
-	for _, piece := range pieces {
-		if piecesCheck.InExcludedCountry.Contains(int(piece.Number)) {
-			if len(toKeep) >= maxToKeep {
-				break
-			}
-			toKeep[piece.Number] = struct{}{}
-		}
-	}
+	// Create the order limits for the PUT_REPAIR action.
+	toKeep := piecesToKeep(pieces, piecesCheck, int(newRedundancy.TotalShares)-len(newNodes))
 
 	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, segment, newRedundancy, getOrderLimits, toKeep, newNodes)
 	if err != nil {
@@ -748,43 +675,7 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 	mon.FloatVal("healthy_ratio_after_repair").Observe(healthyRatioAfterRepair) //mon:locked
 	stats.healthyRatioAfterRepair.Observe(healthyRatioAfterRepair)
 
-	toRemove := make(map[uint16]metabase.Piece, piecesCheck.Unhealthy.Count())
-	switch {
-	case healthyAfterRepair >= int(newRedundancy.OptimalShares):
-		// Repair was fully successful; remove all unhealthy pieces except those in
-		// (Retrievable AND InExcludedCountry). Those, we allow to remain on the nodes as
-		// long as the nodes are keeping the pieces intact and available.
-		for _, piece := range pieces {
-			if piecesCheck.Unhealthy.Contains(int(piece.Number)) {
-				retrievable := piecesCheck.Retrievable.Contains(int(piece.Number))
-				inExcludedCountry := piecesCheck.InExcludedCountry.Contains(int(piece.Number))
-				if retrievable && inExcludedCountry {
-					continue
-				}
-				toRemove[piece.Number] = piece
-			}
-		}
-	case healthyAfterRepair > int(newRedundancy.RepairShares):
-		// Repair was successful enough that we still want to drop all out-of-placement
-		// pieces. We want to do that wherever possible, except where doing so puts data in
-		// jeopardy.
-		for _, piece := range pieces {
-			if piecesCheck.OutOfPlacement.Contains(int(piece.Number)) {
-				toRemove[piece.Number] = piece
-			}
-		}
-	default:
-		// Repair improved the health of the piece, but it is still at or below the
-		// repair threshold (not counting unhealthy-but-retrievable pieces). To be safe,
-		// we will keep unhealthy-but-retrievable pieces in the segment for now.
-	}
-
-	// in any case, we want to remove pieces for which we have replacements now.
-	for _, piece := range pieces {
-		if repairedMap[piece.Number] {
-			toRemove[piece.Number] = piece
-		}
-	}
+	toRemove := piecesToRemove(pieces, piecesCheck, newRedundancy, healthyAfterRepair, repairedMap)
 
 	// add pieces that failed piece hash verification to the removal list
 	for _, outcome := range piecesReport.Failed {
@@ -866,6 +757,147 @@ func (repairer *SegmentRepairer) checkIfSegmentAltered(ctx context.Context, oldS
 	return nil
 }
 
+// reconstructToTempfile writes the reconstructed segment into a tempfile and
+// returns the tempfile positioned at its beginning. segmentReader is closed
+// when the segment was written successfully.
+func reconstructToTempfile(segmentReader io.ReadCloser) (_ io.ReadCloser, err error) {
+	tempfile, err := tmpfile.New("", "repaired-segment-*")
+	if err != nil {
+		return nil, repairReconstructError.New("could not open tempfile: %w", err)
+	}
+	defer func() {
+		if recoverErr := recover(); recoverErr != nil {
+			err = repairReconstructError.New("panic during segment reconstruction: %v", recoverErr)
+		}
+		if err != nil {
+			_ = tempfile.Close()
+		}
+	}()
+	_, err = io.Copy(tempfile, segmentReader)
+	if err != nil {
+		return nil, repairReconstructError.New("could not reconstruct segment: %w", err)
+	}
+	_, err = tempfile.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, repairReconstructError.New("could not seek to beginning of tempfile: %w", err)
+	}
+	err = segmentReader.Close()
+	if err != nil {
+		return nil, repairReconstructError.New("could not close segmentReader: %w", err)
+	}
+	return tempfile, nil
+}
+
+// dropForcingRepairPieces removes the pieces which force a repair from a
+// segment, which doesn't need a repair otherwise.
+func (repairer *SegmentRepairer) dropForcingRepairPieces(ctx context.Context, segment metabase.Segment, piecesCheck repair.PiecesCheckResult, newRedundancy storj.RedundancyScheme) (dropPieces metabase.Pieces, err error) {
+	if piecesCheck.ForcingRepair.Count() == 0 {
+		return nil, nil
+	}
+
+	// No repair is needed, but remove forcing-repair pieces without a repair operation,
+	// as we will still be above the repair threshold.
+	for _, piece := range segment.Pieces {
+		if piecesCheck.ForcingRepair.Contains(int(piece.Number)) {
+			dropPieces = append(dropPieces, piece)
+		}
+	}
+	if len(dropPieces) == 0 {
+		return nil, nil
+	}
+
+	newPieces, err := segment.Pieces.Update(nil, dropPieces)
+	if err != nil {
+		return nil, metainfoPutError.Wrap(err)
+	}
+
+	err = repairer.metabase.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+
+		OldPieces:     segment.Pieces,
+		NewRedundancy: newRedundancy,
+		NewPieces:     newPieces,
+
+		NewRepairedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, metainfoPutError.Wrap(err)
+	}
+
+	mon.Meter("dropped_undesirable_pieces_without_repair").Mark(len(dropPieces))
+	return dropPieces, nil
+}
+
+// piecesToKeep returns the piece numbers, which don't get a PUT_REPAIR order
+// limit. We want to keep pieces in Healthy as well as pieces in InExcludedCountry
+// (our policy is to let those nodes keep the pieces they have, as long as they
+// are kept intact and retrievable).
+func piecesToKeep(pieces metabase.Pieces, piecesCheck repair.PiecesCheckResult, maxToKeep int) map[uint16]struct{} {
+	toKeep := map[uint16]struct{}{}
+
+	// TODO how to avoid this two loops
+	for _, piece := range pieces {
+		if piecesCheck.Healthy.Contains(int(piece.Number)) {
+			toKeep[piece.Number] = struct{}{}
+		}
+	}
+	for _, piece := range pieces {
+		if piecesCheck.InExcludedCountry.Contains(int(piece.Number)) {
+			if len(toKeep) >= maxToKeep {
+				break
+			}
+			toKeep[piece.Number] = struct{}{}
+		}
+	}
+
+	return toKeep
+}
+
+// piecesToRemove returns the pieces which should be removed from the segment
+// after the repair stored the repaired pieces.
+func piecesToRemove(pieces metabase.Pieces, piecesCheck repair.PiecesCheckResult, newRedundancy storj.RedundancyScheme, healthyAfterRepair int, repairedMap map[uint16]bool) map[uint16]metabase.Piece {
+	toRemove := make(map[uint16]metabase.Piece, piecesCheck.Unhealthy.Count())
+	switch {
+	case healthyAfterRepair >= int(newRedundancy.OptimalShares):
+		// Repair was fully successful; remove all unhealthy pieces except those in
+		// (Retrievable AND InExcludedCountry). Those, we allow to remain on the nodes as
+		// long as the nodes are keeping the pieces intact and available.
+		for _, piece := range pieces {
+			if piecesCheck.Unhealthy.Contains(int(piece.Number)) {
+				retrievable := piecesCheck.Retrievable.Contains(int(piece.Number))
+				inExcludedCountry := piecesCheck.InExcludedCountry.Contains(int(piece.Number))
+				if retrievable && inExcludedCountry {
+					continue
+				}
+				toRemove[piece.Number] = piece
+			}
+		}
+	case healthyAfterRepair > int(newRedundancy.RepairShares):
+		// Repair was successful enough that we still want to drop all out-of-placement
+		// pieces. We want to do that wherever possible, except where doing so puts data in
+		// jeopardy.
+		for _, piece := range pieces {
+			if piecesCheck.OutOfPlacement.Contains(int(piece.Number)) {
+				toRemove[piece.Number] = piece
+			}
+		}
+	default:
+		// Repair improved the health of the piece, but it is still at or below the
+		// repair threshold (not counting unhealthy-but-retrievable pieces). To be safe,
+		// we will keep unhealthy-but-retrievable pieces in the segment for now.
+	}
+
+	// in any case, we want to remove pieces for which we have replacements now.
+	for _, piece := range pieces {
+		if repairedMap[piece.Number] {
+			toRemove[piece.Number] = piece
+		}
+	}
+
+	return toRemove
+}
+
 func (repairer *SegmentRepairer) getStatsByRS(redundancy storj.RedundancyScheme) *stats {
 	return repairer.statsCollector.getStatsByRS(getRSString(redundancy))
 }
@@ -0,0 +1,216 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/rpc"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/uplink/private/eestream"
+)
+
+// WorkerConfig contains configurable values for an external repair worker.
+type WorkerConfig struct {
+	Satellite       string        `help:"node URL of the satellite repair coordinator (id@host:port)" default:""`
+	Concurrency     int           `help:"maximum segments that can be repaired concurrently" default:"5"`
+	DialTimeout     time.Duration `help:"time limit for dialing storage node" default:"5s"`
+	Timeout         time.Duration `help:"time limit for uploading repaired pieces to new storage nodes" default:"5m0s"`
+	DownloadTimeout time.Duration `help:"time limit for downloading pieces from a node for repair" default:"5m0s"`
+	InMemoryRepair  bool          `help:"whether to download pieces for repair in memory (true) or download to disk (false)" default:"false"`
+	InMemoryUpload  bool          `help:"whether to upload pieces for repair using memory (true) or disk (false)" default:"false"`
+	RetryInterval   time.Duration `help:"how long to wait before contacting the coordinator again after a failed request" default:"30s"`
+}
+
+// DelegatedWorker repairs segments on behalf of a satellite. It gets repair
+// jobs from the repair coordinator of the satellite, downloads and
+// reconstructs the segments, uploads the new pieces and reports the result
+// back to the coordinator.
+//
+// architecture: Worker
+type DelegatedWorker struct {
+	log       *zap.Logger
+	dialer    rpc.Dialer
+	satellite storj.NodeURL
+	config    WorkerConfig
+}
+
+// NewDelegatedWorker creates a new external repair worker.
+func NewDelegatedWorker(log *zap.Logger, dialer rpc.Dialer, config WorkerConfig) (*DelegatedWorker, error) {
+	satellite, err := storj.ParseNodeURL(config.Satellite)
+	if err != nil {
+		return nil, Error.New("invalid satellite node URL: %w", err)
+	}
+	if satellite.ID.IsZero() {
+		return nil, Error.New("satellite node URL must contain the node ID")
+	}
+	if config.Concurrency <= 0 {
+		config.Concurrency = 1
+	}
+
+	return &DelegatedWorker{
+		log:       log,
+		dialer:    dialer,
+		satellite: satellite,
+		config:    config,
+	}, nil
+}
+
+// Run repairs segments until the context is canceled.
+func (worker *DelegatedWorker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := worker.dialer.DialNodeURL(ctx, worker.satellite)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	satellite, err := conn.PeerIdentity()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	ec := NewECRepairer(
+		worker.dialer,
+		signing.SigneeFromPeerIdentity(satellite),
+		worker.config.DialTimeout,
+		worker.config.DownloadTimeout,
+		worker.config.InMemoryRepair,
+		worker.config.InMemoryUpload,
+	)
+	client := internalpb.NewDRPCRepairCoordinatorClient(conn)
+
+	group, ctx := errgroup.WithContext(ctx)
+	for i := 0; i < worker.config.Concurrency; i++ {
+		group.Go(func() error {
+			return worker.loop(ctx, client, ec)
+		})
+	}
+	return group.Wait()
+}
+
+// loop requests repair jobs from the coordinator and executes them.
+func (worker *DelegatedWorker) loop(ctx context.Context, client internalpb.DRPCRepairCoordinatorClient, ec *ECRepairer) error {
+	var last *internalpb.RepairJobResult
+	for {
+		response, err := client.RepairJob(ctx, &internalpb.RepairJobRequest{LastJobResult: last})
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// keep the last result, so it's reported with the next request.
+			worker.log.Warn("failed to get repair job", zap.Error(err))
+			if !sync2.Sleep(ctx, worker.config.RetryInterval) {
+				return ctx.Err()
+			}
+			continue
+		}
+		last = nil
+
+		if response.NewJob == nil {
+			if !sync2.Sleep(ctx, time.Duration(response.ComeBackInMillis)*time.Millisecond) {
+				return ctx.Err()
+			}
+			continue
+		}
+
+		last = worker.Repair(ctx, ec, response.NewJob)
+	}
+}
+
+// Repair executes a single repair job and returns its result.
+func (worker *DelegatedWorker) Repair(ctx context.Context, ec *ECRepairer, job *internalpb.RepairJobDefinition) (result *internalpb.RepairJobResult) {
+	defer mon.Task()(&ctx)(nil)
+
+	result = &internalpb.RepairJobResult{
+		JobId:     job.JobId,
+		PutOrders: job.PutOrders,
+	}
+
+	log := worker.log
+	if id, err := uuid.FromBytes(job.JobId); err == nil {
+		log = log.With(zap.Stringer("Job ID", id))
+	}
+
+	ctx, cancel := context.WithDeadline(ctx, job.ExpirationTime)
+	defer cancel()
+
+	getKey, err := storj.PiecePrivateKeyFromBytes(job.PrivateKeyForGet)
+	if err != nil {
+		result.ReconstructError = err.Error()
+		return result
+	}
+	putKey, err := storj.PiecePrivateKeyFromBytes(job.PrivateKeyForPut)
+	if err != nil {
+		result.StoreError = err.Error()
+		return result
+	}
+
+	// the segment may have less pieces than the repaired segment, so the
+	// scheme for downloading is based on the number of GET order limits.
+	fec, err := eestream.NewFEC(int(job.Redundancy.GetMinReq()), len(job.GetOrders))
+	if err != nil {
+		result.ReconstructError = err.Error()
+		return result
+	}
+	getScheme := eestream.NewRSScheme(fec, int(job.Redundancy.GetErasureShareSize()))
+
+	putStrategy, err := eestream.NewRedundancyStrategyFromProto(job.Redundancy)
+	if err != nil {
+		result.StoreError = err.Error()
+		return result
+	}
+
+	segmentReader, piecesReport, err := ec.Get(ctx, log, limitsFromProto(job.GetOrders), nil, getKey, getScheme, job.SegmentSize)
+	for _, outcome := range piecesReport.Failed {
+		result.DeletePieceNums = append(result.DeletePieceNums, int32(outcome.Piece.Number))
+	}
+	if err != nil {
+		var irreparableErr *irreparableError
+		if errors.As(err, &irreparableErr) {
+			result.IrreparablePiecesRetrieved = irreparableErr.piecesAvailable
+		}
+		result.ReconstructError = err.Error()
+		return result
+	}
+	defer func() { _ = segmentReader.Close() }()
+
+	if !worker.config.InMemoryRepair {
+		tempfile, err := reconstructToTempfile(segmentReader)
+		if err != nil {
+			result.ReconstructError = err.Error()
+			return result
+		}
+		segmentReader = tempfile
+	}
+
+	_, hashes, err := ec.Repair(ctx, log, limitsFromProto(job.PutOrders), putKey, putStrategy, segmentReader, worker.config.Timeout, int(job.DesiredPieceCount))
+	if err != nil {
+		result.StoreError = err.Error()
+		return result
+	}
+
+	for _, hash := range hashes {
+		if hash != nil {
+			result.NewPiecesStored = append(result.NewPiecesStored, hash)
+		}
+	}
+
+	log.Debug("repaired segment", zap.Int("stored pieces", len(result.NewPiecesStored)))
+	return result
+}
+
+// Close closes resources.
+func (worker *DelegatedWorker) Close() error { return nil }
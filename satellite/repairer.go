@@ -6,6 +6,7 @@ package satellite
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
 	"runtime/pprof"
 
@@ -23,10 +24,13 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/version"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/server"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo/pointerverification"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
@@ -70,6 +74,10 @@ type Repairer struct {
 	EcRepairer      *repairer.ECRepairer
 	SegmentRepairer *repairer.SegmentRepairer
 	Repairer        *repairer.Service
+
+	// Server is set only when the repair coordinator is enabled.
+	Server            *server.Server
+	RepairCoordinator *repairer.Coordinator
 }
 
 // NewRepairer creates a new repairer peer.
@@ -79,9 +87,11 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 	repairQueue queue.RepairQueue,
 	bucketsDB buckets.DB,
 	overlayCache overlay.DB,
+	peerIdentities overlay.PeerIdentities,
 	nodeEvents nodeevents.DB,
 	reputationdb reputation.DB,
 	containmentDB audit.Containment,
+	auditHistoryDB audit.HistoryDB,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel,
 ) (*Repairer, error) {
 	peer := &Repairer{
@@ -200,12 +210,18 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 	}
 
 	{ // setup audit
+		var auditHistory audit.HistoryDB
+		if config.Audit.HistoryRetention > 0 {
+			auditHistory = auditHistoryDB
+		}
+
 		peer.Audit.Reporter = audit.NewReporter(
 			log.Named("reporter"),
 			peer.Reputation,
 			peer.Overlay,
 			metabaseDB,
 			containmentDB,
+			auditHistory,
 			config.Audit.MaxRetriesStatDB,
 			int32(config.Audit.MaxReverifyCount))
 	}
@@ -253,6 +269,47 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 
 	}
 
+	if config.RepairCoordinator.Enabled { // setup repair coordinator
+		sc := config.Server
+		sc.Address = config.RepairCoordinator.Address
+		sc.PrivateAddress = config.RepairCoordinator.PrivateAddress
+
+		tlsOptions, err := tlsopts.NewOptions(peer.Identity, sc.Config, revocationDB)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Server, err = server.New(log.Named("server"), tlsOptions, sc)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.RepairCoordinator, err = repairer.NewCoordinator(
+			log.Named("repair:coordinator"),
+			repairQueue,
+			peer.SegmentRepairer,
+			pointerverification.NewService(peerIdentities, peer.Overlay, nil, false),
+			&config.Repairer,
+			config.RepairCoordinator,
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		if err := internalpb.DRPCRegisterRepairCoordinator(peer.Server.DRPC(), peer.RepairCoordinator); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Servers.Add(lifecycle.Item{
+			Name: "server",
+			Run: func(ctx context.Context) error {
+				peer.Log.Info(fmt.Sprintf("Repair coordinator started on %s", peer.Server.Addr()))
+				return peer.Server.Run(ctx)
+			},
+			Close: peer.Server.Close,
+		})
+	}
+
 	return peer, nil
 }
 
@@ -0,0 +1,172 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package replication_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/replication"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDB_Rules(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		rdb := db.Replication()
+		projectID := testrand.UUID()
+
+		rules, err := rdb.ListRules(ctx, projectID, "source")
+		require.NoError(t, err)
+		require.Empty(t, rules)
+
+		rule := replication.Rule{
+			ProjectID:         projectID,
+			BucketName:        "source",
+			DestinationBucket: "destination",
+			Prefix:            "photos/",
+			CreatedAt:         time.Now().Truncate(time.Microsecond),
+		}
+		require.NoError(t, rdb.SetRule(ctx, rule))
+
+		rule.ReplicateDeletes = true
+		require.NoError(t, rdb.SetRule(ctx, rule))
+
+		rules, err = rdb.ListRules(ctx, projectID, "source")
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		require.Equal(t, rule.DestinationBucket, rules[0].DestinationBucket)
+		require.Equal(t, rule.Prefix, rules[0].Prefix)
+		require.True(t, rules[0].ReplicateDeletes)
+		require.WithinDuration(t, rule.CreatedAt, rules[0].CreatedAt, time.Second)
+
+		require.NoError(t, rdb.DeleteRule(ctx, projectID, "source", "destination"))
+		err = rdb.DeleteRule(ctx, projectID, "source", "destination")
+		require.True(t, replication.ErrRuleNotFound.Has(err))
+	})
+}
+
+func TestDB_Queue(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		rdb := db.Replication()
+
+		location := metabase.ObjectLocation{
+			ProjectID:  testrand.UUID(),
+			BucketName: "source",
+			ObjectKey:  metabase.ObjectKey(testrand.Bytes(16)),
+		}
+		job := replication.Job{
+			ProjectID:         location.ProjectID,
+			BucketName:        location.BucketName,
+			ObjectKey:         location.ObjectKey,
+			Version:           1,
+			StreamID:          testrand.UUID(),
+			DestinationBucket: "destination",
+			Action:            replication.ActionCopy,
+		}
+		require.NoError(t, rdb.Enqueue(ctx, []replication.Job{job}))
+
+		claimed, err := rdb.Claim(ctx, replication.StatusPending, time.Now(), 10)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		require.Equal(t, job.StreamID, claimed[0].StreamID)
+		require.Equal(t, replication.StatusPending, claimed[0].Status)
+
+		// claimed jobs are not returned again until they are old enough.
+		claimed, err = rdb.Claim(ctx, replication.StatusPending, time.Now().Add(-time.Hour), 10)
+		require.NoError(t, err)
+		require.Empty(t, claimed)
+
+		job.Status = replication.StatusReplicating
+		job.DestinationStreamID = testrand.UUID()
+		job.DestinationVersion = 5
+		require.NoError(t, rdb.Update(ctx, job))
+
+		got, err := rdb.Get(ctx, location, 1, "destination", replication.ActionCopy)
+		require.NoError(t, err)
+		require.Equal(t, replication.StatusReplicating, got.Status)
+		require.Equal(t, job.DestinationStreamID, got.DestinationStreamID)
+		require.Equal(t, job.DestinationVersion, got.DestinationVersion)
+
+		_, err = rdb.Get(ctx, location, 1, "destination", replication.ActionDelete)
+		require.True(t, replication.ErrJobNotFound.Has(err))
+
+		job.Status = replication.StatusCompleted
+		job.UpdatedAt = time.Now().Add(-time.Hour)
+		require.NoError(t, rdb.Update(ctx, job))
+
+		statuses, err := rdb.ObjectStatus(ctx, location, 1)
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		require.Equal(t, replication.StatusCompleted, statuses[0].Status)
+
+		deleted, err := rdb.DeleteFinished(ctx, time.Now())
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deleted)
+
+		statuses, err = rdb.ObjectStatus(ctx, location, 1)
+		require.NoError(t, err)
+		require.Empty(t, statuses)
+	})
+}
+
+func TestDB_EnqueueMissing(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		rdb := db.Replication()
+
+		rule := replication.Rule{
+			ProjectID:         testrand.UUID(),
+			BucketName:        "source",
+			DestinationBucket: "destination",
+			CreatedAt:         time.Now().Truncate(time.Microsecond),
+		}
+		require.NoError(t, rdb.SetRule(ctx, rule))
+
+		rules, err := rdb.ListAllRules(ctx)
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		require.Equal(t, rule.ProjectID, rules[0].ProjectID)
+		require.Equal(t, rule.DestinationBucket, rules[0].DestinationBucket)
+
+		location := metabase.ObjectLocation{
+			ProjectID:  rule.ProjectID,
+			BucketName: rule.BucketName,
+			ObjectKey:  metabase.ObjectKey(testrand.Bytes(16)),
+		}
+		job := replication.Job{
+			ProjectID:         location.ProjectID,
+			BucketName:        location.BucketName,
+			ObjectKey:         location.ObjectKey,
+			Version:           1,
+			StreamID:          testrand.UUID(),
+			DestinationBucket: rule.DestinationBucket,
+			Action:            replication.ActionCopy,
+		}
+		require.NoError(t, rdb.Enqueue(ctx, []replication.Job{job}))
+
+		job.Status = replication.StatusCompleted
+		require.NoError(t, rdb.Update(ctx, job))
+
+		// queued jobs are left as they are.
+		other := job
+		other.Version = 2
+		other.StreamID = testrand.UUID()
+		other.Status = replication.StatusPending
+		require.NoError(t, rdb.EnqueueMissing(ctx, []replication.Job{job, other}))
+
+		got, err := rdb.Get(ctx, location, 1, rule.DestinationBucket, replication.ActionCopy)
+		require.NoError(t, err)
+		require.Equal(t, replication.StatusCompleted, got.Status)
+
+		got, err = rdb.Get(ctx, location, 2, rule.DestinationBucket, replication.ActionCopy)
+		require.NoError(t, err)
+		require.Equal(t, replication.StatusPending, got.Status)
+		require.Equal(t, other.StreamID, got.StreamID)
+	})
+}
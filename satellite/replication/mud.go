@@ -0,0 +1,15 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package replication
+
+import (
+	"storj.io/storj/shared/modular/config"
+	"storj.io/storj/shared/mud"
+)
+
+// Module is a mud module.
+func Module(ball *mud.Ball) {
+	config.RegisterConfig[Config](ball, "replication")
+	mud.Provide[*Service](ball, NewService)
+}
@@ -0,0 +1,206 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package replication implements asynchronous bucket-to-bucket replication.
+//
+// Objects committed into a bucket with replication rules are queued. Workers
+// copy the object metadata into the destination bucket, keeping the encrypted
+// object key, metadata and segment keys, and assign the destination bucket
+// placement to the copied segments. The repairer then moves the pieces onto
+// nodes which satisfy the destination placement, so the data never round-trips
+// through the client.
+//
+// Because the encrypted object key is copied as is, replicas can be read only
+// with an access which uses the source bucket encryption key for the
+// destination bucket (e.g. the same bucket name in another satellite project,
+// or an access with an overridden bucket encryption key).
+package replication
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the replication package.
+	Error = errs.Class("replication")
+
+	// ErrRuleNotFound is returned when a replication rule does not exist.
+	ErrRuleNotFound = errs.Class("replication rule not found")
+	// ErrInvalidRule is returned when a replication rule is not valid.
+	ErrInvalidRule = errs.Class("invalid replication rule")
+	// ErrJobNotFound is returned when a replication job does not exist.
+	ErrJobNotFound = errs.Class("replication job not found")
+)
+
+// Rule describes replication of objects from a source bucket into a
+// destination bucket of the same project.
+type Rule struct {
+	ProjectID         uuid.UUID
+	BucketName        metabase.BucketName
+	DestinationBucket metabase.BucketName
+
+	// Prefix limits the replication to objects with the specified encrypted
+	// object key prefix. Empty prefix matches all objects.
+	Prefix metabase.ObjectKey
+	// ReplicateDeletes indicates whether deletes in the source bucket are
+	// replicated into the destination bucket.
+	ReplicateDeletes bool
+
+	CreatedAt time.Time
+}
+
+// Verify checks whether the rule is valid.
+func (rule Rule) Verify() error {
+	switch {
+	case rule.ProjectID.IsZero():
+		return ErrInvalidRule.New("project ID is missing")
+	case rule.BucketName == "":
+		return ErrInvalidRule.New("bucket name is missing")
+	case rule.DestinationBucket == "":
+		return ErrInvalidRule.New("destination bucket name is missing")
+	case rule.BucketName == rule.DestinationBucket:
+		return ErrInvalidRule.New("destination bucket must differ from the source bucket")
+	}
+	return nil
+}
+
+// Matches returns whether the object key is covered by the rule.
+func (rule Rule) Matches(key metabase.ObjectKey) bool {
+	return bytes.HasPrefix([]byte(key), []byte(rule.Prefix))
+}
+
+// Action is the kind of operation replicated into the destination bucket.
+type Action int
+
+const (
+	// ActionCopy copies a committed object version into the destination bucket.
+	ActionCopy Action = 1
+	// ActionDelete replicates a delete of an object into the destination bucket.
+	ActionDelete Action = 2
+)
+
+// String implements fmt.Stringer.
+func (action Action) String() string {
+	switch action {
+	case ActionCopy:
+		return "copy"
+	case ActionDelete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// Status is the replication status of an object version.
+type Status int
+
+const (
+	// StatusPending means that the job is waiting in the queue.
+	StatusPending Status = 1
+	// StatusReplicating means that the object was copied into the destination
+	// bucket and its pieces are being moved into the destination placement.
+	StatusReplicating Status = 2
+	// StatusCompleted means that the replication finished.
+	StatusCompleted Status = 3
+	// StatusFailed means that the replication failed permanently.
+	StatusFailed Status = 4
+)
+
+// String implements fmt.Stringer.
+func (status Status) String() string {
+	switch status {
+	case StatusPending:
+		return "pending"
+	case StatusReplicating:
+		return "replicating"
+	case StatusCompleted:
+		return "completed"
+	case StatusFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// Job is a replication of a single object version into a destination bucket.
+type Job struct {
+	ProjectID  uuid.UUID
+	BucketName metabase.BucketName
+	ObjectKey  metabase.ObjectKey
+	Version    metabase.Version
+	StreamID   uuid.UUID
+
+	DestinationBucket metabase.BucketName
+	Action            Action
+	Status            Status
+
+	// DestinationStreamID and DestinationVersion identify the replica
+	// created by a copy job.
+	DestinationStreamID uuid.UUID
+	DestinationVersion  metabase.Version
+
+	Attempts  int
+	LastError string
+
+	InsertedAt time.Time
+	UpdatedAt  time.Time
+}
+
+// Location returns the location of the source object.
+func (job Job) Location() metabase.ObjectLocation {
+	return metabase.ObjectLocation{
+		ProjectID:  job.ProjectID,
+		BucketName: job.BucketName,
+		ObjectKey:  job.ObjectKey,
+	}
+}
+
+// DestinationLocation returns the location of the replica.
+func (job Job) DestinationLocation() metabase.ObjectLocation {
+	return metabase.ObjectLocation{
+		ProjectID:  job.ProjectID,
+		BucketName: job.DestinationBucket,
+		ObjectKey:  job.ObjectKey,
+	}
+}
+
+// DB stores replication rules and the replication queue.
+//
+// architecture: Database
+type DB interface {
+	// SetRule creates or updates a replication rule.
+	SetRule(ctx context.Context, rule Rule) error
+	// DeleteRule deletes a replication rule.
+	DeleteRule(ctx context.Context, projectID uuid.UUID, bucketName, destinationBucket metabase.BucketName) error
+	// ListRules returns replication rules of a bucket.
+	ListRules(ctx context.Context, projectID uuid.UUID, bucketName metabase.BucketName) ([]Rule, error)
+	// ListAllRules returns replication rules of all buckets.
+	ListAllRules(ctx context.Context) ([]Rule, error)
+
+	// Enqueue adds jobs to the queue. Jobs which are already queued are reset to pending.
+	Enqueue(ctx context.Context, jobs []Job) error
+	// EnqueueMissing adds jobs to the queue, which are not queued yet. Jobs
+	// which are already queued are not changed.
+	EnqueueMissing(ctx context.Context, jobs []Job) error
+	// Claim returns up to limit jobs with the specified status which were not
+	// updated since updatedBefore, and marks them as updated.
+	Claim(ctx context.Context, status Status, updatedBefore time.Time, limit int) ([]Job, error)
+	// Update updates the status of a job.
+	Update(ctx context.Context, job Job) error
+	// Get returns a single job.
+	Get(ctx context.Context, location metabase.ObjectLocation, version metabase.Version, destinationBucket metabase.BucketName, action Action) (Job, error)
+	// ObjectStatus returns all jobs of an object version.
+	ObjectStatus(ctx context.Context, location metabase.ObjectLocation, version metabase.Version) ([]Job, error)
+	// DeleteFinished deletes completed and failed jobs last updated before the specified time.
+	DeleteFinished(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package replication_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/replicationpb"
+	"storj.io/storj/satellite/replication"
+)
+
+func TestRule_Verify(t *testing.T) {
+	valid := replication.Rule{
+		ProjectID:         testrand.UUID(),
+		BucketName:        "source",
+		DestinationBucket: "destination",
+	}
+	require.NoError(t, valid.Verify())
+
+	for _, tc := range []struct {
+		name   string
+		modify func(rule *replication.Rule)
+	}{
+		{"missing project", func(rule *replication.Rule) { rule.ProjectID = [16]byte{} }},
+		{"missing bucket", func(rule *replication.Rule) { rule.BucketName = "" }},
+		{"missing destination", func(rule *replication.Rule) { rule.DestinationBucket = "" }},
+		{"same bucket", func(rule *replication.Rule) { rule.DestinationBucket = rule.BucketName }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rule := valid
+			tc.modify(&rule)
+			require.True(t, replication.ErrInvalidRule.Has(rule.Verify()))
+		})
+	}
+}
+
+func TestRule_Matches(t *testing.T) {
+	rule := replication.Rule{}
+	require.True(t, rule.Matches(""))
+	require.True(t, rule.Matches("any/key"))
+
+	rule.Prefix = "photos/"
+	require.True(t, rule.Matches("photos/cat.jpg"))
+	require.True(t, rule.Matches("photos/"))
+	require.False(t, rule.Matches("photo"))
+	require.False(t, rule.Matches("videos/photos/dog.mp4"))
+}
+
+func TestStatusToProto(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jobs := []replication.Job{
+		{DestinationBucket: "bucket-eu", Action: replication.ActionCopy, Status: replication.StatusCompleted, UpdatedAt: updatedAt},
+		{DestinationBucket: "bucket-us", Action: replication.ActionCopy, Status: replication.StatusPending, UpdatedAt: updatedAt},
+		{DestinationBucket: "bucket-eu", Action: replication.ActionDelete, Status: replication.StatusPending, UpdatedAt: updatedAt},
+	}
+
+	data, err := pb.Marshal(&replicationpb.GetObjectReplicationStatusResponse{
+		ReplicationStatus: replication.StatusToProto(jobs),
+	})
+	require.NoError(t, err)
+
+	var status replicationpb.GetObjectReplicationStatusResponse
+	require.NoError(t, pb.Unmarshal(data, &status))
+	require.Len(t, status.ReplicationStatus, 2)
+	require.Equal(t, []byte("bucket-eu"), status.ReplicationStatus[0].DestinationBucket)
+	require.Equal(t, replicationpb.ReplicationStatus_COMPLETED, status.ReplicationStatus[0].Status)
+	require.True(t, updatedAt.Equal(status.ReplicationStatus[0].UpdatedAt))
+	require.Equal(t, []byte("bucket-us"), status.ReplicationStatus[1].DestinationBucket)
+	require.Equal(t, replicationpb.ReplicationStatus_PENDING, status.ReplicationStatus[1].Status)
+}
@@ -0,0 +1,228 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package replication
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/replicationpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/shared/lrucache"
+)
+
+// Config contains configurable values for bucket replication.
+type Config struct {
+	Enabled bool `help:"whether bucket replication is enabled" default:"false"`
+
+	Interval       time.Duration `help:"how often the replication queue is processed" releaseDefault:"1m" devDefault:"10s" testDefault:"$TESTINTERVAL"`
+	BatchSize      int           `help:"number of replication jobs processed in a single iteration" default:"100"`
+	VerifyInterval time.Duration `help:"how long to wait between checks whether the replicated pieces were moved into the destination placement" releaseDefault:"1h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	MaxAttempts    int           `help:"number of failed attempts after which a replication job is marked as failed" default:"5"`
+	Retention      time.Duration `help:"how long finished replication jobs are kept for status reporting" default:"720h"`
+
+	ReconcileInterval time.Duration `help:"how often recently committed objects are checked for replication jobs which failed to be queued, 0 disables the check" default:"1h"`
+	ReconcileWindow   time.Duration `help:"how far back committed objects are checked for missing replication jobs, it should be shorter than the retention of finished jobs" default:"24h"`
+
+	RulesCacheExpiration time.Duration `help:"how long replication rules of a bucket are cached" default:"5m" testDefault:"0"`
+	RulesCacheCapacity   int           `help:"number of buckets which replication rules are cached" default:"10000"`
+}
+
+// Service manages replication rules and enqueues replication jobs.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	rules *lrucache.ExpiringLRUOf[[]Rule]
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new replication service.
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	return &Service{
+		log:    log,
+		db:     db,
+		config: config,
+		rules: lrucache.NewOf[[]Rule](lrucache.Options{
+			Expiration: config.RulesCacheExpiration,
+			Capacity:   config.RulesCacheCapacity,
+			Name:       "replication-rules",
+		}),
+		nowFn: time.Now,
+	}
+}
+
+// SetRule creates or updates a replication rule.
+func (service *Service) SetRule(ctx context.Context, rule Rule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := rule.Verify(); err != nil {
+		return err
+	}
+	if rule.CreatedAt.IsZero() {
+		rule.CreatedAt = service.nowFn()
+	}
+
+	err = service.db.SetRule(ctx, rule)
+	service.rules.Delete(ctx, ruleCacheKey(rule.ProjectID, rule.BucketName))
+	return Error.Wrap(err)
+}
+
+// DeleteRule deletes a replication rule.
+func (service *Service) DeleteRule(ctx context.Context, projectID uuid.UUID, bucketName, destinationBucket metabase.BucketName) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.db.DeleteRule(ctx, projectID, bucketName, destinationBucket)
+	service.rules.Delete(ctx, ruleCacheKey(projectID, bucketName))
+	if ErrRuleNotFound.Has(err) {
+		return err
+	}
+	return Error.Wrap(err)
+}
+
+// ListRules returns the replication rules of a bucket.
+func (service *Service) ListRules(ctx context.Context, projectID uuid.UUID, bucketName metabase.BucketName) (_ []Rule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rules, err := service.db.ListRules(ctx, projectID, bucketName)
+	return rules, Error.Wrap(err)
+}
+
+// cachedRules returns the replication rules of a bucket, possibly from cache.
+func (service *Service) cachedRules(ctx context.Context, projectID uuid.UUID, bucketName metabase.BucketName) ([]Rule, error) {
+	return service.rules.Get(ctx, ruleCacheKey(projectID, bucketName), func() ([]Rule, error) {
+		return service.db.ListRules(ctx, projectID, bucketName)
+	})
+}
+
+// ObjectCommitted enqueues replication of a committed object version.
+func (service *Service) ObjectCommitted(ctx context.Context, object metabase.Object) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled || object.Status.IsDeleteMarker() {
+		return nil
+	}
+
+	rules, err := service.cachedRules(ctx, object.ProjectID, object.BucketName)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	jobs := copyJobs(rules, object)
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	mon.Meter("replication_jobs_enqueued").Mark(len(jobs))
+	return Error.Wrap(service.db.Enqueue(ctx, jobs))
+}
+
+// ObjectsDeleted enqueues replication of deletes. It expects the result of
+// deleting a single object key; removed versions and created delete markers
+// are both replicated as a delete.
+func (service *Service) ObjectsDeleted(ctx context.Context, result metabase.DeleteObjectResult) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	deleted := make([]metabase.Object, 0, len(result.Removed)+len(result.Markers))
+	deleted = append(deleted, result.Removed...)
+	deleted = append(deleted, result.Markers...)
+	if len(deleted) == 0 {
+		return nil
+	}
+
+	rules, err := service.cachedRules(ctx, deleted[0].ProjectID, deleted[0].BucketName)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var jobs []Job
+	for _, object := range deleted {
+		jobs = append(jobs, deleteJobs(rules, object)...)
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	mon.Meter("replication_jobs_enqueued").Mark(len(jobs))
+	return Error.Wrap(service.db.Enqueue(ctx, jobs))
+}
+
+// ObjectStatus returns the replication status of an object version for every
+// destination bucket.
+func (service *Service) ObjectStatus(ctx context.Context, location metabase.ObjectLocation, version metabase.Version) (_ []Job, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	jobs, err := service.db.ObjectStatus(ctx, location, version)
+	return jobs, Error.Wrap(err)
+}
+
+// StatusToProto converts the replication status of an object version into
+// the protobuf representation. Only copy jobs are included, as they describe
+// the replicas of the version.
+func StatusToProto(jobs []Job) []*replicationpb.ReplicationStatus {
+	var statuses []*replicationpb.ReplicationStatus
+	for _, job := range jobs {
+		if job.Action != ActionCopy {
+			continue
+		}
+		statuses = append(statuses, &replicationpb.ReplicationStatus{
+			DestinationBucket: []byte(job.DestinationBucket),
+			Status:            replicationpb.ReplicationStatus_Status(job.Status),
+			UpdatedAt:         job.UpdatedAt,
+		})
+	}
+	return statuses
+}
+
+// copyJobs returns the jobs which copy the committed object version into the
+// destination buckets of the matching rules.
+func copyJobs(rules []Rule, object metabase.Object) (jobs []Job) {
+	for _, rule := range rules {
+		if !rule.Matches(object.ObjectKey) {
+			continue
+		}
+		jobs = append(jobs, newJob(rule, object, ActionCopy))
+	}
+	return jobs
+}
+
+// deleteJobs returns the jobs which replicate the delete of the object
+// version into the destination buckets of the matching rules.
+func deleteJobs(rules []Rule, object metabase.Object) (jobs []Job) {
+	for _, rule := range rules {
+		if !rule.ReplicateDeletes || !rule.Matches(object.ObjectKey) {
+			continue
+		}
+		jobs = append(jobs, newJob(rule, object, ActionDelete))
+	}
+	return jobs
+}
+
+func newJob(rule Rule, object metabase.Object, action Action) Job {
+	return Job{
+		ProjectID:         object.ProjectID,
+		BucketName:        object.BucketName,
+		ObjectKey:         object.ObjectKey,
+		Version:           object.Version,
+		StreamID:          object.StreamID,
+		DestinationBucket: rule.DestinationBucket,
+		Action:            action,
+		Status:            StatusPending,
+	}
+}
+
+func ruleCacheKey(projectID uuid.UUID, bucketName metabase.BucketName) string {
+	return projectID.String() + "/" + bucketName.String()
+}
@@ -0,0 +1,443 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package replication
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// repairSegmentHealth is the health used for segments inserted into the
+// repair queue by replication. It is high, so that the moves are processed
+// after repairs of segments which are really at risk.
+const repairSegmentHealth = 1e9
+
+// reconcileGracePeriod is how old a committed object has to be to be checked
+// by Reconcile. Jobs of newer objects may still be queued by the endpoint.
+const reconcileGracePeriod = 5 * time.Minute
+
+// Worker processes the replication queue.
+//
+// Copy jobs are copied into the destination bucket with the destination
+// placement and the copied segments are inserted into the repair queue, so
+// the repairer moves the pieces into the destination placement. Such jobs
+// stay in StatusReplicating until all pieces are in the destination placement.
+//
+// Jobs which the metainfo endpoint failed to queue are queued by Reconcile.
+//
+// architecture: Chore
+type Worker struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	metabase    *metabase.DB
+	buckets     buckets.DB
+	repairQueue queue.RepairQueue
+	overlay     *overlay.Service
+	placements  nodeselection.PlacementDefinitions
+
+	nowFn        func() time.Time
+	reconciledAt time.Time
+	Loop         *sync2.Cycle
+}
+
+// NewWorker creates a new replication worker.
+func NewWorker(log *zap.Logger, db DB, metabaseDB *metabase.DB, bucketsDB buckets.DB, repairQueue queue.RepairQueue, overlay *overlay.Service, placements nodeselection.PlacementDefinitions, config Config) *Worker {
+	return &Worker{
+		log:    log,
+		db:     db,
+		config: config,
+
+		metabase:    metabaseDB,
+		buckets:     bucketsDB,
+		repairQueue: repairQueue,
+		overlay:     overlay,
+		placements:  placements,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the worker.
+func (worker *Worker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return worker.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := worker.Process(ctx); err != nil {
+			worker.log.Error("replication failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Process processes a single batch of pending jobs and verifies a single
+// batch of replicating jobs.
+func (worker *Worker) Process(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := worker.nowFn()
+
+	// failed attempts stay pending, so they are retried in the next iteration.
+	pending, err := worker.db.Claim(ctx, StatusPending, now, worker.config.BatchSize)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, job := range pending {
+		worker.update(ctx, worker.process(ctx, job))
+	}
+
+	replicating, err := worker.db.Claim(ctx, StatusReplicating, now.Add(-worker.config.VerifyInterval), worker.config.BatchSize)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, job := range replicating {
+		worker.update(ctx, worker.verify(ctx, job))
+	}
+
+	if worker.config.Retention > 0 {
+		deleted, err := worker.db.DeleteFinished(ctx, now.Add(-worker.config.Retention))
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		mon.IntVal("replication_jobs_deleted").Observe(deleted)
+	}
+
+	if worker.config.ReconcileInterval > 0 && now.Sub(worker.reconciledAt) >= worker.config.ReconcileInterval {
+		if err := worker.Reconcile(ctx); err != nil {
+			return err
+		}
+		worker.reconciledAt = now
+	}
+
+	return nil
+}
+
+// Reconcile queues the jobs of objects committed within the reconcile window,
+// which are missing, because the endpoint failed to queue them. Copies and
+// delete markers are reconciled; deletes of unversioned objects can't be, as
+// the removed objects don't exist anymore.
+func (worker *Worker) Reconcile(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rules, err := worker.db.ListAllRules(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	now := worker.nowFn()
+	since, until := now.Add(-worker.config.ReconcileWindow), now.Add(-reconcileGracePeriod)
+
+	// the rules are ordered by project and bucket.
+	for len(rules) > 0 {
+		n := 1
+		for n < len(rules) && rules[n].ProjectID == rules[0].ProjectID && rules[n].BucketName == rules[0].BucketName {
+			n++
+		}
+		if err := worker.reconcileBucket(ctx, rules[:n], since, until); err != nil {
+			return Error.Wrap(err)
+		}
+		rules = rules[n:]
+	}
+	return nil
+}
+
+// reconcileBucket queues the missing jobs of objects committed into the
+// bucket of the rules between since and until.
+func (worker *Worker) reconcileBucket(ctx context.Context, rules []Rule, since, until time.Time) error {
+	var jobs []Job
+	err := worker.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+		ProjectID:  rules[0].ProjectID,
+		BucketName: rules[0].BucketName,
+		Recursive:  true,
+	}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+		var entry metabase.ObjectEntry
+		for it.Next(ctx, &entry) {
+			if entry.CreatedAt.Before(since) || !entry.CreatedAt.Before(until) {
+				continue
+			}
+
+			// objects committed before the rule was created are not replicated.
+			var active []Rule
+			for _, rule := range rules {
+				if !entry.CreatedAt.Before(rule.CreatedAt) {
+					active = append(active, rule)
+				}
+			}
+
+			object := metabase.Object{
+				ObjectStream: metabase.ObjectStream{
+					ProjectID:  rules[0].ProjectID,
+					BucketName: rules[0].BucketName,
+					ObjectKey:  entry.ObjectKey,
+					Version:    entry.Version,
+					StreamID:   entry.StreamID,
+				},
+			}
+			if entry.Status.IsDeleteMarker() {
+				jobs = append(jobs, deleteJobs(active, object)...)
+			} else {
+				jobs = append(jobs, copyJobs(active, object)...)
+			}
+
+			if len(jobs) >= worker.config.BatchSize {
+				if err := worker.db.EnqueueMissing(ctx, jobs); err != nil {
+					return err
+				}
+				jobs = jobs[:0]
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return worker.db.EnqueueMissing(ctx, jobs)
+}
+
+// update stores the job, logging failures.
+func (worker *Worker) update(ctx context.Context, job Job) {
+	mon.Meter("replication_job_" + job.Status.String()).Mark(1)
+
+	job.UpdatedAt = worker.nowFn()
+	if err := worker.db.Update(ctx, job); err != nil {
+		worker.log.Error("unable to update replication job",
+			zap.Stringer("Project ID", job.ProjectID),
+			zap.Stringer("Stream ID", job.StreamID),
+			zap.Error(err))
+	}
+}
+
+// fail records an error for the job and marks the job failed when it
+// exceeded the maximum number of attempts or when the error is permanent.
+func (worker *Worker) fail(job Job, err error, permanent bool) Job {
+	job.Attempts++
+	job.LastError = err.Error()
+	if permanent || job.Attempts >= worker.config.MaxAttempts {
+		job.Status = StatusFailed
+		worker.log.Warn("replication job failed",
+			zap.Stringer("Project ID", job.ProjectID),
+			zap.Stringer("Stream ID", job.StreamID),
+			zap.Stringer("Action", job.Action),
+			zap.Error(err))
+	}
+	return job
+}
+
+// process replicates a pending job.
+func (worker *Worker) process(ctx context.Context, job Job) Job {
+	destination, err := worker.buckets.GetBucket(ctx, []byte(job.DestinationBucket), job.ProjectID)
+	if err != nil {
+		return worker.fail(job, err, buckets.ErrBucketNotFound.Has(err))
+	}
+
+	switch job.Action {
+	case ActionCopy:
+		return worker.copy(ctx, job, destination)
+	case ActionDelete:
+		return worker.delete(ctx, job, destination)
+	default:
+		return worker.fail(job, Error.New("unknown action %d", job.Action), true)
+	}
+}
+
+// copy copies the source object version into the destination bucket.
+func (worker *Worker) copy(ctx context.Context, job Job, destination buckets.Bucket) Job {
+	source, err := worker.metabase.BeginCopyObject(ctx, metabase.BeginCopyObject{
+		ObjectLocation: job.Location(),
+		Version:        job.Version,
+	})
+	if err != nil {
+		return worker.fail(job, err, metabase.ErrObjectNotFound.Has(err))
+	}
+	if source.StreamID != job.StreamID {
+		return worker.fail(job, Error.New("object version was replaced"), true)
+	}
+
+	newStreamID, err := uuid.New()
+	if err != nil {
+		return worker.fail(job, err, false)
+	}
+
+	var metadataKeyNonce storj.Nonce
+	if len(source.EncryptedMetadataKeyNonce) > 0 {
+		metadataKeyNonce, err = storj.NonceFromBytes(source.EncryptedMetadataKeyNonce)
+		if err != nil {
+			return worker.fail(job, err, true)
+		}
+	}
+
+	placement := destination.Placement
+	object, err := worker.metabase.FinishCopyObject(ctx, metabase.FinishCopyObject{
+		ObjectStream: metabase.ObjectStream{
+			ProjectID:  job.ProjectID,
+			BucketName: job.BucketName,
+			ObjectKey:  job.ObjectKey,
+			Version:    job.Version,
+			StreamID:   job.StreamID,
+		},
+		NewStreamID:                  newStreamID,
+		NewSegmentKeys:               source.EncryptedKeysNonces,
+		NewBucket:                    job.DestinationBucket,
+		NewEncryptedObjectKey:        job.ObjectKey,
+		NewEncryptedMetadataKeyNonce: metadataKeyNonce,
+		NewEncryptedMetadataKey:      source.EncryptedMetadataKey,
+
+		NewVersioned: destination.Versioning == buckets.VersioningEnabled,
+		NewPlacement: &placement,
+	})
+	if err != nil {
+		return worker.fail(job, err, false)
+	}
+
+	job.Status = StatusReplicating
+	job.DestinationStreamID = object.StreamID
+	job.DestinationVersion = object.Version
+	job.LastError = ""
+
+	return worker.verify(ctx, job)
+}
+
+// delete replicates a delete of the source object version.
+//
+// Versions in a versioned destination bucket are never removed, the delete is
+// mirrored with a delete marker instead, so the replica can still be used to
+// recover from deletes in the source bucket.
+func (worker *Worker) delete(ctx context.Context, job Job, destination buckets.Bucket) Job {
+	var err error
+	if destination.Versioning == buckets.VersioningEnabled || destination.Versioning == buckets.VersioningSuspended {
+		_, err = worker.metabase.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+			ObjectLocation: job.DestinationLocation(),
+			Versioned:      destination.Versioning == buckets.VersioningEnabled,
+			Suspended:      destination.Versioning == buckets.VersioningSuspended,
+		})
+	} else {
+		var copied Job
+		copied, err = worker.db.Get(ctx, job.Location(), job.Version, job.DestinationBucket, ActionCopy)
+		switch {
+		case err == nil:
+			if copied.DestinationVersion == 0 {
+				job.Status = StatusCompleted
+				return job
+			}
+			_, err = worker.metabase.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: job.DestinationLocation(),
+				Version:        copied.DestinationVersion,
+			})
+		case ErrJobNotFound.Has(err):
+			// the version was not replicated (e.g. it is a delete marker), so we
+			// delete the latest replica the same way as the source was deleted.
+			_, err = worker.metabase.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: job.DestinationLocation(),
+			})
+		}
+	}
+	if err != nil && !metabase.ErrObjectNotFound.Has(err) {
+		return worker.fail(job, err, false)
+	}
+
+	job.Status = StatusCompleted
+	job.LastError = ""
+	return job
+}
+
+// verify checks whether all pieces of the replica are in the destination
+// placement and queues segments which are not for repair.
+func (worker *Worker) verify(ctx context.Context, job Job) Job {
+	object, err := worker.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+		ObjectLocation: job.DestinationLocation(),
+		Version:        job.DestinationVersion,
+	})
+	if err != nil {
+		return worker.fail(job, err, metabase.ErrObjectNotFound.Has(err))
+	}
+	if object.StreamID != job.DestinationStreamID {
+		return worker.fail(job, Error.New("replica was replaced"), true)
+	}
+
+	var injured []*queue.InjuredSegment
+	cursor := metabase.SegmentPosition{}
+	for {
+		result, err := worker.metabase.ListSegments(ctx, metabase.ListSegments{
+			ProjectID: job.ProjectID,
+			StreamID:  job.DestinationStreamID,
+			Cursor:    cursor,
+			Limit:     int(metabase.ListLimit),
+		})
+		if err != nil {
+			return worker.fail(job, err, false)
+		}
+
+		for _, segment := range result.Segments {
+			inPlacement, err := worker.inPlacement(ctx, segment)
+			if err != nil {
+				return worker.fail(job, err, false)
+			}
+			if !inPlacement {
+				injured = append(injured, &queue.InjuredSegment{
+					StreamID:      segment.StreamID,
+					Position:      segment.Position,
+					SegmentHealth: repairSegmentHealth,
+					Placement:     segment.Placement,
+				})
+			}
+		}
+
+		if !result.More || len(result.Segments) == 0 {
+			break
+		}
+		cursor = result.Segments[len(result.Segments)-1].Position
+	}
+
+	if len(injured) == 0 {
+		job.Status = StatusCompleted
+		return job
+	}
+
+	if _, err := worker.repairQueue.InsertBatch(ctx, injured); err != nil {
+		return worker.fail(job, err, false)
+	}
+	mon.Meter("replication_segments_queued_for_repair").Mark(len(injured))
+
+	return job
+}
+
+// inPlacement returns whether all pieces of the segment are on nodes in the
+// placement of the segment.
+func (worker *Worker) inPlacement(ctx context.Context, segment metabase.Segment) (bool, error) {
+	if segment.Inline() {
+		return true, nil
+	}
+
+	nodeIDs := make(storj.NodeIDList, len(segment.Pieces))
+	for i, piece := range segment.Pieces {
+		nodeIDs[i] = piece.StorageNode
+	}
+	nodes, err := worker.overlay.GetActiveNodes(ctx, nodeIDs)
+	if err != nil {
+		return false, err
+	}
+
+	result := repair.ClassifySegmentPieces(segment.Pieces, nodes, nil, true, false, worker.placements[segment.Placement], int(segment.Redundancy.RepairShares))
+	return result.ForcingRepair.Count() == 0, nil
+}
+
+// Close closes the worker.
+func (worker *Worker) Close() error {
+	worker.Loop.Close()
+	return nil
+}
@@ -107,12 +107,17 @@ func (service *Service) ApplyAudit(ctx context.Context, nodeID storj.NodeID, rep
 
 	// There are some cases where the caller did not get updated reputation-status information.
 	// (Usually this means the node was offline, disqualified, or exited and we skipped creating an order limit for it.)
-	var nodeExited bool
-	if reputation.Email == "" {
-		dossier, err := service.overlay.Get(ctx, nodeID)
+	// The maintenance window of the node is needed only for offline audits.
+	var dossier *overlay.NodeDossier
+	if reputation.Email == "" || result == AuditOffline {
+		dossier, err = service.overlay.Get(ctx, nodeID)
 		if err != nil {
 			return err
 		}
+	}
+
+	var nodeExited bool
+	if reputation.Email == "" {
 		reputation = dossier.Reputation.Status
 		if dossier.ExitStatus.ExitFinishedAt != nil {
 			nodeExited = true
@@ -125,6 +130,13 @@ func (service *Service) ApplyAudit(ctx context.Context, nodeID storj.NodeID, rep
 	}
 
 	now := time.Now()
+
+	// Offline audits during the maintenance window declared by the node are not
+	// counted against the online score.
+	if result == AuditOffline && dossier.Maintenance.Active(now) {
+		mon.Counter("audit_offline_in_maintenance").Inc(1)
+		return nil
+	}
 	statusUpdate, err := service.db.Update(ctx, UpdateRequest{
 		NodeID:       nodeID,
 		AuditOutcome: result,
@@ -239,3 +239,44 @@ func TestExitedAndDQNodesGetNoAudit(t *testing.T) {
 		require.Zero(t, info.TotalAuditCount)
 	})
 }
+
+func TestApplyAuditOfflineInMaintenance(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		nodeID := planet.StorageNodes[0].ID()
+		service := planet.Satellites[0].Reputation.Service
+
+		totalAudits := func() (total int32) {
+			node, err := service.Get(ctx, nodeID)
+			require.NoError(t, err)
+			if node.AuditHistory == nil {
+				return 0
+			}
+			for _, window := range node.AuditHistory.Windows {
+				total += window.TotalCount
+			}
+			return total
+		}
+
+		_, err := planet.Satellites[0].Overlay.Service.DeclareMaintenance(ctx, nodeID, time.Time{}, time.Hour)
+		require.NoError(t, err)
+
+		// offline audits are ignored during the maintenance
+		err = service.ApplyAudit(ctx, nodeID, overlay.ReputationStatus{}, reputation.AuditOffline)
+		require.NoError(t, err)
+		require.Zero(t, totalAudits())
+
+		// but the other audits are applied
+		err = service.ApplyAudit(ctx, nodeID, overlay.ReputationStatus{}, reputation.AuditSuccess)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, totalAudits())
+
+		_, err = planet.Satellites[0].Overlay.Service.CancelMaintenance(ctx, nodeID)
+		require.NoError(t, err)
+
+		err = service.ApplyAudit(ctx, nodeID, overlay.ReputationStatus{}, reputation.AuditOffline)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, totalAudits())
+	})
+}
@@ -0,0 +1,203 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/shared/dbutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// ensure that auditHistory implements audit.HistoryDB.
+var _ audit.HistoryDB = (*auditHistory)(nil)
+
+// auditHistory implements storj.io/storj/satellite/audit.HistoryDB.
+type auditHistory struct {
+	db *satelliteDB
+}
+
+// auditHistoryDeleteBatchSize is the number of outcomes deleted by a single
+// query when removing expired outcomes.
+const auditHistoryDeleteBatchSize = 1000
+
+// Insert adds audit outcomes to the history. Outcomes which are already
+// recorded are ignored.
+func (h *auditHistory) Insert(ctx context.Context, entries []audit.HistoryEntry) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var query strings.Builder
+	switch h.db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		query.WriteString(`INSERT INTO audit_outcomes (node_id, created_at, stream_id, position, outcome, reverify_count) VALUES `)
+	case dbutil.Spanner:
+		query.WriteString(`INSERT OR IGNORE INTO audit_outcomes (node_id, created_at, stream_id, position, outcome, reverify_count) VALUES `)
+	default:
+		return Error.New("unsupported database: %v", h.db.impl)
+	}
+
+	args := make([]any, 0, len(entries)*6)
+	for i, entry := range entries {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(?, ?, ?, ?, ?, ?)")
+		args = append(args, entry.NodeID.Bytes(), entry.CreatedAt, entry.StreamID.Bytes(), entry.Position.Encode(), int(entry.Outcome), int64(entry.ReverifyCount))
+	}
+	if h.db.impl != dbutil.Spanner {
+		query.WriteString(" ON CONFLICT DO NOTHING")
+	}
+
+	_, err = h.db.ExecContext(ctx, h.db.Rebind(query.String()), args...)
+	return Error.Wrap(err)
+}
+
+// List returns up to limit of the most recent audit outcomes of the node,
+// newest first.
+func (h *auditHistory) List(ctx context.Context, nodeID storj.NodeID, limit int) (_ []audit.HistoryEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := h.db.QueryContext(ctx, h.db.Rebind(`
+		SELECT created_at, stream_id, position, outcome, reverify_count
+		FROM audit_outcomes
+		WHERE node_id = ?
+		ORDER BY created_at DESC, stream_id, position
+		LIMIT ?
+	`), nodeID.Bytes(), int64(limit))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var entries []audit.HistoryEntry
+	for rows.Next() {
+		var streamID []byte
+		var position uint64
+		var outcome, reverifyCount int64
+		entry := audit.HistoryEntry{NodeID: nodeID}
+		if err := rows.Scan(&entry.CreatedAt, &streamID, &position, &outcome, &reverifyCount); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		entry.StreamID, err = uuid.FromBytes(streamID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		entry.Position = metabase.SegmentPositionFromEncoded(position)
+		entry.Outcome = audit.HistoryOutcome(outcome)
+		entry.ReverifyCount = int(reverifyCount)
+		entries = append(entries, entry)
+	}
+	return entries, Error.Wrap(rows.Err())
+}
+
+// DeleteBefore removes audit outcomes recorded before the given time.
+func (h *auditHistory) DeleteBefore(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	var args []any
+	switch h.db.impl {
+	case dbutil.Cockroach:
+		query = `
+			DELETE FROM audit_outcomes
+			WHERE created_at < $1
+			LIMIT $2`
+		args = []any{before, auditHistoryDeleteBatchSize}
+	case dbutil.Postgres:
+		query = `
+			DELETE FROM audit_outcomes
+			WHERE ctid IN (
+				SELECT ctid
+				FROM audit_outcomes
+				WHERE created_at < $1
+				LIMIT $2
+			)`
+		args = []any{before, auditHistoryDeleteBatchSize}
+	case dbutil.Spanner:
+		return h.deleteBeforeSpanner(ctx, before)
+	default:
+		return 0, Error.New("unsupported database: %v", h.db.impl)
+	}
+
+	for {
+		result, err := h.db.ExecContext(ctx, query, args...)
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+		deleted += affected
+		if affected == 0 {
+			return deleted, nil
+		}
+	}
+}
+
+// deleteBeforeSpanner removes the expired audit outcomes on Spanner, which
+// doesn't support LIMIT in DELETE. The keys of a batch are selected first,
+// and the batch is deleted by the keys.
+func (h *auditHistory) deleteBeforeSpanner(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	type outcomeKey struct {
+		NodeID    []byte
+		CreatedAt time.Time
+		StreamID  []byte
+		Position  int64
+	}
+
+	for {
+		var keys []outcomeKey
+		err := withRows(h.db.QueryContext(ctx, `
+			SELECT node_id, created_at, stream_id, position
+			FROM audit_outcomes
+			WHERE created_at < ?
+			LIMIT ?`, before, auditHistoryDeleteBatchSize))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var key outcomeKey
+				if err := rows.Scan(&key.NodeID, &key.CreatedAt, &key.StreamID, &key.Position); err != nil {
+					return err
+				}
+				keys = append(keys, key)
+			}
+			return nil
+		})
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+		if len(keys) == 0 {
+			return deleted, nil
+		}
+
+		result, err := h.db.ExecContext(ctx, `
+			DELETE FROM audit_outcomes
+			WHERE STRUCT<NodeID BYTES, CreatedAt TIMESTAMP, StreamID BYTES, Position INT64>(node_id, created_at, stream_id, position) IN UNNEST(?)`,
+			keys)
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+		deleted += affected
+		if len(keys) < auditHistoryDeleteBatchSize {
+			return deleted, nil
+		}
+	}
+}
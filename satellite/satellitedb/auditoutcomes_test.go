@@ -0,0 +1,119 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAuditHistory(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		history := db.AuditHistory()
+
+		nodeID, otherNodeID := testrand.NodeID(), testrand.NodeID()
+		now := time.Now().Truncate(time.Second).UTC()
+
+		var entries []audit.HistoryEntry
+		for i := 0; i < 5; i++ {
+			entries = append(entries, audit.HistoryEntry{
+				NodeID:        nodeID,
+				StreamID:      testrand.UUID(),
+				Position:      metabase.SegmentPosition{Part: 1, Index: uint32(i)},
+				Outcome:       audit.HistoryOutcome(i),
+				ReverifyCount: i,
+				CreatedAt:     now.Add(-time.Duration(i) * time.Hour),
+			})
+		}
+		entries = append(entries, audit.HistoryEntry{
+			NodeID:    otherNodeID,
+			StreamID:  testrand.UUID(),
+			Outcome:   audit.HistorySuccess,
+			CreatedAt: now,
+		})
+
+		require.NoError(t, history.Insert(ctx, entries))
+		// inserting the same outcomes again is ignored.
+		require.NoError(t, history.Insert(ctx, entries[:1]))
+
+		listed, err := history.List(ctx, nodeID, 10)
+		require.NoError(t, err)
+		require.Len(t, listed, 5)
+		for i, entry := range listed {
+			require.Equal(t, entries[i].StreamID, entry.StreamID)
+			require.Equal(t, entries[i].Position, entry.Position)
+			require.Equal(t, entries[i].Outcome, entry.Outcome)
+			require.Equal(t, entries[i].ReverifyCount, entry.ReverifyCount)
+			require.WithinDuration(t, entries[i].CreatedAt, entry.CreatedAt, time.Second)
+		}
+
+		listed, err = history.List(ctx, nodeID, 2)
+		require.NoError(t, err)
+		require.Len(t, listed, 2)
+		require.Equal(t, entries[0].StreamID, listed[0].StreamID)
+
+		deleted, err := history.DeleteBefore(ctx, now.Add(-90*time.Minute))
+		require.NoError(t, err)
+		require.EqualValues(t, 3, deleted)
+
+		listed, err = history.List(ctx, nodeID, 10)
+		require.NoError(t, err)
+		require.Len(t, listed, 2)
+
+		listed, err = history.List(ctx, otherNodeID, 10)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+	})
+}
+
+func TestAuditHistory_DeleteBeforeBatches(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		history := db.AuditHistory()
+
+		nodeID := testrand.NodeID()
+		now := time.Now().Truncate(time.Second).UTC()
+
+		// more than a single deletion batch of expired outcomes.
+		const expired = 2500
+		for i := 0; i < expired; i += 100 {
+			var entries []audit.HistoryEntry
+			for k := i; k < i+100; k++ {
+				entries = append(entries, audit.HistoryEntry{
+					NodeID:    nodeID,
+					StreamID:  testrand.UUID(),
+					Position:  metabase.SegmentPosition{Index: uint32(k)},
+					Outcome:   audit.HistorySuccess,
+					CreatedAt: now.Add(-48*time.Hour - time.Duration(k)*time.Second),
+				})
+			}
+			require.NoError(t, history.Insert(ctx, entries))
+		}
+
+		recent := audit.HistoryEntry{
+			NodeID:    nodeID,
+			StreamID:  testrand.UUID(),
+			Outcome:   audit.HistoryFailure,
+			CreatedAt: now,
+		}
+		require.NoError(t, history.Insert(ctx, []audit.HistoryEntry{recent}))
+
+		deleted, err := history.DeleteBefore(ctx, now.Add(-time.Hour))
+		require.NoError(t, err)
+		require.EqualValues(t, expired, deleted)
+
+		listed, err := history.List(ctx, nodeID, 10)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		require.Equal(t, recent.StreamID, listed[0].StreamID)
+	})
+}
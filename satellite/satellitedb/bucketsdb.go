@@ -4,6 +4,7 @@
 package satellitedb
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"errors"
@@ -636,3 +637,400 @@ func (db *bucketsDB) GetBucketObjectLockEnabled(ctx context.Context, bucketName
 	}
 	return row.ObjectLockEnabled, nil
 }
+
+// bucketLocationTables are the tables, besides bucket_metainfos, which are
+// keyed by the bucket location and are moved together with the bucket.
+// The columns exclude project_id and bucket_name.
+//
+// TestBucketLocationTables verifies the list against the database schema.
+var bucketLocationTables = []struct {
+	table   string
+	columns string
+}{
+	{"value_attributions", "user_agent, last_updated"},
+	{"bucket_storage_tallies", "interval_start, total_bytes, inline, remote, total_segments_count, remote_segments_count, inline_segments_count, object_count, metadata_size"},
+	{"bucket_bandwidth_rollups", "interval_start, interval_seconds, action, inline, allocated, settled"},
+	{"bucket_bandwidth_rollup_archives", "interval_start, interval_seconds, action, inline, allocated, settled"},
+	{"bucket_placement_migrations", "placement, segments_total, segments_migrated, created_at, checked_at, completed_at"},
+}
+
+// bucketMetainfoColumns are the columns of bucket_metainfos, excluding project_id and name.
+const bucketMetainfoColumns = `id, user_agent, versioning, object_lock_enabled,
+	default_retention_mode, default_retention_days, default_retention_years,
+	path_cipher, created_at, default_segment_size,
+	default_encryption_cipher_suite, default_encryption_block_size,
+	default_redundancy_algorithm, default_redundancy_share_size,
+	default_redundancy_required_shares, default_redundancy_repair_shares,
+	default_redundancy_optimal_shares, default_redundancy_total_shares,
+	placement, created_by`
+
+// StartBucketMove records a move of the bucket into the destination project
+// and creates the bucket in the destination project, which reserves the name.
+// Starting a move which is already in progress into the same project is a no-op.
+func (db *bucketsDB) StartBucketMove(ctx context.Context, bucket metabase.BucketLocation, destination uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		move, err := tx.Get_BucketMove_By_ProjectId_And_BucketName(ctx,
+			dbx.BucketMove_ProjectId(bucket.ProjectID[:]),
+			dbx.BucketMove_BucketName([]byte(bucket.BucketName)),
+		)
+		switch {
+		case err == nil:
+			if !bytes.Equal(move.DestinationProjectId, destination[:]) {
+				return buckets.ErrConflict.New("bucket is already being moved into a different project")
+			}
+			return nil
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		}
+
+		_, err = tx.Get_BucketMetainfo_By_ProjectId_And_Name(ctx,
+			dbx.BucketMetainfo_ProjectId(bucket.ProjectID[:]),
+			dbx.BucketMetainfo_Name([]byte(bucket.BucketName)),
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return buckets.ErrBucketNotFound.New("%s", bucket.BucketName)
+			}
+			return err
+		}
+
+		exists, err := tx.Has_BucketMetainfo_By_ProjectId_And_Name(ctx,
+			dbx.BucketMetainfo_ProjectId(destination[:]),
+			dbx.BucketMetainfo_Name([]byte(bucket.BucketName)),
+		)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return buckets.ErrBucketAlreadyExists.New("%s", bucket.BucketName)
+		}
+
+		var rules int64
+		err = tx.Tx.QueryRowContext(ctx, db.db.Rebind(`
+			SELECT COUNT(*) FROM bucket_replication_rules
+			WHERE project_id = ? AND (bucket_name = ? OR destination_bucket_name = ?)
+		`), bucket.ProjectID[:], []byte(bucket.BucketName), []byte(bucket.BucketName)).Scan(&rules)
+		if err != nil {
+			return err
+		}
+		if rules > 0 {
+			return buckets.ErrConflict.New("bucket has replication rules, which must be removed before moving it")
+		}
+
+		err = tx.CreateNoReturn_BucketMove(ctx,
+			dbx.BucketMove_ProjectId(bucket.ProjectID[:]),
+			dbx.BucketMove_BucketName([]byte(bucket.BucketName)),
+			dbx.BucketMove_DestinationProjectId(destination[:]),
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, db.db.Rebind(`
+			INSERT INTO bucket_metainfos (project_id, name, `+bucketMetainfoColumns+`)
+			SELECT ?, name, `+bucketMetainfoColumns+` FROM bucket_metainfos
+			WHERE project_id = ? AND name = ?
+		`), destination[:], bucket.ProjectID[:], []byte(bucket.BucketName))
+		return err
+	})
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) || buckets.ErrBucketAlreadyExists.Has(err) || buckets.ErrConflict.Has(err) {
+			return err
+		}
+		return buckets.ErrBucket.Wrap(err)
+	}
+	return nil
+}
+
+// FinishBucketMove moves attribution, storage tallies and bandwidth rollups of
+// a bucket, whose move was started with StartBucketMove, into the destination
+// project and removes the bucket from the source project. Finishing a move,
+// which was already finished, returns the moved bucket.
+//
+// Rows are re-inserted under the new location, because Spanner doesn't allow
+// updating primary key columns.
+func (db *bucketsDB) FinishBucketMove(ctx context.Context, bucket metabase.BucketLocation, destination uuid.UUID) (_ buckets.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var moved buckets.Bucket
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		move, err := tx.Get_BucketMove_By_ProjectId_And_BucketName(ctx,
+			dbx.BucketMove_ProjectId(bucket.ProjectID[:]),
+			dbx.BucketMove_BucketName([]byte(bucket.BucketName)),
+		)
+		switch {
+		case err == nil:
+			if !bytes.Equal(move.DestinationProjectId, destination[:]) {
+				return buckets.ErrConflict.New("bucket is being moved into a different project")
+			}
+
+			for _, t := range bucketLocationTables {
+				_, err = tx.Tx.ExecContext(ctx, db.db.Rebind(`
+					INSERT INTO `+t.table+` (project_id, bucket_name, `+t.columns+`)
+					SELECT ?, bucket_name, `+t.columns+` FROM `+t.table+`
+					WHERE project_id = ? AND bucket_name = ?
+				`), destination[:], bucket.ProjectID[:], []byte(bucket.BucketName))
+				if err != nil {
+					return err
+				}
+
+				_, err = tx.Tx.ExecContext(ctx, db.db.Rebind(`
+					DELETE FROM `+t.table+` WHERE project_id = ? AND bucket_name = ?
+				`), bucket.ProjectID[:], []byte(bucket.BucketName))
+				if err != nil {
+					return err
+				}
+			}
+
+			_, err = tx.Delete_BucketMetainfo_By_ProjectId_And_Name(ctx,
+				dbx.BucketMetainfo_ProjectId(bucket.ProjectID[:]),
+				dbx.BucketMetainfo_Name([]byte(bucket.BucketName)),
+			)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Delete_BucketMove_By_ProjectId_And_BucketName(ctx,
+				dbx.BucketMove_ProjectId(bucket.ProjectID[:]),
+				dbx.BucketMove_BucketName([]byte(bucket.BucketName)),
+			)
+			if err != nil {
+				return err
+			}
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		default:
+			// the move was already finished when the source bucket is gone.
+			exists, err := tx.Has_BucketMetainfo_By_ProjectId_And_Name(ctx,
+				dbx.BucketMetainfo_ProjectId(bucket.ProjectID[:]),
+				dbx.BucketMetainfo_Name([]byte(bucket.BucketName)),
+			)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return buckets.ErrConflict.New("bucket move was not started")
+			}
+		}
+
+		row, err := tx.Get_BucketMetainfo_By_ProjectId_And_Name(ctx,
+			dbx.BucketMetainfo_ProjectId(destination[:]),
+			dbx.BucketMetainfo_Name([]byte(bucket.BucketName)),
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return buckets.ErrBucketNotFound.New("%s", bucket.BucketName)
+			}
+			return err
+		}
+		moved, err = convertDBXtoBucket(row)
+		return err
+	})
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) || buckets.ErrConflict.Has(err) {
+			return buckets.Bucket{}, err
+		}
+		return buckets.Bucket{}, buckets.ErrBucket.Wrap(err)
+	}
+	return moved, nil
+}
+
+// IsBucketMoving returns whether a bucket is being moved out of or into the
+// project. Only moves in progress have a row, so scanning by the destination
+// project doesn't need an index.
+func (db *bucketsDB) IsBucketMoving(ctx context.Context, bucketName []byte, projectID uuid.UUID) (moving bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var count int64
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT COUNT(*) FROM bucket_moves
+		WHERE bucket_name = ? AND (project_id = ? OR destination_project_id = ?)
+	`), bucketName, projectID[:], projectID[:]).Scan(&count)
+	if err != nil {
+		return false, buckets.ErrBucket.Wrap(err)
+	}
+	return count > 0, nil
+}
+
+// ChangeBucketPlacement changes the placement of a bucket and starts a new
+// placement migration of the bucket in a single transaction. A previous
+// migration of the bucket is replaced.
+func (db *bucketsDB) ChangeBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID, placement storj.PlacementConstraint) (_ buckets.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var bucket buckets.Bucket
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		row, err := tx.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+			dbx.BucketMetainfo_ProjectId(projectID[:]),
+			dbx.BucketMetainfo_Name(bucketName),
+			dbx.BucketMetainfo_Update_Fields{
+				Placement: dbx.BucketMetainfo_Placement(int(placement)),
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if row == nil {
+			return buckets.ErrBucketNotFound.New("%s", bucketName)
+		}
+
+		_, err = tx.Delete_BucketPlacementMigration_By_ProjectId_And_BucketName(ctx,
+			dbx.BucketPlacementMigration_ProjectId(projectID[:]),
+			dbx.BucketPlacementMigration_BucketName(bucketName),
+		)
+		if err != nil {
+			return err
+		}
+
+		err = tx.CreateNoReturn_BucketPlacementMigration(ctx,
+			dbx.BucketPlacementMigration_ProjectId(projectID[:]),
+			dbx.BucketPlacementMigration_BucketName(bucketName),
+			dbx.BucketPlacementMigration_Placement(int(placement)),
+			dbx.BucketPlacementMigration_Create_Fields{},
+		)
+		if err != nil {
+			return err
+		}
+
+		bucket, err = convertDBXtoBucket(row)
+		return err
+	})
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			return buckets.Bucket{}, err
+		}
+		return buckets.Bucket{}, buckets.ErrBucket.Wrap(err)
+	}
+	return bucket, nil
+}
+
+// GetPlacementMigration returns the placement migration of a bucket. A migration
+// of a bucket, which was deleted or whose placement was changed without a
+// migration, is not returned.
+func (db *bucketsDB) GetPlacementMigration(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ buckets.PlacementMigration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row, err := db.db.Get_BucketPlacementMigration_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketPlacementMigration_ProjectId(projectID[:]),
+		dbx.BucketPlacementMigration_BucketName(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.PlacementMigration{}, buckets.ErrPlacementMigrationNotFound.New("%s", bucketName)
+		}
+		return buckets.PlacementMigration{}, buckets.ErrBucket.Wrap(err)
+	}
+
+	current, err := db.isCurrentPlacementMigration(ctx, row)
+	if err != nil {
+		return buckets.PlacementMigration{}, buckets.ErrBucket.Wrap(err)
+	}
+	if !current {
+		return buckets.PlacementMigration{}, buckets.ErrPlacementMigrationNotFound.New("%s", bucketName)
+	}
+
+	migration, err := convertDBXtoPlacementMigration(row)
+	return migration, buckets.ErrBucket.Wrap(err)
+}
+
+// ListPlacementMigrations returns all placement migrations which are not
+// completed. Migrations of buckets, which were deleted or whose placement was
+// changed without a migration, are ignored.
+func (db *bucketsDB) ListPlacementMigrations(ctx context.Context) (_ []buckets.PlacementMigration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.All_BucketPlacementMigration_By_CompletedAt_Is_Null_OrderBy_Asc_ProjectId_Asc_BucketName(ctx)
+	if err != nil {
+		return nil, buckets.ErrBucket.Wrap(err)
+	}
+
+	migrations := make([]buckets.PlacementMigration, 0, len(rows))
+	for _, row := range rows {
+		current, err := db.isCurrentPlacementMigration(ctx, row)
+		if err != nil {
+			return nil, buckets.ErrBucket.Wrap(err)
+		}
+		if !current {
+			continue
+		}
+
+		migration, err := convertDBXtoPlacementMigration(row)
+		if err != nil {
+			return nil, buckets.ErrBucket.Wrap(err)
+		}
+		migrations = append(migrations, migration)
+	}
+	return migrations, nil
+}
+
+// isCurrentPlacementMigration returns whether the bucket of the migration
+// exists and has the placement of the migration.
+func (db *bucketsDB) isCurrentPlacementMigration(ctx context.Context, row *dbx.BucketPlacementMigration) (bool, error) {
+	bucket, err := db.db.Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(row.ProjectId),
+		dbx.BucketMetainfo_Name(row.BucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var placement int
+	if bucket.Placement != nil {
+		placement = *bucket.Placement
+	}
+	return placement == row.Placement, nil
+}
+
+// UpdatePlacementMigrations updates the progress of placement migrations.
+// Migrations which were restarted with a different placement are not updated.
+func (db *bucketsDB) UpdatePlacementMigrations(ctx context.Context, migrations []buckets.PlacementMigration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(migrations) == 0 {
+		return nil
+	}
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		for _, migration := range migrations {
+			err := tx.UpdateNoReturn_BucketPlacementMigration_By_ProjectId_And_BucketName_And_Placement(ctx,
+				dbx.BucketPlacementMigration_ProjectId(migration.ProjectID[:]),
+				dbx.BucketPlacementMigration_BucketName([]byte(migration.BucketName)),
+				dbx.BucketPlacementMigration_Placement(int(migration.Placement)),
+				dbx.BucketPlacementMigration_Update_Fields{
+					SegmentsTotal:    dbx.BucketPlacementMigration_SegmentsTotal(migration.SegmentsTotal),
+					SegmentsMigrated: dbx.BucketPlacementMigration_SegmentsMigrated(migration.SegmentsMigrated),
+					CheckedAt:        dbx.BucketPlacementMigration_CheckedAt_Raw(migration.CheckedAt),
+					CompletedAt:      dbx.BucketPlacementMigration_CompletedAt_Raw(migration.CompletedAt),
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return buckets.ErrBucket.Wrap(err)
+}
+
+func convertDBXtoPlacementMigration(row *dbx.BucketPlacementMigration) (buckets.PlacementMigration, error) {
+	projectID, err := uuid.FromBytes(row.ProjectId)
+	if err != nil {
+		return buckets.PlacementMigration{}, err
+	}
+
+	return buckets.PlacementMigration{
+		ProjectID:        projectID,
+		BucketName:       metabase.BucketName(row.BucketName),
+		Placement:        storj.PlacementConstraint(row.Placement),
+		CreatedAt:        row.CreatedAt,
+		SegmentsTotal:    row.SegmentsTotal,
+		SegmentsMigrated: row.SegmentsMigrated,
+		CheckedAt:        row.CheckedAt,
+		CompletedAt:      row.CompletedAt,
+	}, nil
+}
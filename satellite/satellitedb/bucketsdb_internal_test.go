@@ -0,0 +1,86 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestBucketLocationTables verifies that the tables and columns, which are
+// moved together with a bucket, match the database schema.
+func TestBucketLocationTables(t *testing.T) {
+	schema, err := os.ReadFile("dbx/satellitedb.dbx.pgx.sql")
+	require.NoError(t, err)
+
+	// tables which are keyed by the bucket location, but aren't moved
+	// together with the bucket.
+	notMoved := []string{
+		// buckets with replication rules can't be moved.
+		"bucket_replication_rules",
+		"replication_jobs",
+		// tracks the move itself.
+		"bucket_moves",
+	}
+
+	tables := map[string][]string{}
+	for _, match := range regexp.MustCompile(`(?s)CREATE TABLE (\w+) \((.*?)\n\) ;`).FindAllSubmatch(schema, -1) {
+		var columns []string
+		for _, line := range strings.Split(string(match[2]), "\n") {
+			fields := strings.Fields(line)
+			if len(fields) == 0 || fields[0] == "PRIMARY" || fields[0] == "UNIQUE" || fields[0] == "CONSTRAINT" {
+				continue
+			}
+			columns = append(columns, fields[0])
+		}
+		tables[string(match[1])] = columns
+	}
+
+	splitColumns := func(columns string) []string {
+		var result []string
+		for _, column := range strings.Split(columns, ",") {
+			result = append(result, strings.TrimSpace(column))
+		}
+		slices.Sort(result)
+		return result
+	}
+	withoutColumns := func(columns []string, exclude ...string) []string {
+		var result []string
+		for _, column := range columns {
+			if !slices.Contains(exclude, column) {
+				result = append(result, column)
+			}
+		}
+		slices.Sort(result)
+		return result
+	}
+
+	require.Contains(t, tables, "bucket_metainfos")
+	require.Equal(t, withoutColumns(tables["bucket_metainfos"], "project_id", "name"), splitColumns(bucketMetainfoColumns))
+
+	moved := map[string]string{}
+	for _, location := range bucketLocationTables {
+		moved[location.table] = location.columns
+	}
+
+	for table, columns := range tables {
+		if !slices.Contains(columns, "project_id") || !slices.Contains(columns, "bucket_name") {
+			continue
+		}
+		if slices.Contains(notMoved, table) {
+			require.NotContains(t, moved, table)
+			continue
+		}
+
+		require.Contains(t, moved, table, "table %q is keyed by the bucket location, but isn't moved with the bucket", table)
+		require.Equal(t, withoutColumns(columns, "project_id", "bucket_name"), splitColumns(moved[table]), table)
+		delete(moved, table)
+	}
+	require.Empty(t, moved, "moved tables are missing from the schema")
+}
@@ -19,8 +19,10 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/durability"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/nodetags"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
@@ -28,6 +30,7 @@ import (
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
 	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/replication"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/satellitedb/consoledb"
@@ -212,6 +215,11 @@ func (dbc *satelliteDBCollection) RepairQueue() queue.RepairQueue {
 	return &repairQueue{db: dbc.getByName("repairqueue")}
 }
 
+// Replication is a getter for bucket replication database.
+func (dbc *satelliteDBCollection) Replication() replication.DB {
+	return &replicationDB{db: dbc.getByName("replication")}
+}
+
 // VerifyQueue is a getter for VerifyQueue database.
 func (dbc *satelliteDBCollection) VerifyQueue() audit.VerifyQueue {
 	return &verifyQueue{db: dbc.getByName("verifyqueue")}
@@ -222,6 +230,21 @@ func (dbc *satelliteDBCollection) ReverifyQueue() audit.ReverifyQueue {
 	return &reverifyQueue{db: dbc.getByName("reverifyqueue")}
 }
 
+// AuditHistory is a getter for the audit history database.
+func (dbc *satelliteDBCollection) AuditHistory() audit.HistoryDB {
+	return &auditHistory{db: dbc.getByName("audithistory")}
+}
+
+// Durability returns database for the results of the correlated durability report.
+func (dbc *satelliteDBCollection) Durability() durability.DB {
+	return &durabilityResults{db: dbc.getByName("durability")}
+}
+
+// NodeTags returns database for the node tags signed by the satellite.
+func (dbc *satelliteDBCollection) NodeTags() nodetags.DB {
+	return &nodeTags{db: dbc.getByName("nodetags")}
+}
+
 // StoragenodeAccounting returns database for tracking storagenode usage.
 func (dbc *satelliteDBCollection) StoragenodeAccounting() accounting.StoragenodeAccounting {
 	return &StoragenodeAccounting{db: dbc.getByName("storagenodeaccounting")}
@@ -385,6 +385,16 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( name )
 )`,
 
+		`CREATE TABLE audit_outcomes (
+	node_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	outcome integer NOT NULL,
+	reverify_count bigint NOT NULL,
+	PRIMARY KEY ( node_id, created_at, stream_id, position )
+)`,
+
 		`CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -431,6 +441,36 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 )`,
 
+		`CREATE TABLE bucket_moves (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	destination_project_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
+		`CREATE TABLE bucket_placement_migrations (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	placement integer NOT NULL,
+	segments_total bigint NOT NULL DEFAULT 0,
+	segments_migrated bigint NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	checked_at timestamp with time zone,
+	completed_at timestamp with time zone,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
+		`CREATE TABLE bucket_replication_rules (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	destination_bucket_name bytea NOT NULL,
+	prefix bytea NOT NULL,
+	replicate_deletes boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( project_id, bucket_name, destination_bucket_name )
+)`,
+
 		`CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -459,6 +499,19 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( id )
 )`,
 
+		`CREATE TABLE durability_results (
+	created_at timestamp with time zone NOT NULL,
+	placement integer NOT NULL,
+	class_name text NOT NULL,
+	healthy_margin integer NOT NULL,
+	segment_count bigint NOT NULL,
+	lost_segments bigint NOT NULL,
+	total_segments bigint NOT NULL,
+	segment_exemplars text NOT NULL,
+	class_exemplars text NOT NULL,
+	PRIMARY KEY ( created_at, placement, class_name )
+)`,
+
 		`CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -485,6 +538,16 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 )`,
 
+		`CREATE TABLE node_tag_changes (
+	node_id bytea NOT NULL,
+	name text NOT NULL,
+	value bytea NOT NULL,
+	action text NOT NULL,
+	changed_by text NOT NULL,
+	changed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, name, changed_at )
+)`,
+
 		`CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -525,6 +588,13 @@ func (obj *pgxDB) Schema() []string {
 	noise_public_key bytea,
 	debounce_limit integer NOT NULL DEFAULT 0,
 	features integer NOT NULL DEFAULT 0,
+	asn bigint,
+	as_organization text,
+	maintenance_start timestamp with time zone,
+	maintenance_end timestamp with time zone,
+	last_tcp_success timestamp with time zone,
+	last_quic_success timestamp with time zone,
+	last_noise_success timestamp with time zone,
 	PRIMARY KEY ( id )
 )`,
 
@@ -666,6 +736,24 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( stream_id, position )
 )`,
 
+		`CREATE TABLE replication_jobs (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_key bytea NOT NULL,
+	version bigint NOT NULL,
+	stream_id bytea NOT NULL,
+	destination_bucket_name bytea NOT NULL,
+	action integer NOT NULL,
+	status integer NOT NULL,
+	destination_stream_id bytea,
+	destination_version bigint,
+	attempts integer NOT NULL DEFAULT 0,
+	last_error text,
+	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( project_id, bucket_name, object_key, version, destination_bucket_name, action )
+)`,
+
 		`CREATE TABLE reputations (
 	id bytea NOT NULL,
 	audit_success_count bigint NOT NULL DEFAULT 0,
@@ -1003,6 +1091,8 @@ func (obj *pgxDB) Schema() []string {
 
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
+		`CREATE INDEX audit_outcomes_created_at_index ON audit_outcomes ( created_at )`,
+
 		`CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp )`,
 
 		`CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start )`,
@@ -1021,6 +1111,8 @@ func (obj *pgxDB) Schema() []string {
 
 		`CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at ) WHERE node_events.email_sent is NULL`,
 
+		`CREATE INDEX node_tag_changes_changed_at_index ON node_tag_changes ( changed_at )`,
+
 		`CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id )`,
 
 		`CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id )`,
@@ -1043,6 +1135,8 @@ func (obj *pgxDB) Schema() []string {
 
 		`CREATE INDEX repair_queue_placement_index ON repair_queue ( placement )`,
 
+		`CREATE INDEX replication_jobs_status_updated_at_index ON replication_jobs ( status, updated_at )`,
+
 		`CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at )`,
 
 		`CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start )`,
@@ -1132,6 +1226,8 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS reputations`,
 
+		`DROP TABLE IF EXISTS replication_jobs`,
+
 		`DROP TABLE IF EXISTS repair_queue`,
 
 		`DROP TABLE IF EXISTS registration_tokens`,
@@ -1156,14 +1252,24 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS nodes`,
 
+		`DROP TABLE IF EXISTS node_tag_changes`,
+
 		`DROP TABLE IF EXISTS graceful_exit_segment_transfer_queue`,
 
 		`DROP TABLE IF EXISTS graceful_exit_progress`,
 
+		`DROP TABLE IF EXISTS durability_results`,
+
 		`DROP TABLE IF EXISTS coinpayments_transactions`,
 
 		`DROP TABLE IF EXISTS bucket_storage_tallies`,
 
+		`DROP TABLE IF EXISTS bucket_replication_rules`,
+
+		`DROP TABLE IF EXISTS bucket_placement_migrations`,
+
+		`DROP TABLE IF EXISTS bucket_moves`,
+
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollup_archives`,
 
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollups`,
@@ -1172,6 +1278,8 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`DROP TABLE IF EXISTS audit_outcomes`,
+
 		`DROP TABLE IF EXISTS accounting_timestamps`,
 
 		`DROP TABLE IF EXISTS accounting_rollups`,
@@ -1308,6 +1416,16 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( name )
 )`,
 
+		`CREATE TABLE audit_outcomes (
+	node_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	outcome integer NOT NULL,
+	reverify_count bigint NOT NULL,
+	PRIMARY KEY ( node_id, created_at, stream_id, position )
+)`,
+
 		`CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -1354,6 +1472,36 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 )`,
 
+		`CREATE TABLE bucket_moves (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	destination_project_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
+		`CREATE TABLE bucket_placement_migrations (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	placement integer NOT NULL,
+	segments_total bigint NOT NULL DEFAULT 0,
+	segments_migrated bigint NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	checked_at timestamp with time zone,
+	completed_at timestamp with time zone,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
+		`CREATE TABLE bucket_replication_rules (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	destination_bucket_name bytea NOT NULL,
+	prefix bytea NOT NULL,
+	replicate_deletes boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( project_id, bucket_name, destination_bucket_name )
+)`,
+
 		`CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -1382,6 +1530,19 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( id )
 )`,
 
+		`CREATE TABLE durability_results (
+	created_at timestamp with time zone NOT NULL,
+	placement integer NOT NULL,
+	class_name text NOT NULL,
+	healthy_margin integer NOT NULL,
+	segment_count bigint NOT NULL,
+	lost_segments bigint NOT NULL,
+	total_segments bigint NOT NULL,
+	segment_exemplars text NOT NULL,
+	class_exemplars text NOT NULL,
+	PRIMARY KEY ( created_at, placement, class_name )
+)`,
+
 		`CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -1408,6 +1569,16 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 )`,
 
+		`CREATE TABLE node_tag_changes (
+	node_id bytea NOT NULL,
+	name text NOT NULL,
+	value bytea NOT NULL,
+	action text NOT NULL,
+	changed_by text NOT NULL,
+	changed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, name, changed_at )
+)`,
+
 		`CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -1448,6 +1619,13 @@ func (obj *pgxcockroachDB) Schema() []string {
 	noise_public_key bytea,
 	debounce_limit integer NOT NULL DEFAULT 0,
 	features integer NOT NULL DEFAULT 0,
+	asn bigint,
+	as_organization text,
+	maintenance_start timestamp with time zone,
+	maintenance_end timestamp with time zone,
+	last_tcp_success timestamp with time zone,
+	last_quic_success timestamp with time zone,
+	last_noise_success timestamp with time zone,
 	PRIMARY KEY ( id )
 )`,
 
@@ -1589,6 +1767,24 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( stream_id, position )
 )`,
 
+		`CREATE TABLE replication_jobs (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_key bytea NOT NULL,
+	version bigint NOT NULL,
+	stream_id bytea NOT NULL,
+	destination_bucket_name bytea NOT NULL,
+	action integer NOT NULL,
+	status integer NOT NULL,
+	destination_stream_id bytea,
+	destination_version bigint,
+	attempts integer NOT NULL DEFAULT 0,
+	last_error text,
+	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( project_id, bucket_name, object_key, version, destination_bucket_name, action )
+)`,
+
 		`CREATE TABLE reputations (
 	id bytea NOT NULL,
 	audit_success_count bigint NOT NULL DEFAULT 0,
@@ -1926,6 +2122,8 @@ func (obj *pgxcockroachDB) Schema() []string {
 
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
+		`CREATE INDEX audit_outcomes_created_at_index ON audit_outcomes ( created_at )`,
+
 		`CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp )`,
 
 		`CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start )`,
@@ -1944,6 +2142,8 @@ func (obj *pgxcockroachDB) Schema() []string {
 
 		`CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at ) WHERE node_events.email_sent is NULL`,
 
+		`CREATE INDEX node_tag_changes_changed_at_index ON node_tag_changes ( changed_at )`,
+
 		`CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id )`,
 
 		`CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id )`,
@@ -1966,6 +2166,8 @@ func (obj *pgxcockroachDB) Schema() []string {
 
 		`CREATE INDEX repair_queue_placement_index ON repair_queue ( placement )`,
 
+		`CREATE INDEX replication_jobs_status_updated_at_index ON replication_jobs ( status, updated_at )`,
+
 		`CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at )`,
 
 		`CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start )`,
@@ -2055,6 +2257,8 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS reputations`,
 
+		`DROP TABLE IF EXISTS replication_jobs`,
+
 		`DROP TABLE IF EXISTS repair_queue`,
 
 		`DROP TABLE IF EXISTS registration_tokens`,
@@ -2079,14 +2283,24 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS nodes`,
 
+		`DROP TABLE IF EXISTS node_tag_changes`,
+
 		`DROP TABLE IF EXISTS graceful_exit_segment_transfer_queue`,
 
 		`DROP TABLE IF EXISTS graceful_exit_progress`,
 
+		`DROP TABLE IF EXISTS durability_results`,
+
 		`DROP TABLE IF EXISTS coinpayments_transactions`,
 
 		`DROP TABLE IF EXISTS bucket_storage_tallies`,
 
+		`DROP TABLE IF EXISTS bucket_replication_rules`,
+
+		`DROP TABLE IF EXISTS bucket_placement_migrations`,
+
+		`DROP TABLE IF EXISTS bucket_moves`,
+
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollup_archives`,
 
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollups`,
@@ -2095,6 +2309,8 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`DROP TABLE IF EXISTS audit_outcomes`,
+
 		`DROP TABLE IF EXISTS accounting_timestamps`,
 
 		`DROP TABLE IF EXISTS accounting_rollups`,
@@ -2228,6 +2444,15 @@ func (obj *spannerDB) Schema() []string {
 	value TIMESTAMP NOT NULL
 ) PRIMARY KEY ( name )`,
 
+		`CREATE TABLE audit_outcomes (
+	node_id BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	stream_id BYTES(MAX) NOT NULL,
+	position INT64 NOT NULL,
+	outcome INT64 NOT NULL,
+	reverify_count INT64 NOT NULL
+) PRIMARY KEY ( node_id, created_at, stream_id, position )`,
+
 		`CREATE TABLE billing_balances (
 	user_id BYTES(MAX) NOT NULL,
 	balance INT64 NOT NULL,
@@ -2272,6 +2497,33 @@ func (obj *spannerDB) Schema() []string {
 	settled INT64 NOT NULL
 ) PRIMARY KEY ( bucket_name, project_id, interval_start, action )`,
 
+		`CREATE TABLE bucket_moves (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	destination_project_id BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( project_id, bucket_name )`,
+
+		`CREATE TABLE bucket_placement_migrations (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	placement INT64 NOT NULL,
+	segments_total INT64 NOT NULL DEFAULT (0),
+	segments_migrated INT64 NOT NULL DEFAULT (0),
+	created_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	checked_at TIMESTAMP,
+	completed_at TIMESTAMP
+) PRIMARY KEY ( project_id, bucket_name )`,
+
+		`CREATE TABLE bucket_replication_rules (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	destination_bucket_name BYTES(MAX) NOT NULL,
+	prefix BYTES(MAX) NOT NULL,
+	replicate_deletes BOOL NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT (current_timestamp)
+) PRIMARY KEY ( project_id, bucket_name, destination_bucket_name )`,
+
 		`CREATE TABLE bucket_storage_tallies (
 	bucket_name BYTES(MAX) NOT NULL,
 	project_id BYTES(MAX) NOT NULL,
@@ -2298,6 +2550,18 @@ func (obj *spannerDB) Schema() []string {
 	created_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( id )`,
 
+		`CREATE TABLE durability_results (
+	created_at TIMESTAMP NOT NULL,
+	placement INT64 NOT NULL,
+	class_name STRING(MAX) NOT NULL,
+	healthy_margin INT64 NOT NULL,
+	segment_count INT64 NOT NULL,
+	lost_segments INT64 NOT NULL,
+	total_segments INT64 NOT NULL,
+	segment_exemplars STRING(MAX) NOT NULL,
+	class_exemplars STRING(MAX) NOT NULL
+) PRIMARY KEY ( created_at, placement, class_name )`,
+
 		`CREATE TABLE graceful_exit_progress (
 	node_id BYTES(MAX) NOT NULL,
 	bytes_transferred INT64 NOT NULL,
@@ -2322,6 +2586,15 @@ func (obj *spannerDB) Schema() []string {
 	order_limit_send_count INT64 NOT NULL DEFAULT (0)
 ) PRIMARY KEY ( node_id, stream_id, position, piece_num )`,
 
+		`CREATE TABLE node_tag_changes (
+	node_id BYTES(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	value BYTES(MAX) NOT NULL,
+	action STRING(MAX) NOT NULL,
+	changed_by STRING(MAX) NOT NULL,
+	changed_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( node_id, name, changed_at )`,
+
 		`CREATE TABLE nodes (
 	id BYTES(MAX) NOT NULL,
 	address STRING(MAX) NOT NULL DEFAULT (""),
@@ -2361,7 +2634,14 @@ func (obj *spannerDB) Schema() []string {
 	noise_proto INT64,
 	noise_public_key BYTES(MAX),
 	debounce_limit INT64 NOT NULL DEFAULT (0),
-	features INT64 NOT NULL DEFAULT (0)
+	features INT64 NOT NULL DEFAULT (0),
+	asn INT64,
+	as_organization STRING(MAX),
+	maintenance_start TIMESTAMP,
+	maintenance_end TIMESTAMP,
+	last_tcp_success TIMESTAMP,
+	last_quic_success TIMESTAMP,
+	last_noise_success TIMESTAMP
 ) PRIMARY KEY ( id )`,
 
 		`CREATE TABLE node_api_versions (
@@ -2480,6 +2760,8 @@ func (obj *spannerDB) Schema() []string {
 	created_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( secret )`,
 
+		`CREATE INDEX node_tag_changes_changed_at_index ON node_tag_changes ( changed_at )`,
+
 		`CREATE UNIQUE INDEX index_registration_tokens_owner_id ON registration_tokens ( owner_id )`,
 
 		`CREATE TABLE repair_queue (
@@ -2492,6 +2774,23 @@ func (obj *spannerDB) Schema() []string {
 	placement INT64
 ) PRIMARY KEY ( stream_id, position )`,
 
+		`CREATE TABLE replication_jobs (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	object_key BYTES(MAX) NOT NULL,
+	version INT64 NOT NULL,
+	stream_id BYTES(MAX) NOT NULL,
+	destination_bucket_name BYTES(MAX) NOT NULL,
+	action INT64 NOT NULL,
+	status INT64 NOT NULL,
+	destination_stream_id BYTES(MAX),
+	destination_version INT64,
+	attempts INT64 NOT NULL DEFAULT (0),
+	last_error STRING(MAX),
+	inserted_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	updated_at TIMESTAMP NOT NULL DEFAULT (current_timestamp)
+) PRIMARY KEY ( project_id, bucket_name, object_key, version, destination_bucket_name, action )`,
+
 		`CREATE TABLE reputations (
 	id BYTES(MAX) NOT NULL,
 	audit_success_count INT64 NOT NULL DEFAULT (0),
@@ -2819,6 +3118,8 @@ func (obj *spannerDB) Schema() []string {
 
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
+		`CREATE INDEX audit_outcomes_created_at_index ON audit_outcomes ( created_at )`,
+
 		`CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp )`,
 
 		`CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start )`,
@@ -2859,6 +3160,8 @@ func (obj *spannerDB) Schema() []string {
 
 		`CREATE INDEX repair_queue_placement_index ON repair_queue ( placement )`,
 
+		`CREATE INDEX replication_jobs_status_updated_at_index ON replication_jobs ( status, updated_at )`,
+
 		`CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at )`,
 
 		`CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start )`,
@@ -2918,6 +3221,8 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP INDEX IF EXISTS index_api_keys_name_project_id`,
 
+		`DROP INDEX IF EXISTS node_tag_changes_changed_at_index`,
+
 		`DROP INDEX IF EXISTS index_stripecoinpayments_invoice_project_records_project_id_period_start_period_end`,
 
 		`DROP INDEX IF EXISTS index_stripe_customers_customer_id`,
@@ -2928,6 +3233,8 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP INDEX IF EXISTS accounting_rollups_start_time_index`,
 
+		`DROP INDEX IF EXISTS audit_outcomes_created_at_index`,
+
 		`DROP INDEX IF EXISTS billing_transactions_tx_timestamp_index`,
 
 		`DROP INDEX IF EXISTS bucket_bandwidth_rollups_project_id_action_interval_index`,
@@ -2968,6 +3275,8 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP INDEX IF EXISTS repair_queue_placement_index`,
 
+		`DROP INDEX IF EXISTS replication_jobs_status_updated_at_index`,
+
 		`DROP INDEX IF EXISTS reverification_audits_inserted_at_index`,
 
 		`DROP INDEX IF EXISTS storagenode_bandwidth_rollups_interval_start_index`,
@@ -3228,6 +3537,32 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS reputations`,
 
+		`ALTER TABLE  replication_jobs ALTER project_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS replication_jobs_project_id`,
+
+		`ALTER TABLE  replication_jobs ALTER bucket_name SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS replication_jobs_bucket_name`,
+
+		`ALTER TABLE  replication_jobs ALTER object_key SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS replication_jobs_object_key`,
+
+		`ALTER TABLE  replication_jobs ALTER version SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS replication_jobs_version`,
+
+		`ALTER TABLE  replication_jobs ALTER destination_bucket_name SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS replication_jobs_destination_bucket_name`,
+
+		`ALTER TABLE  replication_jobs ALTER action SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS replication_jobs_action`,
+
+		`DROP TABLE IF EXISTS replication_jobs`,
+
 		`ALTER TABLE  repair_queue ALTER stream_id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS repair_queue_stream_id`,
@@ -3316,6 +3651,20 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS nodes`,
 
+		`ALTER TABLE  node_tag_changes ALTER node_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS node_tag_changes_node_id`,
+
+		`ALTER TABLE  node_tag_changes ALTER name SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS node_tag_changes_name`,
+
+		`ALTER TABLE  node_tag_changes ALTER changed_at SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS node_tag_changes_changed_at`,
+
+		`DROP TABLE IF EXISTS node_tag_changes`,
+
 		`ALTER TABLE  graceful_exit_segment_transfer_queue ALTER node_id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS graceful_exit_segment_transfer_queue_node_id`,
@@ -3340,6 +3689,20 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS graceful_exit_progress`,
 
+		`ALTER TABLE  durability_results ALTER created_at SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS durability_results_created_at`,
+
+		`ALTER TABLE  durability_results ALTER placement SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS durability_results_placement`,
+
+		`ALTER TABLE  durability_results ALTER class_name SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS durability_results_class_name`,
+
+		`DROP TABLE IF EXISTS durability_results`,
+
 		`ALTER TABLE  coinpayments_transactions ALTER id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS coinpayments_transactions_id`,
@@ -3360,6 +3723,40 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS bucket_storage_tallies`,
 
+		`ALTER TABLE  bucket_replication_rules ALTER project_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_replication_rules_project_id`,
+
+		`ALTER TABLE  bucket_replication_rules ALTER bucket_name SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_replication_rules_bucket_name`,
+
+		`ALTER TABLE  bucket_replication_rules ALTER destination_bucket_name SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_replication_rules_destination_bucket_name`,
+
+		`DROP TABLE IF EXISTS bucket_replication_rules`,
+
+		`ALTER TABLE  bucket_placement_migrations ALTER project_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_placement_migrations_project_id`,
+
+		`ALTER TABLE  bucket_placement_migrations ALTER bucket_name SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_placement_migrations_bucket_name`,
+
+		`DROP TABLE IF EXISTS bucket_placement_migrations`,
+
+		`ALTER TABLE  bucket_moves ALTER project_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_moves_project_id`,
+
+		`ALTER TABLE  bucket_moves ALTER bucket_name SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_moves_bucket_name`,
+
+		`DROP TABLE IF EXISTS bucket_moves`,
+
 		`ALTER TABLE  bucket_bandwidth_rollup_archives ALTER bucket_name SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS bucket_bandwidth_rollup_archives_bucket_name`,
@@ -3408,6 +3805,24 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`ALTER TABLE  audit_outcomes ALTER node_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS audit_outcomes_node_id`,
+
+		`ALTER TABLE  audit_outcomes ALTER created_at SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS audit_outcomes_created_at`,
+
+		`ALTER TABLE  audit_outcomes ALTER stream_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS audit_outcomes_stream_id`,
+
+		`ALTER TABLE  audit_outcomes ALTER position SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS audit_outcomes_position`,
+
+		`DROP TABLE IF EXISTS audit_outcomes`,
+
 		`ALTER TABLE  accounting_timestamps ALTER name SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS accounting_timestamps_name`,
@@ -3886,6 +4301,122 @@ func (f AccountingTimestamps_Value_Field) value() any {
 	return f._value
 }
 
+type AuditOutcome struct {
+	NodeId        []byte
+	CreatedAt     time.Time
+	StreamId      []byte
+	Position      uint64
+	Outcome       int
+	ReverifyCount int64
+}
+
+func (AuditOutcome) _Table() string { return "audit_outcomes" }
+
+type AuditOutcome_Update_Fields struct {
+}
+
+type AuditOutcome_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditOutcome_NodeId(v []byte) AuditOutcome_NodeId_Field {
+	return AuditOutcome_NodeId_Field{_set: true, _value: v}
+}
+
+func (f AuditOutcome_NodeId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditOutcome_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func AuditOutcome_CreatedAt(v time.Time) AuditOutcome_CreatedAt_Field {
+	return AuditOutcome_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f AuditOutcome_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditOutcome_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditOutcome_StreamId(v []byte) AuditOutcome_StreamId_Field {
+	return AuditOutcome_StreamId_Field{_set: true, _value: v}
+}
+
+func (f AuditOutcome_StreamId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditOutcome_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func AuditOutcome_Position(v uint64) AuditOutcome_Position_Field {
+	return AuditOutcome_Position_Field{_set: true, _value: v}
+}
+
+func (f AuditOutcome_Position_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditOutcome_Outcome_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func AuditOutcome_Outcome(v int) AuditOutcome_Outcome_Field {
+	return AuditOutcome_Outcome_Field{_set: true, _value: v}
+}
+
+func (f AuditOutcome_Outcome_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditOutcome_ReverifyCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func AuditOutcome_ReverifyCount(v int64) AuditOutcome_ReverifyCount_Field {
+	return AuditOutcome_ReverifyCount_Field{_set: true, _value: v}
+}
+
+func (f AuditOutcome_ReverifyCount_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type BillingBalance struct {
 	UserId      []byte
 	Balance     int64
@@ -4467,6 +4998,404 @@ func (f BucketBandwidthRollupArchive_Settled_Field) value() any {
 	return f._value
 }
 
+type BucketMove struct {
+	ProjectId            []byte
+	BucketName           []byte
+	DestinationProjectId []byte
+	CreatedAt            time.Time
+}
+
+func (BucketMove) _Table() string { return "bucket_moves" }
+
+type BucketMove_Update_Fields struct {
+}
+
+type BucketMove_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMove_ProjectId(v []byte) BucketMove_ProjectId_Field {
+	return BucketMove_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketMove_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketMove_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMove_BucketName(v []byte) BucketMove_BucketName_Field {
+	return BucketMove_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketMove_BucketName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketMove_DestinationProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMove_DestinationProjectId(v []byte) BucketMove_DestinationProjectId_Field {
+	return BucketMove_DestinationProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketMove_DestinationProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketMove_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketMove_CreatedAt(v time.Time) BucketMove_CreatedAt_Field {
+	return BucketMove_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketMove_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketPlacementMigration struct {
+	ProjectId        []byte
+	BucketName       []byte
+	Placement        int
+	SegmentsTotal    int64
+	SegmentsMigrated int64
+	CreatedAt        time.Time
+	CheckedAt        *time.Time
+	CompletedAt      *time.Time
+}
+
+func (BucketPlacementMigration) _Table() string { return "bucket_placement_migrations" }
+
+type BucketPlacementMigration_Create_Fields struct {
+	SegmentsTotal    BucketPlacementMigration_SegmentsTotal_Field
+	SegmentsMigrated BucketPlacementMigration_SegmentsMigrated_Field
+	CreatedAt        BucketPlacementMigration_CreatedAt_Field
+	CheckedAt        BucketPlacementMigration_CheckedAt_Field
+	CompletedAt      BucketPlacementMigration_CompletedAt_Field
+}
+
+type BucketPlacementMigration_Update_Fields struct {
+	Placement        BucketPlacementMigration_Placement_Field
+	SegmentsTotal    BucketPlacementMigration_SegmentsTotal_Field
+	SegmentsMigrated BucketPlacementMigration_SegmentsMigrated_Field
+	CreatedAt        BucketPlacementMigration_CreatedAt_Field
+	CheckedAt        BucketPlacementMigration_CheckedAt_Field
+	CompletedAt      BucketPlacementMigration_CompletedAt_Field
+}
+
+type BucketPlacementMigration_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketPlacementMigration_ProjectId(v []byte) BucketPlacementMigration_ProjectId_Field {
+	return BucketPlacementMigration_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketPlacementMigration_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketPlacementMigration_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketPlacementMigration_BucketName(v []byte) BucketPlacementMigration_BucketName_Field {
+	return BucketPlacementMigration_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketPlacementMigration_BucketName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketPlacementMigration_Placement_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketPlacementMigration_Placement(v int) BucketPlacementMigration_Placement_Field {
+	return BucketPlacementMigration_Placement_Field{_set: true, _value: v}
+}
+
+func (f BucketPlacementMigration_Placement_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketPlacementMigration_SegmentsTotal_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BucketPlacementMigration_SegmentsTotal(v int64) BucketPlacementMigration_SegmentsTotal_Field {
+	return BucketPlacementMigration_SegmentsTotal_Field{_set: true, _value: v}
+}
+
+func (f BucketPlacementMigration_SegmentsTotal_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketPlacementMigration_SegmentsMigrated_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BucketPlacementMigration_SegmentsMigrated(v int64) BucketPlacementMigration_SegmentsMigrated_Field {
+	return BucketPlacementMigration_SegmentsMigrated_Field{_set: true, _value: v}
+}
+
+func (f BucketPlacementMigration_SegmentsMigrated_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketPlacementMigration_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketPlacementMigration_CreatedAt(v time.Time) BucketPlacementMigration_CreatedAt_Field {
+	return BucketPlacementMigration_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketPlacementMigration_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketPlacementMigration_CheckedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func BucketPlacementMigration_CheckedAt(v time.Time) BucketPlacementMigration_CheckedAt_Field {
+	return BucketPlacementMigration_CheckedAt_Field{_set: true, _value: &v}
+}
+
+func BucketPlacementMigration_CheckedAt_Raw(v *time.Time) BucketPlacementMigration_CheckedAt_Field {
+	if v == nil {
+		return BucketPlacementMigration_CheckedAt_Null()
+	}
+	return BucketPlacementMigration_CheckedAt(*v)
+}
+
+func BucketPlacementMigration_CheckedAt_Null() BucketPlacementMigration_CheckedAt_Field {
+	return BucketPlacementMigration_CheckedAt_Field{_set: true, _null: true}
+}
+
+func (f BucketPlacementMigration_CheckedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketPlacementMigration_CheckedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketPlacementMigration_CompletedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func BucketPlacementMigration_CompletedAt(v time.Time) BucketPlacementMigration_CompletedAt_Field {
+	return BucketPlacementMigration_CompletedAt_Field{_set: true, _value: &v}
+}
+
+func BucketPlacementMigration_CompletedAt_Raw(v *time.Time) BucketPlacementMigration_CompletedAt_Field {
+	if v == nil {
+		return BucketPlacementMigration_CompletedAt_Null()
+	}
+	return BucketPlacementMigration_CompletedAt(*v)
+}
+
+func BucketPlacementMigration_CompletedAt_Null() BucketPlacementMigration_CompletedAt_Field {
+	return BucketPlacementMigration_CompletedAt_Field{_set: true, _null: true}
+}
+
+func (f BucketPlacementMigration_CompletedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketPlacementMigration_CompletedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketReplicationRule struct {
+	ProjectId             []byte
+	BucketName            []byte
+	DestinationBucketName []byte
+	Prefix                []byte
+	ReplicateDeletes      bool
+	CreatedAt             time.Time
+}
+
+func (BucketReplicationRule) _Table() string { return "bucket_replication_rules" }
+
+type BucketReplicationRule_Create_Fields struct {
+	CreatedAt BucketReplicationRule_CreatedAt_Field
+}
+
+type BucketReplicationRule_Update_Fields struct {
+	Prefix           BucketReplicationRule_Prefix_Field
+	ReplicateDeletes BucketReplicationRule_ReplicateDeletes_Field
+}
+
+type BucketReplicationRule_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketReplicationRule_ProjectId(v []byte) BucketReplicationRule_ProjectId_Field {
+	return BucketReplicationRule_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketReplicationRule_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketReplicationRule_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketReplicationRule_BucketName(v []byte) BucketReplicationRule_BucketName_Field {
+	return BucketReplicationRule_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketReplicationRule_BucketName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketReplicationRule_DestinationBucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketReplicationRule_DestinationBucketName(v []byte) BucketReplicationRule_DestinationBucketName_Field {
+	return BucketReplicationRule_DestinationBucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketReplicationRule_DestinationBucketName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketReplicationRule_Prefix_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketReplicationRule_Prefix(v []byte) BucketReplicationRule_Prefix_Field {
+	return BucketReplicationRule_Prefix_Field{_set: true, _value: v}
+}
+
+func (f BucketReplicationRule_Prefix_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketReplicationRule_ReplicateDeletes_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func BucketReplicationRule_ReplicateDeletes(v bool) BucketReplicationRule_ReplicateDeletes_Field {
+	return BucketReplicationRule_ReplicateDeletes_Field{_set: true, _value: v}
+}
+
+func (f BucketReplicationRule_ReplicateDeletes_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketReplicationRule_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketReplicationRule_CreatedAt(v time.Time) BucketReplicationRule_CreatedAt_Field {
+	return BucketReplicationRule_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketReplicationRule_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type BucketStorageTally struct {
 	BucketName          []byte
 	ProjectId           []byte
@@ -4850,6 +5779,176 @@ func (f CoinpaymentsTransaction_CreatedAt_Field) value() any {
 	return f._value
 }
 
+type DurabilityResult struct {
+	CreatedAt        time.Time
+	Placement        int
+	ClassName        string
+	HealthyMargin    int
+	SegmentCount     int64
+	LostSegments     int64
+	TotalSegments    int64
+	SegmentExemplars string
+	ClassExemplars   string
+}
+
+func (DurabilityResult) _Table() string { return "durability_results" }
+
+type DurabilityResult_Update_Fields struct {
+}
+
+type DurabilityResult_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func DurabilityResult_CreatedAt(v time.Time) DurabilityResult_CreatedAt_Field {
+	return DurabilityResult_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f DurabilityResult_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityResult_Placement_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func DurabilityResult_Placement(v int) DurabilityResult_Placement_Field {
+	return DurabilityResult_Placement_Field{_set: true, _value: v}
+}
+
+func (f DurabilityResult_Placement_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityResult_ClassName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func DurabilityResult_ClassName(v string) DurabilityResult_ClassName_Field {
+	return DurabilityResult_ClassName_Field{_set: true, _value: v}
+}
+
+func (f DurabilityResult_ClassName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityResult_HealthyMargin_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func DurabilityResult_HealthyMargin(v int) DurabilityResult_HealthyMargin_Field {
+	return DurabilityResult_HealthyMargin_Field{_set: true, _value: v}
+}
+
+func (f DurabilityResult_HealthyMargin_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityResult_SegmentCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func DurabilityResult_SegmentCount(v int64) DurabilityResult_SegmentCount_Field {
+	return DurabilityResult_SegmentCount_Field{_set: true, _value: v}
+}
+
+func (f DurabilityResult_SegmentCount_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityResult_LostSegments_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func DurabilityResult_LostSegments(v int64) DurabilityResult_LostSegments_Field {
+	return DurabilityResult_LostSegments_Field{_set: true, _value: v}
+}
+
+func (f DurabilityResult_LostSegments_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityResult_TotalSegments_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func DurabilityResult_TotalSegments(v int64) DurabilityResult_TotalSegments_Field {
+	return DurabilityResult_TotalSegments_Field{_set: true, _value: v}
+}
+
+func (f DurabilityResult_TotalSegments_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityResult_SegmentExemplars_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func DurabilityResult_SegmentExemplars(v string) DurabilityResult_SegmentExemplars_Field {
+	return DurabilityResult_SegmentExemplars_Field{_set: true, _value: v}
+}
+
+func (f DurabilityResult_SegmentExemplars_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityResult_ClassExemplars_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func DurabilityResult_ClassExemplars(v string) DurabilityResult_ClassExemplars_Field {
+	return DurabilityResult_ClassExemplars_Field{_set: true, _value: v}
+}
+
+func (f DurabilityResult_ClassExemplars_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type GracefulExitProgress struct {
 	NodeId            []byte
 	BytesTransferred  int64
@@ -5340,6 +6439,13 @@ type Node struct {
 	NoisePublicKey          []byte
 	DebounceLimit           int
 	Features                int
+	Asn                     *int64
+	AsOrganization          *string
+	MaintenanceStart        *time.Time
+	MaintenanceEnd          *time.Time
+	LastTcpSuccess          *time.Time
+	LastQuicSuccess         *time.Time
+	LastNoiseSuccess        *time.Time
 }
 
 func (Node) _Table() string { return "nodes" }
@@ -5377,6 +6483,13 @@ type Node_Create_Fields struct {
 	NoisePublicKey          Node_NoisePublicKey_Field
 	DebounceLimit           Node_DebounceLimit_Field
 	Features                Node_Features_Field
+	Asn                     Node_Asn_Field
+	AsOrganization          Node_AsOrganization_Field
+	MaintenanceStart        Node_MaintenanceStart_Field
+	MaintenanceEnd          Node_MaintenanceEnd_Field
+	LastTcpSuccess          Node_LastTcpSuccess_Field
+	LastQuicSuccess         Node_LastQuicSuccess_Field
+	LastNoiseSuccess        Node_LastNoiseSuccess_Field
 }
 
 type Node_Update_Fields struct {
@@ -5416,6 +6529,13 @@ type Node_Update_Fields struct {
 	NoisePublicKey          Node_NoisePublicKey_Field
 	DebounceLimit           Node_DebounceLimit_Field
 	Features                Node_Features_Field
+	Asn                     Node_Asn_Field
+	AsOrganization          Node_AsOrganization_Field
+	MaintenanceStart        Node_MaintenanceStart_Field
+	MaintenanceEnd          Node_MaintenanceEnd_Field
+	LastTcpSuccess          Node_LastTcpSuccess_Field
+	LastQuicSuccess         Node_LastQuicSuccess_Field
+	LastNoiseSuccess        Node_LastNoiseSuccess_Field
 }
 
 type Node_Id_Field struct {
@@ -6293,6 +7413,216 @@ func (f Node_Features_Field) value() any {
 	return f._value
 }
 
+type Node_Asn_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Node_Asn(v int64) Node_Asn_Field {
+	return Node_Asn_Field{_set: true, _value: &v}
+}
+
+func Node_Asn_Raw(v *int64) Node_Asn_Field {
+	if v == nil {
+		return Node_Asn_Null()
+	}
+	return Node_Asn(*v)
+}
+
+func Node_Asn_Null() Node_Asn_Field {
+	return Node_Asn_Field{_set: true, _null: true}
+}
+
+func (f Node_Asn_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Asn_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Node_AsOrganization_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Node_AsOrganization(v string) Node_AsOrganization_Field {
+	return Node_AsOrganization_Field{_set: true, _value: &v}
+}
+
+func Node_AsOrganization_Raw(v *string) Node_AsOrganization_Field {
+	if v == nil {
+		return Node_AsOrganization_Null()
+	}
+	return Node_AsOrganization(*v)
+}
+
+func Node_AsOrganization_Null() Node_AsOrganization_Field {
+	return Node_AsOrganization_Field{_set: true, _null: true}
+}
+
+func (f Node_AsOrganization_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_AsOrganization_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Node_MaintenanceStart_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_MaintenanceStart(v time.Time) Node_MaintenanceStart_Field {
+	return Node_MaintenanceStart_Field{_set: true, _value: &v}
+}
+
+func Node_MaintenanceStart_Raw(v *time.Time) Node_MaintenanceStart_Field {
+	if v == nil {
+		return Node_MaintenanceStart_Null()
+	}
+	return Node_MaintenanceStart(*v)
+}
+
+func Node_MaintenanceStart_Null() Node_MaintenanceStart_Field {
+	return Node_MaintenanceStart_Field{_set: true, _null: true}
+}
+
+func (f Node_MaintenanceStart_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_MaintenanceStart_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Node_MaintenanceEnd_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_MaintenanceEnd(v time.Time) Node_MaintenanceEnd_Field {
+	return Node_MaintenanceEnd_Field{_set: true, _value: &v}
+}
+
+func Node_MaintenanceEnd_Raw(v *time.Time) Node_MaintenanceEnd_Field {
+	if v == nil {
+		return Node_MaintenanceEnd_Null()
+	}
+	return Node_MaintenanceEnd(*v)
+}
+
+func Node_MaintenanceEnd_Null() Node_MaintenanceEnd_Field {
+	return Node_MaintenanceEnd_Field{_set: true, _null: true}
+}
+
+func (f Node_MaintenanceEnd_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_MaintenanceEnd_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Node_LastTcpSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_LastTcpSuccess(v time.Time) Node_LastTcpSuccess_Field {
+	return Node_LastTcpSuccess_Field{_set: true, _value: &v}
+}
+
+func Node_LastTcpSuccess_Raw(v *time.Time) Node_LastTcpSuccess_Field {
+	if v == nil {
+		return Node_LastTcpSuccess_Null()
+	}
+	return Node_LastTcpSuccess(*v)
+}
+
+func Node_LastTcpSuccess_Null() Node_LastTcpSuccess_Field {
+	return Node_LastTcpSuccess_Field{_set: true, _null: true}
+}
+
+func (f Node_LastTcpSuccess_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_LastTcpSuccess_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Node_LastQuicSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_LastQuicSuccess(v time.Time) Node_LastQuicSuccess_Field {
+	return Node_LastQuicSuccess_Field{_set: true, _value: &v}
+}
+
+func Node_LastQuicSuccess_Raw(v *time.Time) Node_LastQuicSuccess_Field {
+	if v == nil {
+		return Node_LastQuicSuccess_Null()
+	}
+	return Node_LastQuicSuccess(*v)
+}
+
+func Node_LastQuicSuccess_Null() Node_LastQuicSuccess_Field {
+	return Node_LastQuicSuccess_Field{_set: true, _null: true}
+}
+
+func (f Node_LastQuicSuccess_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_LastQuicSuccess_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Node_LastNoiseSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_LastNoiseSuccess(v time.Time) Node_LastNoiseSuccess_Field {
+	return Node_LastNoiseSuccess_Field{_set: true, _value: &v}
+}
+
+func Node_LastNoiseSuccess_Raw(v *time.Time) Node_LastNoiseSuccess_Field {
+	if v == nil {
+		return Node_LastNoiseSuccess_Null()
+	}
+	return Node_LastNoiseSuccess(*v)
+}
+
+func Node_LastNoiseSuccess_Null() Node_LastNoiseSuccess_Field {
+	return Node_LastNoiseSuccess_Field{_set: true, _null: true}
+}
+
+func (f Node_LastNoiseSuccess_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_LastNoiseSuccess_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type NodeApiVersion struct {
 	Id         []byte
 	ApiVersion int
@@ -6574,6 +7904,122 @@ func (f NodeEvent_EmailSent_Field) value() any {
 	return f._value
 }
 
+type NodeTagChange struct {
+	NodeId    []byte
+	Name      string
+	Value     []byte
+	Action    string
+	ChangedBy string
+	ChangedAt time.Time
+}
+
+func (NodeTagChange) _Table() string { return "node_tag_changes" }
+
+type NodeTagChange_Update_Fields struct {
+}
+
+type NodeTagChange_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeTagChange_NodeId(v []byte) NodeTagChange_NodeId_Field {
+	return NodeTagChange_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeTagChange_NodeId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeTagChange_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeTagChange_Name(v string) NodeTagChange_Name_Field {
+	return NodeTagChange_Name_Field{_set: true, _value: v}
+}
+
+func (f NodeTagChange_Name_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeTagChange_Value_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeTagChange_Value(v []byte) NodeTagChange_Value_Field {
+	return NodeTagChange_Value_Field{_set: true, _value: v}
+}
+
+func (f NodeTagChange_Value_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeTagChange_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeTagChange_Action(v string) NodeTagChange_Action_Field {
+	return NodeTagChange_Action_Field{_set: true, _value: v}
+}
+
+func (f NodeTagChange_Action_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeTagChange_ChangedBy_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeTagChange_ChangedBy(v string) NodeTagChange_ChangedBy_Field {
+	return NodeTagChange_ChangedBy_Field{_set: true, _value: v}
+}
+
+func (f NodeTagChange_ChangedBy_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeTagChange_ChangedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeTagChange_ChangedAt(v time.Time) NodeTagChange_ChangedAt_Field {
+	return NodeTagChange_ChangedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeTagChange_ChangedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type NodeTags struct {
 	NodeId   []byte
 	Name     string
@@ -8580,6 +10026,324 @@ func (f RepairQueue_Placement_Field) value() any {
 	return f._value
 }
 
+type ReplicationJob struct {
+	ProjectId             []byte
+	BucketName            []byte
+	ObjectKey             []byte
+	Version               int64
+	StreamId              []byte
+	DestinationBucketName []byte
+	Action                int
+	Status                int
+	DestinationStreamId   []byte
+	DestinationVersion    *int64
+	Attempts              int
+	LastError             *string
+	InsertedAt            time.Time
+	UpdatedAt             time.Time
+}
+
+func (ReplicationJob) _Table() string { return "replication_jobs" }
+
+type ReplicationJob_Create_Fields struct {
+	DestinationStreamId ReplicationJob_DestinationStreamId_Field
+	DestinationVersion  ReplicationJob_DestinationVersion_Field
+	Attempts            ReplicationJob_Attempts_Field
+	LastError           ReplicationJob_LastError_Field
+	InsertedAt          ReplicationJob_InsertedAt_Field
+	UpdatedAt           ReplicationJob_UpdatedAt_Field
+}
+
+type ReplicationJob_Update_Fields struct {
+	Status              ReplicationJob_Status_Field
+	DestinationStreamId ReplicationJob_DestinationStreamId_Field
+	DestinationVersion  ReplicationJob_DestinationVersion_Field
+	Attempts            ReplicationJob_Attempts_Field
+	LastError           ReplicationJob_LastError_Field
+	UpdatedAt           ReplicationJob_UpdatedAt_Field
+}
+
+type ReplicationJob_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ReplicationJob_ProjectId(v []byte) ReplicationJob_ProjectId_Field {
+	return ReplicationJob_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ReplicationJob_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ReplicationJob_BucketName(v []byte) ReplicationJob_BucketName_Field {
+	return ReplicationJob_BucketName_Field{_set: true, _value: v}
+}
+
+func (f ReplicationJob_BucketName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_ObjectKey_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ReplicationJob_ObjectKey(v []byte) ReplicationJob_ObjectKey_Field {
+	return ReplicationJob_ObjectKey_Field{_set: true, _value: v}
+}
+
+func (f ReplicationJob_ObjectKey_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_Version_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ReplicationJob_Version(v int64) ReplicationJob_Version_Field {
+	return ReplicationJob_Version_Field{_set: true, _value: v}
+}
+
+func (f ReplicationJob_Version_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ReplicationJob_StreamId(v []byte) ReplicationJob_StreamId_Field {
+	return ReplicationJob_StreamId_Field{_set: true, _value: v}
+}
+
+func (f ReplicationJob_StreamId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_DestinationBucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ReplicationJob_DestinationBucketName(v []byte) ReplicationJob_DestinationBucketName_Field {
+	return ReplicationJob_DestinationBucketName_Field{_set: true, _value: v}
+}
+
+func (f ReplicationJob_DestinationBucketName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func ReplicationJob_Action(v int) ReplicationJob_Action_Field {
+	return ReplicationJob_Action_Field{_set: true, _value: v}
+}
+
+func (f ReplicationJob_Action_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func ReplicationJob_Status(v int) ReplicationJob_Status_Field {
+	return ReplicationJob_Status_Field{_set: true, _value: v}
+}
+
+func (f ReplicationJob_Status_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_DestinationStreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ReplicationJob_DestinationStreamId(v []byte) ReplicationJob_DestinationStreamId_Field {
+	return ReplicationJob_DestinationStreamId_Field{_set: true, _value: v}
+}
+
+func ReplicationJob_DestinationStreamId_Raw(v []byte) ReplicationJob_DestinationStreamId_Field {
+	if v == nil {
+		return ReplicationJob_DestinationStreamId_Null()
+	}
+	return ReplicationJob_DestinationStreamId(v)
+}
+
+func ReplicationJob_DestinationStreamId_Null() ReplicationJob_DestinationStreamId_Field {
+	return ReplicationJob_DestinationStreamId_Field{_set: true, _null: true}
+}
+
+func (f ReplicationJob_DestinationStreamId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f ReplicationJob_DestinationStreamId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_DestinationVersion_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func ReplicationJob_DestinationVersion(v int64) ReplicationJob_DestinationVersion_Field {
+	return ReplicationJob_DestinationVersion_Field{_set: true, _value: &v}
+}
+
+func ReplicationJob_DestinationVersion_Raw(v *int64) ReplicationJob_DestinationVersion_Field {
+	if v == nil {
+		return ReplicationJob_DestinationVersion_Null()
+	}
+	return ReplicationJob_DestinationVersion(*v)
+}
+
+func ReplicationJob_DestinationVersion_Null() ReplicationJob_DestinationVersion_Field {
+	return ReplicationJob_DestinationVersion_Field{_set: true, _null: true}
+}
+
+func (f ReplicationJob_DestinationVersion_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f ReplicationJob_DestinationVersion_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_Attempts_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func ReplicationJob_Attempts(v int) ReplicationJob_Attempts_Field {
+	return ReplicationJob_Attempts_Field{_set: true, _value: v}
+}
+
+func (f ReplicationJob_Attempts_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_LastError_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func ReplicationJob_LastError(v string) ReplicationJob_LastError_Field {
+	return ReplicationJob_LastError_Field{_set: true, _value: &v}
+}
+
+func ReplicationJob_LastError_Raw(v *string) ReplicationJob_LastError_Field {
+	if v == nil {
+		return ReplicationJob_LastError_Null()
+	}
+	return ReplicationJob_LastError(*v)
+}
+
+func ReplicationJob_LastError_Null() ReplicationJob_LastError_Field {
+	return ReplicationJob_LastError_Field{_set: true, _null: true}
+}
+
+func (f ReplicationJob_LastError_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ReplicationJob_LastError_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_InsertedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ReplicationJob_InsertedAt(v time.Time) ReplicationJob_InsertedAt_Field {
+	return ReplicationJob_InsertedAt_Field{_set: true, _value: v}
+}
+
+func (f ReplicationJob_InsertedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ReplicationJob_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ReplicationJob_UpdatedAt(v time.Time) ReplicationJob_UpdatedAt_Field {
+	return ReplicationJob_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f ReplicationJob_UpdatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type Reputation struct {
 	Id                          []byte
 	AuditSuccessCount           int64
@@ -17548,7 +19312,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.as_organization, nodes.maintenance_start, nodes.maintenance_end, nodes.last_tcp_success, nodes.last_quic_success, nodes.last_noise_success FROM nodes WHERE nodes.id = ?")
 
 	var __values []any
 	__values = append(__values, node_id.value())
@@ -17557,7 +19321,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsOrganization, &node.MaintenanceStart, &node.MaintenanceEnd, &node.LastTcpSuccess, &node.LastQuicSuccess, &node.LastNoiseSuccess)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -17616,9 +19380,9 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.as_organization, nodes.maintenance_start, nodes.maintenance_end, nodes.last_tcp_success, nodes.last_quic_success, nodes.last_noise_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.as_organization, nodes.maintenance_start, nodes.maintenance_end, nodes.last_tcp_success, nodes.last_quic_success, nodes.last_noise_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []any
 
@@ -17645,7 +19409,7 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsOrganization, &node.MaintenanceStart, &node.MaintenanceEnd, &node.LastTcpSuccess, &node.LastQuicSuccess, &node.LastNoiseSuccess, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -20703,7 +22467,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.as_organization, nodes.maintenance_start, nodes.maintenance_end, nodes.last_tcp_success, nodes.last_quic_success, nodes.last_noise_success")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -20889,6 +22653,41 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsOrganization._set {
+		__values = append(__values, update.AsOrganization.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("as_organization = ?"))
+	}
+
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
+	if update.LastTcpSuccess._set {
+		__values = append(__values, update.LastTcpSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_tcp_success = ?"))
+	}
+
+	if update.LastQuicSuccess._set {
+		__values = append(__values, update.LastQuicSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_quic_success = ?"))
+	}
+
+	if update.LastNoiseSuccess._set {
+		__values = append(__values, update.LastNoiseSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_noise_success = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -20903,7 +22702,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsOrganization, &node.MaintenanceStart, &node.MaintenanceEnd, &node.LastTcpSuccess, &node.LastQuicSuccess, &node.LastNoiseSuccess)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -21110,6 +22909,41 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsOrganization._set {
+		__values = append(__values, update.AsOrganization.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("as_organization = ?"))
+	}
+
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
+	if update.LastTcpSuccess._set {
+		__values = append(__values, update.LastTcpSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_tcp_success = ?"))
+	}
+
+	if update.LastQuicSuccess._set {
+		__values = append(__values, update.LastQuicSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_quic_success = ?"))
+	}
+
+	if update.LastNoiseSuccess._set {
+		__values = append(__values, update.LastNoiseSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_noise_success = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -21327,6 +23161,41 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsOrganization._set {
+		__values = append(__values, update.AsOrganization.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("as_organization = ?"))
+	}
+
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
+	if update.LastTcpSuccess._set {
+		__values = append(__values, update.LastTcpSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_tcp_success = ?"))
+	}
+
+	if update.LastQuicSuccess._set {
+		__values = append(__values, update.LastQuicSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_quic_success = ?"))
+	}
+
+	if update.LastNoiseSuccess._set {
+		__values = append(__values, update.LastNoiseSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_noise_success = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -23710,6 +25579,338 @@ func (obj *pgxImpl) Delete_AccountFreezeEvent_By_UserId_And_Event(ctx context.Co
 
 }
 
+func (obj *pgxImpl) CreateNoReturn_BucketMove(ctx context.Context,
+	bucket_move_project_id BucketMove_ProjectId_Field,
+	bucket_move_bucket_name BucketMove_BucketName_Field,
+	bucket_move_destination_project_id BucketMove_DestinationProjectId_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_move_project_id.value()
+	__bucket_name_val := bucket_move_bucket_name.value()
+	__destination_project_id_val := bucket_move_destination_project_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_moves ( project_id, bucket_name, destination_project_id, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __destination_project_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxImpl) Get_BucketMove_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_move_project_id BucketMove_ProjectId_Field,
+	bucket_move_bucket_name BucketMove_BucketName_Field) (
+	bucket_move *BucketMove, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_moves.project_id, bucket_moves.bucket_name, bucket_moves.destination_project_id, bucket_moves.created_at FROM bucket_moves WHERE bucket_moves.project_id = ? AND bucket_moves.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_move_project_id.value(), bucket_move_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_move = &BucketMove{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_move.ProjectId, &bucket_move.BucketName, &bucket_move.DestinationProjectId, &bucket_move.CreatedAt)
+	if err != nil {
+		return (*BucketMove)(nil), obj.makeErr(err)
+	}
+	return bucket_move, nil
+
+}
+
+func (obj *pgxImpl) Delete_BucketMove_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_move_project_id BucketMove_ProjectId_Field,
+	bucket_move_bucket_name BucketMove_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_moves WHERE bucket_moves.project_id = ? AND bucket_moves.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_move_project_id.value(), bucket_move_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) CreateNoReturn_BucketPlacementMigration(ctx context.Context,
+	bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+	bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field,
+	bucket_placement_migration_placement BucketPlacementMigration_Placement_Field,
+	optional BucketPlacementMigration_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__project_id_val := bucket_placement_migration_project_id.value()
+	__bucket_name_val := bucket_placement_migration_bucket_name.value()
+	__placement_val := bucket_placement_migration_placement.value()
+
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("project_id, bucket_name, placement")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?")}
+	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO bucket_placement_migrations "), __clause}}
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __placement_val)
+
+	__optional_columns := __sqlbundle_Literals{Join: ", "}
+	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
+
+	if optional.SegmentsTotal._set {
+		__values = append(__values, optional.SegmentsTotal.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("segments_total"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.SegmentsMigrated._set {
+		__values = append(__values, optional.SegmentsMigrated.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("segments_migrated"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.CreatedAt._set {
+		__values = append(__values, optional.CreatedAt.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("created_at"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.CheckedAt._set {
+		__values = append(__values, optional.CheckedAt.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("checked_at"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.CompletedAt._set {
+		__values = append(__values, optional.CompletedAt.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("completed_at"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if len(__optional_columns.SQLs) == 0 {
+		if __columns.SQL == nil {
+			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
+		}
+	} else {
+		__columns.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__columns.SQL, __optional_columns}}
+		__placeholders.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__placeholders.SQL, __optional_placeholders}}
+	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxImpl) Get_BucketPlacementMigration_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+	bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field) (
+	bucket_placement_migration *BucketPlacementMigration, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_placement_migrations.project_id, bucket_placement_migrations.bucket_name, bucket_placement_migrations.placement, bucket_placement_migrations.segments_total, bucket_placement_migrations.segments_migrated, bucket_placement_migrations.created_at, bucket_placement_migrations.checked_at, bucket_placement_migrations.completed_at FROM bucket_placement_migrations WHERE bucket_placement_migrations.project_id = ? AND bucket_placement_migrations.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_placement_migration_project_id.value(), bucket_placement_migration_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_placement_migration = &BucketPlacementMigration{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_placement_migration.ProjectId, &bucket_placement_migration.BucketName, &bucket_placement_migration.Placement, &bucket_placement_migration.SegmentsTotal, &bucket_placement_migration.SegmentsMigrated, &bucket_placement_migration.CreatedAt, &bucket_placement_migration.CheckedAt, &bucket_placement_migration.CompletedAt)
+	if err != nil {
+		return (*BucketPlacementMigration)(nil), obj.makeErr(err)
+	}
+	return bucket_placement_migration, nil
+
+}
+
+func (obj *pgxImpl) All_BucketPlacementMigration_By_CompletedAt_Is_Null_OrderBy_Asc_ProjectId_Asc_BucketName(ctx context.Context) (
+	rows []*BucketPlacementMigration, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_placement_migrations.project_id, bucket_placement_migrations.bucket_name, bucket_placement_migrations.placement, bucket_placement_migrations.segments_total, bucket_placement_migrations.segments_migrated, bucket_placement_migrations.created_at, bucket_placement_migrations.checked_at, bucket_placement_migrations.completed_at FROM bucket_placement_migrations WHERE bucket_placement_migrations.completed_at is NULL ORDER BY bucket_placement_migrations.project_id, bucket_placement_migrations.bucket_name")
+
+	var __values []any
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*BucketPlacementMigration, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				bucket_placement_migration := &BucketPlacementMigration{}
+				err = __rows.Scan(&bucket_placement_migration.ProjectId, &bucket_placement_migration.BucketName, &bucket_placement_migration.Placement, &bucket_placement_migration.SegmentsTotal, &bucket_placement_migration.SegmentsMigrated, &bucket_placement_migration.CreatedAt, &bucket_placement_migration.CheckedAt, &bucket_placement_migration.CompletedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, bucket_placement_migration)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxImpl) UpdateNoReturn_BucketPlacementMigration_By_ProjectId_And_BucketName_And_Placement(ctx context.Context,
+	bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+	bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field,
+	bucket_placement_migration_placement BucketPlacementMigration_Placement_Field,
+	update BucketPlacementMigration_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_placement_migrations SET "), __sets, __sqlbundle_Literal(" WHERE bucket_placement_migrations.project_id = ? AND bucket_placement_migrations.bucket_name = ? AND bucket_placement_migrations.placement = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Placement._set {
+		__values = append(__values, update.Placement.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
+	}
+
+	if update.SegmentsTotal._set {
+		__values = append(__values, update.SegmentsTotal.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segments_total = ?"))
+	}
+
+	if update.SegmentsMigrated._set {
+		__values = append(__values, update.SegmentsMigrated.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segments_migrated = ?"))
+	}
+
+	if update.CreatedAt._set {
+		__values = append(__values, update.CreatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("created_at = ?"))
+	}
+
+	if update.CheckedAt._set {
+		__values = append(__values, update.CheckedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("checked_at = ?"))
+	}
+
+	if update.CompletedAt._set {
+		__values = append(__values, update.CompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("completed_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, bucket_placement_migration_project_id.value(), bucket_placement_migration_bucket_name.value(), bucket_placement_migration_placement.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *pgxImpl) Delete_BucketPlacementMigration_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+	bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_placement_migrations WHERE bucket_placement_migrations.project_id = ? AND bucket_placement_migrations.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_placement_migration_project_id.value(), bucket_placement_migration_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (impl pgxImpl) isConstraintError(err error) (constraint string, ok bool) {
 	if e, ok := err.(*pgconn.PgError); ok {
 		if e.Code[:2] == "23" {
@@ -24141,6 +26342,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_placement_migrations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_moves;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27393,7 +29614,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.as_organization, nodes.maintenance_start, nodes.maintenance_end, nodes.last_tcp_success, nodes.last_quic_success, nodes.last_noise_success FROM nodes WHERE nodes.id = ?")
 
 	var __values []any
 	__values = append(__values, node_id.value())
@@ -27402,7 +29623,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsOrganization, &node.MaintenanceStart, &node.MaintenanceEnd, &node.LastTcpSuccess, &node.LastQuicSuccess, &node.LastNoiseSuccess)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -27461,9 +29682,9 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.as_organization, nodes.maintenance_start, nodes.maintenance_end, nodes.last_tcp_success, nodes.last_quic_success, nodes.last_noise_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.as_organization, nodes.maintenance_start, nodes.maintenance_end, nodes.last_tcp_success, nodes.last_quic_success, nodes.last_noise_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []any
 
@@ -27490,7 +29711,7 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsOrganization, &node.MaintenanceStart, &node.MaintenanceEnd, &node.LastTcpSuccess, &node.LastQuicSuccess, &node.LastNoiseSuccess, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -30548,7 +32769,7 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.as_organization, nodes.maintenance_start, nodes.maintenance_end, nodes.last_tcp_success, nodes.last_quic_success, nodes.last_noise_success")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -30734,6 +32955,41 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsOrganization._set {
+		__values = append(__values, update.AsOrganization.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("as_organization = ?"))
+	}
+
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
+	if update.LastTcpSuccess._set {
+		__values = append(__values, update.LastTcpSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_tcp_success = ?"))
+	}
+
+	if update.LastQuicSuccess._set {
+		__values = append(__values, update.LastQuicSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_quic_success = ?"))
+	}
+
+	if update.LastNoiseSuccess._set {
+		__values = append(__values, update.LastNoiseSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_noise_success = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -30748,7 +33004,7 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsOrganization, &node.MaintenanceStart, &node.MaintenanceEnd, &node.LastTcpSuccess, &node.LastQuicSuccess, &node.LastNoiseSuccess)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -30955,6 +33211,41 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsOrganization._set {
+		__values = append(__values, update.AsOrganization.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("as_organization = ?"))
+	}
+
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
+	if update.LastTcpSuccess._set {
+		__values = append(__values, update.LastTcpSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_tcp_success = ?"))
+	}
+
+	if update.LastQuicSuccess._set {
+		__values = append(__values, update.LastQuicSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_quic_success = ?"))
+	}
+
+	if update.LastNoiseSuccess._set {
+		__values = append(__values, update.LastNoiseSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_noise_success = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -31172,6 +33463,41 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsOrganization._set {
+		__values = append(__values, update.AsOrganization.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("as_organization = ?"))
+	}
+
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
+	if update.LastTcpSuccess._set {
+		__values = append(__values, update.LastTcpSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_tcp_success = ?"))
+	}
+
+	if update.LastQuicSuccess._set {
+		__values = append(__values, update.LastQuicSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_quic_success = ?"))
+	}
+
+	if update.LastNoiseSuccess._set {
+		__values = append(__values, update.LastNoiseSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_noise_success = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -33555,6 +35881,338 @@ func (obj *pgxcockroachImpl) Delete_AccountFreezeEvent_By_UserId_And_Event(ctx c
 
 }
 
+func (obj *pgxcockroachImpl) CreateNoReturn_BucketMove(ctx context.Context,
+	bucket_move_project_id BucketMove_ProjectId_Field,
+	bucket_move_bucket_name BucketMove_BucketName_Field,
+	bucket_move_destination_project_id BucketMove_DestinationProjectId_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_move_project_id.value()
+	__bucket_name_val := bucket_move_bucket_name.value()
+	__destination_project_id_val := bucket_move_destination_project_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_moves ( project_id, bucket_name, destination_project_id, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __destination_project_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketMove_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_move_project_id BucketMove_ProjectId_Field,
+	bucket_move_bucket_name BucketMove_BucketName_Field) (
+	bucket_move *BucketMove, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_moves.project_id, bucket_moves.bucket_name, bucket_moves.destination_project_id, bucket_moves.created_at FROM bucket_moves WHERE bucket_moves.project_id = ? AND bucket_moves.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_move_project_id.value(), bucket_move_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_move = &BucketMove{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_move.ProjectId, &bucket_move.BucketName, &bucket_move.DestinationProjectId, &bucket_move.CreatedAt)
+	if err != nil {
+		return (*BucketMove)(nil), obj.makeErr(err)
+	}
+	return bucket_move, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_BucketMove_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_move_project_id BucketMove_ProjectId_Field,
+	bucket_move_bucket_name BucketMove_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_moves WHERE bucket_moves.project_id = ? AND bucket_moves.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_move_project_id.value(), bucket_move_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) CreateNoReturn_BucketPlacementMigration(ctx context.Context,
+	bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+	bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field,
+	bucket_placement_migration_placement BucketPlacementMigration_Placement_Field,
+	optional BucketPlacementMigration_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__project_id_val := bucket_placement_migration_project_id.value()
+	__bucket_name_val := bucket_placement_migration_bucket_name.value()
+	__placement_val := bucket_placement_migration_placement.value()
+
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("project_id, bucket_name, placement")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?")}
+	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO bucket_placement_migrations "), __clause}}
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __placement_val)
+
+	__optional_columns := __sqlbundle_Literals{Join: ", "}
+	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
+
+	if optional.SegmentsTotal._set {
+		__values = append(__values, optional.SegmentsTotal.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("segments_total"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.SegmentsMigrated._set {
+		__values = append(__values, optional.SegmentsMigrated.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("segments_migrated"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.CreatedAt._set {
+		__values = append(__values, optional.CreatedAt.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("created_at"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.CheckedAt._set {
+		__values = append(__values, optional.CheckedAt.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("checked_at"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.CompletedAt._set {
+		__values = append(__values, optional.CompletedAt.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("completed_at"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if len(__optional_columns.SQLs) == 0 {
+		if __columns.SQL == nil {
+			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
+		}
+	} else {
+		__columns.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__columns.SQL, __optional_columns}}
+		__placeholders.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__placeholders.SQL, __optional_placeholders}}
+	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketPlacementMigration_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+	bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field) (
+	bucket_placement_migration *BucketPlacementMigration, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_placement_migrations.project_id, bucket_placement_migrations.bucket_name, bucket_placement_migrations.placement, bucket_placement_migrations.segments_total, bucket_placement_migrations.segments_migrated, bucket_placement_migrations.created_at, bucket_placement_migrations.checked_at, bucket_placement_migrations.completed_at FROM bucket_placement_migrations WHERE bucket_placement_migrations.project_id = ? AND bucket_placement_migrations.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_placement_migration_project_id.value(), bucket_placement_migration_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_placement_migration = &BucketPlacementMigration{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_placement_migration.ProjectId, &bucket_placement_migration.BucketName, &bucket_placement_migration.Placement, &bucket_placement_migration.SegmentsTotal, &bucket_placement_migration.SegmentsMigrated, &bucket_placement_migration.CreatedAt, &bucket_placement_migration.CheckedAt, &bucket_placement_migration.CompletedAt)
+	if err != nil {
+		return (*BucketPlacementMigration)(nil), obj.makeErr(err)
+	}
+	return bucket_placement_migration, nil
+
+}
+
+func (obj *pgxcockroachImpl) All_BucketPlacementMigration_By_CompletedAt_Is_Null_OrderBy_Asc_ProjectId_Asc_BucketName(ctx context.Context) (
+	rows []*BucketPlacementMigration, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_placement_migrations.project_id, bucket_placement_migrations.bucket_name, bucket_placement_migrations.placement, bucket_placement_migrations.segments_total, bucket_placement_migrations.segments_migrated, bucket_placement_migrations.created_at, bucket_placement_migrations.checked_at, bucket_placement_migrations.completed_at FROM bucket_placement_migrations WHERE bucket_placement_migrations.completed_at is NULL ORDER BY bucket_placement_migrations.project_id, bucket_placement_migrations.bucket_name")
+
+	var __values []any
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*BucketPlacementMigration, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				bucket_placement_migration := &BucketPlacementMigration{}
+				err = __rows.Scan(&bucket_placement_migration.ProjectId, &bucket_placement_migration.BucketName, &bucket_placement_migration.Placement, &bucket_placement_migration.SegmentsTotal, &bucket_placement_migration.SegmentsMigrated, &bucket_placement_migration.CreatedAt, &bucket_placement_migration.CheckedAt, &bucket_placement_migration.CompletedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, bucket_placement_migration)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) UpdateNoReturn_BucketPlacementMigration_By_ProjectId_And_BucketName_And_Placement(ctx context.Context,
+	bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+	bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field,
+	bucket_placement_migration_placement BucketPlacementMigration_Placement_Field,
+	update BucketPlacementMigration_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_placement_migrations SET "), __sets, __sqlbundle_Literal(" WHERE bucket_placement_migrations.project_id = ? AND bucket_placement_migrations.bucket_name = ? AND bucket_placement_migrations.placement = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Placement._set {
+		__values = append(__values, update.Placement.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
+	}
+
+	if update.SegmentsTotal._set {
+		__values = append(__values, update.SegmentsTotal.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segments_total = ?"))
+	}
+
+	if update.SegmentsMigrated._set {
+		__values = append(__values, update.SegmentsMigrated.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segments_migrated = ?"))
+	}
+
+	if update.CreatedAt._set {
+		__values = append(__values, update.CreatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("created_at = ?"))
+	}
+
+	if update.CheckedAt._set {
+		__values = append(__values, update.CheckedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("checked_at = ?"))
+	}
+
+	if update.CompletedAt._set {
+		__values = append(__values, update.CompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("completed_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, bucket_placement_migration_project_id.value(), bucket_placement_migration_bucket_name.value(), bucket_placement_migration_placement.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *pgxcockroachImpl) Delete_BucketPlacementMigration_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+	bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_placement_migrations WHERE bucket_placement_migrations.project_id = ? AND bucket_placement_migrations.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_placement_migration_project_id.value(), bucket_placement_migration_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (impl pgxcockroachImpl) isConstraintError(err error) (constraint string, ok bool) {
 	if e, ok := err.(*pgconn.PgError); ok {
 		if e.Code[:2] == "23" {
@@ -33986,6 +36644,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_placement_migrations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_moves;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -37502,7 +40180,7 @@ func (obj *spannerImpl) Get_Node_By_Id(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.as_organization, nodes.maintenance_start, nodes.maintenance_end, nodes.last_tcp_success, nodes.last_quic_success, nodes.last_noise_success FROM nodes WHERE nodes.id = ?")
 
 	var __values []any
 	__values = append(__values, node_id.value())
@@ -37511,7 +40189,7 @@ func (obj *spannerImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsOrganization, &node.MaintenanceStart, &node.MaintenanceEnd, &node.LastTcpSuccess, &node.LastQuicSuccess, &node.LastNoiseSuccess)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -37570,9 +40248,9 @@ func (obj *spannerImpl) Paged_Node(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE nodes.id > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.as_organization, nodes.maintenance_start, nodes.maintenance_end, nodes.last_tcp_success, nodes.last_quic_success, nodes.last_noise_success, nodes.id FROM nodes WHERE nodes.id > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.as_organization, nodes.maintenance_start, nodes.maintenance_end, nodes.last_tcp_success, nodes.last_quic_success, nodes.last_noise_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []any
 
@@ -37602,7 +40280,7 @@ func (obj *spannerImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsOrganization, &node.MaintenanceStart, &node.MaintenanceEnd, &node.LastTcpSuccess, &node.LastQuicSuccess, &node.LastNoiseSuccess, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -40655,7 +43333,7 @@ func (obj *spannerImpl) Update_Node_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? THEN RETURN nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? THEN RETURN nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.as_organization, nodes.maintenance_start, nodes.maintenance_end, nodes.last_tcp_success, nodes.last_quic_success, nodes.last_noise_success")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -40806,6 +43484,41 @@ func (obj *spannerImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsOrganization._set {
+		__values = append(__values, update.AsOrganization.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("as_organization = ?"))
+	}
+
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
+	if update.LastTcpSuccess._set {
+		__values = append(__values, update.LastTcpSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_tcp_success = ?"))
+	}
+
+	if update.LastQuicSuccess._set {
+		__values = append(__values, update.LastQuicSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_quic_success = ?"))
+	}
+
+	if update.LastNoiseSuccess._set {
+		__values = append(__values, update.LastNoiseSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_noise_success = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -40820,7 +43533,7 @@ func (obj *spannerImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsOrganization, &node.MaintenanceStart, &node.MaintenanceEnd, &node.LastTcpSuccess, &node.LastQuicSuccess, &node.LastNoiseSuccess)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -40992,6 +43705,41 @@ func (obj *spannerImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsOrganization._set {
+		__values = append(__values, update.AsOrganization.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("as_organization = ?"))
+	}
+
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
+	if update.LastTcpSuccess._set {
+		__values = append(__values, update.LastTcpSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_tcp_success = ?"))
+	}
+
+	if update.LastQuicSuccess._set {
+		__values = append(__values, update.LastQuicSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_quic_success = ?"))
+	}
+
+	if update.LastNoiseSuccess._set {
+		__values = append(__values, update.LastNoiseSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_noise_success = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -41174,6 +43922,41 @@ func (obj *spannerImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_E
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsOrganization._set {
+		__values = append(__values, update.AsOrganization.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("as_organization = ?"))
+	}
+
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
+	if update.LastTcpSuccess._set {
+		__values = append(__values, update.LastTcpSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_tcp_success = ?"))
+	}
+
+	if update.LastQuicSuccess._set {
+		__values = append(__values, update.LastQuicSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_quic_success = ?"))
+	}
+
+	if update.LastNoiseSuccess._set {
+		__values = append(__values, update.LastNoiseSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_noise_success = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -43397,6 +46180,338 @@ func (obj *spannerImpl) Delete_AccountFreezeEvent_By_UserId_And_Event(ctx contex
 
 }
 
+func (obj *spannerImpl) CreateNoReturn_BucketMove(ctx context.Context,
+	bucket_move_project_id BucketMove_ProjectId_Field,
+	bucket_move_bucket_name BucketMove_BucketName_Field,
+	bucket_move_destination_project_id BucketMove_DestinationProjectId_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_move_project_id.value()
+	__bucket_name_val := bucket_move_bucket_name.value()
+	__destination_project_id_val := bucket_move_destination_project_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_moves ( project_id, bucket_name, destination_project_id, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __destination_project_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *spannerImpl) Get_BucketMove_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_move_project_id BucketMove_ProjectId_Field,
+	bucket_move_bucket_name BucketMove_BucketName_Field) (
+	bucket_move *BucketMove, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_moves.project_id, bucket_moves.bucket_name, bucket_moves.destination_project_id, bucket_moves.created_at FROM bucket_moves WHERE bucket_moves.project_id = ? AND bucket_moves.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_move_project_id.value(), bucket_move_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_move = &BucketMove{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_move.ProjectId, &bucket_move.BucketName, &bucket_move.DestinationProjectId, &bucket_move.CreatedAt)
+	if err != nil {
+		return (*BucketMove)(nil), obj.makeErr(err)
+	}
+	return bucket_move, nil
+
+}
+
+func (obj *spannerImpl) Delete_BucketMove_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_move_project_id BucketMove_ProjectId_Field,
+	bucket_move_bucket_name BucketMove_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_moves WHERE bucket_moves.project_id = ? AND bucket_moves.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_move_project_id.value(), bucket_move_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *spannerImpl) CreateNoReturn_BucketPlacementMigration(ctx context.Context,
+	bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+	bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field,
+	bucket_placement_migration_placement BucketPlacementMigration_Placement_Field,
+	optional BucketPlacementMigration_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__project_id_val := bucket_placement_migration_project_id.value()
+	__bucket_name_val := bucket_placement_migration_bucket_name.value()
+	__placement_val := bucket_placement_migration_placement.value()
+
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("project_id, bucket_name, placement")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?")}
+	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO bucket_placement_migrations "), __clause}}
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __placement_val)
+
+	__optional_columns := __sqlbundle_Literals{Join: ", "}
+	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
+
+	if optional.SegmentsTotal._set {
+		__values = append(__values, optional.SegmentsTotal.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("segments_total"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.SegmentsMigrated._set {
+		__values = append(__values, optional.SegmentsMigrated.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("segments_migrated"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.CreatedAt._set {
+		__values = append(__values, optional.CreatedAt.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("created_at"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.CheckedAt._set {
+		__values = append(__values, optional.CheckedAt.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("checked_at"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.CompletedAt._set {
+		__values = append(__values, optional.CompletedAt.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("completed_at"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if len(__optional_columns.SQLs) == 0 {
+		if __columns.SQL == nil {
+			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
+		}
+	} else {
+		__columns.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__columns.SQL, __optional_columns}}
+		__placeholders.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__placeholders.SQL, __optional_placeholders}}
+	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *spannerImpl) Get_BucketPlacementMigration_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+	bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field) (
+	bucket_placement_migration *BucketPlacementMigration, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_placement_migrations.project_id, bucket_placement_migrations.bucket_name, bucket_placement_migrations.placement, bucket_placement_migrations.segments_total, bucket_placement_migrations.segments_migrated, bucket_placement_migrations.created_at, bucket_placement_migrations.checked_at, bucket_placement_migrations.completed_at FROM bucket_placement_migrations WHERE bucket_placement_migrations.project_id = ? AND bucket_placement_migrations.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_placement_migration_project_id.value(), bucket_placement_migration_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_placement_migration = &BucketPlacementMigration{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_placement_migration.ProjectId, &bucket_placement_migration.BucketName, &bucket_placement_migration.Placement, &bucket_placement_migration.SegmentsTotal, &bucket_placement_migration.SegmentsMigrated, &bucket_placement_migration.CreatedAt, &bucket_placement_migration.CheckedAt, &bucket_placement_migration.CompletedAt)
+	if err != nil {
+		return (*BucketPlacementMigration)(nil), obj.makeErr(err)
+	}
+	return bucket_placement_migration, nil
+
+}
+
+func (obj *spannerImpl) All_BucketPlacementMigration_By_CompletedAt_Is_Null_OrderBy_Asc_ProjectId_Asc_BucketName(ctx context.Context) (
+	rows []*BucketPlacementMigration, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_placement_migrations.project_id, bucket_placement_migrations.bucket_name, bucket_placement_migrations.placement, bucket_placement_migrations.segments_total, bucket_placement_migrations.segments_migrated, bucket_placement_migrations.created_at, bucket_placement_migrations.checked_at, bucket_placement_migrations.completed_at FROM bucket_placement_migrations WHERE bucket_placement_migrations.completed_at is NULL ORDER BY bucket_placement_migrations.project_id, bucket_placement_migrations.bucket_name")
+
+	var __values []any
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*BucketPlacementMigration, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				bucket_placement_migration := &BucketPlacementMigration{}
+				err = __rows.Scan(&bucket_placement_migration.ProjectId, &bucket_placement_migration.BucketName, &bucket_placement_migration.Placement, &bucket_placement_migration.SegmentsTotal, &bucket_placement_migration.SegmentsMigrated, &bucket_placement_migration.CreatedAt, &bucket_placement_migration.CheckedAt, &bucket_placement_migration.CompletedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, bucket_placement_migration)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *spannerImpl) UpdateNoReturn_BucketPlacementMigration_By_ProjectId_And_BucketName_And_Placement(ctx context.Context,
+	bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+	bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field,
+	bucket_placement_migration_placement BucketPlacementMigration_Placement_Field,
+	update BucketPlacementMigration_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_placement_migrations SET "), __sets, __sqlbundle_Literal(" WHERE bucket_placement_migrations.project_id = ? AND bucket_placement_migrations.bucket_name = ? AND bucket_placement_migrations.placement = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Placement._set {
+		__values = append(__values, update.Placement.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
+	}
+
+	if update.SegmentsTotal._set {
+		__values = append(__values, update.SegmentsTotal.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segments_total = ?"))
+	}
+
+	if update.SegmentsMigrated._set {
+		__values = append(__values, update.SegmentsMigrated.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segments_migrated = ?"))
+	}
+
+	if update.CreatedAt._set {
+		__values = append(__values, update.CreatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("created_at = ?"))
+	}
+
+	if update.CheckedAt._set {
+		__values = append(__values, update.CheckedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("checked_at = ?"))
+	}
+
+	if update.CompletedAt._set {
+		__values = append(__values, update.CompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("completed_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, bucket_placement_migration_project_id.value(), bucket_placement_migration_bucket_name.value(), bucket_placement_migration_placement.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *spannerImpl) Delete_BucketPlacementMigration_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+	bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_placement_migrations WHERE bucket_placement_migrations.project_id = ? AND bucket_placement_migrations.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_placement_migration_project_id.value(), bucket_placement_migration_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (impl spannerImpl) isConstraintError(err error) (constraint string, ok bool) {
 	errcode := spanner.ErrCode(err)
 	return "", errcode == codes.AlreadyExists || errcode == codes.OutOfRange || errcode == codes.FailedPrecondition
@@ -43824,6 +46939,26 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_placement_migrations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_moves;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -43918,6 +47053,9 @@ type Methods interface {
 		billing_transaction_user_id BillingTransaction_UserId_Field) (
 		rows []*BillingTransaction, err error)
 
+	All_BucketPlacementMigration_By_CompletedAt_Is_Null_OrderBy_Asc_ProjectId_Asc_BucketName(ctx context.Context) (
+		rows []*BucketPlacementMigration, err error)
+
 	All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Desc_IntervalStart(ctx context.Context,
 		bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
 		bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
@@ -44034,6 +47172,19 @@ type Methods interface {
 		billing_balance_balance BillingBalance_Balance_Field) (
 		err error)
 
+	CreateNoReturn_BucketMove(ctx context.Context,
+		bucket_move_project_id BucketMove_ProjectId_Field,
+		bucket_move_bucket_name BucketMove_BucketName_Field,
+		bucket_move_destination_project_id BucketMove_DestinationProjectId_Field) (
+		err error)
+
+	CreateNoReturn_BucketPlacementMigration(ctx context.Context,
+		bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+		bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field,
+		bucket_placement_migration_placement BucketPlacementMigration_Placement_Field,
+		optional BucketPlacementMigration_Create_Fields) (
+		err error)
+
 	CreateNoReturn_OauthClient(ctx context.Context,
 		oauth_client_id OauthClient_Id_Field,
 		oauth_client_encrypted_secret OauthClient_EncryptedSecret_Field,
@@ -44280,6 +47431,16 @@ type Methods interface {
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		deleted bool, err error)
 
+	Delete_BucketMove_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_move_project_id BucketMove_ProjectId_Field,
+		bucket_move_bucket_name BucketMove_BucketName_Field) (
+		deleted bool, err error)
+
+	Delete_BucketPlacementMigration_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+		bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field) (
+		deleted bool, err error)
+
 	Delete_BucketStorageTally_By_IntervalStart_Less(ctx context.Context,
 		bucket_storage_tally_interval_start_less BucketStorageTally_IntervalStart_Field) (
 		count int64, err error)
@@ -44444,6 +47605,16 @@ type Methods interface {
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		row *Versioning_Row, err error)
 
+	Get_BucketMove_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_move_project_id BucketMove_ProjectId_Field,
+		bucket_move_bucket_name BucketMove_BucketName_Field) (
+		bucket_move *BucketMove, err error)
+
+	Get_BucketPlacementMigration_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+		bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field) (
+		bucket_placement_migration *BucketPlacementMigration, err error)
+
 	Get_GracefulExitProgress_By_NodeId(ctx context.Context,
 		graceful_exit_progress_node_id GracefulExitProgress_NodeId_Field) (
 		graceful_exit_progress *GracefulExitProgress, err error)
@@ -44809,6 +47980,13 @@ type Methods interface {
 		update BillingTransaction_Update_Fields) (
 		err error)
 
+	UpdateNoReturn_BucketPlacementMigration_By_ProjectId_And_BucketName_And_Placement(ctx context.Context,
+		bucket_placement_migration_project_id BucketPlacementMigration_ProjectId_Field,
+		bucket_placement_migration_bucket_name BucketPlacementMigration_BucketName_Field,
+		bucket_placement_migration_placement BucketPlacementMigration_Placement_Field,
+		update BucketPlacementMigration_Update_Fields) (
+		err error)
+
 	UpdateNoReturn_GracefulExitSegmentTransfer_By_NodeId_And_StreamId_And_Position_And_PieceNum(ctx context.Context,
 		graceful_exit_segment_transfer_node_id GracefulExitSegmentTransfer_NodeId_Field,
 		graceful_exit_segment_transfer_stream_id GracefulExitSegmentTransfer_StreamId_Field,
@@ -26,6 +26,15 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 ) ;
+CREATE TABLE audit_outcomes (
+	node_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	outcome integer NOT NULL,
+	reverify_count bigint NOT NULL,
+	PRIMARY KEY ( node_id, created_at, stream_id, position )
+) ;
 CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -68,6 +77,33 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 ) ;
+CREATE TABLE bucket_moves (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	destination_project_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+) ;
+CREATE TABLE bucket_placement_migrations (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	placement integer NOT NULL,
+	segments_total bigint NOT NULL DEFAULT 0,
+	segments_migrated bigint NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	checked_at timestamp with time zone,
+	completed_at timestamp with time zone,
+	PRIMARY KEY ( project_id, bucket_name )
+) ;
+CREATE TABLE bucket_replication_rules (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	destination_bucket_name bytea NOT NULL,
+	prefix bytea NOT NULL,
+	replicate_deletes boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( project_id, bucket_name, destination_bucket_name )
+) ;
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -94,6 +130,18 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 ) ;
+CREATE TABLE durability_results (
+	created_at timestamp with time zone NOT NULL,
+	placement integer NOT NULL,
+	class_name text NOT NULL,
+	healthy_margin integer NOT NULL,
+	segment_count bigint NOT NULL,
+	lost_segments bigint NOT NULL,
+	total_segments bigint NOT NULL,
+	segment_exemplars text NOT NULL,
+	class_exemplars text NOT NULL,
+	PRIMARY KEY ( created_at, placement, class_name )
+) ;
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -118,6 +166,15 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 ) ;
+CREATE TABLE node_tag_changes (
+	node_id bytea NOT NULL,
+	name text NOT NULL,
+	value bytea NOT NULL,
+	action text NOT NULL,
+	changed_by text NOT NULL,
+	changed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, name, changed_at )
+) ;
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -158,6 +215,13 @@ CREATE TABLE nodes (
 	noise_public_key bytea,
 	debounce_limit integer NOT NULL DEFAULT 0,
 	features integer NOT NULL DEFAULT 0,
+	asn bigint,
+	as_organization text,
+	maintenance_start timestamp with time zone,
+	maintenance_end timestamp with time zone,
+	last_tcp_success timestamp with time zone,
+	last_quic_success timestamp with time zone,
+	last_noise_success timestamp with time zone,
 	PRIMARY KEY ( id )
 ) ;
 CREATE TABLE node_api_versions (
@@ -287,6 +351,23 @@ CREATE TABLE repair_queue (
 	placement integer,
 	PRIMARY KEY ( stream_id, position )
 ) ;
+CREATE TABLE replication_jobs (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_key bytea NOT NULL,
+	version bigint NOT NULL,
+	stream_id bytea NOT NULL,
+	destination_bucket_name bytea NOT NULL,
+	action integer NOT NULL,
+	status integer NOT NULL,
+	destination_stream_id bytea,
+	destination_version bigint,
+	attempts integer NOT NULL DEFAULT 0,
+	last_error text,
+	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( project_id, bucket_name, object_key, version, destination_bucket_name, action )
+) ;
 CREATE TABLE reputations (
 	id bytea NOT NULL,
 	audit_success_count bigint NOT NULL DEFAULT 0,
@@ -597,6 +678,7 @@ CREATE TABLE stripecoinpayments_apply_balance_intents (
 	PRIMARY KEY ( tx_id )
 ) ;
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX audit_outcomes_created_at_index ON audit_outcomes ( created_at ) ;
 CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
@@ -606,6 +688,7 @@ CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_st
 CREATE INDEX bucket_storage_tallies_interval_start_index ON bucket_storage_tallies ( interval_start ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at ) WHERE node_events.email_sent is NULL ;
+CREATE INDEX node_tag_changes_changed_at_index ON node_tag_changes ( changed_at ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
@@ -617,6 +700,7 @@ CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwi
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
 CREATE INDEX repair_queue_placement_index ON repair_queue ( placement ) ;
+CREATE INDEX replication_jobs_status_updated_at_index ON replication_jobs ( status, updated_at ) ;
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
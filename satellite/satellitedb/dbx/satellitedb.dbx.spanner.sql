@@ -23,6 +23,14 @@ CREATE TABLE accounting_timestamps (
 	name STRING(MAX) NOT NULL,
 	value TIMESTAMP NOT NULL
 ) PRIMARY KEY ( name ) ;
+CREATE TABLE audit_outcomes (
+	node_id BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	stream_id BYTES(MAX) NOT NULL,
+	position INT64 NOT NULL,
+	outcome INT64 NOT NULL,
+	reverify_count INT64 NOT NULL
+) PRIMARY KEY ( node_id, created_at, stream_id, position ) ;
 CREATE TABLE billing_balances (
 	user_id BYTES(MAX) NOT NULL,
 	balance INT64 NOT NULL,
@@ -62,6 +70,30 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	allocated INT64 NOT NULL,
 	settled INT64 NOT NULL
 ) PRIMARY KEY ( bucket_name, project_id, interval_start, action ) ;
+CREATE TABLE bucket_moves (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	destination_project_id BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( project_id, bucket_name ) ;
+CREATE TABLE bucket_placement_migrations (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	placement INT64 NOT NULL,
+	segments_total INT64 NOT NULL DEFAULT (0),
+	segments_migrated INT64 NOT NULL DEFAULT (0),
+	created_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	checked_at TIMESTAMP,
+	completed_at TIMESTAMP
+) PRIMARY KEY ( project_id, bucket_name ) ;
+CREATE TABLE bucket_replication_rules (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	destination_bucket_name BYTES(MAX) NOT NULL,
+	prefix BYTES(MAX) NOT NULL,
+	replicate_deletes BOOL NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT (current_timestamp)
+) PRIMARY KEY ( project_id, bucket_name, destination_bucket_name ) ;
 CREATE TABLE bucket_storage_tallies (
 	bucket_name BYTES(MAX) NOT NULL,
 	project_id BYTES(MAX) NOT NULL,
@@ -86,6 +118,17 @@ CREATE TABLE coinpayments_transactions (
 	timeout INT64 NOT NULL,
 	created_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( id ) ;
+CREATE TABLE durability_results (
+	created_at TIMESTAMP NOT NULL,
+	placement INT64 NOT NULL,
+	class_name STRING(MAX) NOT NULL,
+	healthy_margin INT64 NOT NULL,
+	segment_count INT64 NOT NULL,
+	lost_segments INT64 NOT NULL,
+	total_segments INT64 NOT NULL,
+	segment_exemplars STRING(MAX) NOT NULL,
+	class_exemplars STRING(MAX) NOT NULL
+) PRIMARY KEY ( created_at, placement, class_name ) ;
 CREATE TABLE graceful_exit_progress (
 	node_id BYTES(MAX) NOT NULL,
 	bytes_transferred INT64 NOT NULL,
@@ -108,6 +151,14 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	finished_at TIMESTAMP,
 	order_limit_send_count INT64 NOT NULL DEFAULT (0)
 ) PRIMARY KEY ( node_id, stream_id, position, piece_num ) ;
+CREATE TABLE node_tag_changes (
+	node_id BYTES(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	value BYTES(MAX) NOT NULL,
+	action STRING(MAX) NOT NULL,
+	changed_by STRING(MAX) NOT NULL,
+	changed_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( node_id, name, changed_at ) ;
 CREATE TABLE nodes (
 	id BYTES(MAX) NOT NULL,
 	address STRING(MAX) NOT NULL DEFAULT (""),
@@ -147,7 +198,14 @@ CREATE TABLE nodes (
 	noise_proto INT64,
 	noise_public_key BYTES(MAX),
 	debounce_limit INT64 NOT NULL DEFAULT (0),
-	features INT64 NOT NULL DEFAULT (0)
+	features INT64 NOT NULL DEFAULT (0),
+	asn INT64,
+	as_organization STRING(MAX),
+	maintenance_start TIMESTAMP,
+	maintenance_end TIMESTAMP,
+	last_tcp_success TIMESTAMP,
+	last_quic_success TIMESTAMP,
+	last_noise_success TIMESTAMP
 ) PRIMARY KEY ( id ) ;
 CREATE TABLE node_api_versions (
 	id BYTES(MAX) NOT NULL,
@@ -255,6 +313,7 @@ CREATE TABLE registration_tokens (
 	project_limit INT64 NOT NULL,
 	created_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( secret ) ;
+CREATE INDEX node_tag_changes_changed_at_index ON node_tag_changes ( changed_at ) ;
 CREATE UNIQUE INDEX index_registration_tokens_owner_id ON registration_tokens ( owner_id ) ;
 CREATE TABLE repair_queue (
 	stream_id BYTES(MAX) NOT NULL,
@@ -265,6 +324,22 @@ CREATE TABLE repair_queue (
 	segment_health FLOAT64 NOT NULL DEFAULT (1),
 	placement INT64
 ) PRIMARY KEY ( stream_id, position ) ;
+CREATE TABLE replication_jobs (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	object_key BYTES(MAX) NOT NULL,
+	version INT64 NOT NULL,
+	stream_id BYTES(MAX) NOT NULL,
+	destination_bucket_name BYTES(MAX) NOT NULL,
+	action INT64 NOT NULL,
+	status INT64 NOT NULL,
+	destination_stream_id BYTES(MAX),
+	destination_version INT64,
+	attempts INT64 NOT NULL DEFAULT (0),
+	last_error STRING(MAX),
+	inserted_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	updated_at TIMESTAMP NOT NULL DEFAULT (current_timestamp)
+) PRIMARY KEY ( project_id, bucket_name, object_key, version, destination_bucket_name, action ) ;
 CREATE TABLE reputations (
 	id BYTES(MAX) NOT NULL,
 	audit_success_count INT64 NOT NULL DEFAULT (0),
@@ -559,6 +634,7 @@ CREATE TABLE stripecoinpayments_apply_balance_intents (
 	CONSTRAINT stripecoinpayments_apply_balance_intents_tx_id_fkey FOREIGN KEY (tx_id) REFERENCES coinpayments_transactions (id) ON DELETE CASCADE 
 ) PRIMARY KEY ( tx_id ) ;
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX audit_outcomes_created_at_index ON audit_outcomes ( created_at ) ;
 CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
@@ -579,6 +655,7 @@ CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwi
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
 CREATE INDEX repair_queue_placement_index ON repair_queue ( placement ) ;
+CREATE INDEX replication_jobs_status_updated_at_index ON replication_jobs ( status, updated_at ) ;
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
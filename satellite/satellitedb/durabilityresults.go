@@ -0,0 +1,112 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/durability"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensure that durabilityResults implements durability.DB.
+var _ durability.DB = (*durabilityResults)(nil)
+
+// durabilityResults implements storj.io/storj/satellite/durability.DB.
+type durabilityResults struct {
+	db *satelliteDB
+}
+
+// Insert adds the results of a single report.
+func (d *durabilityResults) Insert(ctx context.Context, results []durability.Result) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(results) == 0 {
+		return nil
+	}
+
+	var query strings.Builder
+	query.WriteString(`INSERT INTO durability_results (
+		created_at, placement, class_name, healthy_margin, segment_count,
+		lost_segments, total_segments, segment_exemplars, class_exemplars
+	) VALUES `)
+
+	args := make([]any, 0, len(results)*9)
+	for i, result := range results {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(?, ?, ?, ?, ?, ?, ?, ?, ?)")
+		args = append(args,
+			result.CreatedAt, int64(result.Placement), result.Class, int64(result.HealthyMargin), result.SegmentCount,
+			result.LostSegments, result.TotalSegments,
+			strings.Join(result.SegmentExemplars, ","), strings.Join(result.ClassExemplars, ","))
+	}
+
+	_, err = d.db.ExecContext(ctx, d.db.Rebind(query.String()), args...)
+	return Error.Wrap(err)
+}
+
+// Latest returns the results of the most recent report.
+func (d *durabilityResults) Latest(ctx context.Context) (_ []durability.Result, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT created_at, placement, class_name, healthy_margin, segment_count,
+			lost_segments, total_segments, segment_exemplars, class_exemplars
+		FROM durability_results
+		WHERE created_at = (SELECT MAX(created_at) FROM durability_results)
+		ORDER BY placement, class_name
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var results []durability.Result
+	for rows.Next() {
+		var row dbx.DurabilityResult
+		err := rows.Scan(&row.CreatedAt, &row.Placement, &row.ClassName, &row.HealthyMargin, &row.SegmentCount,
+			&row.LostSegments, &row.TotalSegments, &row.SegmentExemplars, &row.ClassExemplars)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		results = append(results, durability.Result{
+			CreatedAt:        row.CreatedAt,
+			Placement:        storj.PlacementConstraint(row.Placement),
+			Class:            row.ClassName,
+			HealthyMargin:    row.HealthyMargin,
+			SegmentCount:     row.SegmentCount,
+			LostSegments:     row.LostSegments,
+			TotalSegments:    row.TotalSegments,
+			SegmentExemplars: splitExemplars(row.SegmentExemplars),
+			ClassExemplars:   splitExemplars(row.ClassExemplars),
+		})
+	}
+	return results, Error.Wrap(rows.Err())
+}
+
+// DeleteBefore removes the results of reports created before the given time.
+func (d *durabilityResults) DeleteBefore(ctx context.Context, before time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := d.db.ExecContext(ctx, d.db.Rebind(`DELETE FROM durability_results WHERE created_at < ?`), before)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	deleted, err := result.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
+
+func splitExemplars(exemplars string) []string {
+	if exemplars == "" {
+		return nil
+	}
+	return strings.Split(exemplars, ",")
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/durability"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDurabilityResults(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		results := db.Durability()
+
+		latest, err := results.Latest(ctx)
+		require.NoError(t, err)
+		require.Empty(t, latest)
+
+		now := time.Now().Truncate(time.Second).UTC()
+		older := []durability.Result{
+			{CreatedAt: now.Add(-time.Hour), Placement: storj.DefaultPlacement, Class: "email", HealthyMargin: 3, SegmentCount: 1, TotalSegments: 10},
+		}
+		newer := []durability.Result{
+			{
+				CreatedAt: now, Placement: storj.DefaultPlacement, Class: "country+email",
+				HealthyMargin: -1, SegmentCount: 2, LostSegments: 2, TotalSegments: 10,
+				SegmentExemplars: []string{"a/1", "b/2"}, ClassExemplars: []string{"DE+a", "DE+b"},
+			},
+			{CreatedAt: now, Placement: storj.DefaultPlacement, Class: "email", HealthyMargin: 2, SegmentCount: 5, TotalSegments: 10},
+			{CreatedAt: now, Placement: storj.EU, Class: "email", HealthyMargin: 4, SegmentCount: 1, TotalSegments: 1},
+		}
+		require.NoError(t, results.Insert(ctx, older))
+		require.NoError(t, results.Insert(ctx, newer))
+
+		latest, err = results.Latest(ctx)
+		require.NoError(t, err)
+		require.Len(t, latest, len(newer))
+		for i := range newer {
+			require.WithinDuration(t, newer[i].CreatedAt, latest[i].CreatedAt, time.Second)
+			latest[i].CreatedAt = newer[i].CreatedAt
+			require.Equal(t, newer[i], latest[i])
+		}
+
+		deleted, err := results.DeleteBefore(ctx, now.Add(-time.Minute))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deleted)
+	})
+}
@@ -897,6 +897,146 @@ func (db *satelliteDB) productionMigrationSpanner() *migrate.Migration {
 					`ALTER TABLE users ADD COLUMN hubspot_object_id STRING(MAX)`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_replication_rules and replication_jobs tables",
+				Version:     287,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_replication_rules (
+						project_id BYTES(MAX) NOT NULL,
+						bucket_name BYTES(MAX) NOT NULL,
+						destination_bucket_name BYTES(MAX) NOT NULL,
+						prefix BYTES(MAX) NOT NULL,
+						replicate_deletes BOOL NOT NULL,
+						created_at TIMESTAMP NOT NULL DEFAULT (current_timestamp)
+					) PRIMARY KEY ( project_id, bucket_name, destination_bucket_name )`,
+					`CREATE TABLE replication_jobs (
+						project_id BYTES(MAX) NOT NULL,
+						bucket_name BYTES(MAX) NOT NULL,
+						object_key BYTES(MAX) NOT NULL,
+						version INT64 NOT NULL,
+						stream_id BYTES(MAX) NOT NULL,
+						destination_bucket_name BYTES(MAX) NOT NULL,
+						action INT64 NOT NULL,
+						status INT64 NOT NULL,
+						destination_stream_id BYTES(MAX),
+						destination_version INT64,
+						attempts INT64 NOT NULL DEFAULT (0),
+						last_error STRING(MAX),
+						inserted_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+						updated_at TIMESTAMP NOT NULL DEFAULT (current_timestamp)
+					) PRIMARY KEY ( project_id, bucket_name, object_key, version, destination_bucket_name, action )`,
+					`CREATE INDEX replication_jobs_status_updated_at_index ON replication_jobs ( status, updated_at )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_placement_migrations table",
+				Version:     288,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_placement_migrations (
+						project_id BYTES(MAX) NOT NULL,
+						bucket_name BYTES(MAX) NOT NULL,
+						placement INT64 NOT NULL,
+						segments_total INT64 NOT NULL DEFAULT (0),
+						segments_migrated INT64 NOT NULL DEFAULT (0),
+						created_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+						checked_at TIMESTAMP,
+						completed_at TIMESTAMP
+					) PRIMARY KEY ( project_id, bucket_name )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add audit_outcomes table",
+				Version:     289,
+				Action: migrate.SQL{
+					`CREATE TABLE audit_outcomes (
+						node_id BYTES(MAX) NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						stream_id BYTES(MAX) NOT NULL,
+						position INT64 NOT NULL,
+						outcome INT64 NOT NULL,
+						reverify_count INT64 NOT NULL
+					) PRIMARY KEY ( node_id, created_at, stream_id, position )`,
+					`CREATE INDEX audit_outcomes_created_at_index ON audit_outcomes ( created_at )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add durability_results table",
+				Version:     290,
+				Action: migrate.SQL{
+					`CREATE TABLE durability_results (
+						created_at TIMESTAMP NOT NULL,
+						placement INT64 NOT NULL,
+						class_name STRING(MAX) NOT NULL,
+						healthy_margin INT64 NOT NULL,
+						segment_count INT64 NOT NULL,
+						lost_segments INT64 NOT NULL,
+						total_segments INT64 NOT NULL,
+						segment_exemplars STRING(MAX) NOT NULL,
+						class_exemplars STRING(MAX) NOT NULL
+					) PRIMARY KEY ( created_at, placement, class_name )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add node_tag_changes table",
+				Version:     291,
+				Action: migrate.SQL{
+					`CREATE TABLE node_tag_changes (
+						node_id BYTES(MAX) NOT NULL,
+						name STRING(MAX) NOT NULL,
+						value BYTES(MAX) NOT NULL,
+						action STRING(MAX) NOT NULL,
+						changed_by STRING(MAX) NOT NULL,
+						changed_at TIMESTAMP NOT NULL
+					) PRIMARY KEY ( node_id, name, changed_at )`,
+					`CREATE INDEX node_tag_changes_changed_at_index ON node_tag_changes ( changed_at )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add asn and as_organization columns to nodes table",
+				Version:     292,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN asn INT64`,
+					`ALTER TABLE nodes ADD COLUMN as_organization STRING(MAX)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add maintenance_start and maintenance_end columns to nodes table",
+				Version:     293,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN maintenance_start TIMESTAMP`,
+					`ALTER TABLE nodes ADD COLUMN maintenance_end TIMESTAMP`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add last_tcp_success, last_quic_success and last_noise_success columns to nodes table",
+				Version:     294,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN last_tcp_success TIMESTAMP`,
+					`ALTER TABLE nodes ADD COLUMN last_quic_success TIMESTAMP`,
+					`ALTER TABLE nodes ADD COLUMN last_noise_success TIMESTAMP`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_moves table",
+				Version:     295,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_moves (
+						project_id BYTES(MAX) NOT NULL,
+						bucket_name BYTES(MAX) NOT NULL,
+						destination_project_id BYTES(MAX) NOT NULL,
+						created_at TIMESTAMP NOT NULL
+					) PRIMARY KEY ( project_id, bucket_name )`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -3636,6 +3776,153 @@ func (db *satelliteDB) productionMigrationPostgres() *migrate.Migration {
 					`ALTER TABLE users ADD COLUMN hubspot_object_id TEXT`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_replication_rules and replication_jobs tables",
+				Version:     287,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_replication_rules (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						destination_bucket_name bytea NOT NULL,
+						prefix bytea NOT NULL,
+						replicate_deletes boolean NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( project_id, bucket_name, destination_bucket_name )
+					)`,
+					`CREATE TABLE replication_jobs (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						object_key bytea NOT NULL,
+						version bigint NOT NULL,
+						stream_id bytea NOT NULL,
+						destination_bucket_name bytea NOT NULL,
+						action integer NOT NULL,
+						status integer NOT NULL,
+						destination_stream_id bytea,
+						destination_version bigint,
+						attempts integer NOT NULL DEFAULT 0,
+						last_error text,
+						inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( project_id, bucket_name, object_key, version, destination_bucket_name, action )
+					)`,
+					`CREATE INDEX replication_jobs_status_updated_at_index ON replication_jobs ( status, updated_at )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_placement_migrations table",
+				Version:     288,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_placement_migrations (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						placement integer NOT NULL,
+						segments_total bigint NOT NULL DEFAULT 0,
+						segments_migrated bigint NOT NULL DEFAULT 0,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						checked_at timestamp with time zone,
+						completed_at timestamp with time zone,
+						PRIMARY KEY ( project_id, bucket_name )
+					)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add audit_outcomes table",
+				Version:     289,
+				Action: migrate.SQL{
+					`CREATE TABLE audit_outcomes (
+						node_id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						stream_id bytea NOT NULL,
+						position bigint NOT NULL,
+						outcome integer NOT NULL,
+						reverify_count bigint NOT NULL,
+						PRIMARY KEY ( node_id, created_at, stream_id, position )
+					)`,
+					`CREATE INDEX audit_outcomes_created_at_index ON audit_outcomes ( created_at )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add durability_results table",
+				Version:     290,
+				Action: migrate.SQL{
+					`CREATE TABLE durability_results (
+						created_at timestamp with time zone NOT NULL,
+						placement integer NOT NULL,
+						class_name text NOT NULL,
+						healthy_margin integer NOT NULL,
+						segment_count bigint NOT NULL,
+						lost_segments bigint NOT NULL,
+						total_segments bigint NOT NULL,
+						segment_exemplars text NOT NULL,
+						class_exemplars text NOT NULL,
+						PRIMARY KEY ( created_at, placement, class_name )
+					)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add node_tag_changes table",
+				Version:     291,
+				Action: migrate.SQL{
+					`CREATE TABLE node_tag_changes (
+						node_id bytea NOT NULL,
+						name text NOT NULL,
+						value bytea NOT NULL,
+						action text NOT NULL,
+						changed_by text NOT NULL,
+						changed_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id, name, changed_at )
+					)`,
+					`CREATE INDEX node_tag_changes_changed_at_index ON node_tag_changes ( changed_at )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add asn and as_organization columns to nodes table",
+				Version:     292,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN asn bigint;`,
+					`ALTER TABLE nodes ADD COLUMN as_organization text;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add maintenance_start and maintenance_end columns to nodes table",
+				Version:     293,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN maintenance_start timestamp with time zone;`,
+					`ALTER TABLE nodes ADD COLUMN maintenance_end timestamp with time zone;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add last_tcp_success, last_quic_success and last_noise_success columns to nodes table",
+				Version:     294,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN last_tcp_success timestamp with time zone;`,
+					`ALTER TABLE nodes ADD COLUMN last_quic_success timestamp with time zone;`,
+					`ALTER TABLE nodes ADD COLUMN last_noise_success timestamp with time zone;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_moves table",
+				Version:     295,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_moves (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						destination_project_id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					)`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -0,0 +1,135 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/nodetags"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensure that nodeTags implements nodetags.DB.
+var _ nodetags.DB = (*nodeTags)(nil)
+
+// nodeTags implements storj.io/storj/satellite/nodetags.DB.
+type nodeTags struct {
+	db *satelliteDB
+}
+
+// Set inserts or updates the tags and records the changes in the audit log.
+func (n *nodeTags) Set(ctx context.Context, tags nodeselection.NodeTags, changedBy string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(n.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		for _, tag := range tags {
+			err := tx.ReplaceNoReturn_NodeTags(ctx,
+				dbx.NodeTags_NodeId(tag.NodeID.Bytes()),
+				dbx.NodeTags_Name(tag.Name),
+				dbx.NodeTags_Value(tag.Value),
+				dbx.NodeTags_SignedAt(tag.SignedAt),
+				dbx.NodeTags_Signer(tag.Signer.Bytes()),
+			)
+			if err != nil {
+				return err
+			}
+
+			err = n.insertChange(ctx, tx, tag.NodeID, tag.Name, tag.Value, nodetags.ActionSet, changedBy, tag.SignedAt)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// Delete removes a tag and records the change in the audit log.
+func (n *nodeTags) Delete(ctx context.Context, nodeID storj.NodeID, name string, signer storj.NodeID, changedBy string, changedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(n.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, n.db.Rebind(`
+			DELETE FROM node_tags WHERE node_id = ? AND name = ? AND signer = ?
+		`), nodeID.Bytes(), name, signer.Bytes())
+		if err != nil {
+			return err
+		}
+
+		return n.insertChange(ctx, tx, nodeID, name, []byte{}, nodetags.ActionDelete, changedBy, changedAt)
+	}))
+}
+
+func (n *nodeTags) insertChange(ctx context.Context, tx *dbx.Tx, nodeID storj.NodeID, name string, value []byte, action, changedBy string, changedAt time.Time) error {
+	_, err := tx.Tx.ExecContext(ctx, n.db.Rebind(`
+		INSERT INTO node_tag_changes (node_id, name, value, action, changed_by, changed_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`), nodeID.Bytes(), name, value, action, changedBy, changedAt)
+	return err
+}
+
+// ListBySigner returns all the tags signed by the signer.
+func (n *nodeTags) ListBySigner(ctx context.Context, signer storj.NodeID) (_ nodeselection.NodeTags, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := n.db.QueryContext(ctx, n.db.Rebind(`
+		SELECT node_id, name, value, signed_at, signer
+		FROM node_tags
+		WHERE signer = ?
+		ORDER BY node_id, name
+	`), signer.Bytes())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var tags nodeselection.NodeTags
+	for rows.Next() {
+		var tag nodeselection.NodeTag
+		err := rows.Scan(&tag.NodeID, &tag.Name, &tag.Value, &tag.SignedAt, &tag.Signer)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		tags = append(tags, tag)
+	}
+	return tags, Error.Wrap(rows.Err())
+}
+
+// Changes returns the most recent entries of the audit log, the newest first.
+func (n *nodeTags) Changes(ctx context.Context, nodeID storj.NodeID, limit int) (_ []nodetags.Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `
+		SELECT node_id, name, value, action, changed_by, changed_at
+		FROM node_tag_changes
+	`
+	var args []any
+	if !nodeID.IsZero() {
+		query += ` WHERE node_id = ?`
+		args = append(args, nodeID.Bytes())
+	}
+	query += ` ORDER BY changed_at DESC, node_id, name LIMIT ?`
+	args = append(args, int64(limit))
+
+	rows, err := n.db.QueryContext(ctx, n.db.Rebind(query), args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var changes []nodetags.Change
+	for rows.Next() {
+		var change nodetags.Change
+		err := rows.Scan(&change.NodeID, &change.Name, &change.Value, &change.Action, &change.ChangedBy, &change.ChangedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		changes = append(changes, change)
+	}
+	return changes, Error.Wrap(rows.Err())
+}
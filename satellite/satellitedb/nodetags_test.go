@@ -0,0 +1,76 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/nodetags"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNodeTags(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		tagsDB := db.NodeTags()
+
+		satelliteID := testrand.NodeID()
+		nodeOperator := testrand.NodeID()
+		node1, node2 := testrand.NodeID(), testrand.NodeID()
+
+		now := time.Now().Truncate(time.Second).UTC()
+
+		// tags signed by the node operator are not managed by the satellite.
+		require.NoError(t, db.OverlayCache().UpdateNodeTags(ctx, nodeselection.NodeTags{
+			{NodeID: node1, Name: "soc2", Value: []byte("false"), SignedAt: now, Signer: nodeOperator},
+		}))
+
+		require.NoError(t, tagsDB.Set(ctx, nodeselection.NodeTags{
+			{NodeID: node1, Name: "soc2", Value: []byte("true"), SignedAt: now, Signer: satelliteID},
+			{NodeID: node2, Name: "soc2", Value: []byte("true"), SignedAt: now, Signer: satelliteID},
+		}, "first@mail.test"))
+
+		tags, err := tagsDB.ListBySigner(ctx, satelliteID)
+		require.NoError(t, err)
+		require.Len(t, tags, 2)
+		for _, tag := range tags {
+			require.Equal(t, satelliteID, tag.Signer)
+			require.Equal(t, "true", string(tag.Value))
+		}
+
+		require.NoError(t, tagsDB.Delete(ctx, node1, "soc2", satelliteID, "second@mail.test", now.Add(time.Minute)))
+
+		tags, err = tagsDB.ListBySigner(ctx, satelliteID)
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		require.Equal(t, node2, tags[0].NodeID)
+
+		// the tag of the node operator is kept.
+		all, err := db.OverlayCache().GetNodeTags(ctx, node1)
+		require.NoError(t, err)
+		require.Len(t, all, 1)
+		require.Equal(t, nodeOperator, all[0].Signer)
+
+		changes, err := tagsDB.Changes(ctx, storj.NodeID{}, 10)
+		require.NoError(t, err)
+		require.Len(t, changes, 3)
+		require.Equal(t, node1, changes[0].NodeID)
+		require.Equal(t, nodetags.ActionDelete, changes[0].Action)
+		require.Equal(t, "second@mail.test", changes[0].ChangedBy)
+
+		changes, err = tagsDB.Changes(ctx, node2, 10)
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		require.Equal(t, nodetags.ActionSet, changes[0].Action)
+		require.Equal(t, "first@mail.test", changes[0].ChangedBy)
+		require.Equal(t, "true", string(changes[0].Value))
+	})
+}
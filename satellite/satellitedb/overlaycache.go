@@ -74,7 +74,8 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 	switch cache.db.impl {
 	case dbutil.Cockroach, dbutil.Postgres:
 		query := `
-			SELECT id, address, email, wallet, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk
+			SELECT id, address, email, wallet, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk,
+				asn, as_organization, ` + reachableColumns("") + `
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(selectionCfg.AsOfSystemTime.Interval()) + `
 			WHERE disqualified IS NULL
@@ -83,28 +84,33 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 				AND exit_initiated_at IS NULL
 				AND free_disk >= $1
 				AND last_contact_success > $2
+				AND (maintenance_end IS NULL OR maintenance_start > $3 OR maintenance_end <= $3)
 		`
+		now := time.Now()
 		args := []any{
 			// $1
 			selectionCfg.MinimumDiskSpace.Int64(),
 			// $2
-			time.Now().Add(-selectionCfg.OnlineWindow),
+			now.Add(-selectionCfg.OnlineWindow),
+			// $3
+			now,
 		}
 		if selectionCfg.MinimumVersion != "" {
 			version, err := version.NewSemVer(selectionCfg.MinimumVersion)
 			if err != nil {
 				return nil, nil, err
 			}
-			query += `AND (major > $3 OR (major = $4 AND (minor > $5 OR (minor = $6 AND patch >= $7)))) AND release`
+			query += `AND (major > $4 OR (major = $5 AND (minor > $6 OR (minor = $7 AND patch >= $8)))) AND release`
 			args = append(args,
-				// $3 - $7
+				// $4 - $8
 				version.Major, version.Major, version.Minor, version.Minor, version.Patch,
 			)
 		}
 		rows, err = cache.db.Query(ctx, query, args...)
 	case dbutil.Spanner:
 		query := `
-			SELECT id, address, email, wallet, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk
+			SELECT id, address, email, wallet, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk,
+				asn, as_organization, ` + reachableColumns("") + `
 			FROM nodes
 			WHERE disqualified IS NULL
 				AND unknown_audit_suspended IS NULL
@@ -112,12 +118,16 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 				AND exit_initiated_at IS NULL
 				AND free_disk >= ?
 				AND last_contact_success > ?
+				AND (maintenance_end IS NULL OR maintenance_start > ? OR maintenance_end <= ?)
 		`
+		now := time.Now()
 		args := []any{
 			// $1
 			selectionCfg.MinimumDiskSpace.Int64(),
 			// $2
-			time.Now().Add(-selectionCfg.OnlineWindow),
+			now.Add(-selectionCfg.OnlineWindow),
+			// $3, $4
+			now, now,
 		}
 		if selectionCfg.MinimumVersion != "" {
 			version, err := version.NewSemVer(selectionCfg.MinimumVersion)
@@ -126,7 +136,7 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 			}
 			query += `AND (major > ? OR (major = ? AND (minor > ? OR (minor = ? AND patch >= ?)))) AND release`
 			args = append(args,
-				// $3 - $7
+				// $5 - $9
 				version.Major, version.Major, version.Minor, version.Minor, version.Patch,
 			)
 		}
@@ -149,8 +159,11 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 		var lastIPPort, email, wallet sql.NullString
 		var vettedAt *time.Time
 		var noise noiseScanner
+		var asn asnScanner
+		var reachable reachableScanner
 		err = rows.Scan(&node.ID, &node.Address.Address, &email, &wallet, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode, &noise.Proto,
-			&noise.PublicKey, &node.Address.DebounceLimit, &node.Address.Features, &node.CountryCode, &node.PieceCount, &node.FreeDisk)
+			&noise.PublicKey, &node.Address.DebounceLimit, &node.Address.Features, &node.CountryCode, &node.PieceCount, &node.FreeDisk,
+			&asn.Number, &asn.Organization, &reachable.TCP, &reachable.QUIC, &reachable.Noise)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -158,6 +171,8 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 			node.LastIPPort = lastIPPort.String
 		}
 		node.Address.NoiseInfo = noise.Convert()
+		asn.apply(&node)
+		reachable.apply(&node)
 		node.Email = email.String
 		node.Wallet = wallet.String
 		// node.Exiting and node.Suspended are always false here, as we filter them out unconditionally above.
@@ -206,6 +221,7 @@ func (cache *overlaycache) selectAllStorageNodesDownload(ctx context.Context, on
 	case dbutil.Cockroach, dbutil.Postgres:
 		query := `
 			SELECT id, address, email, wallet, last_net, last_ip_port, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk,
+					asn, as_organization, ` + reachableColumns("") + `,
 					exit_initiated_at IS NOT NULL AS exiting, (unknown_audit_suspended IS NOT NULL OR offline_suspended IS NOT NULL) AS suspended, vetted_at is not null as vetted
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(asOfConfig.Interval()) + `
@@ -222,6 +238,7 @@ func (cache *overlaycache) selectAllStorageNodesDownload(ctx context.Context, on
 	case dbutil.Spanner:
 		query := `
 			SELECT id, address, email, wallet, last_net, last_ip_port, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk,
+					asn, as_organization, ` + reachableColumns("") + `,
 					exit_initiated_at IS NOT NULL AS exiting, (unknown_audit_suspended IS NOT NULL OR offline_suspended IS NOT NULL) AS suspended, vetted_at is not null as vetted
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(asOfConfig.Interval()) + `
@@ -249,8 +266,11 @@ func (cache *overlaycache) selectAllStorageNodesDownload(ctx context.Context, on
 		node.Address = &pb.NodeAddress{}
 		var lastIPPort sql.NullString
 		var noise noiseScanner
+		var asn asnScanner
+		var reachable reachableScanner
 		var err = rows.Scan(&node.ID, &node.Address.Address, &node.Email, &node.Wallet, &node.LastNet, &lastIPPort, &noise.Proto,
 			&noise.PublicKey, &node.Address.DebounceLimit, &node.Address.Features, &node.CountryCode, &node.PieceCount, &node.FreeDisk,
+			&asn.Number, &asn.Organization, &reachable.TCP, &reachable.QUIC, &reachable.Noise,
 			&node.Exiting, &node.Suspended, &node.Vetted)
 		if err != nil {
 			return nil, err
@@ -259,6 +279,8 @@ func (cache *overlaycache) selectAllStorageNodesDownload(ctx context.Context, on
 			node.LastIPPort = lastIPPort.String
 		}
 		node.Address.NoiseInfo = noise.Convert()
+		asn.apply(&node)
+		reachable.apply(&node)
 		// we consider all nodes in the download selection cache to be online.
 		node.Online = true
 		nodes = append(nodes, &node)
@@ -377,8 +399,9 @@ func (cache *overlaycache) GetOfflineNodesForEmail(ctx context.Context, offlineW
 				AND email != ''
 				AND disqualified is NULL
 				AND exit_finished_at is NULL
+				AND (maintenance_end IS NULL OR maintenance_start > $5 OR maintenance_end <= $5)
 			LIMIT $4
-		`, now.Add(-offlineWindow), now.Add(-cutoff), now.Add(-cooldown), limit)
+		`, now.Add(-offlineWindow), now.Add(-cutoff), now.Add(-cooldown), limit, now)
 	case dbutil.Spanner:
 		rows, err = cache.db.QueryContext(ctx, `
 			SELECT id, email
@@ -389,8 +412,9 @@ func (cache *overlaycache) GetOfflineNodesForEmail(ctx context.Context, offlineW
 				AND email != ''
 				AND disqualified is NULL
 				AND exit_finished_at is NULL
+				AND (maintenance_end IS NULL OR maintenance_start > ? OR maintenance_end <= ?)
 			LIMIT ?
-		`, now.Add(-offlineWindow), now.Add(-cutoff), now.Add(-cooldown), limit)
+		`, now.Add(-offlineWindow), now.Add(-cutoff), now.Add(-cooldown), now, now, limit)
 	default:
 		return nil, Error.New("unsupported implementation")
 	}
@@ -462,19 +486,21 @@ func (cache *overlaycache) GetActiveNodes(ctx context.Context, nodeIDs storj.Nod
 
 		err = withRows(cache.db.Query(ctx, `
 			SELECT n.id, n.address, n.email, n.wallet, n.last_net, n.last_ip_port, n.country_code, n.piece_count, n.free_disk,
+				n.asn, n.as_organization, `+reachableColumns("n.")+`,
 				n.last_contact_success > $2 AS online,
 				(n.offline_suspended IS NOT NULL OR n.unknown_audit_suspended IS NOT NULL) AS suspended,
 				n.disqualified IS NOT NULL AS disqualified,
 				n.exit_initiated_at IS NOT NULL AS exiting,
 				n.exit_finished_at IS NOT NULL AS exited,
 				node_tags.name, node_tags.value, node_tags.signed_at, node_tags.signer,
-				n.vetted_at IS NOT NULL AS vetted
+				n.vetted_at IS NOT NULL AS vetted,
+				COALESCE(n.maintenance_start <= $3 AND n.maintenance_end > $3, false) AS in_maintenance
 			FROM unnest($1::bytea[]) WITH ORDINALITY AS input(node_id, ordinal)
 				LEFT OUTER JOIN nodes n ON input.node_id = n.id
 				LEFT JOIN node_tags on node_tags.node_id = n.id
 				`+cache.db.impl.AsOfSystemInterval(asOfSystemInterval)+`
 			ORDER BY input.ordinal
-		`, pgutil.NodeIDArray(nodeIDs), time.Now().Add(-onlineWindow),
+		`, pgutil.NodeIDArray(nodeIDs), time.Now().Add(-onlineWindow), time.Now(),
 		))(func(rows tagsql.Rows) error {
 			for rows.Next() {
 				node, tag, disqualifiedOrExited, err := scanSelectedNodeWithTag(rows)
@@ -517,10 +543,12 @@ func (cache *overlaycache) GetActiveNodes(ctx context.Context, nodeIDs storj.Nod
 					SELECT id, address, email, wallet, last_net,
 						last_ip_port, country_code,
 						piece_count, free_disk,
+						asn, as_organization, ` + reachableColumns("") + `,
 						last_contact_success > @online_threshold AS online,
 						(offline_suspended IS NOT NULL OR unknown_audit_suspended IS NOT NULL) AS suspended,
 						exit_initiated_at IS NOT NULL AS exiting,
 						vetted_at IS NOT NULL AS vetted,
+						COALESCE(maintenance_start <= @now AND maintenance_end > @now, false) AS in_maintenance,
 						ARRAY(
 							SELECT AS STRUCT
 								node_tags.name as Name,
@@ -537,6 +565,7 @@ func (cache *overlaycache) GetActiveNodes(ctx context.Context, nodeIDs storj.Nod
 				`,
 				Params: map[string]any{
 					"online_threshold": time.Now().Add(-onlineWindow),
+					"now":              time.Now(),
 					"nodes":            nodeIDs.Bytes(),
 				},
 			})
@@ -544,7 +573,8 @@ func (cache *overlaycache) GetActiveNodes(ctx context.Context, nodeIDs storj.Nod
 			return Error.Wrap(iter.Do(func(row *spanner.Row) error {
 				var node nodeselection.SelectedNode
 				node.Address = &pb.NodeAddress{}
-				var lastIPPort, countryCode sql.NullString
+				var lastIPPort, countryCode, asOrganization sql.NullString
+				var asn spanner.NullInt64
 
 				// TODO(spanner): currently only supports scanning into []*struct.
 				// Needs https://github.com/googleapis/google-cloud-go/issues/11090 to fix.
@@ -554,10 +584,13 @@ func (cache *overlaycache) GetActiveNodes(ctx context.Context, nodeIDs storj.Nod
 					&node.ID, &node.Address.Address, &node.Email, &node.Wallet, &node.LastNet,
 					&lastIPPort, &countryCode,
 					&node.PieceCount, &node.FreeDisk,
+					&asn, &asOrganization,
+					&node.Reachable.TCP, &node.Reachable.QUIC, &node.Reachable.Noise,
 					&node.Online,
 					&node.Suspended,
 					&node.Exiting,
 					&node.Vetted,
+					&node.InMaintenance,
 					&tags)
 				if err != nil {
 					return Error.Wrap(err)
@@ -569,6 +602,10 @@ func (cache *overlaycache) GetActiveNodes(ctx context.Context, nodeIDs storj.Nod
 				if countryCode.Valid {
 					node.CountryCode = location.ToCountryCode(countryCode.String)
 				}
+				if asn.Valid {
+					node.ASN = uint32(asn.Int64)
+				}
+				node.ASOrganization = asOrganization.String
 
 				if len(tags) > 0 {
 					node.Tags = make([]nodeselection.NodeTag, 0, len(tags))
@@ -656,17 +693,19 @@ func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, onlineWind
 	case dbutil.Cockroach, dbutil.Postgres:
 		err = withRows(cache.db.Query(ctx, `
 			SELECT id, address, email, wallet, last_net, last_ip_port, country_code, piece_count, free_disk,
+				asn, as_organization, `+reachableColumns("")+`,
 				last_contact_success > $1 AS online,
 				(offline_suspended IS NOT NULL OR unknown_audit_suspended IS NOT NULL) AS suspended,
 				false AS disqualified,
 				exit_initiated_at IS NOT NULL AS exiting,
 				false AS exited,
-				vetted_at IS NOT NULL AS vetted
+				vetted_at IS NOT NULL AS vetted,
+				COALESCE(maintenance_start <= $2 AND maintenance_end > $2, false) AS in_maintenance
 			FROM nodes
 				`+cache.db.impl.AsOfSystemInterval(asOfSystemInterval)+`
 			WHERE disqualified IS NULL
 				AND exit_finished_at IS NULL
-		`, time.Now().Add(-onlineWindow),
+		`, time.Now().Add(-onlineWindow), time.Now(),
 		))(func(rows tagsql.Rows) error {
 			for rows.Next() {
 				node, err := scanSelectedNode(rows)
@@ -680,17 +719,19 @@ func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, onlineWind
 	case dbutil.Spanner:
 		err = withRows(cache.db.Query(ctx, `
 			SELECT id, address, email, wallet, last_net, last_ip_port, country_code, piece_count, free_disk,
+				asn, as_organization, `+reachableColumns("")+`,
 				last_contact_success > ? AS online,
 				(offline_suspended IS NOT NULL OR unknown_audit_suspended IS NOT NULL) AS suspended,
 				false AS disqualified,
 				exit_initiated_at IS NOT NULL AS exiting,
 				false AS exited,
-				vetted_at IS NOT NULL AS vetted
+				vetted_at IS NOT NULL AS vetted,
+				COALESCE(maintenance_start <= ? AND maintenance_end > ?, false) AS in_maintenance
 			FROM nodes
 				`+cache.db.impl.AsOfSystemInterval(asOfSystemInterval)+`
 			WHERE disqualified IS NULL
 				AND exit_finished_at IS NULL
-		`, time.Now().Add(-onlineWindow),
+		`, time.Now().Add(-onlineWindow), time.Now(), time.Now(),
 		))(func(rows tagsql.Rows) error {
 			for rows.Next() {
 				node, err := scanSelectedNode(rows)
@@ -748,9 +789,12 @@ func scanSelectedNode(rows tagsql.Rows) (nodeselection.SelectedNode, error) {
 	node.Address = &pb.NodeAddress{}
 	var nodeID nullNodeID
 	var address, email, wallet, lastNet, lastIPPort, countryCode sql.NullString
-	var online, suspended, disqualified, exiting, exited, vetted sql.NullBool
+	var online, suspended, disqualified, exiting, exited, vetted, inMaintenance sql.NullBool
+	var asn asnScanner
+	var reachable reachableScanner
 	err := rows.Scan(&nodeID, &address, &email, &wallet, &lastNet, &lastIPPort, &countryCode, &node.PieceCount, &node.FreeDisk,
-		&online, &suspended, &disqualified, &exiting, &exited, &vetted)
+		&asn.Number, &asn.Organization, &reachable.TCP, &reachable.QUIC, &reachable.Noise,
+		&online, &suspended, &disqualified, &exiting, &exited, &vetted, &inMaintenance)
 	if err != nil {
 		return nodeselection.SelectedNode{}, err
 	}
@@ -776,10 +820,13 @@ func scanSelectedNode(rows tagsql.Rows) (nodeselection.SelectedNode, error) {
 	if countryCode.Valid {
 		node.CountryCode = location.ToCountryCode(countryCode.String)
 	}
+	asn.apply(&node)
+	reachable.apply(&node)
 	node.Online = online.Bool
 	node.Suspended = suspended.Bool
 	node.Exiting = exiting.Bool
 	node.Vetted = vetted.Bool
+	node.InMaintenance = inMaintenance.Bool
 	return node, nil
 }
 
@@ -788,8 +835,10 @@ func scanSelectedNodeWithTag(rows tagsql.Rows) (_ nodeselection.SelectedNode, _
 	node.Address = &pb.NodeAddress{}
 	var nodeID nullNodeID
 	var address, wallet, email, lastNet, lastIPPort, countryCode sql.NullString
-	var online, suspended, disqualified, exiting, exited, vetted sql.NullBool
+	var online, suspended, disqualified, exiting, exited, vetted, inMaintenance sql.NullBool
 	var pieceCount, freeDisk sql.NullInt64
+	var asn asnScanner
+	var reachable reachableScanner
 
 	var tag nodeselection.NodeTag
 	var name []byte
@@ -797,7 +846,8 @@ func scanSelectedNodeWithTag(rows tagsql.Rows) (_ nodeselection.SelectedNode, _
 	signer := nullNodeID{}
 
 	err = rows.Scan(&nodeID, &address, &email, &wallet, &lastNet, &lastIPPort, &countryCode, &pieceCount, &freeDisk,
-		&online, &suspended, &disqualified, &exiting, &exited, &name, &tag.Value, &signedAt, &signer, &vetted)
+		&asn.Number, &asn.Organization, &reachable.TCP, &reachable.QUIC, &reachable.Noise,
+		&online, &suspended, &disqualified, &exiting, &exited, &name, &tag.Value, &signedAt, &signer, &vetted, &inMaintenance)
 	if err != nil {
 		return nodeselection.SelectedNode{}, nodeselection.NodeTag{}, true, err
 	}
@@ -826,10 +876,13 @@ func scanSelectedNodeWithTag(rows tagsql.Rows) (_ nodeselection.SelectedNode, _
 	if freeDisk.Valid {
 		node.FreeDisk = freeDisk.Int64
 	}
+	asn.apply(&node)
+	reachable.apply(&node)
 	node.Online = online.Bool
 	node.Suspended = suspended.Bool
 	node.Exiting = exiting.Bool
 	node.Vetted = vetted.Bool
+	node.InMaintenance = inMaintenance.Bool
 
 	if len(name) > 0 {
 		tag.Name = string(name)
@@ -1356,13 +1409,44 @@ func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier,
 	if info.CountryCode != nil {
 		node.CountryCode = location.ToCountryCode(*info.CountryCode)
 	}
+	if info.Asn != nil {
+		node.ASN = uint32(*info.Asn)
+	}
+	if info.AsOrganization != nil {
+		node.ASOrganization = *info.AsOrganization
+	}
+	if info.MaintenanceStart != nil && info.MaintenanceEnd != nil {
+		node.Maintenance = overlay.MaintenanceWindow{
+			Start: *info.MaintenanceStart,
+			End:   *info.MaintenanceEnd,
+		}
+	}
 	if info.Contained != nil {
 		node.Contained = true
 	}
 
+	node.LastTransportSuccess = overlay.TransportSuccess{
+		TCP:   info.LastTcpSuccess,
+		QUIC:  info.LastQuicSuccess,
+		Noise: info.LastNoiseSuccess,
+	}
+	node.Reachable = nodeselection.Transports{
+		TCP:   succeededAtLastContact(info, info.LastTcpSuccess),
+		QUIC:  succeededAtLastContact(info, info.LastQuicSuccess),
+		Noise: succeededAtLastContact(info, info.LastNoiseSuccess),
+	}
+
 	return node, nil
 }
 
+// succeededAtLastContact returns whether the transport succeeded during the
+// last check-in, i.e. its last success is not older than the last contact.
+func succeededAtLastContact(info *dbx.Node, lastSuccess *time.Time) bool {
+	return lastSuccess != nil &&
+		!lastSuccess.Before(info.LastContactSuccess) &&
+		!lastSuccess.Before(info.LastContactFailure)
+}
+
 // encodeWalletFeatures encodes wallet features into comma separated list string.
 func encodeWalletFeatures(features []string) (string, error) {
 	var errGroup errs.Group
@@ -1528,6 +1612,8 @@ func (cache *overlaycache) getNodesForDQLastSeenBefore(ctx context.Context, cuto
 }
 
 func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node overlay.NodeCheckInInfo, timestamp time.Time, semVer version.SemVer, walletFeatures string) (updated bool, err error) {
+	asn, asOrganization := checkInASN(node)
+
 	// Spanner does not support int32 data type
 	var noiseProto sql.NullInt64
 	var noisePublicKey []byte
@@ -1570,6 +1656,20 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 				noise_public_key=$22,
 				debounce_limit=$23,
 				features=$24,
+				asn=$25,
+				as_organization=$26,
+				last_tcp_success = CASE WHEN $27::bool IS TRUE
+					THEN $15::timestamptz
+					ELSE nodes.last_tcp_success
+				END,
+				last_quic_success = CASE WHEN $28::bool IS TRUE
+					THEN $15::timestamptz
+					ELSE nodes.last_quic_success
+				END,
+				last_noise_success = CASE WHEN $29::bool IS TRUE
+					THEN $15::timestamptz
+					ELSE nodes.last_noise_success
+				END,
 				last_software_update_email = CASE
 					WHEN $19::bool IS TRUE THEN $15::timestamptz
 					WHEN $20::bool IS FALSE THEN NULL
@@ -1600,6 +1700,10 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 			node.SoftwareUpdateEmailSent, node.VersionBelowMin,
 			// args $21 - $24
 			noiseProto, noisePublicKey, node.Address.DebounceLimit, node.Address.Features,
+			// args $25 - $26
+			asn, asOrganization,
+			// args $27 - $29
+			node.Reachable.TCP, node.Reachable.QUIC, node.Reachable.Noise,
 		)
 
 	case dbutil.Spanner:
@@ -1622,6 +1726,19 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 				last_ip_port=?, wallet_features=?, country_code=?,
 				noise_proto=?, noise_public_key=?,
 				debounce_limit=?, features=?,
+				asn=?, as_organization=?,
+				last_tcp_success = CASE WHEN CAST(? AS bool) IS TRUE
+					THEN CAST(? AS TIMESTAMP)
+					ELSE nodes.last_tcp_success
+				END,
+				last_quic_success = CASE WHEN CAST(? AS bool) IS TRUE
+					THEN CAST(? AS TIMESTAMP)
+					ELSE nodes.last_quic_success
+				END,
+				last_noise_success = CASE WHEN CAST(? AS bool) IS TRUE
+					THEN CAST(? AS TIMESTAMP)
+					ELSE nodes.last_noise_success
+				END,
 				last_software_update_email = CASE
 					WHEN CAST(? AS bool) IS TRUE THEN CAST(? AS TIMESTAMP)
 					WHEN CAST(? AS bool) IS FALSE THEN NULL
@@ -1643,6 +1760,10 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 			node.LastIPPort, walletFeatures, node.CountryCode.String(),
 			noiseProto, noisePublicKey,
 			int(node.Address.DebounceLimit), node.Address.Features,
+			asn, asOrganization,
+			node.Reachable.TCP, timestamp,
+			node.Reachable.QUIC, timestamp,
+			node.Reachable.Noise, timestamp,
 
 			node.SoftwareUpdateEmailSent, timestamp, node.VersionBelowMin,
 			node.IsUp,
@@ -1664,6 +1785,18 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 	return affected > 0, nil
 }
 
+// checkInASN returns the autonomous system of the node as nullable database
+// values, where the unknown values are stored as NULL.
+func checkInASN(node overlay.NodeCheckInInfo) (asn sql.NullInt64, asOrganization sql.NullString) {
+	if node.ASN != 0 {
+		asn = sql.NullInt64{Int64: int64(node.ASN), Valid: true}
+	}
+	if node.ASOrganization != "" {
+		asOrganization = sql.NullString{String: node.ASOrganization, Valid: true}
+	}
+	return asn, asOrganization
+}
+
 // UpdateCheckIn updates a single storagenode with info from when the the node last checked in.
 func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeCheckInInfo, timestamp time.Time, config overlay.NodeSelectionConfig) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1699,6 +1832,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		}
 		noisePublicKey = node.Address.NoiseInfo.PublicKey
 	}
+	asn, asOrganization := checkInASN(node)
+
 	switch cache.db.impl {
 	case dbutil.Postgres, dbutil.Cockroach:
 		_, err = cache.db.ExecContext(ctx, `
@@ -1711,7 +1846,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				major, minor, patch, commit_hash, release_timestamp, release,
 				last_ip_port, wallet_features, country_code,
 				noise_proto, noise_public_key, debounce_limit,
-				features
+				features, asn, as_organization,
+				last_tcp_success, last_quic_success, last_noise_success
 			)
 			VALUES (
 				$1, $2, $3, $4,
@@ -1725,7 +1861,10 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				$9, $10, $11, $12, $13, $14,
 				$16, $17, $18,
 				$21, $22, $23,
-				$24
+				$24, $25, $26,
+				CASE WHEN $27::bool IS TRUE THEN $15::timestamptz END,
+				CASE WHEN $28::bool IS TRUE THEN $15::timestamptz END,
+				CASE WHEN $29::bool IS TRUE THEN $15::timestamptz END
 			)
 			ON CONFLICT (id)
 			DO UPDATE
@@ -1752,6 +1891,20 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				noise_public_key=$22,
 				debounce_limit=$23,
 				features=$24,
+				asn=$25,
+				as_organization=$26,
+				last_tcp_success = CASE WHEN $27::bool IS TRUE
+					THEN $15::timestamptz
+					ELSE nodes.last_tcp_success
+				END,
+				last_quic_success = CASE WHEN $28::bool IS TRUE
+					THEN $15::timestamptz
+					ELSE nodes.last_quic_success
+				END,
+				last_noise_success = CASE WHEN $29::bool IS TRUE
+					THEN $15::timestamptz
+					ELSE nodes.last_noise_success
+				END,
 				last_software_update_email = CASE
 					WHEN $19::bool IS TRUE THEN $15::timestamptz
 					WHEN $20::bool IS FALSE THEN NULL
@@ -1778,6 +1931,10 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 			node.SoftwareUpdateEmailSent, node.VersionBelowMin,
 			// args $21 - $24
 			noiseProto, noisePublicKey, node.Address.DebounceLimit, node.Address.Features,
+			// args $25 - $26
+			asn, asOrganization,
+			// args $27 - $29
+			node.Reachable.TCP, node.Reachable.QUIC, node.Reachable.Noise,
 		)
 	case dbutil.Spanner:
 
@@ -1798,6 +1955,19 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				last_ip_port=?, wallet_features=?, country_code=?,
 				noise_proto=?, noise_public_key=?,
 				debounce_limit=?, features=?,
+				asn=?, as_organization=?,
+				last_tcp_success = CASE WHEN CAST(? AS bool) IS TRUE
+					THEN CAST(? AS timestamp)
+					ELSE nodes.last_tcp_success
+				END,
+				last_quic_success = CASE WHEN CAST(? AS bool) IS TRUE
+					THEN CAST(? AS timestamp)
+					ELSE nodes.last_quic_success
+				END,
+				last_noise_success = CASE WHEN CAST(? AS bool) IS TRUE
+					THEN CAST(? AS timestamp)
+					ELSE nodes.last_noise_success
+				END,
 				last_software_update_email = CASE
 					WHEN CAST(? AS bool) IS TRUE THEN CAST(? AS timestamp)
 					WHEN CAST(? AS bool) IS FALSE THEN NULL
@@ -1816,6 +1986,10 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 			node.LastIPPort, walletFeatures, node.CountryCode.String(),
 			noiseProto, noisePublicKey,
 			int(node.Address.DebounceLimit), node.Address.Features,
+			asn, asOrganization,
+			node.Reachable.TCP, timestamp,
+			node.Reachable.QUIC, timestamp,
+			node.Reachable.Noise, timestamp,
 			node.SoftwareUpdateEmailSent, timestamp, node.VersionBelowMin,
 			node.IsUp, node.NodeID.Bytes(),
 		)
@@ -1827,7 +2001,9 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				last_contact_success, last_contact_failure, major, minor,
 				patch, commit_hash, release_timestamp, release,
 				last_ip_port, wallet_features, country_code,
-				noise_proto, noise_public_key, debounce_limit, features
+				noise_proto, noise_public_key, debounce_limit, features,
+				asn, as_organization,
+				last_tcp_success, last_quic_success, last_noise_success
 			)
 			VALUES (
 				?, ?, ?, ?, ?, ?, ?,
@@ -1837,7 +2013,11 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				CASE WHEN CAST(? AS bool) IS FALSE THEN CAST(? AS timestamp)
 					ELSE CAST('0001-01-01 00:00:00+00' AS timestamp)
 				END,
-				?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+				?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
+				?, ?,
+				CASE WHEN CAST(? AS bool) IS TRUE THEN CAST(? AS timestamp) END,
+				CASE WHEN CAST(? AS bool) IS TRUE THEN CAST(? AS timestamp) END,
+				CASE WHEN CAST(? AS bool) IS TRUE THEN CAST(? AS timestamp) END
 			);
 				`,
 				node.NodeID.Bytes(), node.Address.GetAddress(), node.LastNet,
@@ -1848,6 +2028,10 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				node.Version.Timestamp, node.Version.GetRelease(), node.LastIPPort,
 				walletFeatures, node.CountryCode.String(), noiseProto,
 				noisePublicKey, int(node.Address.DebounceLimit), node.Address.Features,
+				asn, asOrganization,
+				node.Reachable.TCP, timestamp,
+				node.Reachable.QUIC, timestamp,
+				node.Reachable.Noise, timestamp,
 			)
 		}
 	default:
@@ -2301,6 +2485,61 @@ func (n *noiseScanner) Convert() *pb.NoiseInfo {
 	}
 }
 
+type asnScanner struct {
+	Number       sql.NullInt64
+	Organization sql.NullString
+}
+
+func (a *asnScanner) apply(node *nodeselection.SelectedNode) {
+	if a.Number.Valid {
+		node.ASN = uint32(a.Number.Int64)
+	}
+	node.ASOrganization = a.Organization.String
+}
+
+type reachableScanner struct {
+	TCP   sql.NullBool
+	QUIC  sql.NullBool
+	Noise sql.NullBool
+}
+
+func (r *reachableScanner) apply(node *nodeselection.SelectedNode) {
+	node.Reachable = nodeselection.Transports{
+		TCP:   r.TCP.Bool,
+		QUIC:  r.QUIC.Bool,
+		Noise: r.Noise.Bool,
+	}
+}
+
+// reachableColumns selects for each transport whether it succeeded during the
+// last check-in, i.e. its last success is not older than the last contact.
+func reachableColumns(prefix string) string {
+	columns := make([]string, 0, 3)
+	for _, transport := range []string{"tcp", "quic", "noise"} {
+		lastSuccess := prefix + "last_" + transport + "_success"
+		columns = append(columns, "COALESCE("+
+			lastSuccess+" >= "+prefix+"last_contact_success AND "+
+			lastSuccess+" >= "+prefix+"last_contact_failure, false) AS "+transport+"_reachable")
+	}
+	return strings.Join(columns, ", ")
+}
+
+// SetMaintenanceWindow sets the maintenance window of the node, a zero window clears it.
+func (cache *overlaycache) SetMaintenanceWindow(ctx context.Context, nodeID storj.NodeID, window overlay.MaintenanceWindow) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updateFields := dbx.Node_Update_Fields{
+		MaintenanceStart: dbx.Node_MaintenanceStart_Null(),
+		MaintenanceEnd:   dbx.Node_MaintenanceEnd_Null(),
+	}
+	if !window.IsZero() {
+		updateFields.MaintenanceStart = dbx.Node_MaintenanceStart(window.Start)
+		updateFields.MaintenanceEnd = dbx.Node_MaintenanceEnd(window.End)
+	}
+
+	return Error.Wrap(cache.db.UpdateNoReturn_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields))
+}
+
 // OneTimeFixLastNets updates the last_net values for all node records to be equal to their
 // last_ip_port values.
 //
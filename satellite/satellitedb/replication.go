@@ -0,0 +1,500 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/replication"
+	"storj.io/storj/satellite/satellitedb/dbx"
+	"storj.io/storj/shared/dbutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// ensure that replicationDB implements replication.DB.
+var _ replication.DB = (*replicationDB)(nil)
+
+// replicationDB implements storj.io/storj/satellite/replication.DB.
+type replicationDB struct {
+	db *satelliteDB
+}
+
+const replicationJobColumns = `
+	project_id, bucket_name, object_key, version, stream_id, destination_bucket_name, action,
+	status, destination_stream_id, destination_version, attempts, last_error, inserted_at, updated_at`
+
+// SetRule creates or updates a replication rule.
+func (r *replicationDB) SetRule(ctx context.Context, rule replication.Rule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch r.db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		_, err = r.db.ExecContext(ctx, `
+			INSERT INTO bucket_replication_rules (
+				project_id, bucket_name, destination_bucket_name, prefix, replicate_deletes, created_at
+			) VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (project_id, bucket_name, destination_bucket_name)
+			DO UPDATE SET prefix = EXCLUDED.prefix, replicate_deletes = EXCLUDED.replicate_deletes
+		`, rule.ProjectID, []byte(rule.BucketName), []byte(rule.DestinationBucket), []byte(rule.Prefix), rule.ReplicateDeletes, rule.CreatedAt)
+	case dbutil.Spanner:
+		err = r.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+			result, err := tx.Tx.ExecContext(ctx, `
+				UPDATE bucket_replication_rules SET prefix = ?, replicate_deletes = ?
+				WHERE project_id = ? AND bucket_name = ? AND destination_bucket_name = ?
+			`, []byte(rule.Prefix), rule.ReplicateDeletes, rule.ProjectID, []byte(rule.BucketName), []byte(rule.DestinationBucket))
+			if err != nil {
+				return err
+			}
+			if affected, err := result.RowsAffected(); err != nil || affected > 0 {
+				return err
+			}
+			_, err = tx.Tx.ExecContext(ctx, `
+				INSERT INTO bucket_replication_rules (
+					project_id, bucket_name, destination_bucket_name, prefix, replicate_deletes, created_at
+				) VALUES (?, ?, ?, ?, ?, ?)
+			`, rule.ProjectID, []byte(rule.BucketName), []byte(rule.DestinationBucket), []byte(rule.Prefix), rule.ReplicateDeletes, rule.CreatedAt)
+			return err
+		})
+	default:
+		return Error.New("unsupported database: %v", r.db.impl)
+	}
+	return Error.Wrap(err)
+}
+
+// DeleteRule deletes a replication rule.
+func (r *replicationDB) DeleteRule(ctx context.Context, projectID uuid.UUID, bucketName, destinationBucket metabase.BucketName) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	switch r.db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		query = `DELETE FROM bucket_replication_rules WHERE project_id = $1 AND bucket_name = $2 AND destination_bucket_name = $3`
+	case dbutil.Spanner:
+		query = `DELETE FROM bucket_replication_rules WHERE project_id = ? AND bucket_name = ? AND destination_bucket_name = ?`
+	default:
+		return Error.New("unsupported database: %v", r.db.impl)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, projectID, []byte(bucketName), []byte(destinationBucket))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return replication.ErrRuleNotFound.New("%s -> %s", bucketName, destinationBucket)
+	}
+	return nil
+}
+
+// ListRules returns replication rules of a bucket.
+func (r *replicationDB) ListRules(ctx context.Context, projectID uuid.UUID, bucketName metabase.BucketName) (_ []replication.Rule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	switch r.db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		query = `
+			SELECT destination_bucket_name, prefix, replicate_deletes, created_at
+			FROM bucket_replication_rules
+			WHERE project_id = $1 AND bucket_name = $2
+			ORDER BY destination_bucket_name`
+	case dbutil.Spanner:
+		query = `
+			SELECT destination_bucket_name, prefix, replicate_deletes, created_at
+			FROM bucket_replication_rules
+			WHERE project_id = ? AND bucket_name = ?
+			ORDER BY destination_bucket_name`
+	default:
+		return nil, Error.New("unsupported database: %v", r.db.impl)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, projectID, []byte(bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var rules []replication.Rule
+	for rows.Next() {
+		rule := replication.Rule{
+			ProjectID:  projectID,
+			BucketName: bucketName,
+		}
+		var destination, prefix []byte
+		if err := rows.Scan(&destination, &prefix, &rule.ReplicateDeletes, &rule.CreatedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		rule.DestinationBucket = metabase.BucketName(destination)
+		rule.Prefix = metabase.ObjectKey(prefix)
+		rules = append(rules, rule)
+	}
+	return rules, Error.Wrap(rows.Err())
+}
+
+// ListAllRules returns replication rules of all buckets.
+func (r *replicationDB) ListAllRules(ctx context.Context) (_ []replication.Rule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT project_id, bucket_name, destination_bucket_name, prefix, replicate_deletes, created_at
+		FROM bucket_replication_rules
+		ORDER BY project_id, bucket_name, destination_bucket_name`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var rules []replication.Rule
+	for rows.Next() {
+		var rule replication.Rule
+		var bucket, destination, prefix []byte
+		if err := rows.Scan(&rule.ProjectID, &bucket, &destination, &prefix, &rule.ReplicateDeletes, &rule.CreatedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		rule.BucketName = metabase.BucketName(bucket)
+		rule.DestinationBucket = metabase.BucketName(destination)
+		rule.Prefix = metabase.ObjectKey(prefix)
+		rules = append(rules, rule)
+	}
+	return rules, Error.Wrap(rows.Err())
+}
+
+// Enqueue adds jobs to the queue. Jobs which are already queued are reset to pending.
+func (r *replicationDB) Enqueue(ctx context.Context, jobs []replication.Job) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	var query string
+	switch r.db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		query = `
+			INSERT INTO replication_jobs (
+				project_id, bucket_name, object_key, version, stream_id, destination_bucket_name, action,
+				status, attempts, inserted_at, updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, 0, $9, $9)
+			ON CONFLICT (project_id, bucket_name, object_key, version, destination_bucket_name, action)
+			DO UPDATE SET
+				stream_id = EXCLUDED.stream_id, status = EXCLUDED.status,
+				destination_stream_id = NULL, destination_version = NULL,
+				attempts = 0, last_error = NULL, updated_at = EXCLUDED.updated_at`
+	case dbutil.Spanner:
+		query = `
+			INSERT OR UPDATE INTO replication_jobs (
+				project_id, bucket_name, object_key, version, stream_id, destination_bucket_name, action,
+				status, destination_stream_id, destination_version, attempts, last_error, inserted_at, updated_at
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, NULL, NULL, 0, NULL, ?, ?)`
+	default:
+		return Error.New("unsupported database: %v", r.db.impl)
+	}
+
+	now := time.Now()
+	return Error.Wrap(r.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		for _, job := range jobs {
+			args := []any{
+				job.ProjectID, []byte(job.BucketName), []byte(job.ObjectKey), int64(job.Version), job.StreamID,
+				[]byte(job.DestinationBucket), int64(job.Action), int64(replication.StatusPending), now,
+			}
+			if r.db.impl == dbutil.Spanner {
+				args = append(args, now)
+			}
+			if _, err := tx.Tx.ExecContext(ctx, query, args...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// EnqueueMissing adds jobs to the queue, which are not queued yet. Jobs which
+// are already queued are not changed.
+func (r *replicationDB) EnqueueMissing(ctx context.Context, jobs []replication.Job) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	var query string
+	switch r.db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		query = `
+			INSERT INTO replication_jobs (
+				project_id, bucket_name, object_key, version, stream_id, destination_bucket_name, action,
+				status, attempts, inserted_at, updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, 0, $9, $9)
+			ON CONFLICT (project_id, bucket_name, object_key, version, destination_bucket_name, action)
+			DO NOTHING`
+	case dbutil.Spanner:
+		query = `
+			INSERT OR IGNORE INTO replication_jobs (
+				project_id, bucket_name, object_key, version, stream_id, destination_bucket_name, action,
+				status, attempts, inserted_at, updated_at
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, 0, ?, ?)`
+	default:
+		return Error.New("unsupported database: %v", r.db.impl)
+	}
+
+	now := time.Now()
+	return Error.Wrap(r.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		for _, job := range jobs {
+			args := []any{
+				job.ProjectID, []byte(job.BucketName), []byte(job.ObjectKey), int64(job.Version), job.StreamID,
+				[]byte(job.DestinationBucket), int64(job.Action), int64(replication.StatusPending), now,
+			}
+			if r.db.impl == dbutil.Spanner {
+				args = append(args, now)
+			}
+			if _, err := tx.Tx.ExecContext(ctx, query, args...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// Claim returns up to limit jobs with the specified status which were not
+// updated since updatedBefore, and marks them as updated.
+func (r *replicationDB) Claim(ctx context.Context, status replication.Status, updatedBefore time.Time, limit int) (jobs []replication.Job, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	switch r.db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		rows, err := r.db.QueryContext(ctx, `
+			UPDATE replication_jobs SET updated_at = $3
+			WHERE (project_id, bucket_name, object_key, version, destination_bucket_name, action) IN (
+				SELECT project_id, bucket_name, object_key, version, destination_bucket_name, action
+				FROM replication_jobs
+				WHERE status = $1 AND updated_at <= $2
+				ORDER BY updated_at
+				LIMIT $4
+			)
+			RETURNING `+replicationJobColumns,
+			int64(status), updatedBefore, now, limit)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return scanReplicationJobs(rows)
+	case dbutil.Spanner:
+		err = r.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+			rows, err := tx.Tx.QueryContext(ctx, `
+				SELECT `+replicationJobColumns+`
+				FROM replication_jobs
+				WHERE status = ? AND updated_at <= ?
+				ORDER BY updated_at
+				LIMIT ?`,
+				int64(status), updatedBefore, int64(limit))
+			if err != nil {
+				return err
+			}
+			jobs, err = scanReplicationJobs(rows)
+			if err != nil {
+				return err
+			}
+			for i := range jobs {
+				jobs[i].UpdatedAt = now
+				_, err := tx.Tx.ExecContext(ctx, `
+					UPDATE replication_jobs SET updated_at = ?
+					WHERE project_id = ? AND bucket_name = ? AND object_key = ? AND version = ?
+						AND destination_bucket_name = ? AND action = ?`,
+					now, jobs[i].ProjectID, []byte(jobs[i].BucketName), []byte(jobs[i].ObjectKey), int64(jobs[i].Version),
+					[]byte(jobs[i].DestinationBucket), int64(jobs[i].Action))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		return jobs, Error.Wrap(err)
+	default:
+		return nil, Error.New("unsupported database: %v", r.db.impl)
+	}
+}
+
+// Update updates the status of a job.
+func (r *replicationDB) Update(ctx context.Context, job replication.Job) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	switch r.db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		query = `
+			UPDATE replication_jobs SET
+				status = $1, destination_stream_id = $2, destination_version = $3,
+				attempts = $4, last_error = $5, updated_at = $6
+			WHERE project_id = $7 AND bucket_name = $8 AND object_key = $9 AND version = $10
+				AND destination_bucket_name = $11 AND action = $12`
+	case dbutil.Spanner:
+		query = `
+			UPDATE replication_jobs SET
+				status = ?, destination_stream_id = ?, destination_version = ?,
+				attempts = ?, last_error = ?, updated_at = ?
+			WHERE project_id = ? AND bucket_name = ? AND object_key = ? AND version = ?
+				AND destination_bucket_name = ? AND action = ?`
+	default:
+		return Error.New("unsupported database: %v", r.db.impl)
+	}
+
+	destinationStreamID := uuid.NullUUID{UUID: job.DestinationStreamID, Valid: !job.DestinationStreamID.IsZero()}
+	destinationVersion := sql.NullInt64{Int64: int64(job.DestinationVersion), Valid: job.DestinationVersion != 0}
+	lastError := sql.NullString{String: job.LastError, Valid: job.LastError != ""}
+	if job.UpdatedAt.IsZero() {
+		job.UpdatedAt = time.Now()
+	}
+
+	result, err := r.db.ExecContext(ctx, query,
+		int64(job.Status), destinationStreamID, destinationVersion,
+		int64(job.Attempts), lastError, job.UpdatedAt,
+		job.ProjectID, []byte(job.BucketName), []byte(job.ObjectKey), int64(job.Version),
+		[]byte(job.DestinationBucket), int64(job.Action))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return replication.ErrJobNotFound.New("")
+	}
+	return nil
+}
+
+// Get returns a single job.
+func (r *replicationDB) Get(ctx context.Context, location metabase.ObjectLocation, version metabase.Version, destinationBucket metabase.BucketName, action replication.Action) (_ replication.Job, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	switch r.db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		query = `
+			SELECT ` + replicationJobColumns + `
+			FROM replication_jobs
+			WHERE project_id = $1 AND bucket_name = $2 AND object_key = $3 AND version = $4
+				AND destination_bucket_name = $5 AND action = $6`
+	case dbutil.Spanner:
+		query = `
+			SELECT ` + replicationJobColumns + `
+			FROM replication_jobs
+			WHERE project_id = ? AND bucket_name = ? AND object_key = ? AND version = ?
+				AND destination_bucket_name = ? AND action = ?`
+	default:
+		return replication.Job{}, Error.New("unsupported database: %v", r.db.impl)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query,
+		location.ProjectID, []byte(location.BucketName), []byte(location.ObjectKey), int64(version),
+		[]byte(destinationBucket), int64(action))
+	if err != nil {
+		return replication.Job{}, Error.Wrap(err)
+	}
+	jobs, err := scanReplicationJobs(rows)
+	if err != nil {
+		return replication.Job{}, Error.Wrap(err)
+	}
+	if len(jobs) == 0 {
+		return replication.Job{}, replication.ErrJobNotFound.New("")
+	}
+	return jobs[0], nil
+}
+
+// ObjectStatus returns all jobs of an object version.
+func (r *replicationDB) ObjectStatus(ctx context.Context, location metabase.ObjectLocation, version metabase.Version) (_ []replication.Job, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	switch r.db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		query = `
+			SELECT ` + replicationJobColumns + `
+			FROM replication_jobs
+			WHERE project_id = $1 AND bucket_name = $2 AND object_key = $3 AND version = $4
+			ORDER BY destination_bucket_name, action`
+	case dbutil.Spanner:
+		query = `
+			SELECT ` + replicationJobColumns + `
+			FROM replication_jobs
+			WHERE project_id = ? AND bucket_name = ? AND object_key = ? AND version = ?
+			ORDER BY destination_bucket_name, action`
+	default:
+		return nil, Error.New("unsupported database: %v", r.db.impl)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query,
+		location.ProjectID, []byte(location.BucketName), []byte(location.ObjectKey), int64(version))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	jobs, err := scanReplicationJobs(rows)
+	return jobs, Error.Wrap(err)
+}
+
+// DeleteFinished deletes completed and failed jobs last updated before the specified time.
+func (r *replicationDB) DeleteFinished(ctx context.Context, before time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	switch r.db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		query = `DELETE FROM replication_jobs WHERE status IN ($1, $2) AND updated_at < $3`
+	case dbutil.Spanner:
+		query = `DELETE FROM replication_jobs WHERE status IN (?, ?) AND updated_at < ?`
+	default:
+		return 0, Error.New("unsupported database: %v", r.db.impl)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, int64(replication.StatusCompleted), int64(replication.StatusFailed), before)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	return affected, Error.Wrap(err)
+}
+
+func scanReplicationJobs(rows tagsql.Rows) (jobs []replication.Job, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var job replication.Job
+		var bucketName, objectKey, destinationBucket []byte
+		var version, action, status, attempts int64
+		var destinationStreamID uuid.NullUUID
+		var destinationVersion sql.NullInt64
+		var lastError sql.NullString
+
+		err := rows.Scan(
+			&job.ProjectID, &bucketName, &objectKey, &version, &job.StreamID, &destinationBucket, &action,
+			&status, &destinationStreamID, &destinationVersion, &attempts, &lastError, &job.InsertedAt, &job.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		job.BucketName = metabase.BucketName(bucketName)
+		job.ObjectKey = metabase.ObjectKey(objectKey)
+		job.Version = metabase.Version(version)
+		job.DestinationBucket = metabase.BucketName(destinationBucket)
+		job.Action = replication.Action(action)
+		job.Status = replication.Status(status)
+		job.DestinationStreamID = destinationStreamID.UUID
+		job.DestinationVersion = metabase.Version(destinationVersion.Int64)
+		job.Attempts = int(attempts)
+		job.LastError = lastError.String
+
+		jobs = append(jobs, job)
+	}
+	return jobs, rows.Err()
+}
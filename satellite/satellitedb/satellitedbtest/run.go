@@ -23,6 +23,7 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/metabase"
+	_ "storj.io/storj/satellite/metabase/sqlitedriver" // enables the sqlite3:// metabase
 	"storj.io/storj/satellite/satellitedb"
 	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/dbtest"
@@ -89,6 +90,21 @@ func Databases() []SatelliteDatabases {
 		})
 	}
 
+	sqlite := dbtest.PickSQLiteNoSkip()
+	if sqlite != "" && !strings.EqualFold(sqlite, "omit") {
+		// SQLite is only supported for the metabase, the master database
+		// uses Postgres when it's available.
+		masterConnStr := postgresConnStr
+		if strings.EqualFold(masterConnStr, "omit") {
+			masterConnStr = ""
+		}
+		dbs = append(dbs, SatelliteDatabases{
+			Name:       "SQLite",
+			MasterDB:   Database{"Postgres", masterConnStr, "SQLite is supported only for the metabase, the master database requires -postgres-test-db=" + dbtest.DefaultPostgres + " or STORJ_TEST_POSTGRES environment variable.", nil},
+			MetabaseDB: Database{"SQLite", sqlite, "", nil},
+		})
+	}
+
 	return dbs
 }
 
@@ -223,6 +239,10 @@ func CreateMetabaseDBOnTopOf(ctx context.Context, log *zap.Logger, tempDB *dbuti
 func Run(t *testing.T, test func(ctx *testcontext.Context, t *testing.T, db satellite.DB)) {
 	for _, dbInfo := range Databases() {
 		dbInfo := dbInfo
+		if dbInfo.MasterDB.Name != dbInfo.Name {
+			// the master database is already covered by another entry.
+			continue
+		}
 		t.Run(dbInfo.Name, func(t *testing.T) {
 			t.Parallel()
 
@@ -264,6 +284,10 @@ func Run(t *testing.T, test func(ctx *testcontext.Context, t *testing.T, db sate
 func Bench(b *testing.B, bench func(ctx *testcontext.Context, b *testing.B, db satellite.DB)) {
 	for _, dbInfo := range Databases() {
 		dbInfo := dbInfo
+		if dbInfo.MasterDB.Name != dbInfo.Name {
+			// the master database is already covered by another entry.
+			continue
+		}
 		b.Run(dbInfo.Name, func(b *testing.B) {
 			if dbInfo.MasterDB.URL == "" {
 				b.Skipf("Database %s connection string not provided. %s", dbInfo.MasterDB.Name, dbInfo.MasterDB.Message)
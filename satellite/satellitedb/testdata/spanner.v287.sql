@@ -0,0 +1,933 @@
+-- AUTOGENERATED BY storj.io/dbx
+-- DO NOT EDIT
+CREATE TABLE account_freeze_events (
+	user_id BYTES(MAX) NOT NULL,
+	event INT64 NOT NULL,
+	limits JSON,
+	days_till_escalation INT64,
+	notifications_count INT64 NOT NULL DEFAULT (0),
+	created_at TIMESTAMP NOT NULL DEFAULT (current_timestamp)
+) PRIMARY KEY ( user_id, event );
+
+CREATE TABLE accounting_rollups (
+	node_id BYTES(MAX) NOT NULL,
+	start_time TIMESTAMP NOT NULL,
+	put_total INT64 NOT NULL,
+	get_total INT64 NOT NULL,
+	get_audit_total INT64 NOT NULL,
+	get_repair_total INT64 NOT NULL,
+	put_repair_total INT64 NOT NULL,
+	at_rest_total FLOAT64 NOT NULL,
+	interval_end_time TIMESTAMP
+) PRIMARY KEY ( node_id, start_time );
+
+CREATE TABLE accounting_timestamps (
+	name STRING(MAX) NOT NULL,
+	value TIMESTAMP NOT NULL
+) PRIMARY KEY ( name );
+
+CREATE TABLE billing_balances (
+	user_id BYTES(MAX) NOT NULL,
+	balance INT64 NOT NULL,
+	last_updated TIMESTAMP NOT NULL
+) PRIMARY KEY ( user_id );
+
+CREATE SEQUENCE billing_transactions_id OPTIONS (sequence_kind='bit_reversed_positive');
+
+CREATE TABLE billing_transactions (
+	id INT64 NOT NULL DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE billing_transactions_id)),
+	user_id BYTES(MAX) NOT NULL,
+	amount INT64 NOT NULL,
+	currency STRING(MAX) NOT NULL,
+	description STRING(MAX) NOT NULL,
+	source STRING(MAX) NOT NULL,
+	status STRING(MAX) NOT NULL,
+	type STRING(MAX) NOT NULL,
+	metadata JSON NOT NULL,
+	tx_timestamp TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id );
+
+CREATE TABLE bucket_bandwidth_rollups (
+	bucket_name BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	interval_seconds INT64 NOT NULL,
+	action INT64 NOT NULL,
+	inline INT64 NOT NULL,
+	allocated INT64 NOT NULL,
+	settled INT64 NOT NULL
+) PRIMARY KEY ( project_id, bucket_name, interval_start, action );
+
+CREATE TABLE bucket_bandwidth_rollup_archives (
+	bucket_name BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	interval_seconds INT64 NOT NULL,
+	action INT64 NOT NULL,
+	inline INT64 NOT NULL,
+	allocated INT64 NOT NULL,
+	settled INT64 NOT NULL
+) PRIMARY KEY ( bucket_name, project_id, interval_start, action );
+
+CREATE TABLE bucket_replication_rules (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	destination_bucket_name BYTES(MAX) NOT NULL,
+	prefix BYTES(MAX) NOT NULL,
+	replicate_deletes BOOL NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT (current_timestamp)
+) PRIMARY KEY ( project_id, bucket_name, destination_bucket_name );
+
+CREATE TABLE bucket_storage_tallies (
+	bucket_name BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	total_bytes INT64 NOT NULL DEFAULT (0),
+	inline INT64 NOT NULL,
+	remote INT64 NOT NULL,
+	total_segments_count INT64 NOT NULL DEFAULT (0),
+	remote_segments_count INT64 NOT NULL,
+	inline_segments_count INT64 NOT NULL,
+	object_count INT64 NOT NULL,
+	metadata_size INT64 NOT NULL
+) PRIMARY KEY ( bucket_name, project_id, interval_start );
+
+CREATE TABLE coinpayments_transactions (
+	id STRING(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	address STRING(MAX) NOT NULL,
+	amount_numeric INT64 NOT NULL,
+	received_numeric INT64 NOT NULL,
+	status INT64 NOT NULL,
+	key STRING(MAX) NOT NULL,
+	timeout INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id );
+
+CREATE TABLE graceful_exit_progress (
+	node_id BYTES(MAX) NOT NULL,
+	bytes_transferred INT64 NOT NULL,
+	pieces_transferred INT64 NOT NULL DEFAULT (0),
+	pieces_failed INT64 NOT NULL DEFAULT (0),
+	updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( node_id );
+
+CREATE TABLE graceful_exit_segment_transfer_queue (
+	node_id BYTES(MAX) NOT NULL,
+	stream_id BYTES(MAX) NOT NULL,
+	position INT64 NOT NULL,
+	piece_num INT64 NOT NULL,
+	root_piece_id BYTES(MAX),
+	durability_ratio FLOAT64 NOT NULL,
+	queued_at TIMESTAMP NOT NULL,
+	requested_at TIMESTAMP,
+	last_failed_at TIMESTAMP,
+	last_failed_code INT64,
+	failed_count INT64,
+	finished_at TIMESTAMP,
+	order_limit_send_count INT64 NOT NULL DEFAULT (0)
+) PRIMARY KEY ( node_id, stream_id, position, piece_num );
+
+CREATE TABLE nodes (
+	id BYTES(MAX) NOT NULL,
+	address STRING(MAX) NOT NULL DEFAULT (""),
+	last_net STRING(MAX) NOT NULL,
+	last_ip_port STRING(MAX),
+	country_code STRING(MAX),
+	protocol INT64 NOT NULL DEFAULT (0),
+	email STRING(MAX) NOT NULL,
+	wallet STRING(MAX) NOT NULL,
+	wallet_features STRING(MAX) NOT NULL DEFAULT (""),
+	free_disk INT64 NOT NULL DEFAULT (-1),
+	piece_count INT64 NOT NULL DEFAULT (0),
+	major INT64 NOT NULL DEFAULT (0),
+	minor INT64 NOT NULL DEFAULT (0),
+	patch INT64 NOT NULL DEFAULT (0),
+	commit_hash STRING(MAX) NOT NULL DEFAULT (""),
+	release_timestamp TIMESTAMP NOT NULL DEFAULT ("0001-01-01 00:00:00+00"),
+	release BOOL NOT NULL DEFAULT (false),
+	latency_90 INT64 NOT NULL DEFAULT (0),
+	vetted_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	updated_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	last_contact_success TIMESTAMP NOT NULL DEFAULT (timestamp_seconds(0)),
+	last_contact_failure TIMESTAMP NOT NULL DEFAULT (timestamp_seconds(0)),
+	disqualified TIMESTAMP,
+	disqualification_reason INT64,
+	unknown_audit_suspended TIMESTAMP,
+	offline_suspended TIMESTAMP,
+	under_review TIMESTAMP,
+	exit_initiated_at TIMESTAMP,
+	exit_loop_completed_at TIMESTAMP,
+	exit_finished_at TIMESTAMP,
+	exit_success BOOL NOT NULL DEFAULT (false),
+	contained TIMESTAMP,
+	last_offline_email TIMESTAMP,
+	last_software_update_email TIMESTAMP,
+	noise_proto INT64,
+	noise_public_key BYTES(MAX),
+	debounce_limit INT64 NOT NULL DEFAULT (0),
+	features INT64 NOT NULL DEFAULT (0)
+) PRIMARY KEY ( id );
+
+CREATE TABLE node_api_versions (
+	id BYTES(MAX) NOT NULL,
+	api_version INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id );
+
+CREATE TABLE node_events (
+	id BYTES(MAX) NOT NULL,
+	email STRING(MAX) NOT NULL,
+	last_ip_port STRING(MAX),
+	node_id BYTES(MAX) NOT NULL,
+	event INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	last_attempted TIMESTAMP,
+	email_sent TIMESTAMP
+) PRIMARY KEY ( id );
+
+CREATE TABLE node_tags (
+	node_id BYTES(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	value BYTES(MAX) NOT NULL,
+	signed_at TIMESTAMP NOT NULL,
+	signer BYTES(MAX) NOT NULL
+) PRIMARY KEY ( node_id, name, signer );
+
+CREATE TABLE oauth_clients (
+	id BYTES(MAX) NOT NULL,
+	encrypted_secret BYTES(MAX) NOT NULL,
+	redirect_url STRING(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	app_name STRING(MAX) NOT NULL,
+	app_logo_url STRING(MAX) NOT NULL
+) PRIMARY KEY ( id );
+
+CREATE TABLE oauth_codes (
+	client_id BYTES(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	scope STRING(MAX) NOT NULL,
+	redirect_url STRING(MAX) NOT NULL,
+	challenge STRING(MAX) NOT NULL,
+	challenge_method STRING(MAX) NOT NULL,
+	code STRING(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	claimed_at TIMESTAMP
+) PRIMARY KEY ( code );
+
+CREATE TABLE oauth_tokens (
+	client_id BYTES(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	scope STRING(MAX) NOT NULL,
+	kind INT64 NOT NULL,
+	token BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	expires_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( token );
+
+CREATE TABLE peer_identities (
+	node_id BYTES(MAX) NOT NULL,
+	leaf_serial_number BYTES(MAX) NOT NULL,
+	chain BYTES(MAX) NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( node_id );
+
+CREATE TABLE projects (
+	id BYTES(MAX) NOT NULL,
+	public_id BYTES(MAX),
+	name STRING(MAX) NOT NULL,
+	description STRING(MAX) NOT NULL,
+	usage_limit INT64,
+	bandwidth_limit INT64,
+	user_specified_usage_limit INT64,
+	user_specified_bandwidth_limit INT64,
+	segment_limit INT64 DEFAULT (1000000),
+	rate_limit INT64,
+	burst_limit INT64,
+	rate_limit_head INT64,
+	burst_limit_head INT64,
+	rate_limit_get INT64,
+	burst_limit_get INT64,
+	rate_limit_put INT64,
+	burst_limit_put INT64,
+	rate_limit_list INT64,
+	burst_limit_list INT64,
+	rate_limit_del INT64,
+	burst_limit_del INT64,
+	max_buckets INT64,
+	user_agent BYTES(MAX),
+	owner_id BYTES(MAX) NOT NULL,
+	salt BYTES(MAX),
+	status INT64 DEFAULT (1),
+	created_at TIMESTAMP NOT NULL,
+	default_placement INT64,
+	default_versioning INT64 NOT NULL DEFAULT (1),
+	prompted_for_versioning_beta BOOL NOT NULL DEFAULT (false),
+	passphrase_enc BYTES(MAX),
+	passphrase_enc_key_id INT64,
+	path_encryption BOOL NOT NULL DEFAULT (true)
+) PRIMARY KEY ( id );
+
+CREATE TABLE project_bandwidth_daily_rollups (
+	project_id BYTES(MAX) NOT NULL,
+	interval_day DATE NOT NULL,
+	egress_allocated INT64 NOT NULL,
+	egress_settled INT64 NOT NULL,
+	egress_dead INT64 NOT NULL DEFAULT (0)
+) PRIMARY KEY ( project_id, interval_day );
+
+CREATE TABLE registration_tokens (
+	secret BYTES(MAX) NOT NULL,
+	owner_id BYTES(MAX),
+	project_limit INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( secret );
+
+CREATE UNIQUE INDEX index_registration_tokens_owner_id ON registration_tokens ( owner_id );
+
+CREATE TABLE repair_queue (
+	stream_id BYTES(MAX) NOT NULL,
+	position INT64 NOT NULL,
+	attempted_at TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	inserted_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	segment_health FLOAT64 NOT NULL DEFAULT (1),
+	placement INT64
+) PRIMARY KEY ( stream_id, position );
+
+CREATE TABLE replication_jobs (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	object_key BYTES(MAX) NOT NULL,
+	version INT64 NOT NULL,
+	stream_id BYTES(MAX) NOT NULL,
+	destination_bucket_name BYTES(MAX) NOT NULL,
+	action INT64 NOT NULL,
+	status INT64 NOT NULL,
+	destination_stream_id BYTES(MAX),
+	destination_version INT64,
+	attempts INT64 NOT NULL DEFAULT (0),
+	last_error STRING(MAX),
+	inserted_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	updated_at TIMESTAMP NOT NULL DEFAULT (current_timestamp)
+) PRIMARY KEY ( project_id, bucket_name, object_key, version, destination_bucket_name, action );
+
+CREATE TABLE reputations (
+	id BYTES(MAX) NOT NULL,
+	audit_success_count INT64 NOT NULL DEFAULT (0),
+	total_audit_count INT64 NOT NULL DEFAULT (0),
+	vetted_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	updated_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	disqualified TIMESTAMP,
+	disqualification_reason INT64,
+	unknown_audit_suspended TIMESTAMP,
+	offline_suspended TIMESTAMP,
+	under_review TIMESTAMP,
+	online_score FLOAT64 NOT NULL DEFAULT (1),
+	audit_history BYTES(MAX) NOT NULL,
+	audit_reputation_alpha FLOAT64 NOT NULL DEFAULT (1),
+	audit_reputation_beta FLOAT64 NOT NULL DEFAULT (0),
+	unknown_audit_reputation_alpha FLOAT64 NOT NULL DEFAULT (1),
+	unknown_audit_reputation_beta FLOAT64 NOT NULL DEFAULT (0)
+) PRIMARY KEY ( id );
+
+CREATE TABLE reset_password_tokens (
+	secret BYTES(MAX) NOT NULL,
+	owner_id BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( secret );
+
+CREATE UNIQUE INDEX index_reset_password_tokens_owner_id ON reset_password_tokens ( owner_id );
+
+CREATE TABLE reverification_audits (
+	node_id BYTES(MAX) NOT NULL,
+	stream_id BYTES(MAX) NOT NULL,
+	position INT64 NOT NULL,
+	piece_num INT64 NOT NULL,
+	inserted_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	last_attempt TIMESTAMP,
+	reverify_count INT64 NOT NULL DEFAULT (0)
+) PRIMARY KEY ( node_id, stream_id, position );
+
+CREATE TABLE revocations (
+	revoked BYTES(MAX) NOT NULL,
+	api_key_id BYTES(MAX) NOT NULL
+) PRIMARY KEY ( revoked );
+
+CREATE TABLE segment_pending_audits (
+	node_id BYTES(MAX) NOT NULL,
+	stream_id BYTES(MAX) NOT NULL,
+	position INT64 NOT NULL,
+	piece_id BYTES(MAX) NOT NULL,
+	stripe_index INT64 NOT NULL,
+	share_size INT64 NOT NULL,
+	expected_share_hash BYTES(MAX) NOT NULL,
+	reverify_count INT64 NOT NULL
+) PRIMARY KEY ( node_id );
+
+CREATE TABLE storagenode_bandwidth_rollups (
+	storagenode_id BYTES(MAX) NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	interval_seconds INT64 NOT NULL,
+	action INT64 NOT NULL,
+	allocated INT64 DEFAULT (0),
+	settled INT64 NOT NULL
+) PRIMARY KEY ( storagenode_id, interval_start, action );
+
+CREATE TABLE storagenode_bandwidth_rollup_archives (
+	storagenode_id BYTES(MAX) NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	interval_seconds INT64 NOT NULL,
+	action INT64 NOT NULL,
+	allocated INT64 DEFAULT (0),
+	settled INT64 NOT NULL
+) PRIMARY KEY ( storagenode_id, interval_start, action );
+
+CREATE TABLE storagenode_bandwidth_rollups_phase2 (
+	storagenode_id BYTES(MAX) NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	interval_seconds INT64 NOT NULL,
+	action INT64 NOT NULL,
+	allocated INT64 DEFAULT (0),
+	settled INT64 NOT NULL
+) PRIMARY KEY ( storagenode_id, interval_start, action );
+
+CREATE SEQUENCE storagenode_payments_id OPTIONS (sequence_kind='bit_reversed_positive');
+
+CREATE TABLE storagenode_payments (
+	id INT64 NOT NULL DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE storagenode_payments_id)),
+	created_at TIMESTAMP NOT NULL,
+	node_id BYTES(MAX) NOT NULL,
+	period STRING(MAX) NOT NULL,
+	amount INT64 NOT NULL,
+	receipt STRING(MAX),
+	notes STRING(MAX)
+) PRIMARY KEY ( id );
+
+CREATE TABLE storagenode_paystubs (
+	period STRING(MAX) NOT NULL,
+	node_id BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	codes STRING(MAX) NOT NULL,
+	usage_at_rest FLOAT64 NOT NULL,
+	usage_get INT64 NOT NULL,
+	usage_put INT64 NOT NULL,
+	usage_get_repair INT64 NOT NULL,
+	usage_put_repair INT64 NOT NULL,
+	usage_get_audit INT64 NOT NULL,
+	comp_at_rest INT64 NOT NULL,
+	comp_get INT64 NOT NULL,
+	comp_put INT64 NOT NULL,
+	comp_get_repair INT64 NOT NULL,
+	comp_put_repair INT64 NOT NULL,
+	comp_get_audit INT64 NOT NULL,
+	surge_percent INT64 NOT NULL,
+	held INT64 NOT NULL,
+	owed INT64 NOT NULL,
+	disposed INT64 NOT NULL,
+	paid INT64 NOT NULL,
+	distributed INT64 NOT NULL
+) PRIMARY KEY ( period, node_id );
+
+CREATE TABLE storagenode_storage_tallies (
+	node_id BYTES(MAX) NOT NULL,
+	interval_end_time TIMESTAMP NOT NULL,
+	data_total FLOAT64 NOT NULL
+) PRIMARY KEY ( interval_end_time, node_id );
+
+CREATE TABLE storjscan_payments (
+	chain_id INT64 NOT NULL DEFAULT (0),
+	block_hash BYTES(MAX) NOT NULL,
+	block_number INT64 NOT NULL,
+	transaction BYTES(MAX) NOT NULL,
+	log_index INT64 NOT NULL,
+	from_address BYTES(MAX) NOT NULL,
+	to_address BYTES(MAX) NOT NULL,
+	token_value INT64 NOT NULL,
+	usd_value INT64 NOT NULL,
+	status STRING(MAX) NOT NULL,
+	block_timestamp TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( block_hash, log_index );
+
+CREATE TABLE storjscan_wallets (
+	user_id BYTES(MAX) NOT NULL,
+	wallet_address BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( user_id, wallet_address );
+
+CREATE TABLE stripe_customers (
+	user_id BYTES(MAX) NOT NULL,
+	customer_id STRING(MAX) NOT NULL,
+	billing_customer_id STRING(MAX),
+	package_plan STRING(MAX),
+	purchased_package_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( user_id );
+
+CREATE UNIQUE INDEX index_stripe_customers_customer_id ON stripe_customers ( customer_id );
+
+CREATE TABLE stripecoinpayments_invoice_project_records (
+	id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	storage FLOAT64 NOT NULL,
+	egress INT64 NOT NULL,
+	objects INT64,
+	segments INT64,
+	period_start TIMESTAMP NOT NULL,
+	period_end TIMESTAMP NOT NULL,
+	state INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id );
+
+CREATE UNIQUE INDEX index_stripecoinpayments_invoice_project_records_project_id_period_start_period_end ON stripecoinpayments_invoice_project_records ( project_id, period_start, period_end );
+
+CREATE TABLE stripecoinpayments_tx_conversion_rates (
+	tx_id STRING(MAX) NOT NULL,
+	rate_numeric FLOAT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( tx_id );
+
+CREATE TABLE users (
+	id BYTES(MAX) NOT NULL,
+	external_id STRING(MAX),
+	email STRING(MAX) NOT NULL,
+	normalized_email STRING(MAX) NOT NULL,
+	full_name STRING(MAX) NOT NULL,
+	short_name STRING(MAX),
+	password_hash BYTES(MAX) NOT NULL,
+	new_unverified_email STRING(MAX),
+	email_change_verification_step INT64 NOT NULL DEFAULT (0),
+	status INT64 NOT NULL,
+	status_updated_at TIMESTAMP,
+	final_invoice_generated BOOL NOT NULL DEFAULT (false),
+	user_agent BYTES(MAX),
+	created_at TIMESTAMP NOT NULL,
+	project_limit INT64 NOT NULL DEFAULT (0),
+	project_bandwidth_limit INT64 NOT NULL DEFAULT (0),
+	project_storage_limit INT64 NOT NULL DEFAULT (0),
+	project_segment_limit INT64 NOT NULL DEFAULT (0),
+	paid_tier BOOL NOT NULL DEFAULT (false),
+	position STRING(MAX),
+	company_name STRING(MAX),
+	company_size INT64,
+	working_on STRING(MAX),
+	is_professional BOOL NOT NULL DEFAULT (false),
+	employee_count STRING(MAX),
+	have_sales_contact BOOL NOT NULL DEFAULT (false),
+	mfa_enabled BOOL NOT NULL DEFAULT (false),
+	mfa_secret_key STRING(MAX),
+	mfa_recovery_codes STRING(MAX),
+	signup_promo_code STRING(MAX),
+	verification_reminders INT64 NOT NULL DEFAULT (0),
+	trial_notifications INT64 NOT NULL DEFAULT (0),
+	failed_login_count INT64,
+	login_lockout_expiration TIMESTAMP,
+	signup_captcha FLOAT64,
+	default_placement INT64,
+	activation_code STRING(MAX),
+	signup_id STRING(MAX),
+	trial_expiration TIMESTAMP,
+	upgrade_time TIMESTAMP,
+	hubspot_object_id STRING(MAX)
+) PRIMARY KEY ( id );
+
+CREATE TABLE user_settings (
+	user_id BYTES(MAX) NOT NULL,
+	session_minutes INT64,
+	passphrase_prompt BOOL,
+	onboarding_start BOOL NOT NULL DEFAULT (true),
+	onboarding_end BOOL NOT NULL DEFAULT (true),
+	onboarding_step STRING(MAX),
+	notice_dismissal JSON NOT NULL DEFAULT (JSON "{}")
+) PRIMARY KEY ( user_id );
+
+CREATE TABLE value_attributions (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	user_agent BYTES(MAX),
+	last_updated TIMESTAMP NOT NULL
+) PRIMARY KEY ( project_id, bucket_name );
+
+CREATE TABLE verification_audits (
+	inserted_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
+	stream_id BYTES(MAX) NOT NULL,
+	position INT64 NOT NULL,
+	expires_at TIMESTAMP,
+	encrypted_size INT64 NOT NULL
+) PRIMARY KEY ( inserted_at, stream_id, position );
+
+CREATE TABLE webapp_sessions (
+	id BYTES(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	ip_address STRING(MAX) NOT NULL,
+	user_agent STRING(MAX) NOT NULL,
+	status INT64 NOT NULL,
+	expires_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id );
+
+CREATE TABLE api_keys (
+	id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	head BYTES(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	secret BYTES(MAX) NOT NULL,
+	user_agent BYTES(MAX),
+	created_at TIMESTAMP NOT NULL,
+	created_by BYTES(MAX),
+	version INT64 NOT NULL DEFAULT (0),
+	CONSTRAINT api_keys_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ,
+	CONSTRAINT api_keys_created_by_fkey FOREIGN KEY (created_by) REFERENCES users (id)
+) PRIMARY KEY ( id );
+
+CREATE UNIQUE INDEX index_api_keys_head ON api_keys ( head );
+
+CREATE UNIQUE INDEX index_api_keys_name_project_id ON api_keys ( name, project_id );
+
+CREATE TABLE bucket_metainfos (
+	id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	name BYTES(MAX) NOT NULL,
+	user_agent BYTES(MAX),
+	versioning INT64 NOT NULL DEFAULT (0),
+	object_lock_enabled BOOL NOT NULL DEFAULT (false),
+	default_retention_mode INT64,
+	default_retention_days INT64,
+	default_retention_years INT64,
+	path_cipher INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	default_segment_size INT64 NOT NULL,
+	default_encryption_cipher_suite INT64 NOT NULL,
+	default_encryption_block_size INT64 NOT NULL,
+	default_redundancy_algorithm INT64 NOT NULL,
+	default_redundancy_share_size INT64 NOT NULL,
+	default_redundancy_required_shares INT64 NOT NULL,
+	default_redundancy_repair_shares INT64 NOT NULL,
+	default_redundancy_optimal_shares INT64 NOT NULL,
+	default_redundancy_total_shares INT64 NOT NULL,
+	placement INT64,
+	created_by BYTES(MAX),
+	CONSTRAINT bucket_metainfos_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id),
+	CONSTRAINT bucket_metainfos_created_by_fkey FOREIGN KEY (created_by) REFERENCES users (id)
+) PRIMARY KEY ( project_id, name );
+
+CREATE TABLE project_invitations (
+	project_id BYTES(MAX) NOT NULL,
+	email STRING(MAX) NOT NULL,
+	inviter_id BYTES(MAX),
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT project_invitations_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ,
+	CONSTRAINT project_invitations_inviter_id_fkey FOREIGN KEY (inviter_id) REFERENCES users (id) ON DELETE CASCADE
+) PRIMARY KEY ( project_id, email );
+
+CREATE TABLE project_members (
+	member_id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	role INT64 NOT NULL DEFAULT (0),
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT project_members_member_id_fkey FOREIGN KEY (member_id) REFERENCES users (id) ON DELETE CASCADE ,
+	CONSTRAINT project_members_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+) PRIMARY KEY ( member_id, project_id );
+
+CREATE TABLE stripecoinpayments_apply_balance_intents (
+	tx_id STRING(MAX) NOT NULL,
+	state INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT stripecoinpayments_apply_balance_intents_tx_id_fkey FOREIGN KEY (tx_id) REFERENCES coinpayments_transactions (id) ON DELETE CASCADE
+) PRIMARY KEY ( tx_id );
+
+CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time );
+
+CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp );
+
+CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start );
+
+CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id );
+
+CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start );
+
+CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id );
+
+CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start );
+
+CREATE INDEX bucket_storage_tallies_interval_start_index ON bucket_storage_tallies ( interval_start );
+
+CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at );
+
+CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at );
+
+CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id );
+
+CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id );
+
+CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id );
+
+CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id );
+
+CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id );
+
+CREATE INDEX projects_public_id_index ON projects ( public_id );
+
+CREATE INDEX projects_owner_id_index ON projects ( owner_id );
+
+CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwidth_daily_rollups ( interval_day );
+
+CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at );
+
+CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at );
+
+CREATE INDEX repair_queue_placement_index ON repair_queue ( placement );
+
+CREATE INDEX replication_jobs_status_updated_at_index ON replication_jobs ( status, updated_at );
+
+CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at );
+
+CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start );
+
+CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start );
+
+CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period );
+
+CREATE INDEX storagenode_paystubs_node_id_index ON storagenode_paystubs ( node_id );
+
+CREATE INDEX storagenode_storage_tallies_node_id_index ON storagenode_storage_tallies ( node_id );
+
+CREATE INDEX storjscan_payments_chain_id_block_number_log_index_index ON storjscan_payments ( chain_id, block_number, log_index );
+
+CREATE INDEX storjscan_wallets_wallet_address_index ON storjscan_wallets ( wallet_address );
+
+CREATE INDEX stripecoinpayments_invoice_project_records_unbilled_project_id_index ON stripecoinpayments_invoice_project_records ( project_id );
+
+CREATE INDEX users_email_status_index ON users ( normalized_email, status );
+
+CREATE INDEX trial_expiration_index ON users ( trial_expiration );
+
+CREATE INDEX users_external_id_index ON users ( external_id );
+
+CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id );
+
+CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id );
+
+CREATE INDEX project_invitations_email_index ON project_invitations ( email );
+
+CREATE INDEX project_members_project_id_index ON project_members ( project_id );
+
+-- MAIN DATA --
+
+INSERT INTO `accounting_rollups`(`node_id`, `start_time`, `put_total`, `get_total`, `get_audit_total`, `get_repair_total`, `put_repair_total`, `at_rest_total`) VALUES (B'\\367M\\177\\251]t/\\022\\256\\214\\265\\025\\224\\204:\\217\\212\\0102<\\321\\374\\020&\\271Qc\\325\\261\\354\\246\\233', '2019-02-09 00:00:00+00', 3000, 6000, 9000, 12000, 0, 15000);
+INSERT INTO `accounting_timestamps`(`name`, `value`) VALUES ('LastAtRestTally', '0001-01-01 00:00:00+00');
+INSERT INTO `accounting_timestamps`(`name`, `value`) VALUES ('LastRollup', '0001-01-01 00:00:00+00');
+INSERT INTO `accounting_timestamps`(`name`, `value`) VALUES ('LastBandwidthTally', '0001-01-01 00:00:00+00');
+
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`, `created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`) VALUES (B'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\001', '127.0.0.1:55516', '', 0, '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', NULL, NULL, false);
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`,`created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`) VALUES (B'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', '127.0.0.1:55518', '', 0, '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', NULL, NULL, false);
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`,`created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`) VALUES (B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\014', '127.0.0.1:55517', '', 0, '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', NULL, NULL, false);
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`,`created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`) VALUES (B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\015', '127.0.0.1:55519', '', 0, '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', NULL, NULL, false);
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`,`created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`, `vetted_at`) VALUES (B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\016', '127.0.0.1:55520', '', 0, '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', NULL, NULL, false, '2020-03-18 12:00:00.000000+00');
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`,`created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`) VALUES (B'\\154\\313\\233\\074\\327\\177\\136\\070\\346\\001', '127.0.0.1:55516', '', 0, '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', NULL, NULL, false);
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `last_ip_port`, `protocol`, `email`, `wallet`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`, `created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`) VALUES (B'\\154\\313\\233\\074\\327\\177\\136\\070\\346\\002', '127.0.0.1:55516', '127.0.0.0', '127.0.0.1:55516', 0, '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', NULL, NUll, false);
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`,`created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`) VALUES (B'\\363\\341\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\016', '127.0.0.1:55516', '', 0, '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', NULL, NULL, false);
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `wallet_features`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`,`created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`) VALUES (B'\\362\\341\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\016', '127.0.0.1:55516', '', 0, '', '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', NULL, NULL, false);
+
+INSERT INTO `users`(`id`, `full_name`, `short_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `is_professional`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `paid_tier`, `project_segment_limit`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', 'Noahson', 'William', '1email1@mail.test', '1EMAIL1@MAIL.TEST', B'some_readable_hash', 1, '2019-02-14 08:28:24.614594+00', false, 10, 50000000000, 50000000000, false, 150000);
+INSERT INTO `users`(`id`, `full_name`, `short_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `position`, `company_name`, `working_on`, `company_size`, `is_professional`, `employee_count`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `have_sales_contact`, `project_segment_limit`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\304\\313\\206\\311",', 'Ian', 'Pires', '3email3@mail.test', '3EMAIL3@MAIL.TEST', B'some_readable_hash', 2, '2020-03-18 10:28:24.614594+00', 'engineer', 'storj', 'data storage', 51, true, '1-50', 10, 50000000000, 50000000000, true, 150000);
+INSERT INTO `users`(`id`, `full_name`, `short_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `position`, `company_name`, `working_on`, `company_size`, `is_professional`, `employee_count`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `project_segment_limit`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\205\\312",', 'Campbell', 'Wright', '4email4@mail.test', '4EMAIL4@MAIL.TEST', B'some_readable_hash', 2, '2020-07-17 10:28:24.614594+00', 'engineer', 'storj', 'data storage', 82, true, '1-50', 10, 50000000000, 50000000000, 150000);
+INSERT INTO `users`(`id`, `full_name`, `short_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `position`, `company_name`, `working_on`, `company_size`, `is_professional`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `paid_tier`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `project_segment_limit`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\205\\311",', 'Thierry', 'Berg', '2email2@mail.test', '2EMAIL2@MAIL.TEST', B'some_readable_hash', 2, '2020-05-16 10:28:24.614594+00', 'engineer', 'storj', 'data storage', 55, true, 10, 50000000000, 50000000000, false, false, NULL, NULL, 150000);
+
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `max_buckets`, `owner_id`, `created_at`, `segment_limit`) VALUES (B'\\022\\217/\\014\\376!K\\023\\276\\031\\311}m\\236\\205\\300', 'ProjectName', 'projects description', 500000000000, 500000000000, NULL, B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '2019-02-14 08:28:24.254934+00', 150000);
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `max_buckets`, `owner_id`, `created_at`, `segment_limit`) VALUES (B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\014', 'projName1', 'Test project 1', 500000000000, 500000000000, NULL, B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '2019-02-14 08:28:24.636949+00', 150000);
+INSERT INTO `project_members`(`member_id`, `project_id`, `role`, `created_at`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\014', 0, '2019-02-14 08:28:24.677953+00');
+INSERT INTO `project_members`(`member_id`, `project_id`, `role`, `created_at`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', B'\\022\\217/\\014\\376!K\\023\\276\\031\\311}m\\236\\205\\300', 0, '2019-02-13 08:28:24.677953+00');
+
+INSERT INTO `registration_tokens` (`secret`, `owner_id`, `project_limit`, `created_at`) VALUES (B'\\070\\127\\144\\013\\332\\344\\102\\376\\306\\056\\303\\130\\106\\132\\321\\276\\321\\274\\170\\264\\054\\333\\221\\116\\154\\221\\335\\070\\220\\146\\344\\216', null, 1, '2019-02-14 08:28:24.677953+00');
+
+INSERT INTO `storagenode_bandwidth_rollups` (`storagenode_id`, `interval_start`, `interval_seconds`, `action`, `allocated`, `settled`) VALUES (B'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', '2019-03-06 08:00:00.000000', 3600, 1, 1024, 2024);
+INSERT INTO `storagenode_storage_tallies`(`node_id`,`interval_end_time`,`data_total`) VALUES (B'\\3510\\323\\225"~\\036<\\342\\330m\\0253Jhr\\246\\233K\\246#\\2303\\351\\256\\275j\\212UM\\362\\207', '2019-02-14 08:16:57.812849+00', 1000);
+
+INSERT INTO `bucket_bandwidth_rollups` (`bucket_name`, `project_id`, `interval_start`, `interval_seconds`, `action`, `inline`, `allocated`, `settled`) VALUES (B'testbucket', B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\014','2019-03-06 08:00:00.000000', 3600, 1, 1024, 2024, 3024);
+INSERT INTO `bucket_storage_tallies` (`bucket_name`, `project_id`, `interval_start`, `inline`, `remote`, `remote_segments_count`, `inline_segments_count`, `object_count`, `metadata_size`) VALUES (B'testbucket', B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\014','2019-03-06 08:00:00.000000', 4024, 5024, 0, 0, 0, 0);
+INSERT INTO `bucket_bandwidth_rollups` (`bucket_name`, `project_id`, `interval_start`, `interval_seconds`, `action`, `inline`, `allocated`, `settled`) VALUES (B'testbucket', B'\\170\\160\\157\\370\\274\\366\\113\\364\\272\\235\\301\\243\\321\\102\\321\\136','2019-03-06 08:00:00.000000', 3600, 1, 1024, 2024, 3024);
+INSERT INTO `bucket_storage_tallies` (`bucket_name`, `project_id`, `interval_start`, `inline`, `remote`, `remote_segments_count`, `inline_segments_count`, `object_count`, `metadata_size`) VALUES (B'testbucket', B'\\170\\160\\157\\370\\274\\366\\113\\364\\272\\235\\301\\243\\321\\102\\321\\136','2019-03-06 08:00:00.000000', 4024, 5024, 0, 0, 0, 0);
+
+INSERT INTO `reset_password_tokens` (`secret`, `owner_id`, `created_at`) VALUES (B'\\070\\127\\144\\013\\332\\344\\102\\376\\306\\056\\303\\130\\106\\132\\321\\276\\321\\274\\170\\264\\054\\333\\221\\116\\154\\221\\335\\070\\220\\146\\344\\216', B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '2019-05-08 08:28:24.677953+00');
+
+INSERT INTO `api_keys` (`id`, `project_id`, `head`, `name`, `secret`, `created_at`, `version`) VALUES (B'\\334/\\302;\\225\\355O\\323\\276f\\247\\354/6\\241\\033', B'\\022\\217/\\014\\376!K\\023\\276\\031\\311}m\\236\\205\\300', B'\\111\\142\\147\\304\\132\\375\\070\\163\\270\\160\\251\\370\\126\\063\\351\\037\\257\\071\\143\\375\\351\\320\\253\\232\\220\\260\\075\\173\\306\\307\\115\\136', 'key 2', B'\\254\\011\\315\\333\\273\\365\\001\\071\\024\\154\\253\\332\\301\\216\\361\\074\\221\\367\\251\\231\\274\\333\\300\\367\\001\\272\\327\\111\\315\\123\\042\\016', '2019-02-14 08:28:24.267934+00', 0);
+
+INSERT INTO `value_attributions` (`project_id`, `bucket_name`, `user_agent`, `last_updated`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', B'', NULL, '2019-02-14 08:07:31.028103+00');
+
+INSERT INTO `bucket_metainfos` (`id`, `project_id`, `name`, `versioning`, `object_lock_enabled`, `created_at`, `path_cipher`, `default_segment_size`, `default_encryption_cipher_suite`, `default_encryption_block_size`, `default_redundancy_algorithm`, `default_redundancy_share_size`, `default_redundancy_required_shares`, `default_redundancy_repair_shares`, `default_redundancy_optimal_shares`, `default_redundancy_total_shares`) VALUES (B'\\334/\\302;\\225\\355O\\323\\276f\\247\\354/6\\241\\033', B'\\022\\217/\\014\\376!K\\023\\276\\031\\311}m\\236\\205\\300', B'testbucketuniquename', 0, false, '2019-06-14 08:28:24.677953+00', 1, 65536, 1, 8192, 1, 4096, 4, 6, 8, 10);
+
+INSERT INTO `peer_identities` (`node_id`,`leaf_serial_number`,`chain`,`updated_at`) VALUES (B'\\334/\\302;\\225\\355O\\323\\276f\\247\\354/6\\241\\033', B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\014', B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '2019-02-14 08:07:31.335028+00');
+
+INSERT INTO `graceful_exit_progress` (`node_id`, `bytes_transferred`, `pieces_transferred`, `pieces_failed`, `updated_at`) VALUES (B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\016', 1000000000000000, 0, 0, '2019-09-12 10:07:31.028103+00');
+
+INSERT INTO `stripe_customers` (`user_id`, `customer_id`, `created_at`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', 'stripe_id', '2019-06-01 08:28:24.267934+00');
+
+INSERT INTO `stripecoinpayments_invoice_project_records`(`id`, `project_id`, `storage`, `egress`, `objects`, `period_start`, `period_end`, `state`, `created_at`) VALUES (B'\\022\\217/\\014\\376!K\\023\\276\\031\\311}m\\236\\205\\300', B'\\021\\217/\\014\\376!K\\023\\276\\031\\311}m\\236\\205\\300', 0, 0, 0, '2019-06-01 08:28:24.267934+00', '2019-06-01 08:28:24.267934+00', 0, '2019-06-01 08:28:24.267934+00');
+
+INSERT INTO `stripecoinpayments_tx_conversion_rates` (`tx_id`, `rate_numeric`, `created_at`) VALUES ('tx_id', 1.929883831, '2019-06-01 08:28:24.267934+00');
+
+INSERT INTO `coinpayments_transactions` (`id`, `user_id`, `address`, `amount_numeric`, `received_numeric`, `status`, `key`, `timeout`, `created_at`) VALUES ('tx_id', B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', 'address', 1411112222, 1311112222, 1, 'key', 60, '2019-06-01 08:28:24.267934+00');
+
+INSERT INTO `storagenode_bandwidth_rollups` (`storagenode_id`, `interval_start`, `interval_seconds`, `action`, `settled`) VALUES (B'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', '2020-01-11 08:00:00.000000', 3600, 1, 2024);
+
+INSERT INTO `stripecoinpayments_apply_balance_intents` (`tx_id`, `state`, `created_at`) VALUES ('tx_id', 0, '2019-06-01 08:28:24.267934+00');
+
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `max_buckets`, `rate_limit`, `rate_limit_head`, `rate_limit_get`, `rate_limit_put`, `rate_limit_list`, `rate_limit_del`, `owner_id`, `created_at`, `segment_limit`) VALUES (B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\347', 'projName1', 'Test project 1', 500000000000, 500000000000, NULL, 2000000, 2000000, 2000000, 2000000, 2000000, 2000000, B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '2020-01-15 08:28:24.636949+00', 150000);
+
+INSERT INTO `project_bandwidth_daily_rollups`(`project_id`, `interval_day`, egress_allocated, egress_settled, egress_dead) VALUES (B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\347', '2021-04-22', 10000, 5000, 0);
+
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `max_buckets`,`rate_limit`, `rate_limit_head`, `rate_limit_get`, `rate_limit_put`, `rate_limit_list`, `rate_limit_del`, `owner_id`, `created_at`, `segment_limit`) VALUES (B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\345', 'egress101', 'High Bandwidth Project', 500000000000, 500000000000, NULL, 2000000, 2000000, 2000000, 2000000, 2000000, 2000000, B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '2020-05-15 08:46:24.000000+00', 150000);
+
+INSERT INTO `storagenode_paystubs`(`period`, `node_id`, `created_at`, `codes`, `usage_at_rest`, `usage_get`, `usage_put`, `usage_get_repair`, `usage_put_repair`, `usage_get_audit`, `comp_at_rest`, `comp_get`, `comp_put`, `comp_get_repair`, `comp_put_repair`, `comp_get_audit`, `surge_percent`, `held`, `owed`, `disposed`, `paid`, `distributed`) VALUES ('2020-01', B'\\xf2\\xa3\\xb4\\xc4\\xdf\\xdf\\x72\\x21\\x31\\x03\\x82\\xfd\\x5d\\xb5\\xaa\\x73\\xe1\\xd2\\x27\\xd6\\xdf\\x09\\x73\\x4e\\xc4\\xe5\\x30\\x50\\x00\\x00\\x00\\x00', '2020-04-07T20:14:21.479141Z', '', 1327959864508416, 294054066688, 159031363328, 226751, 0, 836608, 2861984, 5881081, 0, 226751, 0, 8, 300, 0, 26909472, 0, 26909472, 0);
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`,`created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`, `unknown_audit_suspended`, `offline_suspended`, `under_review`) VALUES (B'\\153\\313\\233\\074\\327\\255\\136\\070\\346\\001', '127.0.0.1:55516', '', 0, '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', NULL, NULL, false, '2019-02-14 08:07:31.108963+00', '2019-02-14 08:07:31.108963+00', '2019-02-14 08:07:31.108963+00');
+
+INSERT INTO `node_api_versions`(`id`, `api_version`, `created_at`, `updated_at`) VALUES (B'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\001', 1, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00');
+INSERT INTO `node_api_versions`(`id`, `api_version`, `created_at`, `updated_at`) VALUES (B'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 2, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00');
+INSERT INTO `node_api_versions`(`id`, `api_version`, `created_at`, `updated_at`) VALUES (B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\014', 3, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00');
+
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `rate_limit`, `rate_limit_head`, `rate_limit_get`, `rate_limit_put`, `rate_limit_list`, `rate_limit_del`,  `owner_id`, `created_at`, `max_buckets`, `segment_limit`) VALUES (B'300\\273|\\342N\\347\\347\\363\\342\\363\\371>+F\\256\\263', 'egress102', 'High Bandwidth Project 2', 500000000000, 500000000000, 2000000, 2000000, 2000000, 2000000, 2000000, 2000000, B'265\\343U\\303\\312\\312\\363\\311\\033w\\222\\303Ci",', '2020-05-15 08:46:24.000000+00', 1000, 150000);
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `rate_limit`, `rate_limit_head`, `rate_limit_get`, `rate_limit_put`, `rate_limit_list`, `rate_limit_del`, `owner_id`, `created_at`, `max_buckets`, `segment_limit`) VALUES (B'300\\273|\\342N\\347\\347\\363\\342\\363\\371>+F\\255\\244', 'egress103', 'High Bandwidth Project 3', 500000000000, 500000000000, 2000000, 2000000, 2000000, 2000000, 2000000, 2000000, B'265\\343U\\303\\312\\312\\363\\311\\033w\\222\\303Ci",', '2020-05-15 08:46:24.000000+00', 1000, 150000);
+
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `rate_limit`, `rate_limit_head`, `rate_limit_get`, `rate_limit_put`, `rate_limit_list`, `rate_limit_del`, `owner_id`, `created_at`, `max_buckets`, `segment_limit`) VALUES (B'300\\273|\\342N\\347\\347\\363\\342\\363\\371>+F\\253\\231', 'Limit Test 1', 'This project is above the default', 50000000001, 50000000001, 2000000, 2000000, 2000000, 2000000, 2000000, 2000000, B'265\\343U\\303\\312\\312\\363\\311\\033w\\222\\303Ci",', '2020-10-14 10:10:10.000000+00', 101, 150000);
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `rate_limit`, `rate_limit_head`, `rate_limit_get`, `rate_limit_put`, `rate_limit_list`, `rate_limit_del`, `owner_id`, `created_at`, `max_buckets`, `segment_limit`) VALUES (B'300\\273|\\342N\\347\\347\\363\\342\\363\\371>+F\\252\\230', 'Limit Test 2', 'This project is below the default', 500000000000, 500000000000, 2000000, 2000000, 2000000, 2000000, 2000000, 2000000, B'265\\343U\\303\\312\\312\\363\\311\\033w\\222\\303Ci",', '2020-10-14 10:10:11.000000+00', NULL, 150000);
+
+INSERT INTO `storagenode_bandwidth_rollups_phase2` (`storagenode_id`, `interval_start`, `interval_seconds`, `action`, `allocated`, `settled`) VALUES (B'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', '2019-03-06 08:00:00.000000', 3600, 1, 1024, 2024);
+
+INSERT INTO `storagenode_bandwidth_rollup_archives` (`storagenode_id`, `interval_start`, `interval_seconds`, `action`, `allocated`, `settled`) VALUES (B'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', '2019-03-06 08:00:00.000000', 3600, 1, 1024, 2024);
+INSERT INTO `bucket_bandwidth_rollup_archives` (`bucket_name`, `project_id`, `interval_start`, `interval_seconds`, `action`, `inline`, `allocated`, `settled`) VALUES (B'testbucket', B'\\170\\160\\157\\370\\274\\366\\113\\364\\272\\235\\301\\243\\321\\102\\321\\136','2019-03-06 08:00:00.000000', 3600, 1, 1024, 2024, 3024);
+
+INSERT INTO `storagenode_paystubs`(`period`, `node_id`, `created_at`, `codes`, `usage_at_rest`, `usage_get`, `usage_put`, `usage_get_repair`, `usage_put_repair`, `usage_get_audit`, `comp_at_rest`, `comp_get`, `comp_put`, `comp_get_repair`, `comp_put_repair`, `comp_get_audit`, `surge_percent`, `held`, `owed`, `disposed`, `paid`, `distributed`) VALUES ('2020-12', B'\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11', '2020-04-07T20:14:21.479141Z', '', 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 117);
+INSERT INTO `storagenode_payments`(`id`, `created_at`, `period`, `node_id`, `amount`) VALUES (1, '2020-04-07T20:14:21.479141Z', '2020-12', B'\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11\\x11', 117);
+
+INSERT INTO `reputations`(`id`, `audit_success_count`, `total_audit_count`, `created_at`, `updated_at`, `disqualified`, `audit_reputation_alpha`, `audit_reputation_beta`, `unknown_audit_reputation_alpha`, `unknown_audit_reputation_beta`, `online_score`, `audit_history`) VALUES (B'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\001', 0, 5, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00', NULL, 1000, 0, 1, 0, 1, B'\\x0a\\x23\\x73\\x6f\\x2f\\x6d\\x61\\x6e\\x79\\x2f\\x69\\x63\\x6f\\x6e\\x69\\x63\\x2f\\x70\\x61\\x74\\x68\\x73\\x2f\\x74\\x6f\\x2f\\x63\\x68\\x6f\\x6f\\x73\\x65\\x2f\\x66\\x72\\x6f\\x6d\\x12\\x0a\\x01\\x02\\x03\\x04\\x05\\x06\\x07\\x08\\x09\\x0a');
+
+INSERT INTO `graceful_exit_segment_transfer_queue` (`node_id`, `stream_id`, `position`, `piece_num`, `durability_ratio`, `queued_at`, `requested_at`, `last_failed_at`, `last_failed_code`, `failed_count`, `finished_at`, `order_limit_send_count`) VALUES (B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\016',  B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', 10 , 8, 1.0, '2019-09-12 10:07:31.028103+00', '2019-09-12 10:07:32.028103+00', null, null, 0, '2019-09-12 10:07:33.028103+00', 0);
+
+INSERT INTO `segment_pending_audits` (`node_id`, `piece_id`, `stripe_index`, `share_size`, `expected_share_hash`, `reverify_count`, `stream_id`, position) VALUES (B'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\001', B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', 5, 1024, B'\\070\\127\\144\\013\\332\\344\\102\\376\\306\\056\\303\\130\\106\\132\\321\\276\\321\\274\\170\\264\\054\\333\\221\\116\\154\\221\\335\\070\\220\\146\\344\\216', 1, B'\\x01\\x01\\x01', 1);
+
+INSERT INTO `users`(`id`, `full_name`, `short_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `is_professional`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `paid_tier`, `project_segment_limit`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\266\\342U\\303\\312\\204",', 'Noahson', 'William', '100email1@mail.test', '100EMAIL1@MAIL.TEST', B'some_readable_hash', 1, '2019-02-14 08:28:24.614594+00', false, 10, 100000000000000, 25000000000000, true, 100000000);
+
+INSERT INTO `repair_queue` (`stream_id`, `position`, `attempted_at`, `segment_health`, `updated_at`, `inserted_at`) VALUES (B'\\x01', 1, null, 1, '2020-09-01 00:00:00.000000+00', '2021-09-01 00:00:00.000000+00');
+
+INSERT INTO `users`(`id`, `full_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `project_segment_limit`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\266\\344U\\303\\312\\204",', 'Noahson William', '101email1@mail.test', '101EMAIL1@MAIL.TEST', B'some_readable_hash', 1, '2019-02-14 08:28:24.614594+00', true, 'mfa secret key', '["1a2b3c4d","e5f6g7h8"]', 3, 50000000000, 50000000000, 150000);
+
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `rate_limit`, `rate_limit_head`, `rate_limit_get`, `rate_limit_put`, `rate_limit_list`, `rate_limit_del`, `burst_limit`, `burst_limit_head`, `burst_limit_get`, `burst_limit_put`, `burst_limit_list`, `burst_limit_del`, `owner_id`, `created_at`, `max_buckets`, `segment_limit`) VALUES (B'300\\273|\\342N\\347\\347\\363\\342\\363\\371>+F\\251\\247', 'Limit Test 2', 'This project is below the default', 500000000000, 500000000000, 2000000, 2000000, 2000000, 2000000, 2000000, 2000000, 4000000, 4000000, 4000000, 4000000, 4000000, 4000000, B'265\\343U\\303\\312\\312\\363\\311\\033w\\222\\303Ci",', '2020-10-14 10:10:11.000000+00', NULL, 150000);
+
+INSERT INTO `users`(`id`, `full_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `signup_promo_code`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `project_segment_limit`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\266\\344U\\303\\312\\205",', 'Felicia Smith', '99email1@mail.test', '99EMAIL1@MAIL.TEST', B'some_readable_hash', 1, '2021-08-14 09:13:44.614594+00', true, 'mfa secret key', '["1a2b3c4d","e5f6d7h8"]', 'promo123', 3, 50000000000, 50000000000, 150000);
+
+INSERT INTO `stripecoinpayments_invoice_project_records`(`id`, `project_id`, `storage`, `egress`, `objects`, `segments`, `period_start`, `period_end`, `state`, `created_at`) VALUES (B'\\300\\217/\\014\\376!K\\023\\276\\031\\311}m\\236\\205\\300', B'\\300\\217/\\014\\376!K\\023\\276\\031\\311}m\\236\\205\\300', 0, 0, 0, 0, '2019-06-01 08:28:24.267934+00', '2019-06-01 08:28:24.267934+00', 0, '2019-06-01 08:28:24.267934+00');
+
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`, `created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`, `country_code`) VALUES (B'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\002', '127.0.0.1:55517', '', 0, '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2021-02-14 08:07:31.028103+00', '2021-02-14 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', NULL, NULL, false, 'DE');
+INSERT INTO `bucket_metainfos` (`id`, `project_id`, `name`, `versioning`, `created_at`, `path_cipher`, `default_segment_size`, `default_encryption_cipher_suite`, `default_encryption_block_size`, `default_redundancy_algorithm`, `default_redundancy_share_size`, `default_redundancy_required_shares`, `default_redundancy_repair_shares`, `default_redundancy_optimal_shares`, `default_redundancy_total_shares`, `placement`) VALUES (B'\\144/\\302;\\225\\355O\\323\\276f\\247\\354/6\\241\\033', B'\\022\\217/\\014\\376!K\\023\\276\\031\\311}m\\236\\205\\300', B'testbucketotheruniquename', 0, '2019-06-14 08:28:24.677953+00', 1, 65536, 1, 8192, 1, 4096, 4, 6, 8, 10, 1);
+
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `wallet_features`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`,`created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`, `country_code`) VALUES (B'\\362\\341\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\017', '127.0.0.1:55517', '', 0, '', '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2020-02-14 08:07:31.028103+00', '2021-10-13 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', '2021-10-13 08:07:31.108963+00', 0, false, NULL);
+
+INSERT INTO `users`(`id`, `full_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `signup_promo_code`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `project_segment_limit`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\267\\342U\\303\\312\\203",', 'Jessica Thompson', '143email1@mail.test', '143EMAIL1@MAIL.TEST', B'some_readable_hash', 1, '2021-11-04 08:27:56.614594+00', true, 'mfa secret key', '["2b3c4d5e","f6a7e8e9"]', 'promo123', 3, 150000000000, 150000000000, 150000);
+
+INSERT INTO `users`(`id`, `full_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `signup_promo_code`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `project_segment_limit`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\342U\\303\\312\\202",', 'Heather Jackson', '762email@mail.test', '762EMAIL1@MAIL.TEST', B'some_readable_hash', 1, '2021-11-05 03:22:39.614594+00', true, 'mfa secret key', '["5e4d3c2b","e9e8a7f6"]', 'promo123', 3, 100000000000000, 25000000000000, 150000);
+
+INSERT INTO `users`(`id`, `full_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `signup_promo_code`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `project_segment_limit`) VALUES (B'\\364\\312\\033w\\222\\303Ci\\265\\342U\\303\\312\\202",', 'Michael Mint', '333email2@mail.test', '333EMAIL2@MAIL.TEST', B'some_readable_hash', 1, '2021-10-05 03:22:39.614594+00', true, 'mfa secret key', '["5e4d3c2c","e9e8a7f7"]', 'promo123', 3, 100000000000000, 25000000000000, 150000);
+
+INSERT INTO `oauth_clients`(`id`, `encrypted_secret`, `redirect_url`, `user_id`, `app_name`, `app_logo_url`) VALUES (B'FD6209C0-7A17-4FC3-895C-E57A6C7CBBE1', B'610B723B-E1FF-4B1D-B372-521250690C6E', 'https://example.test/callback/storj', B'\\364\\312\\033w\\222\\303Ci\\265\\342U\\303\\312\\202",', 'Example App', 'https://example.test/logo.png');
+
+INSERT INTO `oauth_codes`(`client_id`, `user_id`, `scope`, `redirect_url`, `challenge`, `challenge_method`, `code`, `created_at`, `expires_at`, `claimed_at`) VALUES (B'FD6209C0-7A17-4FC3-895C-E57A6C7CBBE1', B'\\364\\312\\033w\\222\\303Ci\\265\\342U\\303\\312\\202",', 'scope', 'http://localhost:12345/callback', 'challenge', 'challenge method', 'plaintext code', '2021-12-05 03:22:39.614594+00', '2021-12-05 03:22:39.614594+00', '2021-12-05 03:22:39.614594+00');
+
+INSERT INTO `oauth_tokens`(`client_id`, `user_id`, `scope`, `kind`, `token`, `created_at`, `expires_at`) VALUES (B'FD6209C0-7A17-4FC3-895C-E57A6C7CBBE1', B'\\364\\312\\033w\\222\\303Ci\\265\\342U\\303\\312\\202",', 'scope', 1, B'B9C93D5F-CBD7-4615-9184-E714CFE14365', '2021-12-05 03:22:39.614594+00', '2021-12-05 03:22:39.614594+00');
+
+INSERT INTO `coinpayments_transactions` (`id`, `user_id`, `address`, `amount_numeric`, `received_numeric`, `status`, `key`, `timeout`, `created_at`) VALUES ('different_tx_id_from_before', B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', 'address', 125419938429, 1, 1, 'key', 60, '2021-07-28 20:24:11.932313-05');
+INSERT INTO `stripecoinpayments_tx_conversion_rates` (`tx_id`, `rate_numeric`, `created_at`) VALUES ('different_tx_id_from_before', 3.14159265359, '2021-07-28 20:24:11.932313-05');
+
+INSERT INTO `webapp_sessions`(`id`, `user_id`, `ip_address`, `user_agent`, `status`, `expires_at`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '127.0.0.1', 'Firefox', 0, '2019-02-14 08:28:24.614594+00');
+
+INSERT INTO `users`(`id`, `full_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `signup_promo_code`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `project_segment_limit`, `verification_reminders`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\266\\344U\\304\\312\\205",', 'Felicia Smith', '1testemail1@mail.test', '1TESTEMAIL1@MAIL.TEST', B'some_readable_hash', 1, '2021-08-14 09:13:44.614594+00', true, 'mfa secret key', '["1a2b3c4d","e5f6d7h8"]', 'promo123', 3, 50000000000, 50000000000, 150000, 1);
+
+INSERT INTO `reputations`(`id`, `audit_success_count`, `total_audit_count`, `created_at`, `updated_at`, `disqualified`, `disqualification_reason`, `audit_reputation_alpha`, `audit_reputation_beta`, `unknown_audit_reputation_alpha`, `unknown_audit_reputation_beta`, `online_score`, `audit_history`) VALUES (B'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\002', 2, 5, '2022-04-20 04:20:59.028103+00', '2022-04-20 04:21:09.028103+00', '2022-04-20 04:22:09.028103+00', 3, 50, 0, 1, 0, 1, B'\\x0a\\x23\\x73\\x6f\\x2f\\x6d\\x61\\x6e\\x79\\x2f\\x69\\x63\\x6f\\x6e\\x69\\x63\\x2f\\x70\\x61\\x74\\x68\\x73\\x2f\\x74\\x6f\\x2f\\x63\\x68\\x6f\\x6f\\x73\\x65\\x2f\\x66\\x72\\x6f\\x6d\\x12\\x0a\\x01\\x02\\x03\\x04\\x05\\x06\\x07\\x08\\x09\\x0a');
+
+INSERT INTO `storjscan_wallets` (`user_id`, `wallet_address`, `created_at`) VALUES (B'\\363\\301\\032w\\222\\203Ci\\245\\342U\\304\\332\\202",', B'\\343\\301\\042w\\222\\263Ci\\245\\312U\\304\\312\\202",', '2021-07-28 20:04:11.932313+00');
+
+INSERT INTO `storjscan_payments` (`chain_id`, `block_hash`, `block_number`, `transaction`, `log_index`, `from_address`, `to_address`, `token_value`, `usd_value`, `status`, `block_timestamp`, `created_at`) VALUES (1, B'\\363\\301\\032w\\222\\203Ci\\245\\342U\\304\\332\\202",', 0, B'\\363\\301\\032w\\222\\203Ci\\245\\342U\\304\\332\\202",', 0, B'\\363\\301\\032w\\222\\203Ci\\245\\342U\\304\\332\\202",', B'\\363\\301\\032w\\222\\203Ci\\245\\342U\\304\\332\\202",', 1, 1, 'example', '2022-04-20 04:22:09.028103+00', '2022-04-20 04:22:09.028103+00');
+
+INSERT INTO `projects`(`id`, `public_id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `rate_limit`, `rate_limit_head`, `rate_limit_get`, `rate_limit_put`, `rate_limit_list`, `rate_limit_del`, `burst_limit`, `burst_limit_head`, `burst_limit_get`, `burst_limit_put`, `burst_limit_list`, `burst_limit_del`, `owner_id`, `created_at`, `max_buckets`, `segment_limit`) VALUES (B'300\\273|\\342N\\347\\347\\347\\342\\363\\371>+F\\251\\247', B'300\\273|\\342N\\347\\347\\363\\347\\363\\371>+F\\241\\247', 'Limit Test 2', 'This project is below the default', 500000000000, 500000000000, 2000000, 2000000, 2000000, 2000000, 2000000, 2000000, 4000000, 4000000, 4000000, 4000000, 4000000, 4000000, B'265\\343U\\303\\312\\312\\363\\311\\033w\\222\\303Ci",', '2020-10-14 10:10:11.000000+00', NULL, 150000);
+
+INSERT INTO `accounting_rollups`(`node_id`, `start_time`, `put_total`, `get_total`, `get_audit_total`, `get_repair_total`, `put_repair_total`, `at_rest_total`, `interval_end_time`) VALUES (B'\\367M\\177\\251]t/\\022\\256\\214\\265\\025\\224\\204:\\217\\212\\0102<\\321\\374\\020&\\271Qc\\325\\261\\354\\246\\233', '2019-02-10 00:00:00+00', 2875, 5750, 8635, 11500, 0, 14375, '2019-02-10 23:00:00+00');
+
+INSERT INTO `billing_transactions` (`id`, `user_id`, `amount`, `currency`, `description`, `source`, `status`, `type`, `metadata`, `tx_timestamp`, `created_at`) VALUES (1, B'\\363\\331\\032w\\212\\213Ci\\245\\322U\\314\\302\\202",', 113219736213, 'usd', 'some_description', 'some_source', 'some_status', 'some_type', JSON '{"Wallet": "0x1234", "ReferenceID": "0987654321"}', '2021-07-28 19:14:11.932313+00', '2021-07-28 19:34:11.932323+00');
+
+INSERT INTO `billing_balances` (`user_id`, `balance`, `last_updated`) VALUES (B'\\363\\331\\032w\\222\\203Ci\\245\\312U\\304\\322\\212",', 113219736213, '2021-07-28 19:34:11.932323+00');
+
+INSERT INTO `projects`(`id`, `public_id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `user_specified_usage_limit`, `user_specified_bandwidth_limit`, `rate_limit`, `rate_limit_head`, `rate_limit_get`, `rate_limit_put`, `rate_limit_list`, `rate_limit_del`, `burst_limit`, `burst_limit_head`, `burst_limit_get`, `burst_limit_put`, `burst_limit_list`, `burst_limit_del`, `owner_id`, `created_at`, `max_buckets`, `segment_limit`, `salt`) VALUES (B'300\\273|\\342N\\347\\347\\347\\342\\363\\371>+F\\252\\247', B'300\\273|\\342N\\347\\347\\363\\347\\363\\371>+F\\241\\247', 'Limit Test 2', 'This project is below the default', 500000000000, 500000000000, NULL, NULL, 2000000, 2000000, 2000000, 2000000, 2000000, 2000000, 4000000, 4000000, 4000000, 4000000, 4000000, 4000000, B'265\\343U\\303\\312\\312\\363\\311\\033w\\222\\303Ci",', '2020-10-14 10:10:11.000000+00', NULL, 150000, B'300\\273|\\342N\\347\\347\\347\\342\\363\\371>+F\\252\\247');
+
+INSERT INTO `users` (`id`, `full_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `signup_promo_code`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `project_segment_limit`, `verification_reminders`, `signup_captcha`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\266\\344U\\304\\312\\206",', 'Harold Smith', '1testemail206@mail.test', '1TESTEMAIL206@MAIL.TEST', B'some_readable_hash', 1, '2021-08-14 09:13:44.614594+00', true, 'mfa secret key', '["1a2b3c4d","e5f6d7h8"]', 'promo123', 3, 50000000000, 50000000000, 150000, 1, 1);
+
+INSERT INTO `reverification_audits` (`node_id`, `stream_id`, `position`, `piece_num`, `inserted_at`, `last_attempt`, `reverify_count`) VALUES (B'\\xe3\\xb0\\xc4\\x42\\x98\\xfc\\x1c\\x14\\x9a\\xfb\\xf4\\xc8\\x99\\x6f\\xb9\\x24\\x27\\xae\\x41\\xe4\\x64\\x9b\\x93\\x4c\\xa4\\x95\\x99\\x1b\\x78\\x52\\xb8\\x55', B'\\x01\\xba\\x47\\x19\\xc8\\x0b\\x6f\\xe9\\x11\\xb0\\x91\\xa7\\xc0\\x51\\x24\\xb6\\x4e\\xee\\xce\\x96\\x4e\\x09\\xc0\\x58\\xef\\x8f\\x98\\x05\\xda\\xca\\x54\\x6b', 1152921504606846976, 4, '2008-06-06 14:13:08.845574-07', '2009-08-23 02:19:52.922832-07', 5);
+
+INSERT INTO `node_events` (`id`, `email`, `node_id`, `event`, `created_at`, `email_sent`) VALUES (B'\\362\\341\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\017', 'test@storj.test', B'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\001', 1, '2019-02-14 08:28:24.614594+00', '2019-02-14 08:28:24.614594+00');
+
+INSERT INTO `verification_audits` (`inserted_at`, `stream_id`, `position`, `expires_at`, `encrypted_size`) VALUES ('2022-10-31 00:00:00.000000+00', B'\\xb5\\xbb\\x9d\\x80\\x14\\xa0\\xf9\\xb1\\xd6\\x1e\\x21\\xe7\\x96\\xd7\\x8d\\xcc\\xdf\\x13\\x52\\xf2\\x3c\\xd3\\x28\\x12\\xf4\\x85\\x0b\\x87\\x8a\\xe4\\x94\\x4c', 42949672970, NULL, 2147483647);
+INSERT INTO `verification_audits` (`inserted_at`, `stream_id`, `position`, `expires_at`, `encrypted_size`) VALUES ('2022-10-31 00:01:00.000000+00', B'\\x6e\\x96\\xe4\\x50\\x29\\x87\\x0a\\x9b\\x08\\xcf\\xf2\\xed\\x6a\\xc8\\x40\\xcc\\xde\\x3e\\xdc\\xe2\\x44\\x32\\x7c\\xc1\\xbd\\xde\\xfa\\x1e\\x55\\x5b\\xc8\\x1f', 450971566185, '2023-01-01 23:59:59.999999+13', 12);
+
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `wallet_features`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`,`created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`, `contained`) VALUES (B'\\342\\341\\363\\342>+F\\256\\263\\300\\273|\\342N\\347\\016', '127.0.0.1:55516', '', 0, '', '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2019-02-14 08:07:31.028103+00', '2019-02-14 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', NULL, NULL, false, '2022-06-14 05:07:31.108963+00');
+
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `wallet_features`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`,`created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`, `country_code`, `last_offline_email`) VALUES (B'\\362\\341\\363\\371>+F\\256\\263\\300\\273|\\342N\\345\\017', '127.0.0.1:55517', '', 0, '', '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2020-02-14 08:07:31.028103+00', '2021-10-13 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', '2021-10-13 08:07:31.108963+00', 0, false, NULL, '2021-10-13 08:07:31.108963+00');
+
+INSERT INTO `nodes`(`id`, `address`, `last_net`, `protocol`, `email`, `wallet`, `wallet_features`, `free_disk`, `piece_count`, `major`, `minor`, `patch`, `commit_hash`, `release_timestamp`, `release`,`latency_90`,`created_at`, `updated_at`, `last_contact_success`, `last_contact_failure`, `disqualified`, `disqualification_reason`, `exit_success`, `country_code`, `last_software_update_email`) VALUES (B'\\362\\341\\363\\371>+F\\256\\262\\300\\273|\\342N\\347\\017', '127.0.0.1:55517', '', 0, '', '', '', -1, 0, 0, 1, 0, '', '1970-01-01T00:00:00Z', false, 0, '2020-02-14 08:07:31.028103+00', '2021-10-13 08:07:31.108963+00', '1970-01-01T00:00:00Z', '1970-01-01T00:00:00Z', '2021-10-13 08:07:31.108963+00', 0, false, NULL, '2021-10-13 08:07:31.108963+00');
+
+INSERT INTO `node_events`(`id`, `email`, `node_id`, `event`, `created_at`, `last_attempted`, `email_sent`) VALUES(B'\\362\\341\\363\\371>+F\\256\\263\\300\\274|\\342N\\347\\017', 'test@storj.test', B'\\153\\313\\234\\074\\327\\177\\136\\070\\346\\001', 1, '2019-02-14 08:28:24.614594+00', '2020-02-14 08:28:24.614594+00', '2019-02-14 08:28:24.614594+00');
+
+INSERT INTO `account_freeze_events`(`user_id`, `event`, `limits`, `days_till_escalation`, `created_at`) VALUES(B'\\362\\341\\363\\371>+F\\256\\263\\300\\274|\\342N\\347\\017', 0, JSON '{"userLimits": {"storage": 100, "egress": 100}, "projectLimits": {"projectID0": {"storage": 100, "egress": 100}}}', 60, '2019-02-14 08:28:24.614594+00');
+
+INSERT INTO `user_settings`(`user_id`, `session_minutes`, `passphrase_prompt`, `onboarding_start`, `onboarding_end`, `onboarding_step`) VALUES(B'\\362\\341\\363\\371>+F\\256\\263\\300\\274|\\342N\\347\\017', 15, NULL, true, true, NULL);
+
+INSERT INTO `stripe_customers`(`user_id`, `customer_id`, `package_plan`, `purchased_package_at`, `created_at`) VALUES (B'\\363\\312\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', 'stripe_id0', 'package-name', '2023-03-22 15:34:07.123456+00','2019-06-01 08:28:24.267934+00');
+
+INSERT INTO `project_invitations`(`project_id`, `email`, `created_at`) VALUES (B'\\022\\217/\\014\\376!K\\023\\276\\031\\311}m\\236\\205\\300', '3EMAIL3@MAIL.TEST', '2023-04-24 00:00:00+00');
+INSERT INTO `project_invitations`(`project_id`, `email`, `inviter_id`, `created_at`) VALUES (B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\014', '3EMAIL3@MAIL.TEST', B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '2023-05-09 00:00:00+00');
+
+INSERT INTO `users`(`id`, `full_name`, `short_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `position`, `company_name`, `working_on`, `company_size`, `is_professional`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `paid_tier`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `project_segment_limit`, `default_placement`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\225\\211",', 'Angela', 'Berg', 'eu@mail.test', 'eu@MAIL.TEST', B'some_readable_hash', 2, '2020-05-16 10:28:24.614594+00', 'engineer', 'storj', 'data storage', 55, true, 10, 50000000000, 50000000000, false, false, NULL, NULL, 150000, 1);
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `max_buckets`, `owner_id`, `created_at`, `segment_limit`, `default_placement`, `default_versioning`) VALUES (B'\\363\\342\\363\\371>+F\\256\\263\\300\\273|\\342N\\347\\072', 'projName1', 'Test project 1', 500000000000, 500000000000, NULL, B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '2019-02-14 08:28:24.636949+00', 150000, 1, 1);
+
+INSERT INTO `node_tags`(`node_id`, `name`, `value`, `signed_at`, `signer`)VALUES (B'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\001', 'foo', B'\\xCA\\xFE\\xBA\\xBE','2023-04-24 00:00:00+00',B'\\x01\\x02\\x03');
+
+INSERT INTO `repair_queue` (`stream_id`, `position`, `attempted_at`, `segment_health`, `updated_at`, `inserted_at`, `placement`) VALUES (B'\\x02', 1, null, 1, '2020-09-01 00:00:00.000000+00', '2021-09-01 00:00:00.000000+00', 10);
+
+INSERT INTO `account_freeze_events`(`user_id`, `event`, `limits`, `days_till_escalation`, `created_at`) VALUES(B'\\362\\341\\363\\371>+F\\256\\263\\300\\274|\\342N\\347\\017', 1, JSON '{"userLimits": {"storage": 100, "egress": 100}, "projectLimits": {"projectID0": {"storage": 100, "egress": 100}}}', 15, '2019-02-14 08:28:24.614594+00');
+
+INSERT INTO `users`(`id`, `full_name`, `short_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `position`, `company_name`, `working_on`, `company_size`, `is_professional`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `paid_tier`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `project_segment_limit`, `default_placement`, `activation_code`, `signup_id`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\313\\225\\211",', 'Angela', 'Berg', 'eu@mail.test', 'eu@MAIL.TEST', B'some_readable_hash', 2, '2020-05-16 10:28:24.614594+00', 'engineer', 'storj', 'data storage', 55, true, 10, 50000000000, 50000000000, false, false, NULL, NULL, 150000, 1, '223432', 'H2Oqwerty');
+
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `max_buckets`, `owner_id`, `created_at`, `segment_limit`, `default_placement`, `default_versioning`) VALUES (B'\\233\\342\\363\\371>+F\\236\\263\\321\\273|\\312N\\147\\272', 'projName2', 'Test project 2', 500000000000, 500000000000, NULL, B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '2019-02-14 08:28:24.656949+00', 150000, 1, 1);
+
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `max_buckets`, `owner_id`, `created_at`, `segment_limit`, `default_placement`, `default_versioning`) VALUES (B'\\213\\342\\364\\371>+F\\236\\263\\311\\253|\\312N\\147\\272', 'projName3', 'Test project 3', 500000000000, 500000000000, NULL, B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '2019-02-14 08:28:24.676949+00', 150000, 1, 2);
+
+INSERT INTO `node_events`(`id`, `email`, `last_ip_port`, `node_id`, `event`, `created_at`, `last_attempted`, `email_sent`) VALUES(B'\\361\\341\\363\\371>+F\\256\\263\\300\\274|\\342N\\347\\017', 'test@storj.test', '127.0.0.1:1234', B'\\153\\313\\234\\074\\327\\177\\136\\070\\346\\001', 1, '2019-02-14 08:28:24.614594+00', '2020-02-14 08:28:24.614594+00', '2019-02-14 08:28:24.614594+00');
+
+INSERT INTO `user_settings`(`user_id`, `session_minutes`, `passphrase_prompt`, `onboarding_start`, `onboarding_end`, `onboarding_step`, `notice_dismissal`) VALUES(B'\\362\\341\\363\\371>+F\\256\\263\\300\\274|\\342N\\347\\022', 15, NULL, true, true, NULL, JSON '{"someNotice": true}');
+
+INSERT INTO `users`(`id`, `full_name`, `short_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `position`, `company_name`, `working_on`, `company_size`, `is_professional`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `paid_tier`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `project_segment_limit`, `default_placement`, `activation_code`, `signup_id`, `trial_notifications`, `trial_expiration`, `upgrade_time`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\314\\225\\211",', 'Angela', 'Berg', 'eu@mail.test', 'eu@MAIL.TEST', B'some_readable_hash', 2, '2020-05-16 10:28:24.614594+00', 'engineer', 'storj', 'data storage', 55, true, 10, 50000000000, 50000000000, false, false, NULL, NULL, 150000, 1, '223432', 'H2Oqwerty', 0, NULL, NUll);
+
+INSERT INTO `stripe_customers`(`user_id`, `customer_id`, `billing_customer_id`, `package_plan`, `purchased_package_at`, `created_at`) VALUES (B'\\361\\322\\033w\\232\\303Ci\\255\\343U\\303\\313\\205",', 'stripe_id1', 'stripe_id0', 'package-name', '2024-03-05 15:34:07.123456+00','2020-06-01 08:28:24.267934+00');
+
+INSERT INTO `api_keys` (`id`, `project_id`, `head`, `name`, `secret`, `created_at`, `created_by`) VALUES (B'\\334/\\302;\\225\\355O\\323\\276f\\247\\354/6\\241\\034', B'\\022\\217/\\014\\376!K\\023\\276\\031\\311}m\\236\\205\\300', B'\\111\\142\\147\\304\\132\\375\\070\\163\\270\\160\\251\\370\\126\\063\\351\\037\\257\\071\\143\\375\\351\\320\\253\\232\\220\\260\\075\\173\\306\\307\\115\\137', 'key 3', B'\\254\\011\\315\\333\\273\\365\\001\\071\\024\\154\\253\\332\\301\\216\\361\\074\\221\\367\\251\\231\\274\\333\\300\\367\\001\\272\\327\\111\\315\\123\\042\\016', '2019-02-14 08:28:24.267934+00', B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\314\\225\\211",');
+
+INSERT INTO `bucket_metainfos` (`id`, `project_id`, `name`, `versioning`, `created_at`, `path_cipher`, `default_segment_size`, `default_encryption_cipher_suite`, `default_encryption_block_size`, `default_redundancy_algorithm`, `default_redundancy_share_size`, `default_redundancy_required_shares`, `default_redundancy_repair_shares`, `default_redundancy_optimal_shares`, `default_redundancy_total_shares`, `placement`, `created_by`) VALUES (B'\\144/\\302;\\225\\355O\\323\\276f\\247\\354/6\\241\\034', B'\\022\\217/\\014\\376!K\\023\\276\\031\\311}m\\236\\205\\300', B'testbucketotheruniquename 1', 0, '2019-06-14 08:28:24.677953+00', 1, 65536, 1, 8192, 1, 4096, 4, 6, 8, 10, 1, B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\314\\225\\211",');
+
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `max_buckets`, `owner_id`, `created_at`, `segment_limit`, `default_placement`, `default_versioning`, `prompted_for_versioning_beta`, passphrase_enc, path_encryption) VALUES (B'\\361\\341\\363\\371>+F\\256\\263\\300\\274|\\342N\\347\\017', 'projName4', 'Test project 4', 500000000000, 500000000000, NULL, B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '2019-02-14 08:28:24.676949+00', 150000, 1, 2, false, null, true);
+
+INSERT INTO `account_freeze_events`(`user_id`, `event`, `limits`, `days_till_escalation`, `notifications_count`, `created_at`) VALUES(B'\\362\\341\\363\\371>+F\\256\\263\\300\\274|\\342N\\347\\017', 2, JSON '{"userLimits": {"storage": 100, "egress": 100}, "projectLimits": {"projectID0": {"storage": 100, "egress": 100}}}', 15, 2, '2019-02-14 08:28:24.614594+00');
+
+INSERT INTO `projects`(`id`, `name`, `description`, `usage_limit`, `bandwidth_limit`, `max_buckets`, `owner_id`, `created_at`, `segment_limit`, `default_placement`, `default_versioning`, `prompted_for_versioning_beta`, `passphrase_enc`, `path_encryption`, `passphrase_enc_key_id`) VALUES (B'\\361\\342\\363\\371>+F\\256\\263\\300\\274|\\342N\\347\\017', 'projName4', 'Test project 4', 500000000000, 500000000000, NULL, B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\312\\204",', '2019-02-14 08:28:24.676949+00', 150000, 1, 2, false, null, true, 1);
+
+INSERT INTO `users`(`id`, `full_name`, `short_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `position`, `company_name`, `working_on`, `company_size`, `is_professional`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `paid_tier`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `project_segment_limit`, `default_placement`, `activation_code`, `signup_id`, `trial_notifications`, `trial_expiration`, `upgrade_time`, `status_updated_at`, `final_invoice_generated`, `new_unverified_email`, `email_change_verification_step`) VALUES (B'\\363\\311\\033w\\222\\303Ci\\265\\343U\\303\\314\\225\\212",', 'Angela', 'Berg', 'eu@mail.test', 'eu@MAIL.TEST', B'some_readable_hash', 2, '2020-05-16 10:28:24.614594+00', 'engineer', 'storj', 'data storage', 55, true, 10, 50000000000, 50000000000, false, false, NULL, NULL, 150000, 1, '223432', 'H2Oqwerty', 0, NULL, NUll, '2024-01-01 00:01:02', true, null, 0);
+
+INSERT INTO `users`(`id`, `full_name`, `short_name`, `email`, `normalized_email`, `password_hash`, `status`, `created_at`, `position`, `company_name`, `working_on`, `company_size`, `is_professional`, `project_limit`, `project_bandwidth_limit`, `project_storage_limit`, `paid_tier`, `mfa_enabled`, `mfa_secret_key`, `mfa_recovery_codes`, `project_segment_limit`, `default_placement`, `activation_code`, `signup_id`, `trial_notifications`, `trial_expiration`, `upgrade_time`, `status_updated_at`, `final_invoice_generated`, `new_unverified_email`, `email_change_verification_step`, `external_id`) VALUES (B'\\363\\313\\033w\\222\\303Ci\\262\\343U\\303\\314\\225\\212",', 'Angela', 'Berg', 'eu@mail.test', 'eu@MAIL.TEST', B'some_readable_hash', 2, '2020-05-16 10:28:24.614594+00', 'engineer', 'storj', 'data storage', 55, true, 10, 50000000000, 50000000000, false, false, NULL, NULL, 150000, 1, '223432', 'H2Oqwerty', 0, NULL, NUll, '2024-01-01 00:01:02', true, null, 0, 'test:abc123');
+
+-- NEW DATA --
+
+INSERT INTO `bucket_replication_rules` (`project_id`, `bucket_name`, `destination_bucket_name`, `prefix`, `replicate_deletes`, `created_at`) VALUES (B'\\x0123456701234567', B'source', B'destination', B'photos/', true, '2025-01-01 00:00:00.000000+00');
+
+INSERT INTO `replication_jobs` (`project_id`, `bucket_name`, `object_key`, `version`, `stream_id`, `destination_bucket_name`, `action`, `status`, `destination_stream_id`, `destination_version`, `attempts`, `last_error`, `inserted_at`, `updated_at`) VALUES (B'\\x0123456701234567', B'source', B'photos/cat', 1, B'\\x01', B'destination', 1, 2, B'\\x02', 1, 1, 'failure', '2025-01-01 00:00:00.000000+00', '2025-01-01 00:00:00.000000+00');
@@ -19,8 +19,16 @@ import (
 
 const (
 	version1 = 1
+	// version2 filters cover only a piece ID prefix shard.
+	version2 = 2
 )
 
+// MaxShardBits is the maximum number of piece ID prefix bits used for sharding
+// a filter. The lowest three bits of the first piece ID byte are used by the
+// hash functions to select the bit within a table byte, hence they are not
+// available for sharding.
+const MaxShardBits = 5
+
 // rangeOffsets contains offsets for selecting subranges
 // that minimize overlap in the first hash functions.
 var rangeOffsets = [...]byte{9, 13, 19, 23}
@@ -30,11 +38,33 @@ func GenerateSeed() byte {
 	return byte(rand.Intn(255))
 }
 
+// Shard selects the piece IDs whose first Bits bits are equal to Index.
+//
+// The zero value selects all piece IDs.
+type Shard struct {
+	Bits  byte
+	Index byte
+}
+
+// Count returns the number of shards with the same number of bits.
+func (shard Shard) Count() int {
+	return 1 << shard.Bits
+}
+
+// Includes returns true if the piece ID belongs to the shard.
+func (shard Shard) Includes(pieceID storj.PieceID) bool {
+	if shard.Bits == 0 {
+		return true
+	}
+	return pieceID[0]>>(8-shard.Bits) == shard.Index
+}
+
 // Filter is a bloom filter implementation.
 type Filter struct {
 	seed      byte
 	hashCount byte
 	table     []byte
+	shard     Shard
 
 	offset      byte
 	rangeOffset byte
@@ -56,6 +86,15 @@ func NewExplicit(seed, hashCount byte, sizeInBytes int) *Filter {
 	}
 }
 
+// NewExplicitSharded returns a new filter with the explicit seed and parameters,
+// which only covers the piece IDs of the shard. Contains returns true for all
+// the piece IDs that don't belong to the shard.
+func NewExplicitSharded(seed, hashCount byte, sizeInBytes int, shard Shard) *Filter {
+	filter := NewExplicit(seed, hashCount, sizeInBytes)
+	filter.shard = shard
+	return filter
+}
+
 // NewOptimal returns a filter based on expected element count and false positive rate.
 func NewOptimal(expectedElements int64, falsePositiveRate float64) *Filter {
 	hashCount, sizeInBytes := OptimalParameters(expectedElements, falsePositiveRate, 0)
@@ -82,8 +121,18 @@ func (filter *Filter) SeedAndParameters() (seed, hashCount byte, size int) {
 	return filter.seed, filter.hashCount, len(filter.table)
 }
 
-// Add adds an element to the bloom filter.
+// Shard returns the shard of piece IDs covered by the filter.
+func (filter *Filter) Shard() Shard {
+	return filter.shard
+}
+
+// Add adds an element to the bloom filter. Elements outside of the filter
+// shard are ignored.
 func (filter *Filter) Add(pieceID storj.PieceID) {
+	if !filter.shard.Includes(pieceID) {
+		return
+	}
+
 	var id [len(pieceID) * 2]byte
 	copy(id[:], pieceID[:])
 	copy(id[len(pieceID):], pieceID[:])
@@ -97,8 +146,13 @@ func (filter *Filter) Add(pieceID storj.PieceID) {
 	}
 }
 
-// Contains return true if pieceID may be in the set.
+// Contains return true if pieceID may be in the set or if it isn't covered by
+// the filter shard.
 func (filter *Filter) Contains(pieceID storj.PieceID) bool {
+	if !filter.shard.Includes(pieceID) {
+		return true
+	}
+
 	var id [len(pieceID) * 2]byte
 	copy(id[:], pieceID[:])
 	copy(id[len(pieceID):], pieceID[:])
@@ -132,6 +186,8 @@ func (filter *Filter) AddFilter(operand *Filter) error {
 		return errs.New("cannot merge: mismatched hash count: expected %d but got %d", filter.hashCount, operand.hashCount)
 	case len(filter.table) != len(operand.table):
 		return errs.New("cannot merge: mismatched table size: expected %d but got %d", len(filter.table), len(operand.table))
+	case filter.shard != operand.shard:
+		return errs.New("cannot merge: mismatched shard: expected %v but got %v", filter.shard, operand.shard)
 	}
 	for i := 0; i < len(filter.table); i++ {
 		filter.table[i] |= operand.table[i]
@@ -146,14 +202,28 @@ func NewFromBytes(data []byte) (*Filter, error) {
 	if len(data) < 3 {
 		return nil, errs.New("not enough data")
 	}
-	if data[0] != version1 {
-		return nil, errs.New("unsupported version %d", data[0])
-	}
 
 	filter := &Filter{}
+	switch data[0] {
+	case version1:
+		filter.table = data[3:]
+	case version2:
+		if len(data) < 5 {
+			return nil, errs.New("not enough data")
+		}
+		filter.shard = Shard{Bits: data[3], Index: data[4]}
+		if filter.shard.Bits == 0 || filter.shard.Bits > MaxShardBits {
+			return nil, errs.New("invalid shard bits %d", filter.shard.Bits)
+		}
+		if int(filter.shard.Index) >= filter.shard.Count() {
+			return nil, errs.New("invalid shard index %d", filter.shard.Index)
+		}
+		filter.table = data[5:]
+	default:
+		return nil, errs.New("unsupported version %d", data[0])
+	}
 	filter.seed = data[1]
 	filter.hashCount = data[2]
-	filter.table = data[3:]
 
 	if filter.hashCount == 0 {
 		return nil, errs.New("invalid hash count %d", filter.hashCount)
@@ -175,17 +245,31 @@ func (filter *Filter) FillRate() float64 {
 }
 
 // Bytes encodes the filter into a sequence of bytes that can be transferred on network.
+//
+// Filters without a shard are encoded with the first version, so they can be
+// decoded by storage nodes which don't support sharded filters.
 func (filter *Filter) Bytes() []byte {
-	bytes := make([]byte, 1+1+1+len(filter.table))
-	bytes[0] = version1
+	bytes := make([]byte, filter.Size())
 	bytes[1] = filter.seed
 	bytes[2] = filter.hashCount
-	copy(bytes[3:], filter.table)
+	if filter.shard.Bits == 0 {
+		bytes[0] = version1
+		copy(bytes[3:], filter.table)
+		return bytes
+	}
+	bytes[0] = version2
+	bytes[3] = filter.shard.Bits
+	bytes[4] = filter.shard.Index
+	copy(bytes[5:], filter.table)
 	return bytes
 }
 
 // Size returns the size of Bytes call.
 func (filter *Filter) Size() int64 {
+	if filter.shard.Bits > 0 {
+		// the first five bytes represent the version, seed, hash count, shard bits and shard index
+		return int64(1 + 1 + 1 + 1 + 1 + len(filter.table))
+	}
 	// the first three bytes represent the version, seed, and hash count
 	return int64(1 + 1 + 1 + len(filter.table))
 }
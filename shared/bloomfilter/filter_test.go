@@ -55,6 +55,10 @@ func TestBytes_Failing(t *testing.T) {
 		{1},
 		{1, 0},
 		{255, 10, 10, 10},
+		{2, 10, 10},
+		{2, 10, 10, 0, 0},
+		{2, 10, 10, bloomfilter.MaxShardBits + 1, 0},
+		{2, 10, 10, 2, 4},
 	}
 	for _, bytes := range failing {
 		_, err := bloomfilter.NewFromBytes(bytes)
@@ -62,6 +66,47 @@ func TestBytes_Failing(t *testing.T) {
 	}
 }
 
+func TestSharded(t *testing.T) {
+	shard := bloomfilter.Shard{Bits: 2, Index: 1}
+	filter := bloomfilter.NewExplicitSharded(bloomfilter.GenerateSeed(), 3, 1000, shard)
+	require.Equal(t, shard, filter.Shard())
+	require.Equal(t, 4, shard.Count())
+
+	var included, excluded []storj.PieceID
+	for _, pieceID := range generateTestIDs(1000) {
+		if shard.Includes(pieceID) {
+			require.Equal(t, byte(1), pieceID[0]>>6)
+			included = append(included, pieceID)
+			filter.Add(pieceID)
+		} else {
+			excluded = append(excluded, pieceID)
+		}
+	}
+	require.NotEmpty(t, included)
+	require.NotEmpty(t, excluded)
+
+	unmarshaled, err := bloomfilter.NewFromBytes(filter.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, filter, unmarshaled)
+	require.Equal(t, int64(len(filter.Bytes())), filter.Size())
+
+	for _, pieceID := range append(included, excluded...) {
+		require.True(t, unmarshaled.Contains(pieceID))
+	}
+
+	var falsePositives int
+	for _, pieceID := range generateTestIDs(1000) {
+		if shard.Includes(pieceID) && unmarshaled.Contains(pieceID) {
+			falsePositives++
+		}
+	}
+	require.Less(t, falsePositives, 250)
+
+	seed, hashCount, size := filter.SeedAndParameters()
+	other := bloomfilter.NewExplicitSharded(seed, hashCount, size, bloomfilter.Shard{Bits: 2, Index: 2})
+	require.Error(t, filter.AddFilter(other))
+}
+
 // generateTestIDs generates n piece ids.
 func generateTestIDs(n int) []storj.PieceID {
 	ids := make([]storj.PieceID, n)
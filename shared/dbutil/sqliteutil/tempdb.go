@@ -0,0 +1,69 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sqliteutil
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/shared/dbutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// OpenUnique opens a temporary unique SQLite database file, which is removed
+// when the database is closed. The connection string specifies the directory
+// for the database file, e.g. "sqlite3://" uses the default temporary directory
+// and "sqlite3:///tmp/storj" uses /tmp/storj.
+//
+// Prefer calling "storj.io/storj/shared/dbutil/tempdb".OpenUnique() instead of
+// calling it directly.
+func OpenUnique(ctx context.Context, connstr string, namePrefix string) (*dbutil.TempDatabase, error) {
+	_, dir, impl, err := dbutil.SplitConnStr(connstr)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if impl != dbutil.SQLite3 {
+		return nil, errs.New("not a sqlite connection string: %q", connstr)
+	}
+	if dir == "" {
+		dir = os.TempDir()
+	}
+
+	file, err := os.CreateTemp(dir, strings.ReplaceAll(namePrefix, "/", "_")+"-*.db")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	path := file.Name()
+	if err := file.Close(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	db, err := tagsql.Open(ctx, "sqlite3", path)
+	if err != nil {
+		return nil, errs.Combine(err, os.Remove(path))
+	}
+
+	cleanup := func(tagsql.DB) error {
+		var group errs.Group
+		for _, suffix := range []string{"", "-wal", "-shm", "-journal"} {
+			if err := os.Remove(path + suffix); err != nil && !os.IsNotExist(err) {
+				group.Add(err)
+			}
+		}
+		return group.Err()
+	}
+
+	return &dbutil.TempDatabase{
+		DB:             db,
+		ConnStr:        "sqlite3://" + filepath.ToSlash(path),
+		Schema:         filepath.Base(path),
+		Driver:         "sqlite3",
+		Implementation: dbutil.SQLite3,
+		Cleanup:        cleanup,
+	}, nil
+}
@@ -13,6 +13,7 @@ import (
 	"storj.io/storj/shared/dbutil/cockroachutil"
 	"storj.io/storj/shared/dbutil/pgutil"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/sqliteutil"
 )
 
 // OpenUnique opens a temporary, uniquely named database (or isolated database schema)
@@ -27,5 +28,8 @@ func OpenUnique(ctx context.Context, connURL string, namePrefix string, spannerE
 	if strings.HasPrefix(connURL, "spanner://") {
 		return spannerutil.OpenUnique(ctx, connURL, namePrefix, spannerExtraStatements)
 	}
+	if strings.HasPrefix(connURL, "sqlite3://") {
+		return sqliteutil.OpenUnique(ctx, connURL, namePrefix)
+	}
 	return nil, errs.New("OpenUnique does not yet support the db type for %q", connURL)
 }
@@ -146,7 +146,8 @@ func (s *sqlDB) Name() string {
 		return PostgresName
 	case strings.Contains(driverType, "spanner"):
 		return SpannerName
-	case strings.Contains(driverType, "sqlite3.SQLiteDriver"):
+	case strings.Contains(driverType, "sqlite3.SQLiteDriver"),
+		strings.Contains(driverType, "sqlitedriver.Driver"):
 		return SqliteName
 	// only used by golang benchmark
 	case strings.Contains(driverType, "stdlib.Driver"):
@@ -18,6 +18,7 @@ import (
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/contact"
 	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/operator"
 	"storj.io/storj/storagenode/payouts/estimatedpayouts"
 	"storj.io/storj/storagenode/pricing"
@@ -59,6 +60,8 @@ type Service struct {
 
 	quicStats      *contact.QUICStats
 	configuredPort string
+
+	reachability *nodestats.ReachabilityStat
 }
 
 // NewService returns new instance of Service.
@@ -67,7 +70,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, version *checker.Servic
 	reputationDB reputation.DB, storageUsageDB storageusage.DB, pricingDB pricing.DB, satelliteDB satellites.DB,
 	pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service,
 	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats,
-	spaceReport monitor.SpaceReport) (*Service, error) {
+	spaceReport monitor.SpaceReport, reachability *nodestats.ReachabilityStat) (*Service, error) {
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -111,6 +114,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, version *checker.Servic
 		quicStats:      quicStats,
 		configuredPort: port,
 		spaceReport:    spaceReport,
+		reachability:   reachability,
 	}, nil
 }
 
@@ -236,6 +240,8 @@ type Satellite struct {
 	AuditHistory      reputation.AuditHistory `json:"auditHistory"`
 	PriceModel        PriceModel              `json:"priceModel"`
 	NodeJoinedAt      time.Time               `json:"nodeJoinedAt"`
+	// Reachability contains the transports the satellite could reach the node with.
+	Reachability []nodestats.TransportReachability `json:"reachability"`
 }
 
 // GetSatelliteData returns satellite related data.
@@ -314,6 +320,7 @@ func (s *Service) GetSatelliteData(ctx context.Context, satelliteID storj.NodeID
 		AuditHistory: reputation.GetAuditHistoryFromPB(rep.AuditHistory),
 		PriceModel:   satellitePricing,
 		NodeJoinedAt: rep.JoinedAt,
+		Reachability: s.reachability.Get(satelliteID),
 	}, nil
 }
 
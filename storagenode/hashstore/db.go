@@ -333,6 +333,32 @@ func (d *DB) Read(ctx context.Context, key Key) (_ *Reader, err error) {
 	return nil, Error.Wrap(fs.ErrNotExist)
 }
 
+// Range calls fn for every record in both stores of the database. Iteration stops early if fn
+// returns false or an error. A key may be passed to fn more than once.
+func (d *DB) Range(ctx context.Context, fn func(context.Context, Record) (bool, error)) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := signalError(&d.closed); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	first, second := d.active, d.passive
+	d.mu.Unlock()
+
+	stopped := false
+	wrapped := func(ctx context.Context, rec Record) (bool, error) {
+		ok, err := fn(ctx, rec)
+		stopped = !ok
+		return ok, err
+	}
+
+	if err := first.Range(ctx, wrapped); err != nil || stopped {
+		return err
+	}
+	return second.Range(ctx, wrapped)
+}
+
 // Compact waits for any background compaction to finish and then calls Compact on both stores.
 // After a call to Compact, you can be sure that each Store was fully compacted at least once.
 func (d *DB) Compact(ctx context.Context) (err error) {
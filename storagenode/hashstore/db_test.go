@@ -68,6 +68,32 @@ func TestDB_BasicOperation(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestDB_Range(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t, nil, nil)
+	defer db.Close()
+
+	keys := map[Key]bool{}
+	for i := 0; i < 100; i++ {
+		keys[db.AssertCreate()] = true
+	}
+
+	seen := map[Key]bool{}
+	assert.NoError(t, db.Range(ctx, func(ctx context.Context, rec Record) (bool, error) {
+		seen[rec.Key] = true
+		return true, nil
+	}))
+	assert.DeepEqual(t, seen, keys)
+
+	// returning false stops the iteration.
+	count := 0
+	assert.NoError(t, db.Range(ctx, func(ctx context.Context, rec Record) (bool, error) {
+		count++
+		return false, nil
+	}))
+	assert.Equal(t, count, 1)
+}
+
 func TestDB_TrashStats(t *testing.T) {
 	db := newTestDB(t, alwaysTrash, nil)
 	defer db.Close()
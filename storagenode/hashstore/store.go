@@ -524,6 +524,22 @@ func (s *Store) Read(ctx context.Context, key Key) (_ *Reader, err error) {
 	}
 }
 
+// Range calls fn for every record in the store in hash table order. Iteration stops early if fn
+// returns false or an error.
+func (s *Store) Range(ctx context.Context, fn func(context.Context, Record) (bool, error)) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := signalError(&s.closed); err != nil {
+		return err
+	}
+
+	// ensure that tbl and lfs are consistent.
+	s.rmu.RLock()
+	defer s.rmu.RUnlock()
+
+	return s.tbl.Range(ctx, fn)
+}
+
 func (s *Store) readerForRecord(ctx context.Context, rec Record, revive bool) (_ *Reader, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maintenance.proto
+
+package internalpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DeclareMaintenanceRequest struct {
+	// satellite_ids are the satellites to notify. All the trusted satellites are notified when it's empty.
+	SatelliteIds []NodeID `protobuf:"bytes,1,rep,name=satellite_ids,json=satelliteIds,proto3,customtype=NodeID" json:"satellite_ids"`
+	// start_time is the start of the maintenance. Zero time means now.
+	StartTime            time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	DurationSeconds      int64     `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *DeclareMaintenanceRequest) Reset()         { *m = DeclareMaintenanceRequest{} }
+func (m *DeclareMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeclareMaintenanceRequest) ProtoMessage()    {}
+func (*DeclareMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{0}
+}
+func (m *DeclareMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclareMaintenanceRequest.Unmarshal(m, b)
+}
+func (m *DeclareMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclareMaintenanceRequest.Marshal(b, m, deterministic)
+}
+func (m *DeclareMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclareMaintenanceRequest.Merge(m, src)
+}
+func (m *DeclareMaintenanceRequest) XXX_Size() int {
+	return xxx_messageInfo_DeclareMaintenanceRequest.Size(m)
+}
+func (m *DeclareMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclareMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeclareMaintenanceRequest proto.InternalMessageInfo
+
+func (m *DeclareMaintenanceRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *DeclareMaintenanceRequest) GetDurationSeconds() int64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+type DeclareMaintenanceResponse struct {
+	Statuses             []*MaintenanceStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeclareMaintenanceResponse) Reset()         { *m = DeclareMaintenanceResponse{} }
+func (m *DeclareMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*DeclareMaintenanceResponse) ProtoMessage()    {}
+func (*DeclareMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{1}
+}
+func (m *DeclareMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclareMaintenanceResponse.Unmarshal(m, b)
+}
+func (m *DeclareMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclareMaintenanceResponse.Marshal(b, m, deterministic)
+}
+func (m *DeclareMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclareMaintenanceResponse.Merge(m, src)
+}
+func (m *DeclareMaintenanceResponse) XXX_Size() int {
+	return xxx_messageInfo_DeclareMaintenanceResponse.Size(m)
+}
+func (m *DeclareMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclareMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeclareMaintenanceResponse proto.InternalMessageInfo
+
+func (m *DeclareMaintenanceResponse) GetStatuses() []*MaintenanceStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+type CancelMaintenanceRequest struct {
+	// satellite_ids are the satellites to notify. All the trusted satellites are notified when it's empty.
+	SatelliteIds         []NodeID `protobuf:"bytes,1,rep,name=satellite_ids,json=satelliteIds,proto3,customtype=NodeID" json:"satellite_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelMaintenanceRequest) Reset()         { *m = CancelMaintenanceRequest{} }
+func (m *CancelMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelMaintenanceRequest) ProtoMessage()    {}
+func (*CancelMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{2}
+}
+func (m *CancelMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMaintenanceRequest.Unmarshal(m, b)
+}
+func (m *CancelMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMaintenanceRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMaintenanceRequest.Merge(m, src)
+}
+func (m *CancelMaintenanceRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelMaintenanceRequest.Size(m)
+}
+func (m *CancelMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMaintenanceRequest proto.InternalMessageInfo
+
+type CancelMaintenanceResponse struct {
+	Statuses             []*MaintenanceStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CancelMaintenanceResponse) Reset()         { *m = CancelMaintenanceResponse{} }
+func (m *CancelMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelMaintenanceResponse) ProtoMessage()    {}
+func (*CancelMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{3}
+}
+func (m *CancelMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMaintenanceResponse.Unmarshal(m, b)
+}
+func (m *CancelMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMaintenanceResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMaintenanceResponse.Merge(m, src)
+}
+func (m *CancelMaintenanceResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelMaintenanceResponse.Size(m)
+}
+func (m *CancelMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMaintenanceResponse proto.InternalMessageInfo
+
+func (m *CancelMaintenanceResponse) GetStatuses() []*MaintenanceStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// MaintenanceStatus is the maintenance window accepted by a satellite.
+type MaintenanceStatus struct {
+	SatelliteId NodeID    `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	StartTime   time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime     time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// error is set when the request failed or it was rejected by the satellite.
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintenanceStatus) Reset()         { *m = MaintenanceStatus{} }
+func (m *MaintenanceStatus) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceStatus) ProtoMessage()    {}
+func (*MaintenanceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{4}
+}
+func (m *MaintenanceStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenanceStatus.Unmarshal(m, b)
+}
+func (m *MaintenanceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenanceStatus.Marshal(b, m, deterministic)
+}
+func (m *MaintenanceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceStatus.Merge(m, src)
+}
+func (m *MaintenanceStatus) XXX_Size() int {
+	return xxx_messageInfo_MaintenanceStatus.Size(m)
+}
+func (m *MaintenanceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceStatus proto.InternalMessageInfo
+
+func (m *MaintenanceStatus) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MaintenanceStatus) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *MaintenanceStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DeclareMaintenanceRequest)(nil), "storagenode.maintenance.DeclareMaintenanceRequest")
+	proto.RegisterType((*DeclareMaintenanceResponse)(nil), "storagenode.maintenance.DeclareMaintenanceResponse")
+	proto.RegisterType((*CancelMaintenanceRequest)(nil), "storagenode.maintenance.CancelMaintenanceRequest")
+	proto.RegisterType((*CancelMaintenanceResponse)(nil), "storagenode.maintenance.CancelMaintenanceResponse")
+	proto.RegisterType((*MaintenanceStatus)(nil), "storagenode.maintenance.MaintenanceStatus")
+}
+
+func init() { proto.RegisterFile("maintenance.proto", fileDescriptor_6053ae89a3b3f561) }
+
+var fileDescriptor_6053ae89a3b3f561 = []byte{
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xe7, 0x15, 0x46, 0xf7, 0xb5, 0x30, 0x6a, 0x21, 0x91, 0xe5, 0xd2, 0x28, 0x12, 0x22,
+	0x70, 0x70, 0xb4, 0xf4, 0x01, 0x90, 0xba, 0x09, 0x69, 0x07, 0x40, 0xca, 0x38, 0x71, 0xa9, 0xdc,
+	0xf8, 0x23, 0x0a, 0x4a, 0xed, 0x60, 0x3b, 0x37, 0x24, 0x5e, 0x61, 0x8f, 0xc4, 0x91, 0x67, 0xe0,
+	0x30, 0x24, 0x9e, 0x04, 0x25, 0x56, 0xb6, 0x48, 0x6d, 0x0e, 0x95, 0x7a, 0x8b, 0xfd, 0x7d, 0xff,
+	0xbf, 0x7f, 0xfe, 0x7f, 0x0e, 0xcc, 0x36, 0xbc, 0x90, 0x16, 0x25, 0x97, 0x19, 0xb2, 0x4a, 0x2b,
+	0xab, 0xe8, 0x4b, 0x63, 0x95, 0xe6, 0x39, 0x4a, 0x25, 0x90, 0xf5, 0xca, 0x3e, 0xe4, 0x2a, 0x57,
+	0xae, 0xc9, 0x9f, 0xe7, 0x4a, 0xe5, 0x25, 0xc6, 0xed, 0x6a, 0x5d, 0x7f, 0x8d, 0x6d, 0xb1, 0x41,
+	0x63, 0xf9, 0xa6, 0x72, 0x0d, 0xe1, 0x2f, 0x02, 0xe7, 0x57, 0x98, 0x95, 0x5c, 0xe3, 0x87, 0x07,
+	0x8f, 0x14, 0xbf, 0xd7, 0x68, 0x2c, 0x5d, 0xc0, 0x53, 0xc3, 0x2d, 0x96, 0x65, 0x61, 0x71, 0x55,
+	0x08, 0xe3, 0x91, 0x60, 0x14, 0x4d, 0x97, 0xcf, 0x7e, 0xdf, 0xcd, 0x8f, 0xfe, 0xdc, 0xcd, 0x4f,
+	0x3e, 0x2a, 0x81, 0xd7, 0x57, 0xe9, 0xf4, 0xbe, 0xe9, 0x5a, 0x18, 0x7a, 0x09, 0x60, 0x2c, 0xd7,
+	0x76, 0xd5, 0x9c, 0xe5, 0x1d, 0x07, 0x24, 0x9a, 0x24, 0x3e, 0x73, 0x20, 0xac, 0x03, 0x61, 0x9f,
+	0x3b, 0x90, 0xe5, 0xb8, 0x71, 0xbb, 0xfd, 0x3b, 0x27, 0xe9, 0x69, 0xab, 0x6b, 0x2a, 0xf4, 0x0d,
+	0x3c, 0x17, 0xb5, 0xe6, 0xb6, 0x50, 0x72, 0x65, 0x30, 0x53, 0x52, 0x18, 0x6f, 0x14, 0x90, 0x68,
+	0x94, 0x9e, 0x75, 0xfb, 0x37, 0x6e, 0x3b, 0x14, 0xe0, 0xef, 0xba, 0x81, 0xa9, 0x94, 0x34, 0x48,
+	0xdf, 0xc3, 0xd8, 0x58, 0x6e, 0x6b, 0x83, 0x8e, 0x7e, 0x92, 0xbc, 0x65, 0x03, 0xc9, 0xb1, 0x9e,
+	0xfe, 0xa6, 0xd5, 0xa4, 0xf7, 0xda, 0xf0, 0x13, 0x78, 0x97, 0x4d, 0xa1, 0x3c, 0x50, 0x4c, 0x61,
+	0x06, 0xe7, 0x3b, 0x0c, 0x0f, 0x4c, 0xfd, 0x8f, 0xc0, 0x6c, 0xab, 0x4e, 0x2f, 0x60, 0xda, 0xe7,
+	0xf5, 0x48, 0x40, 0x76, 0xe0, 0x4e, 0x7a, 0xb8, 0x87, 0x19, 0xea, 0x3b, 0x18, 0xa3, 0x14, 0xce,
+	0x62, 0xb4, 0x87, 0xc5, 0x13, 0x94, 0xa2, 0x35, 0x78, 0x01, 0x8f, 0x51, 0x6b, 0xa5, 0xbd, 0x47,
+	0x01, 0x89, 0x4e, 0x53, 0xb7, 0x48, 0x6e, 0x8f, 0xe1, 0xac, 0x61, 0xee, 0x5d, 0x94, 0xfe, 0x04,
+	0xba, 0xfd, 0x28, 0x68, 0x32, 0x18, 0xe2, 0xe0, 0x3f, 0xe0, 0x2f, 0xf6, 0xd2, 0xb8, 0xf9, 0x85,
+	0x47, 0xf4, 0x07, 0xcc, 0xb6, 0xc6, 0x4b, 0x2f, 0x06, 0xbd, 0x86, 0xde, 0x96, 0x9f, 0xec, 0x23,
+	0xe9, 0x4e, 0x5f, 0xbe, 0xfe, 0xf2, 0xaa, 0x91, 0x7d, 0x63, 0x85, 0x8a, 0xdb, 0x8f, 0xb8, 0xe7,
+	0x12, 0x37, 0x0a, 0x2d, 0x79, 0x59, 0xad, 0xd7, 0x27, 0x6d, 0xf0, 0x8b, 0xff, 0x03, 0x00, 0xaf,
+	0xf4, 0x72, 0xe3, 0x61, 0x04, 0x00, 0x00,
+}
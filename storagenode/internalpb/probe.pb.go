@@ -0,0 +1,276 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: probe.proto
+
+package internalpb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ProbeRequest struct {
+	// satellite_ids are the satellites to ask. All the trusted satellites are asked when it's empty.
+	SatelliteIds         []NodeID `protobuf:"bytes,1,rep,name=satellite_ids,json=satelliteIds,proto3,customtype=NodeID" json:"satellite_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbeRequest) Reset()         { *m = ProbeRequest{} }
+func (m *ProbeRequest) String() string { return proto.CompactTextString(m) }
+func (*ProbeRequest) ProtoMessage()    {}
+func (*ProbeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cc8551cf1a5c4f, []int{0}
+}
+func (m *ProbeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbeRequest.Unmarshal(m, b)
+}
+func (m *ProbeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbeRequest.Marshal(b, m, deterministic)
+}
+func (m *ProbeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeRequest.Merge(m, src)
+}
+func (m *ProbeRequest) XXX_Size() int {
+	return xxx_messageInfo_ProbeRequest.Size(m)
+}
+func (m *ProbeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeRequest proto.InternalMessageInfo
+
+type ProbeResponse struct {
+	Results              []*SatelliteProbeResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ProbeResponse) Reset()         { *m = ProbeResponse{} }
+func (m *ProbeResponse) String() string { return proto.CompactTextString(m) }
+func (*ProbeResponse) ProtoMessage()    {}
+func (*ProbeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cc8551cf1a5c4f, []int{1}
+}
+func (m *ProbeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbeResponse.Unmarshal(m, b)
+}
+func (m *ProbeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbeResponse.Marshal(b, m, deterministic)
+}
+func (m *ProbeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeResponse.Merge(m, src)
+}
+func (m *ProbeResponse) XXX_Size() int {
+	return xxx_messageInfo_ProbeResponse.Size(m)
+}
+func (m *ProbeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeResponse proto.InternalMessageInfo
+
+func (m *ProbeResponse) GetResults() []*SatelliteProbeResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// SatelliteProbeResult contains the results of the probe by a satellite.
+type SatelliteProbeResult struct {
+	SatelliteId NodeID                  `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	Transports  []*TransportProbeResult `protobuf:"bytes,2,rep,name=transports,proto3" json:"transports,omitempty"`
+	// error is set when the satellite couldn't be asked, or it refused the request.
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SatelliteProbeResult) Reset()         { *m = SatelliteProbeResult{} }
+func (m *SatelliteProbeResult) String() string { return proto.CompactTextString(m) }
+func (*SatelliteProbeResult) ProtoMessage()    {}
+func (*SatelliteProbeResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cc8551cf1a5c4f, []int{2}
+}
+func (m *SatelliteProbeResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteProbeResult.Unmarshal(m, b)
+}
+func (m *SatelliteProbeResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteProbeResult.Marshal(b, m, deterministic)
+}
+func (m *SatelliteProbeResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteProbeResult.Merge(m, src)
+}
+func (m *SatelliteProbeResult) XXX_Size() int {
+	return xxx_messageInfo_SatelliteProbeResult.Size(m)
+}
+func (m *SatelliteProbeResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteProbeResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatelliteProbeResult proto.InternalMessageInfo
+
+func (m *SatelliteProbeResult) GetTransports() []*TransportProbeResult {
+	if m != nil {
+		return m.Transports
+	}
+	return nil
+}
+
+func (m *SatelliteProbeResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// TransportProbeResult is the result of the probe over a single transport.
+type TransportProbeResult struct {
+	Transport      string `protobuf:"bytes,1,opt,name=transport,proto3" json:"transport,omitempty"`
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	PieceSize      int64  `protobuf:"varint,3,opt,name=piece_size,json=pieceSize,proto3" json:"piece_size,omitempty"`
+	DialMicros     int64  `protobuf:"varint,4,opt,name=dial_micros,json=dialMicros,proto3" json:"dial_micros,omitempty"`
+	UploadMicros   int64  `protobuf:"varint,5,opt,name=upload_micros,json=uploadMicros,proto3" json:"upload_micros,omitempty"`
+	DownloadMicros int64  `protobuf:"varint,6,opt,name=download_micros,json=downloadMicros,proto3" json:"download_micros,omitempty"`
+	// failed_step is the step where the probe failed. It's empty when the probe succeeded.
+	FailedStep           string   `protobuf:"bytes,7,opt,name=failed_step,json=failedStep,proto3" json:"failed_step,omitempty"`
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransportProbeResult) Reset()         { *m = TransportProbeResult{} }
+func (m *TransportProbeResult) String() string { return proto.CompactTextString(m) }
+func (*TransportProbeResult) ProtoMessage()    {}
+func (*TransportProbeResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cc8551cf1a5c4f, []int{3}
+}
+func (m *TransportProbeResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransportProbeResult.Unmarshal(m, b)
+}
+func (m *TransportProbeResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransportProbeResult.Marshal(b, m, deterministic)
+}
+func (m *TransportProbeResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransportProbeResult.Merge(m, src)
+}
+func (m *TransportProbeResult) XXX_Size() int {
+	return xxx_messageInfo_TransportProbeResult.Size(m)
+}
+func (m *TransportProbeResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransportProbeResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransportProbeResult proto.InternalMessageInfo
+
+func (m *TransportProbeResult) GetTransport() string {
+	if m != nil {
+		return m.Transport
+	}
+	return ""
+}
+
+func (m *TransportProbeResult) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TransportProbeResult) GetPieceSize() int64 {
+	if m != nil {
+		return m.PieceSize
+	}
+	return 0
+}
+
+func (m *TransportProbeResult) GetDialMicros() int64 {
+	if m != nil {
+		return m.DialMicros
+	}
+	return 0
+}
+
+func (m *TransportProbeResult) GetUploadMicros() int64 {
+	if m != nil {
+		return m.UploadMicros
+	}
+	return 0
+}
+
+func (m *TransportProbeResult) GetDownloadMicros() int64 {
+	if m != nil {
+		return m.DownloadMicros
+	}
+	return 0
+}
+
+func (m *TransportProbeResult) GetFailedStep() string {
+	if m != nil {
+		return m.FailedStep
+	}
+	return ""
+}
+
+func (m *TransportProbeResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ProbeRequest)(nil), "storagenode.probe.ProbeRequest")
+	proto.RegisterType((*ProbeResponse)(nil), "storagenode.probe.ProbeResponse")
+	proto.RegisterType((*SatelliteProbeResult)(nil), "storagenode.probe.SatelliteProbeResult")
+	proto.RegisterType((*TransportProbeResult)(nil), "storagenode.probe.TransportProbeResult")
+}
+
+func init() { proto.RegisterFile("probe.proto", fileDescriptor_f8cc8551cf1a5c4f) }
+
+var fileDescriptor_f8cc8551cf1a5c4f = []byte{
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x86, 0x97, 0x95, 0xb6, 0xe4, 0x24, 0x1d, 0xc2, 0xea, 0x45, 0x34, 0x81, 0x12, 0x05, 0xa1,
+	0xee, 0x2a, 0x15, 0xdb, 0x13, 0x30, 0x90, 0xd0, 0x24, 0x40, 0x28, 0xe5, 0x06, 0x6e, 0x22, 0x67,
+	0x3e, 0x54, 0x46, 0x26, 0x36, 0xb6, 0x23, 0xa4, 0xbd, 0x06, 0x2f, 0xc1, 0xa3, 0xf0, 0x0c, 0x5c,
+	0xec, 0x59, 0x50, 0x9c, 0xa6, 0xb5, 0xb4, 0xf4, 0xce, 0xfe, 0xcf, 0xf7, 0x3b, 0xbf, 0xfe, 0x1c,
+	0x88, 0x94, 0x96, 0x35, 0x16, 0x4a, 0x4b, 0x2b, 0xc9, 0x53, 0x63, 0xa5, 0xa6, 0x5b, 0x6c, 0x24,
+	0x73, 0x52, 0x8d, 0xe7, 0xb0, 0x95, 0x5b, 0xd9, 0x8f, 0xf3, 0x37, 0x10, 0x7f, 0xea, 0xc4, 0x12,
+	0x7f, 0xb6, 0x68, 0x2c, 0xb9, 0x82, 0x85, 0xa1, 0x16, 0x85, 0xe0, 0x16, 0x2b, 0xce, 0x4c, 0x12,
+	0x64, 0x93, 0x8b, 0xf8, 0xfa, 0xec, 0xef, 0x7d, 0x7a, 0xf2, 0xef, 0x3e, 0x9d, 0x7d, 0x94, 0x0c,
+	0x6f, 0xde, 0x96, 0xf1, 0x1e, 0xba, 0x61, 0x26, 0x2f, 0x61, 0xb1, 0x7b, 0xc4, 0x28, 0xd9, 0x18,
+	0x24, 0xaf, 0x61, 0xae, 0xd1, 0xb4, 0xc2, 0xf6, 0xfe, 0xe8, 0x72, 0x55, 0x3c, 0x88, 0x51, 0x6c,
+	0x86, 0x27, 0x06, 0x6f, 0x2b, 0x6c, 0x39, 0xf8, 0xf2, 0x3f, 0x01, 0x2c, 0xc7, 0x08, 0xf2, 0x0a,
+	0x62, 0x3f, 0x61, 0x12, 0x64, 0xc1, 0x48, 0xc0, 0xc8, 0x0b, 0x48, 0xde, 0x01, 0x58, 0x4d, 0x1b,
+	0xa3, 0xa4, 0xb6, 0x26, 0x39, 0x3d, 0x9a, 0xe8, 0xf3, 0x00, 0xf9, 0x89, 0x3c, 0x2b, 0x59, 0xc2,
+	0x14, 0xb5, 0x96, 0x3a, 0x99, 0x64, 0xc1, 0x45, 0x58, 0xf6, 0x97, 0xfc, 0xf7, 0x29, 0x2c, 0xc7,
+	0xac, 0xe4, 0x19, 0x84, 0x7b, 0xb3, 0xcb, 0x19, 0x96, 0x07, 0x81, 0x24, 0x30, 0xa7, 0x8c, 0x69,
+	0x34, 0x5d, 0xa4, 0x6e, 0x36, 0x5c, 0xc9, 0x73, 0x00, 0xc5, 0xf1, 0x16, 0x2b, 0xc3, 0xef, 0xd0,
+	0x7d, 0x6b, 0x52, 0x86, 0x4e, 0xd9, 0xf0, 0x3b, 0x24, 0x29, 0x44, 0x8c, 0x53, 0x51, 0xfd, 0xe0,
+	0xb7, 0x5a, 0x9a, 0xe4, 0x91, 0x9b, 0x43, 0x27, 0x7d, 0x70, 0x0a, 0x79, 0x01, 0x8b, 0x56, 0x09,
+	0x49, 0xd9, 0x80, 0x4c, 0x1d, 0x12, 0xf7, 0xe2, 0x0e, 0x5a, 0xc1, 0x13, 0x26, 0x7f, 0x35, 0x3e,
+	0x36, 0x73, 0xd8, 0xd9, 0x20, 0xef, 0xc0, 0x14, 0xa2, 0x6f, 0x94, 0x0b, 0x64, 0x95, 0xb1, 0xa8,
+	0x92, 0xb9, 0xcb, 0x0a, 0xbd, 0xb4, 0xb1, 0xa8, 0x0e, 0xad, 0x3c, 0xf6, 0x5a, 0xb9, 0xfc, 0x02,
+	0x61, 0xf7, 0x2f, 0x5c, 0x1f, 0xe4, 0x3d, 0x4c, 0xfb, 0x43, 0x3a, 0x52, 0xbb, 0xbf, 0x80, 0xe7,
+	0xd9, 0x71, 0xa0, 0x5f, 0xae, 0xfc, 0xe4, 0x7a, 0xf5, 0xf5, 0x65, 0x07, 0x7d, 0x2f, 0xb8, 0x5c,
+	0xbb, 0xc3, 0xda, 0xf3, 0xac, 0x79, 0x63, 0x51, 0x37, 0x54, 0xa8, 0xba, 0x9e, 0xb9, 0x25, 0xbf,
+	0xfa, 0x3f, 0x00, 0xf7, 0x43, 0x7c, 0xdc, 0x12, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.35-0.20240709171858-0075ac871661
+// source: probe.proto
+
+package internalpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_probe_proto struct{}
+
+func (drpcEncoding_File_probe_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_probe_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_probe_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_probe_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCNodeProbeClient interface {
+	DRPCConn() drpc.Conn
+
+	Probe(ctx context.Context, in *ProbeRequest) (*ProbeResponse, error)
+}
+
+type drpcNodeProbeClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCNodeProbeClient(cc drpc.Conn) DRPCNodeProbeClient {
+	return &drpcNodeProbeClient{cc}
+}
+
+func (c *drpcNodeProbeClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcNodeProbeClient) Probe(ctx context.Context, in *ProbeRequest) (*ProbeResponse, error) {
+	out := new(ProbeResponse)
+	err := c.cc.Invoke(ctx, "/storagenode.probe.NodeProbe/Probe", drpcEncoding_File_probe_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCNodeProbeServer interface {
+	Probe(context.Context, *ProbeRequest) (*ProbeResponse, error)
+}
+
+type DRPCNodeProbeUnimplementedServer struct{}
+
+func (s *DRPCNodeProbeUnimplementedServer) Probe(context.Context, *ProbeRequest) (*ProbeResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCNodeProbeDescription struct{}
+
+func (DRPCNodeProbeDescription) NumMethods() int { return 1 }
+
+func (DRPCNodeProbeDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/storagenode.probe.NodeProbe/Probe", drpcEncoding_File_probe_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeProbeServer).
+					Probe(
+						ctx,
+						in1.(*ProbeRequest),
+					)
+			}, DRPCNodeProbeServer.Probe, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterNodeProbe(mux drpc.Mux, impl DRPCNodeProbeServer) error {
+	return mux.Register(impl, DRPCNodeProbeDescription{})
+}
+
+type DRPCNodeProbe_ProbeStream interface {
+	drpc.Stream
+	SendAndClose(*ProbeResponse) error
+}
+
+type drpcNodeProbe_ProbeStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeProbe_ProbeStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcNodeProbe_ProbeStream) SendAndClose(m *ProbeResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_probe_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
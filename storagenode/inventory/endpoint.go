@@ -0,0 +1,133 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/storj/private/inventorypb"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the inventory package.
+	Error = errs.Class("inventory")
+)
+
+// ChunkSize is the maximum number of piece IDs sent in a single chunk.
+const ChunkSize = 10000
+
+// PieceWalker walks the pieces stored for a satellite.
+type PieceWalker interface {
+	WalkSatellitePieces(ctx context.Context, satellite storj.NodeID, fn func(storj.PieceID) error) error
+}
+
+// Endpoint lists the pieces the node holds in a piece ID range, so the
+// satellite can audit the node's piece inventory.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	inventorypb.DRPCPieceInventoryUnimplementedServer
+
+	log    *zap.Logger
+	ident  *identity.FullIdentity
+	trust  trust.TrustedSatelliteSource
+	pieces PieceWalker
+}
+
+// NewEndpoint creates a new piece inventory endpoint.
+func NewEndpoint(log *zap.Logger, ident *identity.FullIdentity, trust trust.TrustedSatelliteSource, pieces PieceWalker) *Endpoint {
+	return &Endpoint{
+		log:    log,
+		ident:  ident,
+		trust:  trust,
+		pieces: pieces,
+	}
+}
+
+// List sends the sorted piece IDs of the requested range in signed chunks.
+func (endpoint *Endpoint) List(req *inventorypb.ListRequest, stream inventorypb.DRPCPieceInventory_ListStream) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return rpcstatus.NamedWrap("no-peer", rpcstatus.Unauthenticated, err)
+	}
+	if err := endpoint.trust.VerifySatelliteID(ctx, peer.ID); err != nil {
+		return rpcstatus.NamedErrorf("untrusted-sat", rpcstatus.PermissionDenied, "piece inventory requested by untrusted ID")
+	}
+
+	var pieceIDs []storj.PieceID
+	err = endpoint.pieces.WalkSatellitePieces(ctx, peer.ID, func(pieceID storj.PieceID) error {
+		if inventorypb.InRange(pieceID, req.RangeStart, req.RangeEnd) {
+			pieceIDs = append(pieceIDs, pieceID)
+		}
+		return nil
+	})
+	if err != nil {
+		endpoint.log.Error("failed to list pieces", zap.Stringer("Satellite ID", peer.ID), zap.Error(err))
+		return rpcstatus.NamedWrap("walk-failed", rpcstatus.Internal, err)
+	}
+
+	sort.Slice(pieceIDs, func(i, k int) bool {
+		return bytes.Compare(pieceIDs[i][:], pieceIDs[k][:]) < 0
+	})
+	pieceIDs = compact(pieceIDs)
+
+	signer := signing.SignerFromFullIdentity(endpoint.ident)
+	listedAt := time.Now()
+	for sequence := 0; ; sequence++ {
+		n := min(len(pieceIDs), ChunkSize)
+		response, err := inventorypb.SignChunk(ctx, signer, &inventorypb.Chunk{
+			SatelliteId: peer.ID,
+			NodeId:      endpoint.ident.ID,
+			RangeStart:  req.RangeStart,
+			RangeEnd:    req.RangeEnd,
+			ListedAt:    listedAt,
+			Sequence:    int32(sequence),
+			Last:        n == len(pieceIDs),
+			PieceIds:    pieceIDs[:n],
+		})
+		if err != nil {
+			return rpcstatus.NamedWrap("sign-failed", rpcstatus.Internal, err)
+		}
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+
+		pieceIDs = pieceIDs[n:]
+		if len(pieceIDs) == 0 {
+			return nil
+		}
+	}
+}
+
+// compact removes consecutive duplicates from the sorted piece IDs.
+func compact(pieceIDs []storj.PieceID) []storj.PieceID {
+	if len(pieceIDs) == 0 {
+		return pieceIDs
+	}
+	k := 1
+	for _, pieceID := range pieceIDs[1:] {
+		if pieceID != pieceIDs[k-1] {
+			pieceIDs[k] = pieceID
+			k++
+		}
+	}
+	return pieceIDs[:k]
+}
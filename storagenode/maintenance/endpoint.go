@@ -0,0 +1,104 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package maintenance lets the node operator declare maintenance windows to the
+// satellites, when the node is not counted as offline.
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/nodestats"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	mon = monkit.Package()
+)
+
+// Endpoint implements private inspector for the maintenance windows.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	internalpb.DRPCNodeMaintenanceUnimplementedServer
+
+	log       *zap.Logger
+	trust     *trust.Pool
+	nodestats *nodestats.Service
+}
+
+// NewEndpoint creates a new maintenance endpoint.
+func NewEndpoint(log *zap.Logger, trust *trust.Pool, nodestats *nodestats.Service) *Endpoint {
+	return &Endpoint{
+		log:       log,
+		trust:     trust,
+		nodestats: nodestats,
+	}
+}
+
+// DeclareMaintenance declares a maintenance window to the satellites.
+func (e *Endpoint) DeclareMaintenance(ctx context.Context, req *internalpb.DeclareMaintenanceRequest) (_ *internalpb.DeclareMaintenanceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if req.DurationSeconds <= 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "duration should be positive")
+	}
+	duration := time.Duration(req.DurationSeconds) * time.Second
+
+	statuses, err := e.forEachSatellite(ctx, req.SatelliteIds, func(ctx context.Context, satelliteID storj.NodeID) (nodestats.MaintenanceWindow, error) {
+		return e.nodestats.DeclareMaintenance(ctx, satelliteID, req.StartTime, duration)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &internalpb.DeclareMaintenanceResponse{Statuses: statuses}, nil
+}
+
+// CancelMaintenance cancels the maintenance window declared to the satellites.
+func (e *Endpoint) CancelMaintenance(ctx context.Context, req *internalpb.CancelMaintenanceRequest) (_ *internalpb.CancelMaintenanceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statuses, err := e.forEachSatellite(ctx, req.SatelliteIds, e.nodestats.CancelMaintenance)
+	if err != nil {
+		return nil, err
+	}
+	return &internalpb.CancelMaintenanceResponse{Statuses: statuses}, nil
+}
+
+// forEachSatellite calls fn for each of the satellites (or all the trusted
+// satellites, when none is specified), and collects the results. Failures of
+// individual satellites are reported in the statuses.
+func (e *Endpoint) forEachSatellite(ctx context.Context, satelliteIDs []storj.NodeID, fn func(ctx context.Context, satelliteID storj.NodeID) (nodestats.MaintenanceWindow, error)) ([]*internalpb.MaintenanceStatus, error) {
+	if len(satelliteIDs) == 0 {
+		satelliteIDs = e.trust.GetSatellites(ctx)
+	} else {
+		for _, satelliteID := range satelliteIDs {
+			if !e.trust.IsTrusted(ctx, satelliteID) {
+				return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "satellite %s is not trusted", satelliteID)
+			}
+		}
+	}
+
+	statuses := make([]*internalpb.MaintenanceStatus, 0, len(satelliteIDs))
+	for _, satelliteID := range satelliteIDs {
+		status := &internalpb.MaintenanceStatus{SatelliteId: satelliteID}
+
+		window, err := fn(ctx, satelliteID)
+		if err != nil {
+			e.log.Warn("maintenance request failed", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+			status.Error = err.Error()
+		} else {
+			status.StartTime = window.Start
+			status.EndTime = window.End
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
@@ -15,12 +15,14 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/debug"
+	"storj.io/common/identity"
 	"storj.io/common/pb"
 	"storj.io/common/peertls/extensions"
 	"storj.io/common/peertls/tlsopts"
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/version"
+	"storj.io/storj/private/inventorypb"
 	"storj.io/storj/private/revocation"
 	"storj.io/storj/private/server"
 	"storj.io/storj/private/version/checker"
@@ -36,6 +38,7 @@ import (
 	"storj.io/storj/storagenode/contact"
 	"storj.io/storj/storagenode/hashstore"
 	"storj.io/storj/storagenode/healthcheck"
+	"storj.io/storj/storagenode/inventory"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/notifications"
@@ -367,6 +370,15 @@ func Module(ball *mud.Ball) {
 
 		mud.Provide[*piecestore.Endpoint](ball, piecestore.NewEndpoint)
 
+		mud.Provide[*inventory.Endpoint](ball, func(log *zap.Logger, ident *identity.FullIdentity, trustSource trust.TrustedSatelliteSource, backend *piecestore.OldPieceBackend, srv *server.Server) (*inventory.Endpoint, error) {
+			ep := inventory.NewEndpoint(log, ident, trustSource, backend)
+			if err := inventorypb.DRPCRegisterPieceInventory(srv.DRPC(), ep); err != nil {
+				return nil, err
+			}
+			return ep, nil
+		})
+		mud.Tag[*inventory.Endpoint, modular.Service](ball, modular.Service{})
+
 		mud.Provide[*orders.Service](ball, func(log *zap.Logger, ordersStore *orders.FileStore, trustSource trust.TrustedSatelliteSource, config orders.Config, tlsOptions *tlsopts.Options) *orders.Service {
 			// TODO workaround for custom timeout for order sending request (read/write)
 			dialer := rpc.NewDefaultDialer(tlsOptions)
@@ -25,9 +25,10 @@ import (
 
 // Config defines nodestats cache configuration.
 type Config struct {
-	MaxSleep       time.Duration `help:"maximum duration to wait before requesting data" releaseDefault:"300s" devDefault:"1s"`
-	ReputationSync time.Duration `help:"how often to sync reputation" releaseDefault:"4h" devDefault:"1m" deprecated:"use --reputation.interval" hidden:"true"`
-	StorageSync    time.Duration `help:"how often to sync storage" releaseDefault:"12h" devDefault:"2m"`
+	MaxSleep         time.Duration `help:"maximum duration to wait before requesting data" releaseDefault:"300s" devDefault:"1s"`
+	ReputationSync   time.Duration `help:"how often to sync reputation" releaseDefault:"4h" devDefault:"1m" deprecated:"use --reputation.interval" hidden:"true"`
+	StorageSync      time.Duration `help:"how often to sync storage" releaseDefault:"12h" devDefault:"2m"`
+	ReachabilitySync time.Duration `help:"how often to sync the transports the satellites can reach the node with" releaseDefault:"1h" devDefault:"1m"`
 }
 
 // CacheStorage encapsulates cache DBs.
@@ -48,10 +49,12 @@ type Cache struct {
 	payoutEndpoint *payouts.Endpoint
 	trust          *trust.Pool
 
-	maxSleep time.Duration
-	Storage  *sync2.Cycle
+	maxSleep     time.Duration
+	Storage      *sync2.Cycle
+	Reachability *sync2.Cycle
 
-	UsageStat *UsageStat
+	UsageStat        *UsageStat
+	ReachabilityStat *ReachabilityStat
 }
 
 // NewCache creates new caching service instance.
@@ -66,8 +69,10 @@ func NewCache(log *zap.Logger, config Config, db CacheStorage, service *Service,
 		trust:          trust,
 		maxSleep:       config.MaxSleep,
 		Storage:        sync2.NewCycle(config.StorageSync),
+		Reachability:   sync2.NewCycle(config.ReachabilitySync),
 
-		UsageStat: NewUsageStat(),
+		UsageStat:        NewUsageStat(),
+		ReachabilityStat: NewReachabilityStat(),
 	}
 	mon.Chain(cache.UsageStat)
 	return cache
@@ -129,6 +134,18 @@ func (cache *Cache) Run(ctx context.Context) error {
 
 		return nil
 	})
+	cache.Reachability.Start(ctx, &group, func(ctx context.Context) error {
+		if err := cache.sleep(ctx); err != nil {
+			return err
+		}
+
+		err := cache.CacheReachability(ctx)
+		if err != nil {
+			cache.log.Error("Get reachability query failed", zap.Error(err))
+		}
+
+		return nil
+	})
 
 	return group.Wait()
 }
@@ -239,6 +256,22 @@ func (cache *Cache) CacheHeldAmount(ctx context.Context) (err error) {
 	})
 }
 
+// CacheReachability queries the transports each of the satellites can reach
+// the node with, and keeps them in memory.
+func (cache *Cache) CacheReachability(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cache.satelliteLoop(ctx, func(satellite storj.NodeID) error {
+		transports, err := cache.service.GetReachability(ctx, satellite)
+		if err != nil {
+			return err
+		}
+
+		cache.ReachabilityStat.Update(satellite, transports)
+		return nil
+	})
+}
+
 // sleep for random interval in [0;maxSleep)
 // returns error if context was cancelled.
 func (cache *Cache) sleep(ctx context.Context) error {
@@ -275,5 +308,6 @@ func (cache *Cache) satelliteLoop(ctx context.Context, fn func(id storj.NodeID)
 func (cache *Cache) Close() error {
 	defer mon.Task()(nil)(nil)
 	cache.Storage.Close()
+	cache.Reachability.Close()
 	return nil
 }
@@ -0,0 +1,41 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestats
+
+import (
+	"sync"
+
+	"golang.org/x/exp/slices"
+
+	"storj.io/common/storj"
+)
+
+// ReachabilityStat caches the transports each satellite could reach the node
+// with during the last check-in.
+type ReachabilityStat struct {
+	mu         sync.Mutex
+	transports map[storj.NodeID][]TransportReachability
+}
+
+// NewReachabilityStat initializes a ReachabilityStat.
+func NewReachabilityStat() *ReachabilityStat {
+	return &ReachabilityStat{
+		transports: make(map[storj.NodeID][]TransportReachability),
+	}
+}
+
+// Update updates the cached transports of the satellite.
+func (r *ReachabilityStat) Update(satellite storj.NodeID, transports []TransportReachability) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.transports[satellite] = slices.Clone(transports)
+}
+
+// Get returns the cached transports of the satellite, nil if they are not
+// known yet.
+func (r *ReachabilityStat) Get(satellite storj.NodeID) []TransportReachability {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.transports[satellite])
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestats_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+)
+
+func TestCacheReachability(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		cache := node.NodeStats.Cache
+		cache.Reachability.Pause()
+
+		require.NoError(t, cache.CacheReachability(ctx))
+		require.Nil(t, cache.ReachabilityStat.Get(testrand.NodeID()))
+
+		transports := cache.ReachabilityStat.Get(satellite.ID())
+		require.Len(t, transports, 3)
+		for _, transport := range transports {
+			if transport.Transport == "tcp" {
+				require.True(t, transport.Reachable)
+				require.NotNil(t, transport.LastSuccess)
+			}
+		}
+	})
+}
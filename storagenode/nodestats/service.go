@@ -5,6 +5,7 @@ package nodestats
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -14,6 +15,8 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/nodestatspb"
 	"storj.io/storj/storagenode/pricing"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
@@ -32,6 +35,11 @@ var (
 type Client struct {
 	conn *rpc.Conn
 	pb.DRPCNodeStatsClient
+
+	auditHistory nodestatspb.DRPCAuditHistoryClient
+	maintenance  nodestatspb.DRPCNodeMaintenanceClient
+	probe        nodestatspb.DRPCNodeProbeClient
+	reachability nodestatspb.DRPCNodeReachabilityClient
 }
 
 // Close closes underlying client connection.
@@ -39,6 +47,50 @@ func (c *Client) Close() error {
 	return c.conn.Close()
 }
 
+// AuditOutcome is the outcome of a single audit of the node as recorded by
+// the satellite.
+type AuditOutcome struct {
+	StreamID uuid.UUID
+	// Part and Index are the position of the audited segment in the object.
+	Part          uint32
+	Index         uint32
+	Outcome       string
+	ReverifyCount int
+	CreatedAt     time.Time
+}
+
+// MaintenanceWindow is the maintenance window of the node accepted by the
+// satellite. It's zero when there is no window.
+type MaintenanceWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ProbeResult is the result of the end-to-end test of the node by the
+// satellite over a single transport.
+type ProbeResult struct {
+	Transport string
+	Address   string
+	PieceSize int64
+
+	Dial     time.Duration
+	Upload   time.Duration
+	Download time.Duration
+
+	// FailedStep is the step where the probe failed, it's empty on success.
+	FailedStep string
+	Error      string
+}
+
+// TransportReachability is the reachability of the node with a single
+// transport as seen by the satellite during the last check-in.
+type TransportReachability struct {
+	Transport string `json:"transport"`
+	Reachable bool   `json:"reachable"`
+	// LastSuccess is nil when the satellite never reached the node with the transport.
+	LastSuccess *time.Time `json:"lastSuccess"`
+}
+
 // Service retrieves info from satellites using an rpc client.
 //
 // architecture: Service
@@ -100,6 +152,138 @@ func (s *Service) GetPricingModel(ctx context.Context, satelliteID storj.NodeID)
 	}, nil
 }
 
+// GetAuditHistory returns up to limit of the most recent audit outcomes of the
+// node recorded by a particular satellite, newest first.
+func (s *Service) GetAuditHistory(ctx context.Context, satelliteID storj.NodeID, limit int) (_ []AuditOutcome, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := s.dial(ctx, satelliteID)
+	if err != nil {
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	resp, err := client.auditHistory.GetAuditHistory(ctx, &nodestatspb.GetAuditHistoryRequest{Limit: int32(limit)})
+	if err != nil {
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+
+	outcomes := make([]AuditOutcome, 0, len(resp.GetOutcomes()))
+	for _, outcome := range resp.GetOutcomes() {
+		streamID, err := uuid.FromBytes(outcome.StreamId)
+		if err != nil {
+			return nil, NodeStatsServiceErr.Wrap(err)
+		}
+		outcomes = append(outcomes, AuditOutcome{
+			StreamID:      streamID,
+			Part:          uint32(outcome.Position >> 32),
+			Index:         uint32(outcome.Position),
+			Outcome:       strings.ToLower(outcome.Outcome.String()),
+			ReverifyCount: int(outcome.ReverifyCount),
+			CreatedAt:     outcome.CreatedAt,
+		})
+	}
+	return outcomes, nil
+}
+
+// DeclareMaintenance declares a maintenance window to a particular satellite.
+func (s *Service) DeclareMaintenance(ctx context.Context, satelliteID storj.NodeID, start time.Time, duration time.Duration) (_ MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := s.dial(ctx, satelliteID)
+	if err != nil {
+		return MaintenanceWindow{}, NodeStatsServiceErr.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	resp, err := client.maintenance.DeclareMaintenance(ctx, &nodestatspb.DeclareMaintenanceRequest{
+		StartTime:       start,
+		DurationSeconds: int64(duration / time.Second),
+	})
+	if err != nil {
+		return MaintenanceWindow{}, NodeStatsServiceErr.Wrap(err)
+	}
+
+	return fromMaintenanceWindow(resp.GetWindow()), nil
+}
+
+// CancelMaintenance cancels the maintenance window declared to a particular satellite.
+func (s *Service) CancelMaintenance(ctx context.Context, satelliteID storj.NodeID) (_ MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := s.dial(ctx, satelliteID)
+	if err != nil {
+		return MaintenanceWindow{}, NodeStatsServiceErr.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	resp, err := client.maintenance.CancelMaintenance(ctx, &nodestatspb.CancelMaintenanceRequest{})
+	if err != nil {
+		return MaintenanceWindow{}, NodeStatsServiceErr.Wrap(err)
+	}
+
+	return fromMaintenanceWindow(resp.GetWindow()), nil
+}
+
+// Probe asks a particular satellite to upload a test piece to the node and to
+// download it back.
+func (s *Service) Probe(ctx context.Context, satelliteID storj.NodeID) (_ []ProbeResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := s.dial(ctx, satelliteID)
+	if err != nil {
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	resp, err := client.probe.Probe(ctx, &nodestatspb.ProbeRequest{})
+	if err != nil {
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+
+	results := make([]ProbeResult, 0, len(resp.GetResults()))
+	for _, result := range resp.GetResults() {
+		results = append(results, ProbeResult{
+			Transport:  result.Transport,
+			Address:    result.Address,
+			PieceSize:  result.PieceSize,
+			Dial:       time.Duration(result.DialMicros) * time.Microsecond,
+			Upload:     time.Duration(result.UploadMicros) * time.Microsecond,
+			Download:   time.Duration(result.DownloadMicros) * time.Microsecond,
+			FailedStep: result.FailedStep,
+			Error:      result.Error,
+		})
+	}
+	return results, nil
+}
+
+// GetReachability returns the transports a particular satellite could reach
+// the node with.
+func (s *Service) GetReachability(ctx context.Context, satelliteID storj.NodeID) (_ []TransportReachability, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := s.dial(ctx, satelliteID)
+	if err != nil {
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	resp, err := client.reachability.GetReachability(ctx, &nodestatspb.GetReachabilityRequest{})
+	if err != nil {
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+
+	transports := make([]TransportReachability, 0, len(resp.GetTransports()))
+	for _, transport := range resp.GetTransports() {
+		transports = append(transports, TransportReachability{
+			Transport:   transport.Transport,
+			Reachable:   transport.Reachable,
+			LastSuccess: transport.LastSuccess,
+		})
+	}
+	return transports, nil
+}
+
 // dial dials the NodeStats client for the satellite by id.
 func (s *Service) dial(ctx context.Context, satelliteID storj.NodeID) (_ *Client, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -117,9 +301,24 @@ func (s *Service) dial(ctx context.Context, satelliteID storj.NodeID) (_ *Client
 	return &Client{
 		conn:                conn,
 		DRPCNodeStatsClient: pb.NewDRPCNodeStatsClient(conn),
+		auditHistory:        nodestatspb.NewDRPCAuditHistoryClient(conn),
+		maintenance:         nodestatspb.NewDRPCNodeMaintenanceClient(conn),
+		probe:               nodestatspb.NewDRPCNodeProbeClient(conn),
+		reachability:        nodestatspb.NewDRPCNodeReachabilityClient(conn),
 	}, nil
 }
 
+// fromMaintenanceWindow converts the PB maintenance window, nil is converted to zero window.
+func fromMaintenanceWindow(window *nodestatspb.MaintenanceWindow) MaintenanceWindow {
+	if window == nil {
+		return MaintenanceWindow{}
+	}
+	return MaintenanceWindow{
+		Start: window.StartTime,
+		End:   window.EndTime,
+	}
+}
+
 // fromSpaceUsageResponse get DiskSpaceUsage slice from pb.SpaceUsageResponse.
 func fromSpaceUsageResponse(resp *pb.DailyStorageUsageResponse, satelliteID storj.NodeID) []storageusage.Stamp {
 	var stamps []storageusage.Stamp
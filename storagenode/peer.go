@@ -28,6 +28,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/version"
 	"storj.io/storj/private/emptyfs"
+	"storj.io/storj/private/inventorypb"
 	"storj.io/storj/private/lifecycle"
 	"storj.io/storj/private/multinodepb"
 	"storj.io/storj/private/server"
@@ -46,6 +47,8 @@ import (
 	"storj.io/storj/storagenode/healthcheck"
 	"storj.io/storj/storagenode/inspector"
 	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/inventory"
+	"storj.io/storj/storagenode/maintenance"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/multinode"
 	"storj.io/storj/storagenode/nodestats"
@@ -61,6 +64,7 @@ import (
 	"storj.io/storj/storagenode/piecestore/usedserials"
 	"storj.io/storj/storagenode/preflight"
 	"storj.io/storj/storagenode/pricing"
+	"storj.io/storj/storagenode/probe"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/satellites"
@@ -151,6 +155,8 @@ type Config struct {
 	GracefulExit gracefulexit.Config
 
 	ForgetSatellite forgetsatellite.Config
+
+	Probe probe.Config
 }
 
 // DatabaseConfig returns the storagenodedb.Config that should be used with this Config.
@@ -310,12 +316,25 @@ type Peer struct {
 		BlobsCleaner *gracefulexit.BlobsCleaner
 	}
 
+	Inventory struct {
+		Endpoint *inventory.Endpoint
+	}
+
 	ForgetSatellite struct {
 		Endpoint *forgetsatellite.Endpoint
 		Chore    *forgetsatellite.Chore
 		Cleaner  *forgetsatellite.Cleaner
 	}
 
+	Maintenance struct {
+		Endpoint *maintenance.Endpoint
+	}
+
+	Probe struct {
+		Endpoint *probe.Endpoint
+		Chore    *probe.Chore
+	}
+
 	Notifications struct {
 		Service *notifications.Service
 	}
@@ -841,6 +860,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		})
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Node Stats Cache Storage", peer.NodeStats.Cache.Storage))
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Node Stats Cache Reachability", peer.NodeStats.Cache.Reachability))
 	}
 
 	{ // setup estimation service
@@ -874,6 +895,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			port,
 			peer.Contact.QUICStats,
 			peer.Storage2.SpaceReport,
+			peer.NodeStats.Cache.ReachabilityStat,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -925,6 +947,18 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		}
 	}
 
+	{ // setup piece inventory
+		peer.Inventory.Endpoint = inventory.NewEndpoint(
+			process.NamedLog(peer.Log, "inventory:endpoint"),
+			peer.Identity,
+			peer.Storage2.Trust,
+			peer.Storage2.MigratingBackend,
+		)
+		if err := inventorypb.DRPCRegisterPieceInventory(peer.Server.DRPC(), peer.Inventory.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
 	{ // setup graceful exit service
 		peer.GracefulExit.Service = gracefulexit.NewService(
 			process.NamedLog(peer.Log, "gracefulexit:service"),
@@ -1008,6 +1042,44 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			debug.Cycle("Forget Satellite", peer.ForgetSatellite.Chore.Loop))
 	}
 
+	{ // setup maintenance
+		peer.Maintenance.Endpoint = maintenance.NewEndpoint(
+			process.NamedLog(peer.Log, "maintenance:endpoint"),
+			peer.Storage2.Trust,
+			peer.NodeStats.Service,
+		)
+		if err := internalpb.DRPCRegisterNodeMaintenance(peer.Server.PrivateDRPC(), peer.Maintenance.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
+	{ // setup probe
+		peer.Probe.Endpoint = probe.NewEndpoint(
+			process.NamedLog(peer.Log, "probe:endpoint"),
+			peer.Storage2.Trust,
+			peer.NodeStats.Service,
+		)
+		if err := internalpb.DRPCRegisterNodeProbe(peer.Server.PrivateDRPC(), peer.Probe.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		if config.Probe.Interval > 0 {
+			peer.Probe.Chore = probe.NewChore(
+				process.NamedLog(peer.Log, "probe:chore"),
+				peer.Storage2.Trust,
+				peer.NodeStats.Service,
+				config.Probe,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "probe:chore",
+				Run:   peer.Probe.Chore.Run,
+				Close: peer.Probe.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Probe", peer.Probe.Chore.Loop))
+		}
+	}
+
 	peer.StorageOld.Collector = collector.NewService(
 		process.NamedLog(peer.Log, "collector"),
 		peer.StorageOld.Store,
@@ -312,6 +312,22 @@ func (hsb *HashStoreBackend) StartRestore(ctx context.Context, satellite storj.N
 	return hsb.rtm.SetRestoreTime(ctx, satellite, time.Now())
 }
 
+// WalkSatellitePieces calls fn for every piece of the satellite which is not in the trash.
+func (hsb *HashStoreBackend) WalkSatellitePieces(ctx context.Context, satellite storj.NodeID, fn func(storj.PieceID) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	db, err := hsb.getDB(ctx, satellite)
+	if err != nil {
+		return err
+	}
+	return db.Range(ctx, func(ctx context.Context, rec hashstore.Record) (bool, error) {
+		if rec.Expires.Trash() {
+			return true, nil
+		}
+		return true, fn(rec.Key)
+	})
+}
+
 type hashStoreWriter struct {
 	writer *hashstore.Writer
 	size   int64
@@ -476,6 +492,15 @@ func (opb *OldPieceBackend) StartRestore(ctx context.Context, satellite storj.No
 	return opb.trashChore.StartRestore(ctx, satellite)
 }
 
+// WalkSatellitePieces calls fn for every piece of the satellite which is not in the trash.
+func (opb *OldPieceBackend) WalkSatellitePieces(ctx context.Context, satellite storj.NodeID, fn func(storj.PieceID) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return opb.store.WalkSatellitePieces(ctx, satellite, func(access pieces.StoredPieceAccess) error {
+		return fn(access.PieceID())
+	})
+}
+
 type oldPieceWriter struct {
 	*pieces.Writer
 	store       *pieces.Store
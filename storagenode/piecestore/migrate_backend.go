@@ -187,3 +187,15 @@ func (m *MigratingBackend) StartRestore(ctx context.Context, satellite storj.Nod
 		m.old.StartRestore(ctx, satellite),
 	)
 }
+
+// WalkSatellitePieces calls fn for every piece of the satellite in both backends. The old backend
+// is walked first so that pieces migrated during the walk are not missed. A piece may be passed
+// to fn more than once.
+func (m *MigratingBackend) WalkSatellitePieces(ctx context.Context, satellite storj.NodeID, fn func(storj.PieceID) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := m.old.WalkSatellitePieces(ctx, satellite, fn); err != nil {
+		return err
+	}
+	return m.new.WalkSatellitePieces(ctx, satellite, fn)
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package probe
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/nodestats"
+	"storj.io/storj/storagenode/trust"
+)
+
+// Config defines the config for the probe chore.
+type Config struct {
+	Interval time.Duration `help:"how often to ask the satellites to probe the node, failures are logged as warnings (0 disables)" default:"0"`
+}
+
+// Chore periodically asks the trusted satellites to probe the node.
+//
+// architecture: Chore
+type Chore struct {
+	log       *zap.Logger
+	trust     *trust.Pool
+	nodestats *nodestats.Service
+
+	Loop *sync2.Cycle
+}
+
+// NewChore instantiates a new probe chore.
+func NewChore(log *zap.Logger, trust *trust.Pool, nodestats *nodestats.Service, config Config) *Chore {
+	return &Chore{
+		log:       log,
+		trust:     trust,
+		nodestats: nodestats,
+		Loop:      sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the probe chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, chore.RunOnce)
+}
+
+// RunOnce asks each of the trusted satellites to probe the node.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, satelliteID := range chore.trust.GetSatellites(ctx) {
+		_ = probeSatellite(ctx, chore.log, chore.nodestats, satelliteID)
+	}
+	return nil
+}
+
+// Close stops the probe chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
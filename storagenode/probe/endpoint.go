@@ -0,0 +1,102 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package probe lets the node operator test whether the satellites can upload
+// to and download from the node, not only reach it.
+package probe
+
+import (
+	"context"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/nodestats"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	mon = monkit.Package()
+)
+
+// Endpoint implements private inspector for probing the node.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	internalpb.DRPCNodeProbeUnimplementedServer
+
+	log       *zap.Logger
+	trust     *trust.Pool
+	nodestats *nodestats.Service
+}
+
+// NewEndpoint creates a new probe endpoint.
+func NewEndpoint(log *zap.Logger, trust *trust.Pool, nodestats *nodestats.Service) *Endpoint {
+	return &Endpoint{
+		log:       log,
+		trust:     trust,
+		nodestats: nodestats,
+	}
+}
+
+// Probe asks the satellites to upload a test piece to the node and to
+// download it back.
+func (e *Endpoint) Probe(ctx context.Context, req *internalpb.ProbeRequest) (_ *internalpb.ProbeResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satelliteIDs := req.SatelliteIds
+	if len(satelliteIDs) == 0 {
+		satelliteIDs = e.trust.GetSatellites(ctx)
+	} else {
+		for _, satelliteID := range satelliteIDs {
+			if !e.trust.IsTrusted(ctx, satelliteID) {
+				return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "satellite %s is not trusted", satelliteID)
+			}
+		}
+	}
+
+	resp := &internalpb.ProbeResponse{}
+	for _, satelliteID := range satelliteIDs {
+		resp.Results = append(resp.Results, probeSatellite(ctx, e.log, e.nodestats, satelliteID))
+	}
+	return resp, nil
+}
+
+// probeSatellite asks the satellite to probe the node. Failures are logged and
+// reported in the result.
+func probeSatellite(ctx context.Context, log *zap.Logger, service *nodestats.Service, satelliteID storj.NodeID) *internalpb.SatelliteProbeResult {
+	log = log.With(zap.Stringer("Satellite ID", satelliteID))
+	result := &internalpb.SatelliteProbeResult{SatelliteId: satelliteID}
+
+	transports, err := service.Probe(ctx, satelliteID)
+	if err != nil {
+		log.Warn("probe request failed", zap.Error(err))
+		result.Error = err.Error()
+		return result
+	}
+
+	for _, transport := range transports {
+		if transport.FailedStep != "" {
+			log.Warn("satellite failed to probe the node",
+				zap.String("Transport", transport.Transport),
+				zap.String("Address", transport.Address),
+				zap.String("Step", transport.FailedStep),
+				zap.String("Error", transport.Error))
+		}
+
+		result.Transports = append(result.Transports, &internalpb.TransportProbeResult{
+			Transport:      transport.Transport,
+			Address:        transport.Address,
+			PieceSize:      transport.PieceSize,
+			DialMicros:     transport.Dial.Microseconds(),
+			UploadMicros:   transport.Upload.Microseconds(),
+			DownloadMicros: transport.Download.Microseconds(),
+			FailedStep:     transport.FailedStep,
+			Error:          transport.Error,
+		})
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package probe_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode/internalpb"
+)
+
+func TestEndpoint_Probe(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 2, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+
+		t.Run("all trusted satellites", func(t *testing.T) {
+			resp, err := node.Probe.Endpoint.Probe(ctx, &internalpb.ProbeRequest{})
+			require.NoError(t, err)
+			require.Len(t, resp.Results, len(planet.Satellites))
+
+			for _, result := range resp.Results {
+				require.Empty(t, result.Error)
+				require.Len(t, result.Transports, 2)
+				for _, transport := range result.Transports {
+					require.Empty(t, transport.FailedStep, transport.Error)
+					require.Equal(t, node.Addr(), transport.Address)
+					require.Positive(t, transport.UploadMicros)
+					require.Positive(t, transport.DownloadMicros)
+				}
+			}
+		})
+
+		t.Run("single satellite", func(t *testing.T) {
+			resp, err := node.Probe.Endpoint.Probe(ctx, &internalpb.ProbeRequest{
+				SatelliteIds: []storj.NodeID{planet.Satellites[1].ID()},
+			})
+			require.NoError(t, err)
+			require.Len(t, resp.Results, 1)
+			require.Equal(t, planet.Satellites[1].ID(), resp.Results[0].SatelliteId)
+			require.Empty(t, resp.Results[0].Error)
+		})
+
+		t.Run("untrusted satellite", func(t *testing.T) {
+			_, err := node.Probe.Endpoint.Probe(ctx, &internalpb.ProbeRequest{
+				SatelliteIds: []storj.NodeID{testrand.NodeID()},
+			})
+			require.Error(t, err)
+			require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+		})
+	})
+}
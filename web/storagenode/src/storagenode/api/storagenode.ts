@@ -9,6 +9,7 @@ import {
     Satellites,
     SatelliteScores,
     Traffic,
+    TransportReachability,
 } from '@/storagenode/sno/sno';
 import { HttpClient } from '@/storagenode/utils/httpClient';
 
@@ -85,6 +86,11 @@ export class StorageNodeApi {
             data.ingressSummary,
             audits,
             new Date(data.nodeJoinedAt),
+            (data.reachability || []).map(transport => new TransportReachability(
+                transport.transport,
+                transport.reachable,
+                transport.lastSuccess ? new Date(transport.lastSuccess) : null,
+            )),
         );
     }
 
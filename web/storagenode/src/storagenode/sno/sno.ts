@@ -112,6 +112,18 @@ export class Satellite {
         public ingressSummary: number = 0,
         public audits: SatelliteScores = new SatelliteScores(),
         public joinDate: Date = new Date(),
+        public reachability: TransportReachability[] = [],
+    ) {}
+}
+
+/**
+ * TransportReachability shows whether the satellite could reach the node with the transport during the last check-in.
+ */
+export class TransportReachability {
+    public constructor(
+        public transport: string = '',
+        public reachable: boolean = false,
+        public lastSuccess: Date | null = null,
     ) {}
 }
 